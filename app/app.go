@@ -57,6 +57,33 @@ var gaslessMsgTypes = []string{
 	"/lumen.gateway.v1.MsgClaimPayment",
 	"/lumen.gateway.v1.MsgCancelContract",
 	"/lumen.gateway.v1.MsgFinalizeContract",
+	"/lumen.gateway.v1.MsgBindDomain",
+	"/lumen.gateway.v1.MsgUnbindDomain",
+	"/lumen.gateway.v1.MsgSubmitUsageReport",
+	"/lumen.gateway.v1.MsgAcknowledgeUsageReport",
+	"/lumen.gateway.v1.MsgDisputeUsageReport",
+	"/lumen.gateway.v1.MsgOpenDispute",
+	"/lumen.gateway.v1.MsgSubmitDisputeEvidence",
+	"/lumen.gateway.v1.MsgResolveDispute",
+	"/lumen.gateway.v1.MsgBondGateway",
+	"/lumen.gateway.v1.MsgUnbondGateway",
+	"/lumen.gateway.v1.MsgWithdrawBond",
+	"/lumen.gateway.v1.MsgCreateOffer",
+	"/lumen.gateway.v1.MsgRetireOffer",
+	"/lumen.gateway.v1.MsgExtendContract",
+	"/lumen.gateway.v1.MsgAmendContract",
+	"/lumen.gateway.v1.MsgAcceptAmendment",
+	"/lumen.gateway.v1.MsgClaimAll",
+	"/lumen.gateway.v1.MsgAcceptContract",
+	"/lumen.gateway.v1.MsgRejectContract",
+	"/lumen.gateway.v1.MsgSetGatewayDenoms",
+	"/lumen.gateway.v1.MsgTransferGateway",
+	"/lumen.gateway.v1.MsgAcceptGatewayTransfer",
+	"/lumen.gateway.v1.MsgDecommissionGateway",
+	"/lumen.gateway.v1.MsgMigrateContract",
+	"/lumen.gateway.v1.MsgGatewayHeartbeat",
+	"/lumen.gateway.v1.MsgTransferContract",
+	"/lumen.gateway.v1.MsgApproveContractTransfer",
 
 	"/lumen.dns.v1.MsgRegister",
 	"/lumen.dns.v1.MsgRenew",
@@ -64,6 +91,8 @@ var gaslessMsgTypes = []string{
 	"/lumen.dns.v1.MsgUpdate",
 	"/lumen.dns.v1.MsgBid",
 	"/lumen.dns.v1.MsgSettle",
+	"/lumen.dns.v1.MsgLockDomain",
+	"/lumen.dns.v1.MsgUnlockDomain",
 }
 
 // GaslessMsgTypes exposes the currently whitelisted gasless message URLs.
@@ -178,6 +207,7 @@ func New(
 
 	app.DnsKeeper.SetBankKeeper(app.BankKeeper)
 	app.DnsKeeper.SetAccountKeeper(app.AuthKeeper)
+	app.DnsKeeper.SetHooks(app.GatewaysKeeper.DnsHooks())
 	app.PqcKeeper.SetAuthority(mustModuleAuthority(govtypes.ModuleName))
 	baseAppOptions = append(baseAppOptions, baseapp.SetOptimisticExecution())
 
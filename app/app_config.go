@@ -56,8 +56,9 @@ var (
 		{Account: dnsmoduletypes.ModuleName},
 		{Account: releasemoduletypes.ModuleName},
 		{Account: govtypes.ModuleName, Permissions: []string{authtypes.Burner}},
-		{Account: gatewaysmoduletypes.ModuleAccountTreasury},
+		{Account: gatewaysmoduletypes.ModuleAccountTreasury, Permissions: []string{authtypes.Burner}},
 		{Account: gatewaysmoduletypes.ModuleAccountEscrow},
+		{Account: gatewaysmoduletypes.ModuleAccountBond},
 		{Account: tokenomicsmoduletypes.ModuleName, Permissions: []string{authtypes.Minter}},
 		{Account: minttypes.ModuleName, Permissions: []string{authtypes.Minter}},
 		{Account: ibctransfertypes.ModuleName, Permissions: []string{authtypes.Minter, authtypes.Burner}},
@@ -73,6 +74,7 @@ var (
 		stakingtypes.NotBondedPoolName,
 		tokenomicsmoduletypes.ModuleName,
 		ibctransfertypes.ModuleName,
+		dnsmoduletypes.ModuleName,
 	}
 
 	immutableAuthority = mustModuleAuthority(immutableAuthorityModuleName)
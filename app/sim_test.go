@@ -28,6 +28,8 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
+
+	dnskeeper "lumen/x/dns/keeper"
 )
 
 const (
@@ -43,6 +45,14 @@ func init() {
 	flag.BoolVar(&FlagEnableSimulation, "EnableSimulation", false, "enable full application simulations")
 }
 
+// requireDnsInvariants runs the x/dns invariants against the last committed
+// state.
+func requireDnsInvariants(tb testing.TB, app *App) {
+	tb.Helper()
+	msg, broken := dnskeeper.AllInvariants(app.DnsKeeper)(app.GetContextForCheckTx(nil))
+	require.False(tb, broken, msg)
+}
+
 func fauxMerkleModeOpt(bapp *baseapp.BaseApp) {
 	bapp.SetFauxMerkleMode()
 }
@@ -139,6 +149,7 @@ func TestFullAppSimulation(t *testing.T) {
 	err = simtestutil.CheckExportSimulation(app, config, simParams)
 	require.NoError(t, err)
 	require.NoError(t, simErr)
+	requireDnsInvariants(t, app)
 
 	if config.Commit {
 		simtestutil.PrintStats(db)
@@ -181,6 +192,7 @@ func TestAppImportExport(t *testing.T) {
 	err = simtestutil.CheckExportSimulation(bApp, config, simParams)
 	require.NoError(t, err)
 	require.NoError(t, simErr)
+	requireDnsInvariants(t, bApp)
 
 	if config.Commit {
 		simtestutil.PrintStats(db)
@@ -295,6 +307,7 @@ func TestAppSimulationAfterImport(t *testing.T) {
 	err = simtestutil.CheckExportSimulation(bApp, config, simParams)
 	require.NoError(t, err)
 	require.NoError(t, simErr)
+	requireDnsInvariants(t, bApp)
 
 	if config.Commit {
 		simtestutil.PrintStats(db)
@@ -341,6 +354,7 @@ func TestAppSimulationAfterImport(t *testing.T) {
 		bApp.AppCodec(),
 	)
 	require.NoError(t, err)
+	requireDnsInvariants(t, newApp)
 }
 
 func TestAppStateDeterminism(t *testing.T) {
@@ -484,15 +484,22 @@ func newGatewayRegisterCmd() *cobra.Command {
 				return err
 			}
 
+			profile, err := gatewayProfileFromFlags(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+
 			msg := &gatewaytypes.MsgRegisterGateway{
 				Operator: clientCtx.GetFromAddress().String(),
 				Payout:   payout,
 				Metadata: metadata,
+				Profile:  profile,
 			}
 			return pqctxext.GenerateOrBroadcastTxCLI(cmd, clientCtx, cmd.Flags(), msg)
 		},
 	}
-	cmd.Flags().String("metadata", "", "Optional metadata string (<=512 chars)")
+	cmd.Flags().String("metadata", "", "Optional metadata string (<=1024 chars)")
+	cmd.Flags().String("profile", "", gatewayProfileFlagUsage)
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -525,6 +532,10 @@ func newGatewayUpdateCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			autoClaim, err := cmd.Flags().GetBool("auto-claim")
+			if err != nil {
+				return err
+			}
 
 			msg := &gatewaytypes.MsgUpdateGateway{
 				Operator:  clientCtx.GetFromAddress().String(),
@@ -539,6 +550,12 @@ func newGatewayUpdateCmd() *cobra.Command {
 			if cmd.Flags().Changed("active") {
 				msg.Active = &gogotypes.BoolValue{Value: active}
 			}
+			if cmd.Flags().Changed("auto-claim") {
+				msg.AutoClaim = &gogotypes.BoolValue{Value: autoClaim}
+			}
+			if msg.Profile, err = gatewayProfileFromFlags(cmd, clientCtx); err != nil {
+				return err
+			}
 
 			return pqctxext.GenerateOrBroadcastTxCLI(cmd, clientCtx, cmd.Flags(), msg)
 		},
@@ -546,10 +563,28 @@ func newGatewayUpdateCmd() *cobra.Command {
 	cmd.Flags().String("payout", "", "Optional payout address override")
 	cmd.Flags().String("metadata", "", "Optional metadata update")
 	cmd.Flags().Bool("active", false, "Set active flag (requires explicit flag)")
+	cmd.Flags().Bool("auto-claim", false, "Let the chain claim due payouts automatically (requires explicit flag)")
+	cmd.Flags().String("profile", "", gatewayProfileFlagUsage+"; replaces the current profile")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
 
+const gatewayProfileFlagUsage = `Optional JSON profile, e.g. '{"endpoints":[{"host":"gateway.lumen","protocol":"GATEWAY_PROTOCOL_IPFS"}],` +
+	`"regions":["eu-west"],"protocols":["GATEWAY_PROTOCOL_IPFS"],"capacity":{"storage_gb":1000},"contact":"ops@gateway.lumen"}'; ` +
+	`endpoint hosts must be domains bound to the gateway`
+
+func gatewayProfileFromFlags(cmd *cobra.Command, clientCtx client.Context) (*gatewaytypes.GatewayProfile, error) {
+	raw, err := cmd.Flags().GetString("profile")
+	if err != nil || strings.TrimSpace(raw) == "" {
+		return nil, err
+	}
+	var profile gatewaytypes.GatewayProfile
+	if err := clientCtx.Codec.UnmarshalJSON([]byte(raw), &profile); err != nil {
+		return nil, fmt.Errorf("parse --profile: %w", err)
+	}
+	return &profile, nil
+}
+
 func newGatewayCreateContractCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "create-contract [gateway-id] [price-ulmn] [storage-gb] [network-gb] [months-total]",
@@ -585,6 +620,14 @@ func newGatewayCreateContractCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			offerID, err := cmd.Flags().GetUint64("offer-id")
+			if err != nil {
+				return err
+			}
+			payDenom, err := cmd.Flags().GetString("denom")
+			if err != nil {
+				return err
+			}
 
 			msg := &gatewaytypes.MsgCreateContract{
 				Client:            clientCtx.GetFromAddress().String(),
@@ -594,11 +637,15 @@ func newGatewayCreateContractCmd() *cobra.Command {
 				NetworkGbPerMonth: network,
 				MonthsTotal:       uint32(months),
 				Metadata:          metadata,
+				OfferId:           offerID,
+				Denom:             payDenom,
 			}
 			return pqctxext.GenerateOrBroadcastTxCLI(cmd, clientCtx, cmd.Flags(), msg)
 		},
 	}
 	cmd.Flags().String("metadata", "", "Optional metadata string")
+	cmd.Flags().Uint64("offer-id", 0, "Create the contract from a gateway offer (price/quotas of 0 take the offer's)")
+	cmd.Flags().String("denom", "", "Pay in a whitelisted denom the gateway accepts, e.g. ibc/... (default ulmn)")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
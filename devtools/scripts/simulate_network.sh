@@ -301,6 +301,10 @@ prepare_genesis() {
 		| .app_state.gateways.params.finalize_delay_months = 0
 		| .app_state.gateways.params.finalizer_reward_bps = 500
 		| .app_state.gateways.params.min_price_ulmn_per_month = "100"
+		| .app_state.gateways.params.min_bond_ulmn = "0"
+		| .app_state.gateways.params.bond_per_client_ulmn = "0"
+		| .app_state.gateways.params.usage_dispute_window_seconds = "0"
+		| .app_state.gateways.params.acceptance_timeout_seconds = "0"
 		| .app_state.gateways.gateways = [{
 			id:"1",
 			operator:$gw,
@@ -216,6 +216,10 @@ init_chain() {
     | .app_state.gateways.params.finalize_delay_months = 0
     | .app_state.gateways.params.finalizer_reward_bps = 500
     | .app_state.gateways.params.min_price_ulmn_per_month = "100"
+    | .app_state.gateways.params.min_bond_ulmn = "0"
+    | .app_state.gateways.params.bond_per_client_ulmn = "0"
+    | .app_state.gateways.params.usage_dispute_window_seconds = "0"
+    | .app_state.gateways.params.acceptance_timeout_seconds = "0"
     | .app_state.gateways.params.max_active_contracts_per_gateway = 10
     | .app_state.gateways.gateways = [
         {
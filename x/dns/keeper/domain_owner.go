@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"lumen/x/dns/types"
+)
+
+// DomainOwner returns the owner of name ("domain.ext") and whether the
+// registration is currently active, i.e. not in grace, auction or free.
+func (k Keeper) DomainOwner(ctx context.Context, name string) (string, bool, error) {
+	domain, ext, ok := splitName(name)
+	if !ok {
+		return "", false, types.ErrInvalidFqdn
+	}
+	domain, ext = types.NormalizeDomainParts(domain, ext)
+	if err := types.ValidateDomainParts(domain, ext); err != nil {
+		return "", false, err
+	}
+	dom, err := k.Domain.Get(ctx, domain+"."+ext)
+	if err != nil {
+		return "", false, types.ErrInvalidFqdn
+	}
+	status, err := k.DomainStatus(ctx, dom)
+	if err != nil {
+		return "", false, err
+	}
+	return dom.Owner, status == "active", nil
+}
+
+// DomainStatus returns the lifecycle phase of dom at the current block time:
+// "active", "grace", "auction" or "free".
+func (k Keeper) DomainStatus(ctx context.Context, dom types.Domain) (string, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return "", err
+	}
+	return lifecycleStatus(k.nowSec(ctx), dom.ExpireAt, params.GraceDays, params.AuctionDays), nil
+}
+
+// splitName splits "domain.ext" at its last dot.
+func splitName(name string) (string, string, bool) {
+	idx := strings.LastIndex(name, ".")
+	if idx <= 0 || idx == len(name)-1 {
+		return "", "", false
+	}
+	return name[:idx], name[idx+1:], true
+}
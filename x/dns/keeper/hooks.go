@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"context"
+
+	"lumen/x/dns/types"
+)
+
+// hooksRef is shared by pointer so every copy of the keeper (msg server,
+// app) observes hooks installed after construction.
+type hooksRef struct {
+	hooks types.DnsHooks
+}
+
+// SetHooks installs the x/dns hooks. It may only be called once.
+func (k Keeper) SetHooks(h types.DnsHooks) {
+	if k.hooks.hooks != nil {
+		panic("cannot set dns hooks twice")
+	}
+	k.hooks.hooks = h
+}
+
+func (k Keeper) afterDomainOwnerChanged(ctx context.Context, name, previousOwner, newOwner string) error {
+	if k.hooks == nil || k.hooks.hooks == nil || previousOwner == newOwner {
+		return nil
+	}
+	return k.hooks.hooks.AfterDomainOwnerChanged(ctx, name, previousOwner, newOwner)
+}
@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	"fmt"
+
+	"lumen/app/denom"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"lumen/x/dns/types"
+)
+
+// RegisterInvariants registers all x/dns invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "auction-domain", AuctionDomainInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "domain-owner", DomainOwnerInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "module-balance", ModuleBalanceInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "domain-index", DomainIndexInvariant(k))
+}
+
+// AllInvariants runs every x/dns invariant and stops at the first broken one.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, inv := range []sdk.Invariant{
+			AuctionDomainInvariant(k),
+			DomainOwnerInvariant(k),
+			ModuleBalanceInvariant(k),
+			DomainIndexInvariant(k),
+		} {
+			if msg, broken := inv(ctx); broken {
+				return msg, true
+			}
+		}
+		return "", false
+	}
+}
+
+// AuctionDomainInvariant checks that every auction points at an existing
+// domain whose auction window has opened. An auction whose window already
+// closed is only valid while it still holds a winner awaiting settlement.
+func AuctionDomainInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "auction-domain", fmt.Sprintf("params: %v", err)), true
+		}
+		now := k.nowSec(ctx)
+
+		var (
+			msg   string
+			count int
+		)
+		_ = k.Auction.Walk(ctx, nil, func(name string, auc types.Auction) (bool, error) {
+			dom, err := k.Domain.Get(ctx, name)
+			if err != nil {
+				count++
+				msg += fmt.Sprintf("\tauction %s references a missing domain\n", name)
+				return false, nil
+			}
+			switch lifecycleStatus(now, dom.ExpireAt, params.GraceDays, params.AuctionDays) {
+			case "auction":
+			case "free":
+				if dom.ExpireAt == 0 || auc.Bidder == "" {
+					count++
+					msg += fmt.Sprintf("\tauction %s is closed without a winner to settle\n", name)
+				}
+			default:
+				count++
+				msg += fmt.Sprintf("\tauction %s references a domain outside its auction phase (expire_at %d)\n", name, dom.ExpireAt)
+			}
+			return false, nil
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "auction-domain",
+			fmt.Sprintf("found %d invalid auctions\n%s", count, msg)), count != 0
+	}
+}
+
+// DomainOwnerInvariant checks that no domain is stored without a valid owner.
+func DomainOwnerInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+		_ = k.Domain.Walk(ctx, nil, func(name string, dom types.Domain) (bool, error) {
+			if dom.Owner == "" {
+				count++
+				msg += fmt.Sprintf("\tdomain %s has an empty owner\n", name)
+			} else if _, err := k.addressCodec.StringToBytes(dom.Owner); err != nil {
+				count++
+				msg += fmt.Sprintf("\tdomain %s has an invalid owner %q\n", name, dom.Owner)
+			}
+			return false, nil
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "domain-owner",
+			fmt.Sprintf("found %d domains without a valid owner\n%s", count, msg)), count != 0
+	}
+}
+
+// ModuleBalanceInvariant checks that the x/dns module account holds no base
+// denom. Bids are not escrowed: Settle pulls the winning bid into the module
+// account and forwards all of it within the same transaction.
+func ModuleBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		if k.bank == nil {
+			return sdk.FormatInvariant(types.ModuleName, "module-balance", "bank keeper not set"), false
+		}
+		held := k.bank.SpendableCoins(ctx, authtypes.NewModuleAddress(types.ModuleName)).AmountOf(denom.BaseDenom)
+		return sdk.FormatInvariant(types.ModuleName, "module-balance",
+			fmt.Sprintf("module account holds %s%s, expected 0\n", held, denom.BaseDenom)), !held.IsZero()
+	}
+}
+
+// DomainIndexInvariant checks that every domain is stored under its
+// normalized fqdn and that Index and Name agree with the store key, since
+// Resolve and DomainsByOwner rely on them.
+func DomainIndexInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+		_ = k.Domain.Walk(ctx, nil, func(key string, dom types.Domain) (bool, error) {
+			if dom.Index != key || dom.Name != key {
+				count++
+				msg += fmt.Sprintf("\tdomain stored at %s has index %q and name %q\n", key, dom.Index, dom.Name)
+				return false, nil
+			}
+			domain, ext, ok := splitName(key)
+			if !ok || k.fqdn(domain, ext) != key {
+				count++
+				msg += fmt.Sprintf("\tdomain key %s is not a normalized fqdn\n", key)
+			}
+			return false, nil
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "domain-index",
+			fmt.Sprintf("found %d inconsistent domain index entries\n%s", count, msg)), count != 0
+	}
+}
@@ -0,0 +1,84 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"lumen/app/denom"
+	"lumen/x/dns/keeper"
+	"lumen/x/dns/types"
+)
+
+const day = uint64(24 * 3600)
+
+func TestInvariantsDetectInconsistentState(t *testing.T) {
+	f := initFixture(t)
+	bank := newMockBankKeeper()
+	f.keeper.SetBankKeeper(bank)
+
+	now := uint64(1_700_000_000)
+	f.ctx = sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(int64(now), 0))
+	owner, err := f.addressCodec.BytesToString(sdk.AccAddress([]byte("owner________________")))
+	require.NoError(t, err)
+
+	expired := now - (types.DefaultGraceDays+1)*day
+	require.NoError(t, f.keeper.Domain.Set(f.ctx, "active.lumen", types.Domain{Index: "active.lumen", Name: "active.lumen", Owner: owner, ExpireAt: now + day}))
+	require.NoError(t, f.keeper.Domain.Set(f.ctx, "expired.lumen", types.Domain{Index: "expired.lumen", Name: "expired.lumen", Owner: owner, ExpireAt: expired}))
+	require.NoError(t, f.keeper.Auction.Set(f.ctx, "expired.lumen", types.Auction{Index: "expired.lumen", Name: "expired.lumen", Bidder: owner, HighestBid: "1"}))
+
+	invariant := keeper.AllInvariants(f.keeper)
+	msg, broken := invariant(sdk.UnwrapSDKContext(f.ctx))
+	require.False(t, broken, msg)
+
+	cases := map[string]struct {
+		check  sdk.Invariant
+		mutate func()
+	}{
+		"auction on active domain": {
+			check: keeper.AuctionDomainInvariant(f.keeper),
+			mutate: func() {
+				require.NoError(t, f.keeper.Auction.Set(f.ctx, "active.lumen", types.Auction{Index: "active.lumen", Name: "active.lumen"}))
+			},
+		},
+		"auction without domain": {
+			check: keeper.AuctionDomainInvariant(f.keeper),
+			mutate: func() {
+				require.NoError(t, f.keeper.Auction.Set(f.ctx, "ghost.lumen", types.Auction{Index: "ghost.lumen", Name: "ghost.lumen"}))
+			},
+		},
+		"empty owner": {
+			check: keeper.DomainOwnerInvariant(f.keeper),
+			mutate: func() {
+				require.NoError(t, f.keeper.Domain.Set(f.ctx, "orphan.lumen", types.Domain{Index: "orphan.lumen", Name: "orphan.lumen"}))
+			},
+		},
+		"stranded module funds": {
+			check: keeper.ModuleBalanceInvariant(f.keeper),
+			mutate: func() {
+				bank.setAccount(authtypes.NewModuleAddress(types.ModuleName), sdk.NewCoins(sdk.NewInt64Coin(denom.BaseDenom, 1)))
+			},
+		},
+		"index mismatch": {
+			check: keeper.DomainIndexInvariant(f.keeper),
+			mutate: func() {
+				require.NoError(t, f.keeper.Domain.Set(f.ctx, "Upper.lumen", types.Domain{Index: "Upper.lumen", Name: "Upper.lumen", Owner: owner}))
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cacheCtx, _ := sdk.UnwrapSDKContext(f.ctx).CacheContext()
+			prev := f.ctx
+			f.ctx = cacheCtx
+			defer func() { f.ctx = prev }()
+			tc.mutate()
+			_, broken := tc.check(cacheCtx)
+			require.True(t, broken)
+			bank.setAccount(authtypes.NewModuleAddress(types.ModuleName), sdk.NewCoins())
+		})
+	}
+}
@@ -30,6 +30,8 @@ type Keeper struct {
 	dk types.DistrKeeper
 
 	ak types.AccountKeeper
+
+	hooks *hooksRef
 }
 
 func NewKeeper(
@@ -54,6 +56,8 @@ func NewKeeper(
 		Domain:       collections.NewMap(sb, types.DomainKey, "domain", collections.StringKey, codec.CollValue[types.Domain](cdc)),
 		Auction:      collections.NewMap(sb, types.AuctionKey, "auction", collections.StringKey, codec.CollValue[types.Auction](cdc)),
 		OpsThisBlock: collections.NewItem(sb, types.OpsThisBlockKey, "ops_this_block", collections.Uint64Value),
+
+		hooks: &hooksRef{},
 	}
 
 	schema, err := sb.Build()
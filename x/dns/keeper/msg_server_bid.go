@@ -60,7 +60,7 @@ func (k msgServer) Bid(ctx context.Context, msg *types.MsgBid) (*types.MsgBidRes
 	if !ok || !bidAmt.IsPositive() {
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "invalid bid amount")
 	}
-	_, minBid, err := params.PriceQuote(len(domain), len(ext), defaultDays(0, 365))
+	_, minBid, err := params.PriceQuote(len(domain), len(ext), settledRegistrationDays)
 	if err != nil {
 		return nil, err
 	}
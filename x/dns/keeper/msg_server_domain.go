@@ -71,6 +71,9 @@ func (k msgServer) UpdateDomain(ctx context.Context, msg *types.MsgUpdateDomain)
 		return nil, err
 	}
 	now := k.nowSec(ctx)
+	if val.IsLocked(now) {
+		return nil, types.ErrDomainLocked
+	}
 	if err := enforceUpdateRateLimit(val.UpdatedAt, now, params.UpdateRateLimitSeconds); err != nil {
 		return nil, err
 	}
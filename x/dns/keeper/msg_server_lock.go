@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"lumen/x/dns/types"
+)
+
+func (k msgServer) LockDomain(ctx context.Context, msg *types.MsgLockDomain) (*types.MsgLockDomainResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid creator address")
+	}
+
+	domain := types.NormalizeDomain(msg.Domain)
+	ext := types.NormalizeExt(msg.Ext)
+	if err := types.ValidateDomainParts(domain, ext); err != nil {
+		return nil, err
+	}
+	name := k.fqdn(domain, ext)
+
+	dom, err := k.Domain.Get(ctx, name)
+	if err != nil {
+		return nil, types.ErrInvalidFqdn
+	}
+	if dom.Owner != msg.Creator {
+		return nil, types.ErrNotOwner
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := params.ValidateLockDelay(msg.DelaySeconds); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidLockDelay, err.Error())
+	}
+
+	now := k.nowSec(ctx)
+	// A compromised key must not be able to shorten the delay of an active lock.
+	if dom.IsLocked(now) && msg.DelaySeconds < dom.LockDelaySeconds {
+		return nil, errorsmod.Wrapf(types.ErrInvalidLockDelay, "cannot shorten delay of an active lock (current %d)", dom.LockDelaySeconds)
+	}
+
+	cancelledUnlock := dom.IsLocked(now) && dom.UnlockAt != 0
+	dom.Locked = true
+	dom.LockDelaySeconds = msg.DelaySeconds
+	dom.UnlockAt = 0
+	if err := k.Domain.Set(ctx, name, dom); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent("dns_lock",
+			sdk.NewAttribute("name", name),
+			sdk.NewAttribute("owner", dom.Owner),
+			sdk.NewAttribute("delay_seconds", strconv.FormatUint(dom.LockDelaySeconds, 10)),
+			sdk.NewAttribute("cancelled_unlock", strconv.FormatBool(cancelledUnlock)),
+		),
+	)
+	return &types.MsgLockDomainResponse{}, nil
+}
+
+func (k msgServer) UnlockDomain(ctx context.Context, msg *types.MsgUnlockDomain) (*types.MsgUnlockDomainResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid creator address")
+	}
+
+	domain := types.NormalizeDomain(msg.Domain)
+	ext := types.NormalizeExt(msg.Ext)
+	if err := types.ValidateDomainParts(domain, ext); err != nil {
+		return nil, err
+	}
+	name := k.fqdn(domain, ext)
+
+	dom, err := k.Domain.Get(ctx, name)
+	if err != nil {
+		return nil, types.ErrInvalidFqdn
+	}
+	if dom.Owner != msg.Creator {
+		return nil, types.ErrNotOwner
+	}
+
+	now := k.nowSec(ctx)
+	if !dom.IsLocked(now) {
+		return nil, types.ErrDomainNotLocked
+	}
+	if dom.UnlockAt != 0 {
+		return nil, errorsmod.Wrapf(types.ErrUnlockPending, "unlock takes effect at %d", dom.UnlockAt)
+	}
+
+	dom.UnlockAt = now + dom.LockDelaySeconds
+	if err := k.Domain.Set(ctx, name, dom); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent("dns_unlock_requested",
+			sdk.NewAttribute("name", name),
+			sdk.NewAttribute("owner", dom.Owner),
+			sdk.NewAttribute("unlock_at", strconv.FormatUint(dom.UnlockAt, 10)),
+		),
+	)
+	return &types.MsgUnlockDomainResponse{UnlockAt: dom.UnlockAt}, nil
+}
@@ -0,0 +1,101 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"lumen/app/denom"
+	"lumen/x/dns/keeper"
+	"lumen/x/dns/types"
+)
+
+func TestLockBlocksTransferAndUpdateUntilUnlockMatures(t *testing.T) {
+	f := initFixture(t)
+	disableUpdateGuards(t, f)
+	bank := newMockBankKeeper()
+	f.keeper.SetBankKeeper(bank)
+
+	ownerAddr := sdk.AccAddress([]byte("owner________________"))
+	owner, err := f.addressCodec.BytesToString(ownerAddr)
+	require.NoError(t, err)
+	newOwner, err := f.addressCodec.BytesToString(sdk.AccAddress([]byte("new_owner____________")))
+	require.NoError(t, err)
+
+	start := time.Unix(1_700_000_000, 0)
+	f.ctx = sdk.UnwrapSDKContext(f.ctx).WithBlockTime(start)
+
+	name := "example.lumen"
+	require.NoError(t, f.keeper.Domain.Set(f.ctx, name, types.Domain{Index: name, Name: name, Owner: owner}))
+
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	delay := types.DefaultMinLockDelaySeconds
+	_, err = srv.LockDomain(f.ctx, types.NewMsgLockDomain(owner, "example", "lumen", delay))
+	require.NoError(t, err)
+
+	_, err = srv.Transfer(f.ctx, types.NewMsgTransfer(owner, "example", "lumen", newOwner))
+	require.ErrorIs(t, err, types.ErrDomainLocked)
+	_, err = srv.Update(f.ctx, &types.MsgUpdate{Creator: owner, Domain: "example", Ext: "lumen", Records: []*types.Record{{Key: "cid", Value: "x"}}})
+	require.ErrorIs(t, err, types.ErrDomainLocked)
+
+	res, err := srv.UnlockDomain(f.ctx, types.NewMsgUnlockDomain(owner, "example", "lumen"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(start.Unix())+delay, res.UnlockAt)
+
+	_, err = srv.UnlockDomain(f.ctx, types.NewMsgUnlockDomain(owner, "example", "lumen"))
+	require.ErrorIs(t, err, types.ErrUnlockPending)
+
+	resolved, err := keeper.NewQueryServerImpl(f.keeper).Resolve(f.ctx, &types.QueryResolveRequest{Domain: "example", Ext: "lumen"})
+	require.NoError(t, err)
+	require.True(t, resolved.Locked)
+	require.Equal(t, res.UnlockAt, resolved.UnlockAt)
+
+	// still locked one second before the unlock matures
+	f.ctx = sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(int64(res.UnlockAt)-1, 0))
+	_, err = srv.Transfer(f.ctx, types.NewMsgTransfer(owner, "example", "lumen", newOwner))
+	require.ErrorIs(t, err, types.ErrDomainLocked)
+
+	f.ctx = sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(int64(res.UnlockAt), 0))
+	bank.setAccount(ownerAddr, sdk.NewCoins(sdk.NewInt64Coin(denom.BaseDenom, int64(types.DefaultTransferFeeUlmn))))
+	_, err = srv.Transfer(f.ctx, types.NewMsgTransfer(owner, "example", "lumen", newOwner))
+	require.NoError(t, err)
+
+	dom, err := f.keeper.Domain.Get(f.ctx, name)
+	require.NoError(t, err)
+	require.Equal(t, newOwner, dom.Owner)
+	require.False(t, dom.Locked, "lock must not carry over to the new owner")
+}
+
+func TestLockCancelsPendingUnlockAndCannotShortenDelay(t *testing.T) {
+	f := initFixture(t)
+
+	owner, err := f.addressCodec.BytesToString(sdk.AccAddress([]byte("owner________________")))
+	require.NoError(t, err)
+	f.ctx = sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_700_000_000, 0))
+
+	name := "example.lumen"
+	require.NoError(t, f.keeper.Domain.Set(f.ctx, name, types.Domain{Index: name, Name: name, Owner: owner}))
+
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	delay := 2 * types.DefaultMinLockDelaySeconds
+	_, err = srv.LockDomain(f.ctx, types.NewMsgLockDomain(owner, "example", "lumen", delay))
+	require.NoError(t, err)
+
+	_, err = srv.LockDomain(f.ctx, types.NewMsgLockDomain(owner, "example", "lumen", types.DefaultMinLockDelaySeconds))
+	require.ErrorIs(t, err, types.ErrInvalidLockDelay)
+	_, err = srv.LockDomain(f.ctx, types.NewMsgLockDomain(owner, "example", "lumen", types.DefaultMaxLockDelaySeconds+1))
+	require.ErrorIs(t, err, types.ErrInvalidLockDelay)
+
+	_, err = srv.UnlockDomain(f.ctx, types.NewMsgUnlockDomain(owner, "example", "lumen"))
+	require.NoError(t, err)
+
+	_, err = srv.LockDomain(f.ctx, types.NewMsgLockDomain(owner, "example", "lumen", delay))
+	require.NoError(t, err)
+
+	dom, err := f.keeper.Domain.Get(f.ctx, name)
+	require.NoError(t, err)
+	require.True(t, dom.Locked)
+	require.Zero(t, dom.UnlockAt, "re-locking must cancel the pending unlock")
+}
@@ -64,6 +64,11 @@ func (k msgServer) Register(ctx context.Context, msg *types.MsgRegister) (*types
 		if status == "active" || status == "grace" || status == "auction" {
 			return nil, types.ErrDomainExists
 		}
+		if has, err := k.Auction.Has(ctx, name); err != nil {
+			return nil, err
+		} else if has {
+			return nil, errorsmod.Wrap(types.ErrDomainExists, "auction pending settlement")
+		}
 	}
 
 	days := defaultDays(msg.DurationDays, types.MaxRegistrationDurationDays)
@@ -114,6 +119,11 @@ func (k msgServer) Register(ctx context.Context, msg *types.MsgRegister) (*types
 	if err := k.Domain.Set(ctx, name, newDom); err != nil {
 		return nil, err
 	}
+	if found {
+		if err := k.afterDomainOwnerChanged(ctx, name, cur.Owner, owner); err != nil {
+			return nil, err
+		}
+	}
 
 	cnt, _ := k.OpsThisBlock.Get(ctx)
 	_ = k.OpsThisBlock.Set(ctx, cnt+1)
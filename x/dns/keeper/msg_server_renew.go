@@ -32,6 +32,13 @@ func (k msgServer) Renew(ctx context.Context, msg *types.MsgRenew) (*types.MsgRe
 	if dom.Owner != msg.Creator {
 		return nil, types.ErrNotOwner
 	}
+	// Once bidding has started the domain belongs to the auction; renewing
+	// would leave the auction pointing at an active domain.
+	if has, err := k.Auction.Has(ctx, name); err != nil {
+		return nil, err
+	} else if has {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("auction in progress")
+	}
 
 	params, err := k.Params.Get(ctx)
 	if err != nil {
@@ -14,6 +14,8 @@ import (
 	"lumen/x/dns/types"
 )
 
+const settledRegistrationDays uint64 = 365
+
 func (k msgServer) Settle(ctx context.Context, msg *types.MsgSettle) (*types.MsgSettleResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
@@ -52,10 +54,18 @@ func (k msgServer) Settle(ctx context.Context, msg *types.MsgSettle) (*types.Msg
 		return nil, sdkerrors.ErrInvalidRequest.Wrap("no winner to settle")
 	}
 
+	previousOwner := dom.Owner
 	dom.Owner = auc.Bidder
+	// The minimum bid is quoted for a one-year registration.
+	dom.ExpireAt = now + settledRegistrationDays*24*3600
+	dom.UpdatedAt = now
+	dom.ClearLock()
 	if err := k.Domain.Set(ctx, name, dom); err != nil {
 		return nil, err
 	}
+	if err := k.afterDomainOwnerChanged(ctx, name, previousOwner, auc.Bidder); err != nil {
+		return nil, err
+	}
 
 	if k.bank != nil && auc.HighestBid != "" {
 		if amt, ok := sdkmath.NewIntFromString(auc.HighestBid); ok && amt.IsPositive() {
@@ -0,0 +1,51 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"lumen/app/denom"
+	"lumen/x/dns/keeper"
+	"lumen/x/dns/types"
+)
+
+func TestSettleKeepsAuctionAndDomainConsistent(t *testing.T) {
+	f := initFixture(t)
+	bank := newMockBankKeeper()
+	f.keeper.SetBankKeeper(bank)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	ownerAddr := sdk.AccAddress([]byte("owner________________"))
+	winnerAddr := sdk.AccAddress([]byte("winner_______________"))
+	owner, err := f.addressCodec.BytesToString(ownerAddr)
+	require.NoError(t, err)
+	winner, err := f.addressCodec.BytesToString(winnerAddr)
+	require.NoError(t, err)
+
+	now := uint64(1_700_000_000)
+	expire := now - (types.DefaultGraceDays+types.DefaultAuctionDays)*day
+	name := "example.lumen"
+	require.NoError(t, f.keeper.Domain.Set(f.ctx, name, types.Domain{Index: name, Name: name, Owner: owner, ExpireAt: expire}))
+	require.NoError(t, f.keeper.Auction.Set(f.ctx, name, types.Auction{Index: name, Name: name, Bidder: winner, HighestBid: "1000"}))
+	f.ctx = sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(int64(now), 0))
+
+	_, err = srv.Renew(f.ctx, &types.MsgRenew{Creator: owner, Domain: "example", Ext: "lumen", DurationDays: 30})
+	require.Error(t, err, "renewing must not override a pending auction")
+	_, err = srv.Register(f.ctx, &types.MsgRegister{Creator: owner, Domain: "example", Ext: "lumen", DurationDays: 30})
+	require.ErrorIs(t, err, types.ErrDomainExists)
+
+	bank.setAccount(winnerAddr, sdk.NewCoins(sdk.NewInt64Coin(denom.BaseDenom, 1000)))
+	_, err = srv.Settle(f.ctx, &types.MsgSettle{Creator: owner, Domain: "example", Ext: "lumen"})
+	require.NoError(t, err)
+
+	dom, err := f.keeper.Domain.Get(f.ctx, name)
+	require.NoError(t, err)
+	require.Equal(t, winner, dom.Owner)
+	require.Equal(t, now+365*day, dom.ExpireAt)
+
+	msg, broken := keeper.AllInvariants(f.keeper)(sdk.UnwrapSDKContext(f.ctx))
+	require.False(t, broken, msg)
+}
@@ -36,6 +36,9 @@ func (k msgServer) Transfer(ctx context.Context, msg *types.MsgTransfer) (*types
 	if dom.Owner != msg.Creator {
 		return nil, types.ErrNotOwner
 	}
+	if dom.IsLocked(k.nowSec(ctx)) {
+		return nil, types.ErrDomainLocked
+	}
 
 	params, err := k.Params.Get(ctx)
 	if err != nil {
@@ -64,9 +67,13 @@ func (k msgServer) Transfer(ctx context.Context, msg *types.MsgTransfer) (*types
 	}
 
 	dom.Owner = msg.NewOwner
+	dom.ClearLock()
 	if err := k.Domain.Set(ctx, name, dom); err != nil {
 		return nil, err
 	}
+	if err := k.afterDomainOwnerChanged(ctx, name, msg.Creator, msg.NewOwner); err != nil {
+		return nil, err
+	}
 
 	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
 		sdk.NewEvent("dns_transfer",
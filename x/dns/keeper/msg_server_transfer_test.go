@@ -53,8 +53,8 @@ func (m *mockBankKeeper) addModule(module string, coins sdk.Coins) {
 	m.modules[module] = current.Add(coins...)
 }
 
-func (m *mockBankKeeper) SpendableCoins(context.Context, sdk.AccAddress) sdk.Coins {
-	return sdk.NewCoins()
+func (m *mockBankKeeper) SpendableCoins(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	return m.getAccount(addr)
 }
 
 func (m *mockBankKeeper) SendCoins(ctx context.Context, from sdk.AccAddress, to sdk.AccAddress, amt sdk.Coins) error {
@@ -132,3 +132,34 @@ func TestMsgTransferChargesFixedFee(t *testing.T) {
 	collected := bank.modules[authtypes.FeeCollectorName]
 	require.Equal(t, sdk.NewCoins(sdk.NewCoin(denom.BaseDenom, fee)), collected)
 }
+
+type recordingDnsHooks struct {
+	calls [][3]string
+}
+
+func (h *recordingDnsHooks) AfterDomainOwnerChanged(_ context.Context, name, previousOwner, newOwner string) error {
+	h.calls = append(h.calls, [3]string{name, previousOwner, newOwner})
+	return nil
+}
+
+func TestMsgTransferCallsOwnerChangedHook(t *testing.T) {
+	f := initFixture(t)
+	bank := newMockBankKeeper()
+	f.keeper.SetBankKeeper(bank)
+	hooks := &recordingDnsHooks{}
+	f.keeper.SetHooks(hooks)
+
+	creatorAddr := sdk.AccAddress([]byte("creator________________"))
+	creator, err := f.addressCodec.BytesToString(creatorAddr)
+	require.NoError(t, err)
+	newOwner, err := f.addressCodec.BytesToString(sdk.AccAddress([]byte("new_owner____________")))
+	require.NoError(t, err)
+	bank.setAccount(creatorAddr, sdk.NewCoins(sdk.NewInt64Coin(denom.BaseDenom, int64(types.DefaultTransferFeeUlmn))))
+
+	name := "example.lumen"
+	require.NoError(t, f.keeper.Domain.Set(f.ctx, name, types.Domain{Index: name, Name: name, Owner: creator}))
+
+	_, err = keeper.NewMsgServerImpl(f.keeper).Transfer(f.ctx, types.NewMsgTransfer(creator, "example", "lumen", newOwner))
+	require.NoError(t, err)
+	require.Equal(t, [][3]string{{name, creator, newOwner}}, hooks.calls)
+}
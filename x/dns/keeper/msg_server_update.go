@@ -34,6 +34,13 @@ func (k msgServer) Update(ctx context.Context, msg *types.MsgUpdate) (*types.Msg
 		return nil, err
 	}
 	now := k.nowSec(ctx)
+	if dom.IsLocked(now) {
+		return nil, types.ErrDomainLocked
+	}
+	if dom.Locked {
+		// the pending unlock has matured; the lock is spent
+		dom.ClearLock()
+	}
 	if err := enforceUpdateRateLimit(dom.UpdatedAt, now, params.UpdateRateLimitSeconds); err != nil {
 		return nil, err
 	}
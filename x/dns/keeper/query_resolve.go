@@ -26,7 +26,13 @@ func (q queryServer) Resolve(ctx context.Context, req *types.QueryResolveRequest
 		return nil, status.Error(codes.NotFound, "not found")
 	}
 
-	return &types.QueryResolveResponse{
-		Owner: dom.Owner,
-	}, nil
+	now := q.k.nowSec(ctx)
+	resp := &types.QueryResolveResponse{
+		Owner:  dom.Owner,
+		Locked: dom.IsLocked(now),
+	}
+	if resp.Locked {
+		resp.UnlockAt = dom.UnlockAt
+	}
+	return resp, nil
 }
@@ -116,6 +116,18 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "domain"}, {ProtoField: "ext"},
 					},
 				},
+				{
+					RpcMethod:      "LockDomain",
+					Use:            "lock-domain [domain] [ext] [delay-seconds]",
+					Short:          "Lock a domain against transfers and record updates",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "domain"}, {ProtoField: "ext"}, {ProtoField: "delay_seconds"}},
+				},
+				{
+					RpcMethod:      "UnlockDomain",
+					Use:            "unlock-domain [domain] [ext]",
+					Short:          "Request an unlock that takes effect after the lock delay",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "domain"}, {ProtoField: "ext"}},
+				},
 			},
 		},
 	}
@@ -1,10 +1,9 @@
 package dns
 
 import (
+	"fmt"
 	"math/rand"
 
-	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
-	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
@@ -13,27 +12,69 @@ import (
 	"lumen/x/dns/types"
 )
 
-func randomAccAddress() string {
-	pk := ed25519.GenPrivKey().PubKey()
-	return sdk.AccAddress(pk.Address()).String()
-}
+const simDay = uint64(24 * 3600)
 
+// GenerateGenesisState seeds domains owned by simulation accounts across every
+// lifecycle phase relative to genesis time, so renew-in-grace, bids and
+// settlement all have work to do as the simulated clock advances.
 func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
-	accs := make([]string, len(simState.Accounts))
+	params := types.DefaultParams()
+	now := uint64(simState.GenTimestamp.Unix())
+	graceEnd := func(expire uint64) uint64 { return expire + params.GraceDays*simDay }
+
+	domains := make([]types.Domain, 0, len(simState.Accounts))
+	auctions := make([]types.Auction, 0)
 	for i, acc := range simState.Accounts {
-		accs[i] = acc.Address.String()
+		if i >= 20 {
+			break
+		}
+		name := fmt.Sprintf("genesis-%d.sim", i)
+		owner := acc.Address.String()
+
+		var expire uint64
+		switch i % 4 {
+		case 0: // active
+			expire = now + uint64(30+simState.Rand.Intn(335))*simDay
+		case 1: // about to enter grace
+			expire = now + uint64(1+simState.Rand.Intn(3))*simDay
+		case 2: // in grace
+			expire = now - uint64(1+simState.Rand.Intn(int(params.GraceDays)))*simDay + 1
+		case 3: // in auction with a standing bid
+			expire = now - params.GraceDays*simDay - uint64(1+simState.Rand.Intn(int(params.AuctionDays)))*simDay + 1
+		}
+
+		domains = append(domains, types.Domain{
+			Creator:   owner,
+			Index:     name,
+			Name:      name,
+			Owner:     owner,
+			ExpireAt:  expire,
+			UpdatedAt: now,
+		})
+
+		if i%4 == 3 {
+			bidder, _ := simtypes.RandomAcc(simState.Rand, simState.Accounts)
+			_, minBid, err := params.PriceQuote(len(name)-len(".sim"), len("sim"), 365)
+			if err != nil {
+				panic(err)
+			}
+			auctions = append(auctions, types.Auction{
+				Creator:    bidder.Address.String(),
+				Index:      name,
+				Name:       name,
+				Start:      graceEnd(expire),
+				End:        graceEnd(expire) + params.AuctionDays*simDay,
+				HighestBid: minBid.String(),
+				Bidder:     bidder.Address.String(),
+			})
+		}
 	}
+
 	dnsGenesis := types.GenesisState{
-		Params: types.DefaultParams(),
-		DomainMap: []types.Domain{{Creator: randomAccAddress(),
-			Index: "0",
-		}, {Creator: randomAccAddress(),
-			Index: "1",
-		}}, AuctionMap: []types.Auction{{Creator: randomAccAddress(),
-			Index: "0",
-		}, {Creator: randomAccAddress(),
-			Index: "1",
-		}}}
+		Params:     params,
+		DomainMap:  domains,
+		AuctionMap: auctions,
+	}
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&dnsGenesis)
 }
 
@@ -116,6 +157,21 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		weightMsgBid,
 		dnssimulation.SimulateMsgBid(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
 	))
+	const (
+		opWeightMsgSettle          = "op_weight_msg_dns"
+		defaultWeightMsgSettle int = 50
+	)
+
+	var weightMsgSettle int
+	simState.AppParams.GetOrGenerate(opWeightMsgSettle, &weightMsgSettle, nil,
+		func(_ *rand.Rand) {
+			weightMsgSettle = defaultWeightMsgSettle
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgSettle,
+		dnssimulation.SimulateMsgSettle(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
 	return operations
 }
 
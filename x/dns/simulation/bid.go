@@ -25,20 +25,23 @@ func SimulateMsgBid(
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
 		simAccount, _ := simtypes.RandomAcc(r, accs)
 
-		var auctions []types.Auction
-		if err := k.Auction.Walk(ctx, nil, func(_ string, auc types.Auction) (bool, error) {
-			auctions = append(auctions, auc)
+		inAuction := hasStatus(ctx, k, "auction")
+		var doms []types.Domain
+		if err := k.Domain.Walk(ctx, nil, func(_ string, dom types.Domain) (bool, error) {
+			if inAuction(dom) {
+				doms = append(doms, dom)
+			}
 			return false, nil
 		}); err != nil {
 			return simtypes.OperationMsg{}, nil, err
 		}
-		if len(auctions) == 0 {
-			return simtypes.NoOpMsg(types.ModuleName, "bid", "no auctions"), nil, nil
+		if len(doms) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, "bid", "no domains in auction"), nil, nil
 		}
-		auc := auctions[r.Intn(len(auctions))]
-		domainPart, ext, ok := splitFQDN(auc.Name)
+		dom := doms[r.Intn(len(doms))]
+		domainPart, ext, ok := splitFQDN(dom.Name)
 		if !ok {
-			return simtypes.NoOpMsg(types.ModuleName, "bid", "invalid auction name"), nil, nil
+			return simtypes.NoOpMsg(types.ModuleName, "bid", "invalid domain name"), nil, nil
 		}
 
 		params, err := k.Params.Get(ctx)
@@ -50,7 +53,7 @@ func SimulateMsgBid(
 			return simtypes.OperationMsg{}, nil, err
 		}
 		bid := minBid
-		if auc.HighestBid != "" {
+		if auc, err := k.Auction.Get(ctx, dom.Name); err == nil && auc.HighestBid != "" {
 			if cur, ok := sdkmath.NewIntFromString(auc.HighestBid); ok {
 				inc := sdkmath.NewIntFromUint64(uint64(r.Intn(10) + 1))
 				bid = cur.Add(inc)
@@ -77,6 +80,16 @@ func SimulateMsgBid(
 			AccountKeeper:   ak,
 			Bankkeeper:      bk,
 		}
-		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+		opMsg, _, err := deliverGasless(txCtx)
+		if err != nil {
+			return opMsg, nil, err
+		}
+
+		// Jump ahead to the end of the auction window and settle it.
+		settle := simtypes.FutureOperation{
+			BlockTime: auctionEnd(dom, params),
+			Op:        SimulateMsgSettle(ak, bk, k, txGen),
+		}
+		return opMsg, []simtypes.FutureOperation{settle}, nil
 	}
 }
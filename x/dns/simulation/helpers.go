@@ -4,20 +4,31 @@ import (
 	"fmt"
 	"math/rand"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
 
 	"lumen/x/dns/keeper"
 	"lumen/x/dns/types"
 )
 
 func pickRandomDomain(ctx sdk.Context, k keeper.Keeper, ak types.AuthKeeper, accs []simtypes.Account) (types.Domain, simtypes.Account, bool, error) {
+	return pickDomain(ctx, k, ak, accs, nil)
+}
+
+// pickDomain returns the first domain owned by a simulation account that
+// passes keep (nil keeps every domain).
+func pickDomain(ctx sdk.Context, k keeper.Keeper, ak types.AuthKeeper, accs []simtypes.Account, keep func(types.Domain) bool) (types.Domain, simtypes.Account, bool, error) {
 	var (
 		selected types.Domain
 		account  simtypes.Account
 	)
 	err := k.Domain.Walk(ctx, nil, func(_ string, dom types.Domain) (bool, error) {
+		if keep != nil && !keep(dom) {
+			return false, nil
+		}
 		addrBz, err := ak.AddressCodec().StringToBytes(dom.Owner)
 		if err != nil {
 			return false, err
@@ -38,6 +49,33 @@ func pickRandomDomain(ctx sdk.Context, k keeper.Keeper, ak types.AuthKeeper, acc
 	return selected, account, true, nil
 }
 
+// hasStatus keeps domains in one of the given lifecycle phases.
+func hasStatus(ctx sdk.Context, k keeper.Keeper, statuses ...string) func(types.Domain) bool {
+	return func(dom types.Domain) bool {
+		status, err := k.DomainStatus(ctx, dom)
+		if err != nil {
+			return false
+		}
+		for _, s := range statuses {
+			if status == s {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// auctionEnd returns when the auction window of dom closes.
+func auctionEnd(dom types.Domain, params types.Params) time.Time {
+	return time.Unix(int64(dom.ExpireAt+(params.GraceDays+params.AuctionDays)*24*3600), 0)
+}
+
+// deliverGasless delivers a dns tx without fees: x/dns messages are gasless
+// and the ante handler rejects any non-zero fee for them.
+func deliverGasless(txCtx simulation.OperationInput) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	return simulation.GenAndDeliverTx(txCtx, sdk.NewCoins())
+}
+
 func splitFQDN(name string) (string, string, bool) {
 	parts := strings.Split(name, ".")
 	if len(parts) < 2 {
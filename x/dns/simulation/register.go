@@ -59,6 +59,6 @@ func SimulateMsgRegister(
 			AccountKeeper:   ak,
 			Bankkeeper:      bk,
 		}
-		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+		return deliverGasless(txCtx)
 	}
 }
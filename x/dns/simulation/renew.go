@@ -21,7 +21,12 @@ func SimulateMsgRenew(
 ) simtypes.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		dom, ownerAcc, found, err := pickRandomDomain(ctx, k, ak, accs)
+		// Prefer domains in their grace period so the late-renewal path is
+		// exercised; fall back to active ones.
+		dom, ownerAcc, found, err := pickDomain(ctx, k, ak, accs, hasStatus(ctx, k, "grace"))
+		if err == nil && !found {
+			dom, ownerAcc, found, err = pickDomain(ctx, k, ak, accs, hasStatus(ctx, k, "active"))
+		}
 		if err != nil {
 			return simtypes.OperationMsg{}, nil, err
 		}
@@ -53,6 +58,6 @@ func SimulateMsgRenew(
 			AccountKeeper:   ak,
 			Bankkeeper:      bk,
 		}
-		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+		return deliverGasless(txCtx)
 	}
 }
@@ -0,0 +1,88 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	sdkmath "cosmossdk.io/math"
+
+	"lumen/app/denom"
+	"lumen/x/dns/keeper"
+	"lumen/x/dns/types"
+)
+
+// SimulateMsgSettle settles an auction whose window has closed. Bid schedules
+// it as a future operation at the auction end; it also runs as a weighted
+// operation to pick up auctions seeded in genesis.
+func SimulateMsgSettle(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		closed := hasStatus(ctx, k, "free")
+		var ready []types.Auction
+		if err := k.Auction.Walk(ctx, nil, func(name string, auc types.Auction) (bool, error) {
+			if auc.Bidder == "" || auc.HighestBid == "" {
+				return false, nil
+			}
+			dom, err := k.Domain.Get(ctx, name)
+			if err != nil || !closed(dom) {
+				return false, nil
+			}
+			amt, ok := sdkmath.NewIntFromString(auc.HighestBid)
+			if !ok {
+				return false, nil
+			}
+			winner, err := ak.AddressCodec().StringToBytes(auc.Bidder)
+			if err != nil {
+				return false, nil
+			}
+			// Settle charges the winner; skip auctions it can no longer pay for.
+			if bk.SpendableCoins(ctx, winner).AmountOf(denom.BaseDenom).LT(amt) {
+				return false, nil
+			}
+			ready = append(ready, auc)
+			return false, nil
+		}); err != nil {
+			return simtypes.OperationMsg{}, nil, err
+		}
+		if len(ready) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, "settle", "no closed auctions"), nil, nil
+		}
+		auc := ready[r.Intn(len(ready))]
+		domainPart, ext, ok := splitFQDN(auc.Name)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, "settle", "invalid auction name"), nil, nil
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		msg := &types.MsgSettle{
+			Creator: simAccount.Address.String(),
+			Domain:  domainPart,
+			Ext:     ext,
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+		}
+		return deliverGasless(txCtx)
+	}
+}
@@ -58,6 +58,6 @@ func SimulateMsgTransfer(
 			AccountKeeper:   ak,
 			Bankkeeper:      bk,
 		}
-		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+		return deliverGasless(txCtx)
 	}
 }
@@ -21,12 +21,21 @@ func SimulateMsgUpdate(
 ) simtypes.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		dom, ownerAcc, found, err := pickRandomDomain(ctx, k, ak, accs)
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return simtypes.OperationMsg{}, nil, err
+		}
+		// Skip locked domains and those still inside the update rate limit.
+		now := uint64(ctx.BlockTime().Unix())
+		updatable := func(dom types.Domain) bool {
+			return !dom.IsLocked(now) && (dom.UpdatedAt == 0 || now < dom.UpdatedAt || now-dom.UpdatedAt >= params.UpdateRateLimitSeconds)
+		}
+		dom, ownerAcc, found, err := pickDomain(ctx, k, ak, accs, updatable)
 		if err != nil {
 			return simtypes.OperationMsg{}, nil, err
 		}
 		if !found {
-			return simtypes.NoOpMsg(types.ModuleName, "update", "no domain owners found"), nil, nil
+			return simtypes.NoOpMsg(types.ModuleName, "update", "no updatable domains found"), nil, nil
 		}
 
 		domainPart, ext, ok := splitFQDN(dom.Name)
@@ -34,10 +43,6 @@ func SimulateMsgUpdate(
 			return simtypes.NoOpMsg(types.ModuleName, "update", "invalid fqdn"), nil, nil
 		}
 
-		params, err := k.Params.Get(ctx)
-		if err != nil {
-			return simtypes.OperationMsg{}, nil, err
-		}
 		identifier := normalizeFQDN(domainPart, ext)
 		nonce := mineNonce(identifier, ownerAcc.Address.String(), params.UpdatePowDifficulty)
 
@@ -62,6 +67,6 @@ func SimulateMsgUpdate(
 			AccountKeeper:   ak,
 			Bankkeeper:      bk,
 		}
-		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+		return deliverGasless(txCtx)
 	}
 }
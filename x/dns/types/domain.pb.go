@@ -90,6 +90,12 @@ type Domain struct {
 	ExpireAt  uint64    `protobuf:"varint,7,opt,name=expire_at,json=expireAt,proto3" json:"expire_at,omitempty"`
 	Creator   string    `protobuf:"bytes,8,opt,name=creator,proto3" json:"creator,omitempty"`
 	UpdatedAt uint64    `protobuf:"varint,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Owner-set lock: while locked, Transfer and Update are rejected until an
+	// unlock request has matured (unlock_at reached).
+	Locked           bool   `protobuf:"varint,10,opt,name=locked,proto3" json:"locked,omitempty"`
+	LockDelaySeconds uint64 `protobuf:"varint,11,opt,name=lock_delay_seconds,json=lockDelaySeconds,proto3" json:"lock_delay_seconds,omitempty"`
+	// Time at which a pending unlock takes effect; 0 when no unlock is pending.
+	UnlockAt uint64 `protobuf:"varint,12,opt,name=unlock_at,json=unlockAt,proto3" json:"unlock_at,omitempty"`
 }
 
 func (m *Domain) Reset()         { *m = Domain{} }
@@ -174,6 +180,27 @@ func (m *Domain) GetUpdatedAt() uint64 {
 	return 0
 }
 
+func (m *Domain) GetLocked() bool {
+	if m != nil {
+		return m.Locked
+	}
+	return false
+}
+
+func (m *Domain) GetLockDelaySeconds() uint64 {
+	if m != nil {
+		return m.LockDelaySeconds
+	}
+	return 0
+}
+
+func (m *Domain) GetUnlockAt() uint64 {
+	if m != nil {
+		return m.UnlockAt
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Record)(nil), "lumen.dns.v1.Record")
 	proto.RegisterType((*Domain)(nil), "lumen.dns.v1.Domain")
@@ -182,25 +209,28 @@ func init() {
 func init() { proto.RegisterFile("lumen/dns/v1/domain.proto", fileDescriptor_4e8e350275d09e03) }
 
 var fileDescriptor_4e8e350275d09e03 = []byte{
-	// 279 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x50, 0xb1, 0x4e, 0xc3, 0x30,
-	0x14, 0x8c, 0x49, 0x49, 0x1a, 0xc3, 0x00, 0x56, 0x07, 0x23, 0x84, 0x15, 0x75, 0x8a, 0x84, 0xe4,
-	0xa8, 0xf0, 0x05, 0xad, 0xf8, 0x82, 0x8c, 0x2c, 0xc8, 0xd4, 0x1e, 0x22, 0x12, 0x3b, 0x72, 0x9c,
-	0x90, 0xfe, 0x05, 0x9f, 0xc5, 0x84, 0x3a, 0x32, 0xa2, 0xe4, 0x47, 0x90, 0xed, 0x46, 0xea, 0xf6,
-	0xee, 0x7c, 0x77, 0x3e, 0x1d, 0xbc, 0xab, 0xba, 0x5a, 0xc8, 0x9c, 0xcb, 0x36, 0xef, 0x37, 0x39,
-	0x57, 0x35, 0x2b, 0x25, 0x6d, 0xb4, 0x32, 0x0a, 0x5d, 0xbb, 0x27, 0xca, 0x65, 0x4b, 0xfb, 0xcd,
-	0x7a, 0x07, 0xa3, 0x42, 0xec, 0x95, 0xe6, 0xe8, 0x06, 0x86, 0x1f, 0xe2, 0x80, 0x41, 0x0a, 0xb2,
-	0xa4, 0xb0, 0x27, 0x5a, 0xc1, 0xcb, 0x9e, 0x55, 0x9d, 0xc0, 0x17, 0x8e, 0xf3, 0xc0, 0xea, 0x8c,
-	0xa9, 0x70, 0x98, 0x82, 0x6c, 0x51, 0xd8, 0x73, 0xfd, 0x03, 0x60, 0xf4, 0xe2, 0xbe, 0xb0, 0x96,
-	0x52, 0x72, 0x31, 0x9c, 0x62, 0x3c, 0x40, 0x08, 0x2e, 0x24, 0xab, 0xe7, 0x1c, 0x77, 0x5b, 0xa5,
-	0xfa, 0x94, 0x42, 0xbb, 0xa0, 0xa4, 0xf0, 0x00, 0x51, 0x18, 0x6b, 0x57, 0xa7, 0xc5, 0x51, 0x1a,
-	0x66, 0x57, 0x4f, 0x2b, 0x7a, 0x5e, 0x97, 0xfa, 0xae, 0xc5, 0x2c, 0x42, 0xf7, 0x30, 0x11, 0x43,
-	0x53, 0x6a, 0xf1, 0xc6, 0x0c, 0x8e, 0x5d, 0xa5, 0xa5, 0x27, 0xb6, 0x06, 0x61, 0x18, 0xef, 0xb5,
-	0x60, 0x46, 0x69, 0xbc, 0x74, 0x9f, 0xcc, 0x10, 0x3d, 0x40, 0xd8, 0x35, 0x9c, 0x19, 0xc1, 0xad,
-	0x2f, 0x71, 0xbe, 0xe4, 0xc4, 0x6c, 0xcd, 0xee, 0xf1, 0x7b, 0x24, 0xe0, 0x38, 0x12, 0xf0, 0x37,
-	0x12, 0xf0, 0x35, 0x91, 0xe0, 0x38, 0x91, 0xe0, 0x77, 0x22, 0xc1, 0xeb, 0xad, 0xdf, 0x75, 0x70,
-	0xcb, 0x9a, 0x43, 0x23, 0xda, 0xf7, 0xc8, 0xcd, 0xfa, 0xfc, 0x1f, 0x00, 0x00, 0xff, 0xff, 0x27,
-	0xac, 0xdd, 0xeb, 0x73, 0x01, 0x00, 0x00,
+	// 331 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x51, 0x41, 0x6e, 0xea, 0x30,
+	0x10, 0x25, 0xc0, 0x0f, 0x64, 0x60, 0xc1, 0xb7, 0x50, 0xe5, 0xaa, 0x6a, 0x14, 0xb1, 0x8a, 0xd4,
+	0x2a, 0x88, 0xf6, 0x04, 0x20, 0x4e, 0x90, 0xee, 0xba, 0x41, 0x6e, 0x3c, 0x0b, 0x44, 0xb0, 0x23,
+	0xc7, 0xa1, 0x70, 0x8b, 0x5e, 0xa5, 0xb7, 0xe8, 0x92, 0x65, 0x97, 0x15, 0x5c, 0xa4, 0xf2, 0x18,
+	0xa4, 0xee, 0xde, 0x7b, 0xf3, 0xde, 0xcc, 0x93, 0x06, 0x6e, 0xcb, 0x66, 0x8b, 0x6a, 0x2a, 0x55,
+	0x3d, 0xdd, 0xcd, 0xa6, 0x52, 0x6f, 0xc5, 0x5a, 0x65, 0x95, 0xd1, 0x56, 0xb3, 0x21, 0x8d, 0x32,
+	0xa9, 0xea, 0x6c, 0x37, 0x9b, 0x2c, 0x20, 0xcc, 0xb1, 0xd0, 0x46, 0xb2, 0x11, 0x74, 0x36, 0x78,
+	0xe0, 0x41, 0x12, 0xa4, 0x51, 0xee, 0x20, 0x1b, 0xc3, 0xbf, 0x9d, 0x28, 0x1b, 0xe4, 0x6d, 0xd2,
+	0x3c, 0x71, 0x3e, 0x6b, 0x4b, 0xde, 0x49, 0x82, 0xb4, 0x9b, 0x3b, 0x38, 0xf9, 0x6c, 0x43, 0xb8,
+	0xa4, 0x13, 0x2e, 0xb2, 0x56, 0x12, 0xf7, 0x97, 0x35, 0x9e, 0x30, 0x06, 0x5d, 0x25, 0xb6, 0xd7,
+	0x3d, 0x84, 0x9d, 0x53, 0xbf, 0x2b, 0x34, 0xb4, 0x28, 0xca, 0x3d, 0x61, 0x19, 0xf4, 0x0c, 0xd5,
+	0xa9, 0x79, 0x98, 0x74, 0xd2, 0xc1, 0xd3, 0x38, 0xfb, 0x5b, 0x37, 0xf3, 0x5d, 0xf3, 0xab, 0x89,
+	0xdd, 0x41, 0x84, 0xfb, 0x6a, 0x6d, 0x70, 0x25, 0x2c, 0xef, 0x51, 0xa5, 0xbe, 0x17, 0xe6, 0x96,
+	0x71, 0xe8, 0x15, 0x06, 0x85, 0xd5, 0x86, 0xf7, 0xe9, 0xc8, 0x95, 0xb2, 0x7b, 0x80, 0xa6, 0x92,
+	0xc2, 0xa2, 0x74, 0xb9, 0x88, 0x72, 0xd1, 0x45, 0x99, 0x5b, 0x76, 0x03, 0x61, 0xa9, 0x8b, 0x0d,
+	0x4a, 0x0e, 0x49, 0x90, 0xf6, 0xf3, 0x0b, 0x63, 0x8f, 0xc0, 0x1c, 0x5a, 0x49, 0x2c, 0xc5, 0x61,
+	0x55, 0x63, 0xa1, 0x95, 0xac, 0xf9, 0x80, 0xe2, 0x23, 0x37, 0x59, 0xba, 0xc1, 0x8b, 0xd7, 0x5d,
+	0xb7, 0x46, 0x91, 0x5f, 0x58, 0x3e, 0xf4, 0xdd, 0xbc, 0x30, 0xb7, 0x8b, 0x87, 0xaf, 0x53, 0x1c,
+	0x1c, 0x4f, 0x71, 0xf0, 0x73, 0x8a, 0x83, 0x8f, 0x73, 0xdc, 0x3a, 0x9e, 0xe3, 0xd6, 0xf7, 0x39,
+	0x6e, 0xbd, 0xfe, 0xf7, 0xaf, 0xdb, 0xd3, 0xf3, 0xec, 0xa1, 0xc2, 0xfa, 0x2d, 0xa4, 0xcf, 0x3d,
+	0xff, 0x0e, 0x00, 0x63, 0x48, 0x7e, 0x24, 0xd6, 0x01, 0x00, 0x00,
 }
 
 func (m *Record) Marshal() (dAtA []byte, err error) {
@@ -265,6 +295,26 @@ func (m *Domain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.UnlockAt != 0 {
+		i = encodeVarintDomain(dAtA, i, uint64(m.UnlockAt))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.LockDelaySeconds != 0 {
+		i = encodeVarintDomain(dAtA, i, uint64(m.LockDelaySeconds))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Locked {
+		i--
+		if m.Locked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
 	if m.UpdatedAt != 0 {
 		i = encodeVarintDomain(dAtA, i, uint64(m.UpdatedAt))
 		i--
@@ -385,6 +435,15 @@ func (m *Domain) Size() (n int) {
 	if m.UpdatedAt != 0 {
 		n += 1 + sovDomain(uint64(m.UpdatedAt))
 	}
+	if m.Locked {
+		n += 2
+	}
+	if m.LockDelaySeconds != 0 {
+		n += 1 + sovDomain(uint64(m.LockDelaySeconds))
+	}
+	if m.UnlockAt != 0 {
+		n += 1 + sovDomain(uint64(m.UnlockAt))
+	}
 	return n
 }
 
@@ -756,6 +815,64 @@ func (m *Domain) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Locked = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockDelaySeconds", wireType)
+			}
+			m.LockDelaySeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockDelaySeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockAt", wireType)
+			}
+			m.UnlockAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnlockAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDomain(dAtA[iNdEx:])
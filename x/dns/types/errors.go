@@ -16,4 +16,9 @@ var (
 	ErrInsufficientFee = errors.Register(ModuleName, 1108, "insufficient fee")
 
 	ErrInvalidRequest = errors.Register(ModuleName, 1109, "invalid request")
+
+	ErrDomainLocked     = errors.Register(ModuleName, 1110, "domain is locked")
+	ErrDomainNotLocked  = errors.Register(ModuleName, 1111, "domain is not locked")
+	ErrUnlockPending    = errors.Register(ModuleName, 1112, "unlock already pending")
+	ErrInvalidLockDelay = errors.Register(ModuleName, 1113, "invalid lock delay")
 )
@@ -0,0 +1,27 @@
+package types
+
+import "context"
+
+// DnsHooks lets other modules react to x/dns state changes.
+type DnsHooks interface {
+	// AfterDomainOwnerChanged is called whenever name ("domain.ext") moves to
+	// a new owner: transfers, auction settlement and re-registration of a
+	// lapsed name.
+	AfterDomainOwnerChanged(ctx context.Context, name, previousOwner, newOwner string) error
+}
+
+// MultiDnsHooks fans hook calls out to several implementations in order.
+type MultiDnsHooks []DnsHooks
+
+func NewMultiDnsHooks(hooks ...DnsHooks) MultiDnsHooks {
+	return hooks
+}
+
+func (h MultiDnsHooks) AfterDomainOwnerChanged(ctx context.Context, name, previousOwner, newOwner string) error {
+	for _, hook := range h {
+		if err := hook.AfterDomainOwnerChanged(ctx, name, previousOwner, newOwner); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,17 @@
+package types
+
+// IsLocked reports whether the owner lock still blocks Transfer and Update at
+// now. A pending unlock releases the lock once unlock_at has been reached.
+func (d Domain) IsLocked(now uint64) bool {
+	if !d.Locked {
+		return false
+	}
+	return d.UnlockAt == 0 || now < d.UnlockAt
+}
+
+// ClearLock drops any lock state, e.g. when ownership changes hands.
+func (d *Domain) ClearLock() {
+	d.Locked = false
+	d.LockDelaySeconds = 0
+	d.UnlockAt = 0
+}
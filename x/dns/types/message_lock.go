@@ -0,0 +1,18 @@
+package types
+
+func NewMsgLockDomain(creator string, domain string, ext string, delaySeconds uint64) *MsgLockDomain {
+	return &MsgLockDomain{
+		Creator:      creator,
+		Domain:       domain,
+		Ext:          ext,
+		DelaySeconds: delaySeconds,
+	}
+}
+
+func NewMsgUnlockDomain(creator string, domain string, ext string) *MsgUnlockDomain {
+	return &MsgUnlockDomain{
+		Creator: creator,
+		Domain:  domain,
+		Ext:     ext,
+	}
+}
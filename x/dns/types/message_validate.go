@@ -14,6 +14,8 @@ var (
 	_ sdk.Msg = (*MsgTransfer)(nil)
 	_ sdk.Msg = (*MsgBid)(nil)
 	_ sdk.Msg = (*MsgSettle)(nil)
+	_ sdk.Msg = (*MsgLockDomain)(nil)
+	_ sdk.Msg = (*MsgUnlockDomain)(nil)
 	_ sdk.Msg = (*MsgCreateDomain)(nil)
 	_ sdk.Msg = (*MsgUpdateDomain)(nil)
 	_ sdk.Msg = (*MsgDeleteDomain)(nil)
@@ -95,6 +97,23 @@ func (msg *MsgSettle) ValidateBasic() error {
 	return validateDomainAndExt(msg.Domain, msg.Ext)
 }
 
+func (msg *MsgLockDomain) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address (%s)", err)
+	}
+	if msg.DelaySeconds == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("delay_seconds must be > 0")
+	}
+	return validateDomainAndExt(msg.Domain, msg.Ext)
+}
+
+func (msg *MsgUnlockDomain) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address (%s)", err)
+	}
+	return validateDomainAndExt(msg.Domain, msg.Ext)
+}
+
 func (msg *MsgCreateDomain) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
 		return sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address (%s)", err)
@@ -38,6 +38,11 @@ var (
 
 	// DefaultMinPriceUlmnPerMonth is the DAO-controlled floor before multipliers.
 	DefaultMinPriceUlmnPerMonth uint64 = 2_000_000 // 2 LMN / month
+
+	// DefaultMinLockDelaySeconds / DefaultMaxLockDelaySeconds bound the unlock
+	// delay an owner may pick when locking a domain.
+	DefaultMinLockDelaySeconds uint64 = 24 * 3600      // 1 day
+	DefaultMaxLockDelaySeconds uint64 = 30 * 24 * 3600 // 30 days
 )
 
 func NewParams(
@@ -75,7 +80,7 @@ func NewParams(
 }
 
 func DefaultParams() Params {
-	return NewParams(
+	p := NewParams(
 		DefaultBaseFeeDns,
 		DefaultAlpha,
 		DefaultFloor,
@@ -91,6 +96,9 @@ func DefaultParams() Params {
 		defaultLengthTiers(defaultExtTierDefs),
 		DefaultMinPriceUlmnPerMonth,
 	)
+	p.MinLockDelaySeconds = DefaultMinLockDelaySeconds
+	p.MaxLockDelaySeconds = DefaultMaxLockDelaySeconds
+	return p
 }
 
 func (p Params) Validate() error {
@@ -136,6 +144,9 @@ func (p Params) Validate() error {
 	if err := validateMinPrice(p.MinPriceUlmnPerMonth); err != nil {
 		return err
 	}
+	if err := validateLockDelayBounds(p.MinLockDelaySeconds, p.MaxLockDelaySeconds); err != nil {
+		return err
+	}
 
 	base, e1 := sdkmath.LegacyNewDecFromStr(p.BaseFeeDns)
 	floor, e2 := sdkmath.LegacyNewDecFromStr(p.Floor)
@@ -203,6 +214,27 @@ func validateMinPrice(v uint64) error {
 	return nil
 }
 
+func validateLockDelayBounds(min, max uint64) error {
+	if max != 0 && min > max {
+		return fmt.Errorf("min_lock_delay_seconds must be <= max_lock_delay_seconds")
+	}
+	return nil
+}
+
+// ValidateLockDelay checks an owner-chosen unlock delay against the lock bounds.
+func (p Params) ValidateLockDelay(delay uint64) error {
+	if delay == 0 {
+		return fmt.Errorf("delay_seconds must be > 0")
+	}
+	if delay < p.MinLockDelaySeconds {
+		return fmt.Errorf("delay_seconds must be >= %d", p.MinLockDelaySeconds)
+	}
+	if p.MaxLockDelaySeconds != 0 && delay > p.MaxLockDelaySeconds {
+		return fmt.Errorf("delay_seconds must be <= %d", p.MaxLockDelaySeconds)
+	}
+	return nil
+}
+
 type tierDef struct {
 	maxLen uint32
 	bps    uint32
@@ -41,6 +41,9 @@ type Params struct {
 	MinPriceUlmnPerMonth   uint64        `protobuf:"varint,18,opt,name=min_price_ulmn_per_month,json=minPriceUlmnPerMonth,proto3" json:"min_price_ulmn_per_month,omitempty"`
 	// Flat fee (in ulmn) charged on every MsgUpdate.
 	UpdateFeeUlmn uint64 `protobuf:"varint,19,opt,name=update_fee_ulmn,json=updateFeeUlmn,proto3" json:"update_fee_ulmn,omitempty"`
+	// Bounds for the owner-chosen unlock delay of a domain lock (0 max = unbounded).
+	MinLockDelaySeconds uint64 `protobuf:"varint,20,opt,name=min_lock_delay_seconds,json=minLockDelaySeconds,proto3" json:"min_lock_delay_seconds,omitempty"`
+	MaxLockDelaySeconds uint64 `protobuf:"varint,21,opt,name=max_lock_delay_seconds,json=maxLockDelaySeconds,proto3" json:"max_lock_delay_seconds,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -181,6 +184,20 @@ func (m *Params) GetUpdateFeeUlmn() uint64 {
 	return 0
 }
 
+func (m *Params) GetMinLockDelaySeconds() uint64 {
+	if m != nil {
+		return m.MinLockDelaySeconds
+	}
+	return 0
+}
+
+func (m *Params) GetMaxLockDelaySeconds() uint64 {
+	if m != nil {
+		return m.MaxLockDelaySeconds
+	}
+	return 0
+}
+
 // LengthTier defines a multiplier (in basis points) that applies when the
 // domain or extension length is ≤ max_len. The last tier must set max_len = 0
 // to denote an open upper bound.
@@ -244,43 +261,45 @@ func init() {
 func init() { proto.RegisterFile("lumen/dns/v1/params.proto", fileDescriptor_c607f3588324c4ae) }
 
 var fileDescriptor_c607f3588324c4ae = []byte{
-	// 573 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0xc1, 0x6e, 0x13, 0x3d,
-	0x10, 0xc7, 0xbb, 0x5f, 0xb7, 0x69, 0x32, 0xd9, 0xb4, 0x89, 0xdb, 0x0f, 0xdc, 0x22, 0x42, 0xa8,
-	0x04, 0x8a, 0x8a, 0x94, 0xa8, 0x45, 0x20, 0x51, 0x6e, 0x55, 0xd5, 0x43, 0x54, 0xa4, 0x68, 0x81,
-	0x0b, 0x97, 0x95, 0x93, 0x9d, 0xa4, 0x96, 0xd6, 0xf6, 0xca, 0xf6, 0xb6, 0xc9, 0x0b, 0x70, 0xe0,
-	0xc4, 0x23, 0xf0, 0x08, 0x3c, 0x06, 0xc7, 0x1e, 0x39, 0xa2, 0xf6, 0x00, 0x8f, 0x81, 0xd6, 0x4e,
-	0x9b, 0xde, 0xb8, 0x58, 0x33, 0xff, 0xff, 0x6f, 0xa2, 0x99, 0xc9, 0x2c, 0xec, 0x64, 0x85, 0x40,
-	0xd9, 0x4f, 0xa5, 0xe9, 0x5f, 0x1c, 0xf4, 0x73, 0xa6, 0x99, 0x30, 0xbd, 0x5c, 0x2b, 0xab, 0x48,
-	0xe4, 0xac, 0x5e, 0x2a, 0x4d, 0xef, 0xe2, 0x60, 0xb7, 0xc5, 0x04, 0x97, 0xaa, 0xef, 0x5e, 0x0f,
-	0xec, 0x6e, 0x4f, 0xd5, 0x54, 0xb9, 0xb0, 0x5f, 0x46, 0x5e, 0xdd, 0xfb, 0xbc, 0x06, 0x95, 0xa1,
-	0xfb, 0x1d, 0xd2, 0x81, 0x68, 0xc4, 0x0c, 0x26, 0x13, 0xc4, 0x24, 0x95, 0x86, 0x06, 0x9d, 0xa0,
-	0x5b, 0x8b, 0xa1, 0xd4, 0x4e, 0x11, 0x4f, 0xa4, 0x21, 0xdb, 0xb0, 0xc6, 0xb2, 0xfc, 0x9c, 0xd1,
-	0xff, 0x9c, 0xe5, 0x93, 0x52, 0x9d, 0x64, 0x4a, 0x69, 0xba, 0xea, 0x55, 0x97, 0x10, 0x0a, 0xeb,
-	0x63, 0xe4, 0x19, 0x97, 0x53, 0x1a, 0x3a, 0xfd, 0x36, 0x25, 0x11, 0x04, 0x96, 0xae, 0x75, 0x82,
-	0x6e, 0x18, 0x07, 0x96, 0x3c, 0x06, 0x98, 0x6a, 0x36, 0xc6, 0x24, 0x65, 0x73, 0x43, 0x2b, 0x4e,
-	0xae, 0x39, 0xe5, 0x84, 0xcd, 0x0d, 0x79, 0x0a, 0x11, 0x2b, 0xc6, 0x96, 0x2b, 0xe9, 0x81, 0x75,
-	0x07, 0xd4, 0x17, 0x9a, 0x43, 0xf6, 0xa1, 0x65, 0x35, 0x93, 0x66, 0x82, 0xda, 0xf5, 0x5e, 0x64,
-	0x42, 0xd2, 0xc8, 0x71, 0x9b, 0xb7, 0xc6, 0x29, 0xe2, 0xc7, 0x4c, 0x48, 0x37, 0x23, 0x4f, 0x97,
-	0x58, 0xc3, 0x61, 0x30, 0xe2, 0xe9, 0x2d, 0xf1, 0x06, 0x76, 0x8a, 0x3c, 0x65, 0x16, 0x13, 0x5d,
-	0x3e, 0x19, 0x17, 0xdc, 0x26, 0x06, 0xc7, 0x4a, 0xa6, 0x86, 0x6e, 0x38, 0xfc, 0x81, 0x07, 0x62,
-	0x66, 0xf1, 0xac, 0xb4, 0xdf, 0x7b, 0x97, 0x1c, 0xc2, 0xff, 0x8b, 0xd2, 0x5c, 0x5d, 0x26, 0x29,
-	0x9f, 0x4c, 0xf8, 0xb8, 0xc8, 0xec, 0x9c, 0x6e, 0x76, 0x82, 0x6e, 0x23, 0xde, 0xf2, 0xe6, 0x50,
-	0x5d, 0x9e, 0xdc, 0x59, 0xe4, 0x2d, 0x44, 0xa9, 0x12, 0x8c, 0xcb, 0xc4, 0x72, 0xd4, 0x86, 0x36,
-	0x3b, 0xab, 0xdd, 0xfa, 0x21, 0xed, 0xdd, 0xff, 0x37, 0x7b, 0x67, 0x28, 0xa7, 0xf6, 0xfc, 0x03,
-	0x47, 0x1d, 0xd7, 0x3d, 0x5d, 0xc6, 0x86, 0xbc, 0x82, 0x1a, 0xce, 0xec, 0xa2, 0xb2, 0xf5, 0x8f,
-	0xca, 0x2a, 0xce, 0xac, 0x2f, 0x7b, 0x0d, 0x54, 0x70, 0x99, 0xe4, 0x9a, 0x8f, 0xfd, 0x1a, 0x92,
-	0x1c, 0x75, 0x22, 0x94, 0xb4, 0xe7, 0x94, 0xb8, 0x09, 0xb7, 0x05, 0x97, 0xc3, 0xd2, 0x2e, 0x57,
-	0x32, 0x44, 0xfd, 0xae, 0xf4, 0xc8, 0x73, 0xd8, 0x5c, 0xcc, 0x77, 0xb7, 0xbf, 0x2d, 0x87, 0x37,
-	0xbc, 0xbc, 0x58, 0xe1, 0xd1, 0xa3, 0x3f, 0xdf, 0x9e, 0x04, 0x5f, 0x7e, 0x7f, 0xdf, 0x27, 0xfe,
-	0x5c, 0x67, 0xee, 0x60, 0xfd, 0x95, 0x0d, 0xc2, 0x6a, 0xb5, 0x59, 0x1b, 0x84, 0xd5, 0x5a, 0x13,
-	0x06, 0x61, 0x15, 0x9a, 0xf5, 0x41, 0x58, 0xad, 0x37, 0xa3, 0xbd, 0x18, 0x60, 0xd9, 0x2c, 0x79,
-	0x08, 0xeb, 0x82, 0xcd, 0x92, 0x0c, 0xa5, 0x3b, 0xc3, 0x46, 0x5c, 0x11, 0x6c, 0x76, 0x86, 0x92,
-	0x3c, 0x83, 0x0d, 0x51, 0x64, 0x96, 0xe7, 0x19, 0x47, 0x9d, 0x8c, 0x72, 0xe3, 0x6e, 0xb1, 0x11,
-	0x37, 0x96, 0xea, 0x71, 0x6e, 0x8e, 0xc2, 0xb2, 0x85, 0xe3, 0x17, 0x3f, 0xae, 0xdb, 0xc1, 0xd5,
-	0x75, 0x3b, 0xf8, 0x75, 0xdd, 0x0e, 0xbe, 0xde, 0xb4, 0x57, 0xae, 0x6e, 0xda, 0x2b, 0x3f, 0x6f,
-	0xda, 0x2b, 0x9f, 0x5a, 0xf7, 0x3b, 0xb3, 0xf3, 0x1c, 0xcd, 0xa8, 0xe2, 0x3e, 0x88, 0x97, 0x7f,
-	0x03, 0x00, 0x00, 0xff, 0xff, 0x9a, 0x14, 0x62, 0x37, 0x64, 0x03, 0x00, 0x00,
+	// 607 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
+	0x1c, 0xc6, 0x17, 0x96, 0x75, 0xad, 0x9b, 0x6e, 0xad, 0xd7, 0x0d, 0x6f, 0x88, 0x52, 0x26, 0x81,
+	0xaa, 0x21, 0xb5, 0xda, 0x26, 0x90, 0x18, 0xb7, 0xa9, 0xda, 0xa1, 0x1a, 0x52, 0x15, 0xe0, 0xc2,
+	0x25, 0x72, 0x93, 0x7f, 0x3b, 0x6b, 0x89, 0x1d, 0xd9, 0xce, 0xd6, 0xbe, 0x02, 0x27, 0x1e, 0x81,
+	0x47, 0xe0, 0x31, 0x38, 0xee, 0xc8, 0x11, 0x6d, 0x07, 0x38, 0xf2, 0x08, 0xc8, 0x76, 0xbb, 0x4d,
+	0x08, 0x89, 0x4b, 0x64, 0x7f, 0xdf, 0xef, 0x4b, 0xec, 0x2f, 0x36, 0xda, 0x4e, 0x8b, 0x0c, 0x78,
+	0x2f, 0xe1, 0xaa, 0x77, 0xb1, 0xdf, 0xcb, 0xa9, 0xa4, 0x99, 0xea, 0xe6, 0x52, 0x68, 0x81, 0x03,
+	0x6b, 0x75, 0x13, 0xae, 0xba, 0x17, 0xfb, 0x3b, 0x0d, 0x9a, 0x31, 0x2e, 0x7a, 0xf6, 0xe9, 0x80,
+	0x9d, 0xe6, 0x44, 0x4c, 0x84, 0x1d, 0xf6, 0xcc, 0xc8, 0xa9, 0xbb, 0xbf, 0x57, 0x50, 0x69, 0x68,
+	0xdf, 0x83, 0xdb, 0x28, 0x18, 0x51, 0x05, 0xd1, 0x18, 0x20, 0x4a, 0xb8, 0x22, 0x5e, 0xdb, 0xeb,
+	0x54, 0x42, 0x64, 0xb4, 0x13, 0x80, 0x3e, 0x57, 0xb8, 0x89, 0x56, 0x68, 0x9a, 0x9f, 0x51, 0xf2,
+	0xc0, 0x5a, 0x6e, 0x62, 0xd4, 0x71, 0x2a, 0x84, 0x24, 0xcb, 0x4e, 0xb5, 0x13, 0x4c, 0xd0, 0x6a,
+	0x0c, 0x2c, 0x65, 0x7c, 0x42, 0x7c, 0xab, 0x2f, 0xa6, 0x38, 0x40, 0x9e, 0x26, 0x2b, 0x6d, 0xaf,
+	0xe3, 0x87, 0x9e, 0xc6, 0x8f, 0x11, 0x9a, 0x48, 0x1a, 0x43, 0x94, 0xd0, 0x99, 0x22, 0x25, 0x2b,
+	0x57, 0xac, 0xd2, 0xa7, 0x33, 0x85, 0x9f, 0xa2, 0x80, 0x16, 0xb1, 0x66, 0x82, 0x3b, 0x60, 0xd5,
+	0x02, 0xd5, 0xb9, 0x66, 0x91, 0x3d, 0xd4, 0xd0, 0x92, 0x72, 0x35, 0x06, 0x69, 0xd7, 0x5e, 0xa4,
+	0x19, 0x27, 0x81, 0xe5, 0xd6, 0x17, 0xc6, 0x09, 0xc0, 0x87, 0x34, 0xe3, 0x76, 0x8f, 0x2c, 0xb9,
+	0xc3, 0x6a, 0x16, 0x43, 0x23, 0x96, 0x2c, 0x88, 0xd7, 0x68, 0xbb, 0xc8, 0x13, 0xaa, 0x21, 0x92,
+	0xe6, 0x91, 0xb2, 0x8c, 0xe9, 0x48, 0x41, 0x2c, 0x78, 0xa2, 0xc8, 0x9a, 0xc5, 0xb7, 0x1c, 0x10,
+	0x52, 0x0d, 0xa7, 0xc6, 0x7e, 0xe7, 0x5c, 0x7c, 0x80, 0x36, 0xe7, 0xd1, 0x5c, 0x5c, 0x46, 0x09,
+	0x1b, 0x8f, 0x59, 0x5c, 0xa4, 0x7a, 0x46, 0xd6, 0xdb, 0x5e, 0xa7, 0x16, 0x6e, 0x38, 0x73, 0x28,
+	0x2e, 0xfb, 0xb7, 0x16, 0x7e, 0x83, 0x82, 0x44, 0x64, 0x94, 0xf1, 0x48, 0x33, 0x90, 0x8a, 0xd4,
+	0xdb, 0xcb, 0x9d, 0xea, 0x01, 0xe9, 0xde, 0xff, 0x9b, 0xdd, 0x53, 0xe0, 0x13, 0x7d, 0xf6, 0x9e,
+	0x81, 0x0c, 0xab, 0x8e, 0x36, 0x63, 0x85, 0x5f, 0xa2, 0x0a, 0x4c, 0xf5, 0x3c, 0xd9, 0xf8, 0x4f,
+	0xb2, 0x0c, 0x53, 0xed, 0x62, 0xaf, 0x10, 0xc9, 0x18, 0x8f, 0x72, 0xc9, 0x62, 0x57, 0x43, 0x94,
+	0x83, 0x8c, 0x32, 0xc1, 0xf5, 0x19, 0xc1, 0x76, 0x87, 0xcd, 0x8c, 0xf1, 0xa1, 0xb1, 0x4d, 0x25,
+	0x43, 0x90, 0x6f, 0x8d, 0x87, 0x9f, 0xa3, 0xf5, 0xf9, 0xfe, 0x6e, 0xfb, 0xdb, 0xb0, 0x78, 0xcd,
+	0xc9, 0x8b, 0x0a, 0x0f, 0xd1, 0x96, 0x79, 0x7f, 0x2a, 0xe2, 0xf3, 0x28, 0x81, 0x94, 0xce, 0x6e,
+	0xfb, 0x6b, 0x5a, 0x7c, 0x23, 0x63, 0xfc, 0x54, 0xc4, 0xe7, 0x7d, 0xe3, 0x2d, 0xca, 0x33, 0x21,
+	0x3a, 0xfd, 0x57, 0x68, 0x73, 0x1e, 0xa2, 0xd3, 0xbf, 0x43, 0x47, 0x8f, 0x7e, 0x7d, 0x79, 0xe2,
+	0x7d, 0xfa, 0xf9, 0x75, 0x0f, 0xbb, 0x8b, 0x31, 0xb5, 0x57, 0xc3, 0x9d, 0xe7, 0x81, 0x5f, 0x2e,
+	0xd7, 0x2b, 0x03, 0xbf, 0x5c, 0xa9, 0xa3, 0x81, 0x5f, 0x46, 0xf5, 0xea, 0xc0, 0x2f, 0x57, 0xeb,
+	0xc1, 0x6e, 0x88, 0xd0, 0x5d, 0x2d, 0xf8, 0x21, 0x5a, 0xb5, 0xdf, 0x05, 0x6e, 0x0f, 0x7c, 0x2d,
+	0x2c, 0x99, 0x0f, 0x01, 0xc7, 0xcf, 0xd0, 0x5a, 0x56, 0xa4, 0x9a, 0xe5, 0x29, 0x03, 0x19, 0x8d,
+	0x72, 0x65, 0x4f, 0x7d, 0x2d, 0xac, 0xdd, 0xa9, 0xc7, 0xb9, 0x3a, 0xf2, 0xcd, 0x12, 0x8e, 0x5f,
+	0x7c, 0xbb, 0x6e, 0x79, 0x57, 0xd7, 0x2d, 0xef, 0xc7, 0x75, 0xcb, 0xfb, 0x7c, 0xd3, 0x5a, 0xba,
+	0xba, 0x69, 0x2d, 0x7d, 0xbf, 0x69, 0x2d, 0x7d, 0x6c, 0xdc, 0x5f, 0x99, 0x9e, 0xe5, 0xa0, 0x46,
+	0x25, 0x7b, 0xf5, 0x0e, 0xff, 0x0c, 0x00, 0x36, 0x63, 0x5a, 0xf7, 0xce, 0x03, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -357,6 +376,12 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.UpdateFeeUlmn != that1.UpdateFeeUlmn {
 		return false
 	}
+	if this.MinLockDelaySeconds != that1.MinLockDelaySeconds {
+		return false
+	}
+	if this.MaxLockDelaySeconds != that1.MaxLockDelaySeconds {
+		return false
+	}
 	return true
 }
 func (this *LengthTier) Equal(that interface{}) bool {
@@ -406,6 +431,20 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxLockDelaySeconds != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxLockDelaySeconds))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.MinLockDelaySeconds != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinLockDelaySeconds))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
 	if m.UpdateFeeUlmn != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.UpdateFeeUlmn))
 		i--
@@ -623,6 +662,12 @@ func (m *Params) Size() (n int) {
 	if m.UpdateFeeUlmn != 0 {
 		n += 2 + sovParams(uint64(m.UpdateFeeUlmn))
 	}
+	if m.MinLockDelaySeconds != 0 {
+		n += 2 + sovParams(uint64(m.MinLockDelaySeconds))
+	}
+	if m.MaxLockDelaySeconds != 0 {
+		n += 2 + sovParams(uint64(m.MaxLockDelaySeconds))
+	}
 	return n
 }
 
@@ -1043,6 +1088,44 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinLockDelaySeconds", wireType)
+			}
+			m.MinLockDelaySeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinLockDelaySeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLockDelaySeconds", wireType)
+			}
+			m.MaxLockDelaySeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLockDelaySeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
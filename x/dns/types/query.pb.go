@@ -192,6 +192,8 @@ type QueryResolveResponse struct {
 	Records  []*Record `protobuf:"bytes,4,rep,name=records,proto3" json:"records,omitempty"`
 	ExpireAt uint64    `protobuf:"varint,5,opt,name=expire_at,json=expireAt,proto3" json:"expire_at,omitempty"`
 	Status   string    `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	Locked   bool      `protobuf:"varint,7,opt,name=locked,proto3" json:"locked,omitempty"`
+	UnlockAt uint64    `protobuf:"varint,8,opt,name=unlock_at,json=unlockAt,proto3" json:"unlock_at,omitempty"`
 }
 
 func (m *QueryResolveResponse) Reset()         { *m = QueryResolveResponse{} }
@@ -255,6 +257,20 @@ func (m *QueryResolveResponse) GetStatus() string {
 	return ""
 }
 
+func (m *QueryResolveResponse) GetLocked() bool {
+	if m != nil {
+		return m.Locked
+	}
+	return false
+}
+
+func (m *QueryResolveResponse) GetUnlockAt() uint64 {
+	if m != nil {
+		return m.UnlockAt
+	}
+	return 0
+}
+
 type QueryDomainsByOwnerRequest struct {
 	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
 }
@@ -1023,76 +1039,78 @@ func init() {
 func init() { proto.RegisterFile("lumen/dns/v1/query.proto", fileDescriptor_e0914967168595d8) }
 
 var fileDescriptor_e0914967168595d8 = []byte{
-	// 1101 bytes of a gzipped FileDescriptorProto
+	// 1124 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x97, 0x41, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0xb3, 0xb1, 0x63, 0xe3, 0x49, 0x40, 0x74, 0x70, 0xd2, 0xed, 0x36, 0x75, 0xcc, 0x92,
-	0xb6, 0xa6, 0x88, 0x1d, 0x25, 0x08, 0x15, 0x71, 0x22, 0xa6, 0xb4, 0x08, 0x2a, 0x08, 0xe6, 0xc6,
-	0xc5, 0xac, 0xb3, 0x53, 0x67, 0x85, 0xbd, 0xeb, 0xee, 0xac, 0x83, 0xad, 0x65, 0x25, 0xc4, 0x07,
-	0x80, 0x22, 0xd4, 0x03, 0x42, 0xdc, 0x39, 0x72, 0xe0, 0x43, 0xf4, 0x58, 0x89, 0x0b, 0x27, 0x84,
-	0x12, 0x24, 0xbe, 0x00, 0x1f, 0x00, 0xed, 0x9b, 0xb7, 0xf1, 0x6e, 0x76, 0xec, 0x04, 0xa9, 0x97,
-	0x64, 0xdf, 0xec, 0x9b, 0xf9, 0xff, 0xde, 0x9b, 0xe7, 0xf7, 0x6c, 0xa2, 0x0f, 0xc6, 0x43, 0xee,
-	0x31, 0xc7, 0x13, 0xec, 0x68, 0x87, 0x3d, 0x1c, 0xf3, 0x60, 0x6a, 0x8d, 0x02, 0x3f, 0xf4, 0xe9,
-	0x1a, 0xbc, 0xb1, 0x1c, 0x4f, 0x58, 0x47, 0x3b, 0xc6, 0x25, 0x7b, 0xe8, 0x7a, 0x3e, 0x83, 0xbf,
-	0xd2, 0xc1, 0xb8, 0x75, 0xe0, 0x8b, 0xa1, 0x2f, 0x58, 0xcf, 0x16, 0x5c, 0xee, 0x64, 0x47, 0x3b,
-	0x3d, 0x1e, 0xda, 0x3b, 0x6c, 0x64, 0xf7, 0x5d, 0xcf, 0x0e, 0x5d, 0xdf, 0x43, 0xdf, 0x7a, 0xdf,
-	0xef, 0xfb, 0xf0, 0xc8, 0x92, 0x27, 0x5c, 0xdd, 0xec, 0xfb, 0x7e, 0x7f, 0xc0, 0x99, 0x3d, 0x72,
-	0x99, 0xed, 0x79, 0x7e, 0x08, 0x5b, 0x04, 0xbe, 0x35, 0x72, 0x68, 0xf6, 0xf8, 0x20, 0x73, 0xde,
-	0x95, 0xdc, 0x3b, 0xc7, 0x1f, 0xda, 0xae, 0xfa, 0xd5, 0xc8, 0x0e, 0xec, 0x21, 0x9e, 0x68, 0xd6,
-	0x09, 0xfd, 0x24, 0xe1, 0xdc, 0x87, 0xc5, 0x0e, 0x7f, 0x38, 0xe6, 0x22, 0x34, 0x3f, 0x22, 0x2f,
-	0xe5, 0x56, 0xc5, 0xc8, 0xf7, 0x04, 0xa7, 0xb7, 0x49, 0x45, 0x6e, 0xd6, 0xb5, 0xa6, 0xd6, 0x5a,
-	0xdd, 0xad, 0x5b, 0xd9, 0x84, 0x58, 0xd2, 0xbb, 0x5d, 0x7b, 0xf2, 0xe7, 0xd6, 0xd2, 0x2f, 0xff,
-	0xfc, 0x7a, 0x4b, 0xeb, 0xa0, 0xbb, 0xf9, 0xad, 0x86, 0x07, 0x76, 0xb8, 0xf0, 0x07, 0x47, 0x1c,
-	0x75, 0xe8, 0x06, 0xa9, 0x48, 0x50, 0x38, 0xb0, 0xd6, 0x41, 0x8b, 0xbe, 0x48, 0x4a, 0x7c, 0x12,
-	0xea, 0xcb, 0xb0, 0x98, 0x3c, 0x52, 0x9d, 0x54, 0x03, 0x7e, 0xe0, 0x07, 0x8e, 0xd0, 0x57, 0x60,
-	0x35, 0x35, 0xe9, 0x55, 0x52, 0xe3, 0x93, 0x91, 0x1b, 0xf0, 0xae, 0x1d, 0xea, 0x95, 0xa6, 0xd6,
-	0x2a, 0x77, 0x9e, 0x93, 0x0b, 0x7b, 0x20, 0x20, 0x42, 0x3b, 0x1c, 0x0b, 0xbd, 0x2a, 0x05, 0xa4,
-	0x65, 0x7e, 0xaf, 0x91, 0x7a, 0x1e, 0x08, 0x43, 0xac, 0x93, 0x15, 0xff, 0x4b, 0x8f, 0x07, 0x08,
-	0x24, 0x0d, 0x6a, 0xcd, 0xd4, 0xcb, 0xcd, 0x52, 0x31, 0xf2, 0x0e, 0xbc, 0x9c, 0xc3, 0xb4, 0x32,
-	0x97, 0xa9, 0x92, 0x63, 0xda, 0x25, 0x06, 0x20, 0xdd, 0x81, 0x1c, 0x88, 0xf6, 0xf4, 0xe3, 0x44,
-	0x3b, 0x4d, 0x95, 0x12, 0xcc, 0xbc, 0x4d, 0xae, 0x2a, 0xf7, 0x60, 0x34, 0x3a, 0xa9, 0xca, 0x8c,
-	0x26, 0x37, 0x56, 0x4a, 0xb2, 0x86, 0xa6, 0xf9, 0x48, 0x23, 0x57, 0x60, 0xe7, 0x9e, 0x2c, 0xa2,
-	0x4f, 0x81, 0xe1, 0xff, 0xdf, 0x4b, 0xb2, 0xe2, 0x39, 0x7a, 0x09, 0x62, 0x4c, 0x1e, 0xe9, 0x16,
-	0x59, 0x3d, 0x74, 0xfb, 0x87, 0x5c, 0x84, 0xdd, 0x9e, 0xeb, 0xe8, 0x65, 0xf0, 0x25, 0xb8, 0xd4,
-	0x76, 0x9d, 0xe4, 0xf0, 0x9e, 0xeb, 0x38, 0x3c, 0xc0, 0x9b, 0x44, 0xcb, 0x8c, 0x31, 0xfe, 0x33,
-	0x44, 0xb3, 0x8b, 0x11, 0xa1, 0x1d, 0x84, 0x40, 0x54, 0xee, 0x48, 0x23, 0x95, 0x5f, 0x9e, 0x2b,
-	0x5f, 0x5a, 0x20, 0x5f, 0xce, 0xc9, 0x0f, 0xc8, 0x06, 0xc8, 0xb7, 0x6d, 0xc1, 0xef, 0x72, 0x7e,
-	0xc7, 0x3b, 0xcd, 0xc6, 0x1a, 0xd1, 0x52, 0x59, 0x0d, 0x2e, 0xc2, 0x1e, 0x8c, 0x0e, 0x6d, 0xcc,
-	0x82, 0x34, 0x92, 0xd5, 0x07, 0x03, 0xdf, 0x0f, 0x50, 0x50, 0x1a, 0x49, 0xfe, 0x0f, 0xb8, 0x3b,
-	0x70, 0xbd, 0x3e, 0x8a, 0xa5, 0xa6, 0xf9, 0x9d, 0x46, 0x2e, 0x17, 0xe4, 0x30, 0xd4, 0x26, 0x59,
-	0x4b, 0x1a, 0x48, 0xf7, 0x01, 0xe7, 0x5d, 0xc7, 0x13, 0x78, 0x07, 0xa4, 0x77, 0xea, 0x29, 0x89,
-	0x96, 0x0b, 0x44, 0x25, 0x25, 0x51, 0x79, 0x0e, 0xd1, 0x4a, 0x9e, 0xe8, 0x75, 0xb2, 0x0e, 0x40,
-	0xf7, 0x78, 0x28, 0xab, 0x29, 0x53, 0x79, 0xae, 0xe7, 0xf0, 0x49, 0x5a, 0x79, 0x60, 0x98, 0xf7,
-	0x31, 0x5d, 0x19, 0x77, 0xc4, 0xdf, 0xcd, 0x15, 0x4f, 0xe1, 0xb3, 0x22, 0xbd, 0xdb, 0xe5, 0xa4,
-	0x4b, 0xa4, 0x85, 0x65, 0x76, 0x51, 0x7c, 0x6f, 0x30, 0xc8, 0x8b, 0xdf, 0x25, 0x64, 0xd6, 0x39,
-	0xf1, 0xc0, 0x1b, 0x96, 0x6c, 0xb3, 0x56, 0x92, 0x11, 0x4b, 0x36, 0x68, 0x6c, 0xb3, 0xd6, 0xbe,
-	0xdd, 0x4f, 0xbb, 0x4b, 0x27, 0xb3, 0xd3, 0x7c, 0xac, 0x21, 0x6f, 0x46, 0x41, 0xc1, 0x5b, 0xba,
-	0x18, 0x2f, 0xbd, 0x97, 0xc3, 0x5a, 0x06, 0xac, 0x9b, 0xe7, 0x62, 0x49, 0xc1, 0x1c, 0x97, 0x35,
-	0x4b, 0x23, 0xd6, 0xfd, 0xe2, 0xb4, 0xef, 0x63, 0xd9, 0x64, 0xfd, 0x31, 0x8e, 0x37, 0x49, 0x15,
-	0x27, 0x02, 0xe6, 0x69, 0x3d, 0x1f, 0x08, 0xfa, 0x63, 0x24, 0xa9, 0xaf, 0xf9, 0xf9, 0x2c, 0x31,
-	0x67, 0x08, 0x9e, 0x55, 0xee, 0x7f, 0x4c, 0x6b, 0x3d, 0x2b, 0xa1, 0x82, 0x2e, 0x5d, 0x14, 0xfa,
-	0x99, 0xe5, 0x7f, 0xf7, 0xdf, 0x1a, 0x59, 0x01, 0x36, 0xfa, 0x05, 0xa9, 0xc8, 0x01, 0x46, 0x9b,
-	0x79, 0x84, 0xe2, 0x7c, 0x34, 0x5e, 0x5e, 0xe0, 0x21, 0x45, 0xcc, 0xcd, 0x6f, 0x7e, 0xff, 0xfb,
-	0x87, 0xe5, 0x0d, 0x5a, 0x67, 0x8a, 0xe1, 0x4b, 0x7f, 0xd6, 0x48, 0x15, 0x47, 0x0f, 0x55, 0x1d,
-	0x96, 0x9f, 0x93, 0x86, 0xb9, 0xc8, 0x05, 0x05, 0x3f, 0x04, 0xc1, 0xf7, 0xe8, 0xbb, 0x79, 0xc1,
-	0x40, 0xba, 0xb1, 0x48, 0x56, 0x6e, 0xcc, 0x22, 0x3e, 0x09, 0x63, 0x16, 0xe1, 0xa8, 0x02, 0x1b,
-	0x27, 0x55, 0xcc, 0x22, 0x39, 0x8a, 0x62, 0xfa, 0x58, 0x23, 0x2f, 0xe4, 0x67, 0x0a, 0x6d, 0x29,
-	0x18, 0x94, 0xa3, 0xca, 0x78, 0xf5, 0x02, 0x9e, 0x08, 0x6d, 0x01, 0x74, 0x8b, 0xde, 0x60, 0x8a,
-	0x6f, 0x2f, 0xa2, 0xdb, 0x9b, 0x76, 0x61, 0xce, 0xb1, 0x08, 0xfe, 0xc5, 0xf4, 0x37, 0x8d, 0x3c,
-	0x9f, 0x9b, 0x0f, 0xf4, 0xa6, 0x42, 0x4c, 0x35, 0xd3, 0x8c, 0xd6, 0xf9, 0x8e, 0x08, 0xb5, 0x0f,
-	0x50, 0x1f, 0xd0, 0xf7, 0x99, 0xea, 0xeb, 0x56, 0x57, 0xe6, 0xa8, 0x90, 0x50, 0xee, 0x39, 0x31,
-	0x8b, 0x32, 0xb3, 0x27, 0x66, 0x91, 0x1c, 0x2d, 0x31, 0xfd, 0x49, 0x23, 0x64, 0xd6, 0xe8, 0xe9,
-	0xb6, 0x02, 0xa5, 0x30, 0x76, 0x8c, 0xeb, 0xe7, 0x78, 0x21, 0xed, 0x3b, 0x40, 0xfb, 0x36, 0x7d,
-	0x2b, 0x4f, 0x9b, 0x9d, 0x20, 0x2c, 0x4a, 0x00, 0x61, 0x2c, 0xc4, 0x2c, 0x82, 0x41, 0x10, 0xb3,
-	0x08, 0x1b, 0x7f, 0x4c, 0xbf, 0x22, 0xb5, 0xd3, 0x2e, 0x4e, 0x5f, 0x51, 0xa8, 0x9e, 0x1d, 0x09,
-	0xc6, 0xf6, 0x62, 0x27, 0x24, 0xdb, 0x06, 0xb2, 0x06, 0xdd, 0x54, 0x5d, 0x2e, 0x8b, 0xa0, 0xa1,
-	0xc5, 0x74, 0x4c, 0xc8, 0x7d, 0x57, 0x2c, 0x92, 0x3f, 0x3b, 0x14, 0x94, 0xf2, 0x85, 0xbe, 0x3e,
-	0xef, 0x13, 0x88, 0x1d, 0xfc, 0x6b, 0x8d, 0x90, 0x59, 0x13, 0xa5, 0x73, 0x22, 0xca, 0x77, 0x44,
-	0xe5, 0x95, 0x14, 0x3b, 0xb1, 0x79, 0x1d, 0x94, 0xb7, 0xe8, 0x35, 0x65, 0x01, 0x9d, 0x46, 0x3e,
-	0x25, 0xab, 0x49, 0xe4, 0x8b, 0x10, 0x0a, 0x4d, 0x59, 0x89, 0x50, 0xec, 0xab, 0xe6, 0x35, 0x40,
-	0xb8, 0x4c, 0xd7, 0x95, 0x08, 0xed, 0xd7, 0x9e, 0x1c, 0x37, 0xb4, 0xa7, 0xc7, 0x0d, 0xed, 0xaf,
-	0xe3, 0x86, 0xf6, 0xe8, 0xa4, 0xb1, 0xf4, 0xf4, 0xa4, 0xb1, 0xf4, 0xc7, 0x49, 0x63, 0xe9, 0xb3,
-	0x4b, 0xd2, 0x7f, 0x02, 0x3b, 0xc2, 0xe9, 0x88, 0x8b, 0x5e, 0x05, 0x7e, 0x2a, 0xbc, 0xf1, 0x5f,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x15, 0x47, 0x47, 0xde, 0x19, 0x0d, 0x00, 0x00,
+	0x14, 0xc7, 0xb3, 0xb1, 0xe3, 0x24, 0x2f, 0x01, 0xd1, 0xc1, 0x49, 0xb6, 0xdb, 0xd4, 0x09, 0x4b,
+	0xd2, 0x86, 0x22, 0x76, 0x94, 0x20, 0x54, 0xc4, 0x89, 0x98, 0xd2, 0x22, 0xa8, 0x20, 0x98, 0x1b,
+	0x17, 0xb3, 0xce, 0x4e, 0x9d, 0x55, 0x37, 0xbb, 0xee, 0xce, 0x3a, 0xc4, 0x5a, 0x56, 0x42, 0x7c,
+	0x00, 0xa8, 0x84, 0x7a, 0x40, 0x88, 0x3b, 0x47, 0x0e, 0x7c, 0x06, 0xd4, 0x63, 0x25, 0x2e, 0x9c,
+	0x10, 0x4a, 0x90, 0xf8, 0x02, 0x7c, 0x00, 0x34, 0x33, 0x6f, 0x63, 0xaf, 0x3d, 0xb6, 0x83, 0xd4,
+	0x4b, 0xb2, 0xef, 0xed, 0x9b, 0xf9, 0xff, 0xde, 0x9b, 0xf1, 0x7b, 0x36, 0x98, 0x41, 0xf7, 0x98,
+	0x85, 0xd4, 0x0b, 0x39, 0x3d, 0xd9, 0xa5, 0x8f, 0xba, 0x2c, 0xee, 0x39, 0x9d, 0x38, 0x4a, 0x22,
+	0xb2, 0x2c, 0xdf, 0x38, 0x5e, 0xc8, 0x9d, 0x93, 0x5d, 0xeb, 0x8a, 0x7b, 0xec, 0x87, 0x11, 0x95,
+	0x7f, 0x55, 0x80, 0x75, 0xeb, 0x30, 0xe2, 0xc7, 0x11, 0xa7, 0x2d, 0x97, 0x33, 0xb5, 0x92, 0x9e,
+	0xec, 0xb6, 0x58, 0xe2, 0xee, 0xd2, 0x8e, 0xdb, 0xf6, 0x43, 0x37, 0xf1, 0xa3, 0x10, 0x63, 0xab,
+	0xed, 0xa8, 0x1d, 0xc9, 0x47, 0x2a, 0x9e, 0xd0, 0xbb, 0xde, 0x8e, 0xa2, 0x76, 0xc0, 0xa8, 0xdb,
+	0xf1, 0xa9, 0x1b, 0x86, 0x51, 0x22, 0x97, 0x70, 0x7c, 0x6b, 0x15, 0xd0, 0xdc, 0xee, 0xe1, 0xc0,
+	0x7e, 0x57, 0x0b, 0xef, 0xbc, 0xe8, 0xd8, 0xf5, 0xf5, 0xaf, 0x3a, 0x6e, 0xec, 0x1e, 0xe3, 0x8e,
+	0x76, 0x15, 0xc8, 0xa7, 0x82, 0xf3, 0x40, 0x3a, 0x1b, 0xec, 0x51, 0x97, 0xf1, 0xc4, 0xfe, 0x18,
+	0x5e, 0x2e, 0x78, 0x79, 0x27, 0x0a, 0x39, 0x23, 0xb7, 0xa1, 0xa2, 0x16, 0x9b, 0xc6, 0xa6, 0xb1,
+	0xb3, 0xb4, 0x57, 0x75, 0x06, 0x0b, 0xe2, 0xa8, 0xe8, 0xfa, 0xe2, 0xd3, 0x3f, 0x37, 0x66, 0x7e,
+	0xfe, 0xe7, 0x97, 0x5b, 0x46, 0x03, 0xc3, 0xed, 0x6f, 0x0d, 0xdc, 0xb0, 0xc1, 0x78, 0x14, 0x9c,
+	0x30, 0xd4, 0x21, 0xab, 0x50, 0x51, 0xa0, 0x72, 0xc3, 0xc5, 0x06, 0x5a, 0xe4, 0x25, 0x28, 0xb1,
+	0xd3, 0xc4, 0x9c, 0x95, 0x4e, 0xf1, 0x48, 0x4c, 0x98, 0x8f, 0xd9, 0x61, 0x14, 0x7b, 0xdc, 0x9c,
+	0x93, 0xde, 0xdc, 0x24, 0xd7, 0x60, 0x91, 0x9d, 0x76, 0xfc, 0x98, 0x35, 0xdd, 0xc4, 0xac, 0x6c,
+	0x1a, 0x3b, 0xe5, 0xc6, 0x82, 0x72, 0xec, 0x4b, 0x01, 0x9e, 0xb8, 0x49, 0x97, 0x9b, 0xf3, 0x4a,
+	0x40, 0x59, 0xf6, 0x6f, 0x06, 0x54, 0x8b, 0x40, 0x98, 0x62, 0x15, 0xe6, 0xa2, 0x2f, 0x43, 0x16,
+	0x23, 0x90, 0x32, 0x88, 0xd3, 0x57, 0x2f, 0x6f, 0x96, 0x46, 0x33, 0x6f, 0xc8, 0x97, 0x63, 0x98,
+	0xe6, 0xc6, 0x32, 0x55, 0x06, 0x99, 0x84, 0x3f, 0x88, 0x0e, 0x1f, 0x32, 0x4f, 0xb2, 0x2e, 0x34,
+	0xd0, 0x12, 0x9b, 0x75, 0x43, 0xf1, 0x2c, 0x36, 0x5b, 0x50, 0x9b, 0x29, 0xc7, 0x7e, 0x62, 0xef,
+	0x81, 0x25, 0xf3, 0xb8, 0x23, 0x0b, 0xc7, 0xeb, 0xbd, 0x4f, 0x04, 0x70, 0x5e, 0x5f, 0x6d, 0x36,
+	0xf6, 0x6d, 0xb8, 0xa6, 0x5d, 0x83, 0x25, 0x30, 0x61, 0x5e, 0x1d, 0x83, 0x38, 0xe6, 0x92, 0x28,
+	0x35, 0x9a, 0xf6, 0x63, 0x03, 0xae, 0xca, 0x95, 0xfb, 0xea, 0xe6, 0x7d, 0x26, 0xc1, 0xff, 0xff,
+	0x61, 0x0a, 0x4f, 0xe8, 0x99, 0x25, 0x99, 0x8b, 0x78, 0x24, 0x1b, 0xb0, 0x74, 0xe4, 0xb7, 0x8f,
+	0x18, 0x4f, 0x9a, 0x2d, 0xdf, 0x33, 0xcb, 0x32, 0x16, 0xd0, 0x55, 0xf7, 0x3d, 0xb1, 0x79, 0xcb,
+	0xf7, 0x3c, 0x16, 0xe3, 0xf1, 0xa3, 0x65, 0x67, 0x60, 0xe9, 0x88, 0xfa, 0xa7, 0xc9, 0x13, 0x37,
+	0x4e, 0x24, 0x51, 0xb9, 0xa1, 0x8c, 0x5c, 0x7e, 0x76, 0xac, 0x7c, 0x69, 0x82, 0x7c, 0xb9, 0x20,
+	0x1f, 0xc0, 0xaa, 0x94, 0xaf, 0xbb, 0x9c, 0xdd, 0x65, 0xec, 0x4e, 0x78, 0x51, 0x8d, 0x65, 0x30,
+	0x72, 0x59, 0x43, 0x1e, 0x84, 0x1b, 0x74, 0x8e, 0x5c, 0xac, 0x82, 0x32, 0x84, 0xf7, 0x41, 0x10,
+	0x45, 0x31, 0x0a, 0x2a, 0x43, 0xd4, 0xff, 0x90, 0xf9, 0x81, 0x1f, 0xb6, 0x51, 0x2c, 0x37, 0xed,
+	0xef, 0x0c, 0x58, 0x1b, 0x91, 0xc3, 0x54, 0x37, 0x61, 0x59, 0x74, 0x9d, 0xe6, 0x03, 0xc6, 0x9a,
+	0x5e, 0xc8, 0xf1, 0x0c, 0xa0, 0x75, 0x11, 0xa9, 0x88, 0x66, 0x47, 0x88, 0x4a, 0x5a, 0xa2, 0xf2,
+	0x18, 0xa2, 0xb9, 0x22, 0xd1, 0x1b, 0xb0, 0x22, 0x81, 0xee, 0xb1, 0x44, 0xdd, 0xa6, 0x81, 0x9b,
+	0xe7, 0x87, 0x1e, 0x3b, 0xcd, 0x6f, 0x9e, 0x34, 0xec, 0xfb, 0xb0, 0x3a, 0x1c, 0x8e, 0xf8, 0x7b,
+	0x85, 0xcb, 0x33, 0xf2, 0x01, 0x53, 0xd1, 0xf5, 0xb2, 0x68, 0x2d, 0xf9, 0xc5, 0xb2, 0x9b, 0x28,
+	0xbe, 0x1f, 0x04, 0x45, 0xf1, 0xbb, 0x00, 0xfd, 0x76, 0x8b, 0x1b, 0xde, 0x70, 0x54, 0x6f, 0x76,
+	0x44, 0x45, 0x1c, 0xd5, 0xd5, 0xb1, 0x37, 0x3b, 0x07, 0x6e, 0x3b, 0x6f, 0x49, 0x8d, 0x81, 0x95,
+	0xf6, 0x13, 0x03, 0x56, 0x87, 0x15, 0x34, 0xbc, 0xa5, 0xcb, 0xf1, 0x92, 0x7b, 0x05, 0xac, 0x59,
+	0x89, 0x75, 0x73, 0x2a, 0x96, 0x12, 0x2c, 0x70, 0x39, 0xfd, 0x32, 0xe2, 0xbd, 0x9f, 0x5c, 0xf6,
+	0x03, 0x58, 0x1b, 0x89, 0xc7, 0x3c, 0xde, 0x82, 0x79, 0x1c, 0x23, 0x58, 0xa7, 0x95, 0x62, 0x22,
+	0x18, 0x8f, 0x99, 0xe4, 0xb1, 0xf6, 0x17, 0xfd, 0xc2, 0x0c, 0x11, 0x3c, 0xaf, 0xda, 0xff, 0x90,
+	0xdf, 0xf5, 0x41, 0x09, 0x1d, 0x74, 0xe9, 0xb2, 0xd0, 0xcf, 0xad, 0xfe, 0x7b, 0xff, 0x2e, 0xc2,
+	0x9c, 0x64, 0x23, 0x0f, 0xa1, 0xa2, 0xa6, 0x1e, 0xd9, 0x2c, 0x22, 0x8c, 0x0e, 0x55, 0xeb, 0x95,
+	0x09, 0x11, 0x4a, 0xc4, 0x5e, 0xff, 0xe6, 0xf7, 0xbf, 0xbf, 0x9f, 0x5d, 0x25, 0x55, 0xaa, 0x99,
+	0xd8, 0xe4, 0x27, 0x03, 0xe6, 0x71, 0x5e, 0x11, 0xdd, 0x66, 0xc5, 0xe1, 0x6a, 0xd9, 0x93, 0x42,
+	0x50, 0xf0, 0x23, 0x29, 0xf8, 0x3e, 0x79, 0xaf, 0x28, 0x18, 0xab, 0x30, 0x9a, 0xaa, 0x9b, 0x9b,
+	0xd1, 0x94, 0x9d, 0x26, 0x19, 0x4d, 0x71, 0xbe, 0x49, 0x1b, 0xc7, 0x5b, 0x46, 0x53, 0x35, 0xbf,
+	0x32, 0xf2, 0xc4, 0x80, 0x17, 0x8b, 0x33, 0x85, 0xec, 0x68, 0x18, 0xb4, 0xa3, 0xca, 0x7a, 0xed,
+	0x12, 0x91, 0x08, 0xed, 0x48, 0xe8, 0x1d, 0x72, 0x83, 0x6a, 0xbe, 0xf2, 0xf0, 0x66, 0xab, 0xd7,
+	0x94, 0x73, 0x8e, 0xa6, 0xf2, 0x5f, 0x46, 0x7e, 0x35, 0xe0, 0x85, 0xc2, 0x7c, 0x20, 0x37, 0x35,
+	0x62, 0xba, 0x99, 0x66, 0xed, 0x4c, 0x0f, 0x44, 0xa8, 0x03, 0x09, 0xf5, 0x21, 0xf9, 0x80, 0xea,
+	0xbe, 0xa3, 0x35, 0x55, 0x8d, 0x46, 0x0a, 0xca, 0x42, 0x2f, 0xa3, 0xe9, 0xc0, 0xec, 0xc9, 0x68,
+	0xaa, 0x46, 0x4b, 0x46, 0x7e, 0x34, 0x00, 0xfa, 0x8d, 0x9e, 0x6c, 0x69, 0x50, 0x46, 0xc6, 0x8e,
+	0xb5, 0x3d, 0x25, 0x0a, 0x69, 0xdf, 0x95, 0xb4, 0xef, 0x90, 0xb7, 0x8b, 0xb4, 0x83, 0x13, 0x84,
+	0xa6, 0x02, 0x50, 0x8e, 0x85, 0x8c, 0xa6, 0x72, 0x10, 0x64, 0x34, 0xc5, 0xc6, 0x9f, 0x91, 0xaf,
+	0x60, 0xf1, 0xa2, 0x8b, 0x93, 0x57, 0x35, 0xaa, 0xc3, 0x23, 0xc1, 0xda, 0x9a, 0x1c, 0x84, 0x64,
+	0x5b, 0x92, 0xac, 0x46, 0xd6, 0x75, 0x87, 0x4b, 0x53, 0xd9, 0xd0, 0x32, 0xd2, 0x05, 0xb8, 0xef,
+	0xf3, 0x49, 0xf2, 0xc3, 0x43, 0xc1, 0xda, 0x9a, 0x1c, 0x34, 0xf9, 0x13, 0x88, 0x1d, 0xfc, 0x6b,
+	0x03, 0xa0, 0xdf, 0x44, 0xc9, 0x98, 0x8c, 0x8a, 0x1d, 0xd1, 0xda, 0x9e, 0x12, 0x85, 0xca, 0xdb,
+	0x52, 0x79, 0x83, 0x5c, 0xd7, 0x5e, 0xa0, 0x8b, 0xcc, 0x7b, 0xb0, 0x24, 0x32, 0x9f, 0x84, 0x30,
+	0xd2, 0x94, 0xad, 0xed, 0x29, 0x51, 0x88, 0x70, 0x5d, 0x22, 0xac, 0x91, 0x15, 0x2d, 0x42, 0xfd,
+	0xf5, 0xa7, 0x67, 0x35, 0xe3, 0xd9, 0x59, 0xcd, 0xf8, 0xeb, 0xac, 0x66, 0x3c, 0x3e, 0xaf, 0xcd,
+	0x3c, 0x3b, 0xaf, 0xcd, 0xfc, 0x71, 0x5e, 0x9b, 0xf9, 0xfc, 0x8a, 0x8a, 0x3f, 0x95, 0x2b, 0x92,
+	0x5e, 0x87, 0xf1, 0x56, 0x45, 0xfe, 0xbe, 0x78, 0xf3, 0xbf, 0x01, 0x00, 0x54, 0x49, 0x62, 0x74,
+	0x4e, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1596,6 +1614,21 @@ func (m *QueryResolveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.UnlockAt != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UnlockAt))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Locked {
+		i--
+		if m.Locked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
 	if len(m.Status) > 0 {
 		i -= len(m.Status)
 		copy(dAtA[i:], m.Status)
@@ -2278,6 +2311,12 @@ func (m *QueryResolveResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.Locked {
+		n += 2
+	}
+	if m.UnlockAt != 0 {
+		n += 1 + sovQuery(uint64(m.UnlockAt))
+	}
 	return n
 }
 
@@ -3006,6 +3045,45 @@ func (m *QueryResolveResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Locked = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockAt", wireType)
+			}
+			m.UnlockAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnlockAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1439,6 +1439,217 @@ func (m *MsgSettleResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSettleResponse proto.InternalMessageInfo
 
+// MsgLockDomain locks a domain against Transfer and Update. Sending it while
+// an unlock is pending cancels that unlock.
+type MsgLockDomain struct {
+	Creator      string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Domain       string `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	Ext          string `protobuf:"bytes,3,opt,name=ext,proto3" json:"ext,omitempty"`
+	DelaySeconds uint64 `protobuf:"varint,4,opt,name=delay_seconds,json=delaySeconds,proto3" json:"delay_seconds,omitempty"`
+}
+
+func (m *MsgLockDomain) Reset()         { *m = MsgLockDomain{} }
+func (m *MsgLockDomain) String() string { return proto.CompactTextString(m) }
+func (*MsgLockDomain) ProtoMessage()    {}
+func (*MsgLockDomain) Descriptor() ([]byte, []int) {
+	return fileDescriptor_062f93c8fad38547, []int{26}
+}
+func (m *MsgLockDomain) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLockDomain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLockDomain.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgLockDomain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLockDomain.Merge(m, src)
+}
+func (m *MsgLockDomain) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLockDomain) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLockDomain.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgLockDomain proto.InternalMessageInfo
+
+func (m *MsgLockDomain) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgLockDomain) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *MsgLockDomain) GetExt() string {
+	if m != nil {
+		return m.Ext
+	}
+	return ""
+}
+
+func (m *MsgLockDomain) GetDelaySeconds() uint64 {
+	if m != nil {
+		return m.DelaySeconds
+	}
+	return 0
+}
+
+type MsgLockDomainResponse struct {
+}
+
+func (m *MsgLockDomainResponse) Reset()         { *m = MsgLockDomainResponse{} }
+func (m *MsgLockDomainResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgLockDomainResponse) ProtoMessage()    {}
+func (*MsgLockDomainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_062f93c8fad38547, []int{27}
+}
+func (m *MsgLockDomainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLockDomainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLockDomainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgLockDomainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLockDomainResponse.Merge(m, src)
+}
+func (m *MsgLockDomainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLockDomainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLockDomainResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgLockDomainResponse proto.InternalMessageInfo
+
+// MsgUnlockDomain requests an unlock that takes effect after the lock delay.
+type MsgUnlockDomain struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Domain  string `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	Ext     string `protobuf:"bytes,3,opt,name=ext,proto3" json:"ext,omitempty"`
+}
+
+func (m *MsgUnlockDomain) Reset()         { *m = MsgUnlockDomain{} }
+func (m *MsgUnlockDomain) String() string { return proto.CompactTextString(m) }
+func (*MsgUnlockDomain) ProtoMessage()    {}
+func (*MsgUnlockDomain) Descriptor() ([]byte, []int) {
+	return fileDescriptor_062f93c8fad38547, []int{28}
+}
+func (m *MsgUnlockDomain) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnlockDomain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnlockDomain.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnlockDomain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnlockDomain.Merge(m, src)
+}
+func (m *MsgUnlockDomain) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnlockDomain) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnlockDomain.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnlockDomain proto.InternalMessageInfo
+
+func (m *MsgUnlockDomain) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgUnlockDomain) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *MsgUnlockDomain) GetExt() string {
+	if m != nil {
+		return m.Ext
+	}
+	return ""
+}
+
+type MsgUnlockDomainResponse struct {
+	UnlockAt uint64 `protobuf:"varint,1,opt,name=unlock_at,json=unlockAt,proto3" json:"unlock_at,omitempty"`
+}
+
+func (m *MsgUnlockDomainResponse) Reset()         { *m = MsgUnlockDomainResponse{} }
+func (m *MsgUnlockDomainResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnlockDomainResponse) ProtoMessage()    {}
+func (*MsgUnlockDomainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_062f93c8fad38547, []int{29}
+}
+func (m *MsgUnlockDomainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnlockDomainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnlockDomainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnlockDomainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnlockDomainResponse.Merge(m, src)
+}
+func (m *MsgUnlockDomainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnlockDomainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnlockDomainResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnlockDomainResponse proto.InternalMessageInfo
+
+func (m *MsgUnlockDomainResponse) GetUnlockAt() uint64 {
+	if m != nil {
+		return m.UnlockAt
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "lumen.dns.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "lumen.dns.v1.MsgUpdateParamsResponse")
@@ -1466,69 +1677,79 @@ func init() {
 	proto.RegisterType((*MsgDeleteAuctionResponse)(nil), "lumen.dns.v1.MsgDeleteAuctionResponse")
 	proto.RegisterType((*MsgSettle)(nil), "lumen.dns.v1.MsgSettle")
 	proto.RegisterType((*MsgSettleResponse)(nil), "lumen.dns.v1.MsgSettleResponse")
+	proto.RegisterType((*MsgLockDomain)(nil), "lumen.dns.v1.MsgLockDomain")
+	proto.RegisterType((*MsgLockDomainResponse)(nil), "lumen.dns.v1.MsgLockDomainResponse")
+	proto.RegisterType((*MsgUnlockDomain)(nil), "lumen.dns.v1.MsgUnlockDomain")
+	proto.RegisterType((*MsgUnlockDomainResponse)(nil), "lumen.dns.v1.MsgUnlockDomainResponse")
 }
 
 func init() { proto.RegisterFile("lumen/dns/v1/tx.proto", fileDescriptor_062f93c8fad38547) }
 
 var fileDescriptor_062f93c8fad38547 = []byte{
-	// 909 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0x4f, 0x6f, 0xe3, 0x44,
-	0x14, 0xaf, 0x49, 0xe2, 0xc6, 0xaf, 0x05, 0xda, 0xd9, 0x6c, 0xeb, 0xba, 0x90, 0x96, 0x20, 0xa4,
-	0xaa, 0x88, 0x44, 0x5b, 0x24, 0x10, 0x7b, 0x41, 0x0d, 0x3d, 0x20, 0xa4, 0x02, 0xf2, 0x2e, 0x17,
-	0x2e, 0x91, 0x9b, 0x19, 0x5c, 0xa3, 0x7a, 0x26, 0x9a, 0x99, 0x6c, 0x92, 0x1b, 0xe2, 0x88, 0x38,
-	0x70, 0xe2, 0xc2, 0x17, 0xe0, 0x58, 0x21, 0x3e, 0x02, 0x87, 0x3d, 0xae, 0x38, 0x71, 0x82, 0x55,
-	0x7b, 0xa8, 0xc4, 0x47, 0x40, 0x42, 0x42, 0x9e, 0x19, 0xbb, 0x8e, 0xed, 0xf2, 0x4f, 0x1b, 0x69,
-	0xb5, 0x97, 0x6a, 0xde, 0x1f, 0xbf, 0xf9, 0xfd, 0xde, 0x7b, 0xf3, 0xf2, 0x0a, 0xb7, 0xcf, 0xc6,
-	0x31, 0xa1, 0x3d, 0x4c, 0x45, 0xef, 0xc1, 0x9d, 0x9e, 0x9c, 0x76, 0x47, 0x9c, 0x49, 0x86, 0x56,
-	0x95, 0xba, 0x8b, 0xa9, 0xe8, 0x3e, 0xb8, 0xe3, 0xad, 0x07, 0x71, 0x44, 0x59, 0x4f, 0xfd, 0xd5,
-	0x0e, 0xde, 0xe6, 0x90, 0x89, 0x98, 0x89, 0x5e, 0x2c, 0xc2, 0xe4, 0xc3, 0x58, 0x84, 0xc6, 0xb0,
-	0xa5, 0x0d, 0x03, 0x25, 0xf5, 0xb4, 0x60, 0x4c, 0xad, 0x90, 0x85, 0x4c, 0xeb, 0x93, 0x53, 0xfa,
-	0xc1, 0x1c, 0x02, 0xcc, 0xe2, 0x20, 0xa2, 0x95, 0xa6, 0x51, 0xc0, 0x83, 0xd8, 0xc4, 0xea, 0xfc,
-	0x60, 0xc1, 0x8b, 0xc7, 0x22, 0xfc, 0x64, 0x84, 0x03, 0x49, 0x3e, 0x56, 0x16, 0xf4, 0x16, 0x38,
-	0xc1, 0x58, 0x9e, 0x32, 0x1e, 0xc9, 0x99, 0x6b, 0xed, 0x5a, 0x7b, 0x4e, 0xdf, 0xfd, 0xf9, 0xc7,
-	0x37, 0x5a, 0x06, 0xc4, 0x21, 0xc6, 0x9c, 0x08, 0x71, 0x4f, 0xf2, 0x88, 0x86, 0xfe, 0xb5, 0x2b,
-	0x7a, 0x1b, 0x6c, 0x1d, 0xdb, 0x7d, 0x6e, 0xd7, 0xda, 0x5b, 0x39, 0x68, 0x75, 0xf3, 0xec, 0xbb,
-	0x3a, 0x7a, 0xdf, 0x79, 0xf8, 0xeb, 0xce, 0xd2, 0xf7, 0x57, 0xe7, 0xfb, 0x96, 0x6f, 0xdc, 0xef,
-	0x76, 0xbf, 0xbc, 0x3a, 0xdf, 0xbf, 0x0e, 0xf4, 0xd5, 0xd5, 0xf9, 0xfe, 0xb6, 0x86, 0x3c, 0x55,
-	0xa0, 0x0b, 0x00, 0x3b, 0x5b, 0xb0, 0x59, 0x50, 0xf9, 0x44, 0x8c, 0x18, 0x15, 0xa4, 0xf3, 0xbb,
-	0x05, 0x2b, 0xc7, 0x22, 0xf4, 0x49, 0x18, 0x09, 0x49, 0x38, 0x3a, 0x80, 0xe5, 0x21, 0x27, 0x81,
-	0x64, 0xfc, 0x1f, 0x99, 0xa4, 0x8e, 0x68, 0x03, 0x6c, 0x9d, 0x3e, 0xc5, 0xc3, 0xf1, 0x8d, 0x84,
-	0xd6, 0xa0, 0x46, 0xa6, 0xd2, 0xad, 0x29, 0x65, 0x72, 0x44, 0x5d, 0x58, 0xe6, 0x64, 0xc8, 0x38,
-	0x16, 0xae, 0xbd, 0x5b, 0x2b, 0x53, 0xf6, 0x95, 0xd1, 0x4f, 0x9d, 0xd0, 0xab, 0xf0, 0x3c, 0x1e,
-	0xf3, 0x40, 0x46, 0x8c, 0x0e, 0x70, 0x30, 0x13, 0xee, 0xf2, 0xae, 0xb5, 0x57, 0xf7, 0x57, 0x53,
-	0xe5, 0x51, 0x30, 0x13, 0xa8, 0x05, 0x0d, 0x36, 0xa1, 0x84, 0xbb, 0x8e, 0xba, 0x48, 0x0b, 0x77,
-	0x57, 0x93, 0x1c, 0xa5, 0x10, 0x3f, 0xa8, 0x37, 0x9b, 0x6b, 0x4e, 0xe7, 0x36, 0xdc, 0xca, 0x71,
-	0xcd, 0x72, 0xf0, 0x93, 0x05, 0x4e, 0x96, 0x9f, 0xa7, 0x2c, 0x03, 0xdb, 0xe0, 0x8c, 0xd8, 0x64,
-	0x40, 0x19, 0x1d, 0x12, 0xc3, 0xbe, 0x39, 0x62, 0x93, 0x0f, 0x13, 0x79, 0x9e, 0x63, 0xe7, 0x16,
-	0xac, 0x67, 0x2c, 0x32, 0x6e, 0xdf, 0x59, 0xd0, 0x54, 0x9c, 0x29, 0x99, 0x2c, 0x98, 0x5a, 0xa9,
-	0x58, 0xf5, 0x72, 0xb1, 0x0a, 0x90, 0x11, 0xac, 0xa5, 0xe0, 0x32, 0xc4, 0xdf, 0xea, 0x8e, 0xbc,
-	0xcf, 0x03, 0x2a, 0x3e, 0x5b, 0x78, 0x47, 0x6e, 0x83, 0x43, 0xc9, 0x64, 0xa0, 0x1b, 0xa8, 0xae,
-	0xf4, 0x4d, 0x4a, 0x26, 0x1f, 0x95, 0x7b, 0xc8, 0x74, 0x4f, 0x8a, 0x2b, 0xc3, 0xfb, 0xb5, 0x05,
-	0xf6, 0xb1, 0x08, 0xfb, 0x11, 0x5e, 0x30, 0xd4, 0x0d, 0xb0, 0x83, 0x98, 0x8d, 0xa9, 0x34, 0x38,
-	0x8d, 0x54, 0x40, 0xb9, 0x06, 0x2f, 0x68, 0x34, 0x19, 0xc0, 0xc7, 0x7a, 0x64, 0xbd, 0x97, 0x38,
-	0x90, 0x23, 0x1d, 0xfd, 0xff, 0x20, 0x6d, 0x41, 0x23, 0xa2, 0x98, 0x4c, 0x0d, 0x50, 0x2d, 0x20,
-	0x04, 0x75, 0x1a, 0xc4, 0xc4, 0x00, 0x55, 0xe7, 0xeb, 0x17, 0x59, 0xcf, 0xbd, 0xc8, 0x7c, 0xeb,
-	0x37, 0xfe, 0x65, 0xeb, 0x93, 0xe9, 0x28, 0xe2, 0x64, 0x10, 0x48, 0xd7, 0xd6, 0xad, 0xaf, 0x15,
-	0x87, 0x45, 0xd2, 0x7a, 0xc0, 0xe5, 0x19, 0x66, 0xec, 0xff, 0xcc, 0x0f, 0xec, 0x67, 0x84, 0xfd,
-	0x7f, 0x99, 0x0a, 0xf9, 0xd9, 0x5f, 0x48, 0x4d, 0xa4, 0x32, 0x73, 0x44, 0xce, 0xc8, 0x93, 0xcf,
-	0x4c, 0x25, 0x8a, 0xfc, 0x55, 0x19, 0x8a, 0xdf, 0x2c, 0x35, 0x04, 0x74, 0xf1, 0x0e, 0xc7, 0xc3,
-	0x64, 0x54, 0x2c, 0xbe, 0x42, 0x42, 0x06, 0x5c, 0x9a, 0x09, 0xa5, 0x05, 0xf5, 0xe2, 0x28, 0x76,
-	0x1b, 0x4a, 0x97, 0x1c, 0xd1, 0x0e, 0xac, 0x9c, 0x46, 0xe1, 0x29, 0x11, 0x72, 0x70, 0x12, 0x61,
-	0x55, 0x05, 0xc7, 0x07, 0xa3, 0x4a, 0x1e, 0xfc, 0x06, 0xd8, 0x27, 0x11, 0xc6, 0x84, 0xab, 0x22,
-	0x38, 0xbe, 0x91, 0x0a, 0xe4, 0x3d, 0x70, 0x8b, 0x04, 0x8b, 0xec, 0x75, 0x7d, 0x9e, 0x61, 0xf6,
-	0x73, 0x04, 0x33, 0xf6, 0x9f, 0x2b, 0xf2, 0xba, 0x2d, 0x9e, 0x38, 0xf9, 0x4a, 0x1c, 0x73, 0x77,
-	0x65, 0x38, 0x26, 0x6a, 0x01, 0xb8, 0x47, 0xa4, 0x3c, 0x5b, 0xf0, 0x02, 0x50, 0xf9, 0x9b, 0xad,
-	0x2f, 0x4e, 0xd1, 0x1c, 0xfc, 0x51, 0x83, 0xda, 0xb1, 0x08, 0xd1, 0x7d, 0x58, 0x9d, 0xdb, 0x33,
-	0x5f, 0x9e, 0x9f, 0x18, 0x85, 0x95, 0xce, 0x7b, 0xed, 0x6f, 0xcd, 0x69, 0x74, 0xf4, 0x3e, 0x34,
-	0xb3, 0x6d, 0x6f, 0xab, 0xf4, 0x49, 0x6a, 0xf2, 0x5e, 0xb9, 0xd1, 0x94, 0x45, 0xea, 0x83, 0x6d,
-	0x76, 0xa6, 0xcd, 0x1b, 0xae, 0xf6, 0x76, 0x6e, 0x30, 0x64, 0x31, 0xde, 0x85, 0x86, 0xde, 0x4d,
-	0x36, 0x2a, 0xee, 0xa3, 0x64, 0xe2, 0xb5, 0xab, 0xf5, 0x79, 0x3a, 0xd9, 0xaa, 0x50, 0xa6, 0x93,
-	0x9a, 0x2a, 0xe8, 0x14, 0x7f, 0xc8, 0xd1, 0x3b, 0x50, 0x4b, 0xba, 0xba, 0x55, 0xf2, 0xec, 0x47,
-	0xd8, 0x7b, 0xa9, 0x4a, 0x9b, 0xcf, 0x84, 0x69, 0x9e, 0x72, 0x26, 0xb4, 0xa1, 0x22, 0x13, 0xf3,
-	0x55, 0xf7, 0x1a, 0x5f, 0x24, 0x3b, 0x7e, 0xff, 0xf5, 0x87, 0x17, 0x6d, 0xeb, 0xd1, 0x45, 0xdb,
-	0x7a, 0x7c, 0xd1, 0xb6, 0xbe, 0xb9, 0x6c, 0x2f, 0x3d, 0xba, 0x6c, 0x2f, 0xfd, 0x72, 0xd9, 0x5e,
-	0xfa, 0x74, 0x3d, 0xbf, 0xe2, 0xcb, 0xd9, 0x88, 0x88, 0x13, 0x5b, 0xfd, 0x53, 0xf2, 0xe6, 0x5f,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0xda, 0x5a, 0x6f, 0x6c, 0x4e, 0x0d, 0x00, 0x00,
+	// 1007 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0x41, 0x6f, 0xe3, 0x44,
+	0x14, 0xae, 0x69, 0xe2, 0xc6, 0xaf, 0x5d, 0x48, 0xbd, 0x69, 0xe3, 0xba, 0x90, 0x76, 0xb3, 0x5a,
+	0xa9, 0x2a, 0x22, 0xd1, 0x16, 0x69, 0x11, 0x7b, 0x41, 0x0d, 0x3d, 0x20, 0x44, 0x16, 0xe4, 0x2e,
+	0x17, 0x2e, 0x91, 0x1b, 0x0f, 0xae, 0x21, 0x9e, 0x89, 0x3c, 0x93, 0x4d, 0x72, 0x40, 0x42, 0x1c,
+	0x11, 0x07, 0x4e, 0x5c, 0xb8, 0xec, 0x91, 0x63, 0x85, 0xf8, 0x09, 0x1c, 0xf6, 0xb8, 0xe2, 0xc4,
+	0x09, 0x56, 0xed, 0xa1, 0x12, 0xff, 0x01, 0x09, 0x79, 0x66, 0x3c, 0x71, 0xec, 0xa4, 0xb0, 0xb0,
+	0x41, 0xab, 0xbd, 0x44, 0x7e, 0xef, 0x9b, 0xbc, 0xf9, 0xbe, 0x37, 0x6f, 0x9e, 0x9f, 0x61, 0xa3,
+	0x37, 0x08, 0x11, 0x6e, 0x7a, 0x98, 0x36, 0x1f, 0xdc, 0x6e, 0xb2, 0x51, 0xa3, 0x1f, 0x11, 0x46,
+	0xcc, 0x35, 0xee, 0x6e, 0x78, 0x98, 0x36, 0x1e, 0xdc, 0xb6, 0xd7, 0xdd, 0x30, 0xc0, 0xa4, 0xc9,
+	0x7f, 0xc5, 0x02, 0xbb, 0xda, 0x25, 0x34, 0x24, 0xb4, 0x19, 0x52, 0x3f, 0xfe, 0x63, 0x48, 0x7d,
+	0x09, 0x6c, 0x09, 0xa0, 0xc3, 0xad, 0xa6, 0x30, 0x24, 0x54, 0xf1, 0x89, 0x4f, 0x84, 0x3f, 0x7e,
+	0x4a, 0xfe, 0x30, 0xc5, 0xc0, 0x23, 0xa1, 0x1b, 0xe0, 0x99, 0x50, 0xdf, 0x8d, 0xdc, 0x50, 0xc6,
+	0xaa, 0xff, 0xa8, 0xc1, 0x2b, 0x6d, 0xea, 0x7f, 0xdc, 0xf7, 0x5c, 0x86, 0x3e, 0xe2, 0x88, 0x79,
+	0x07, 0x0c, 0x77, 0xc0, 0x4e, 0x49, 0x14, 0xb0, 0xb1, 0xa5, 0xed, 0x6a, 0x7b, 0x46, 0xcb, 0xfa,
+	0xe5, 0xa7, 0x37, 0x2a, 0x92, 0xc4, 0xa1, 0xe7, 0x45, 0x88, 0xd2, 0x63, 0x16, 0x05, 0xd8, 0x77,
+	0x26, 0x4b, 0xcd, 0xb7, 0x40, 0x17, 0xb1, 0xad, 0x97, 0x76, 0xb5, 0xbd, 0xd5, 0x83, 0x4a, 0x23,
+	0xad, 0xbe, 0x21, 0xa2, 0xb7, 0x8c, 0x47, 0xbf, 0xed, 0x2c, 0xfd, 0x70, 0x79, 0xb6, 0xaf, 0x39,
+	0x72, 0xf9, 0xdd, 0xc6, 0x57, 0x97, 0x67, 0xfb, 0x93, 0x40, 0x5f, 0x5f, 0x9e, 0xed, 0x6f, 0x0b,
+	0xca, 0x23, 0x4e, 0x3a, 0x43, 0xb0, 0xbe, 0x05, 0xd5, 0x8c, 0xcb, 0x41, 0xb4, 0x4f, 0x30, 0x45,
+	0xf5, 0x3f, 0x34, 0x58, 0x6d, 0x53, 0xdf, 0x41, 0x7e, 0x40, 0x19, 0x8a, 0xcc, 0x03, 0x58, 0xe9,
+	0x46, 0xc8, 0x65, 0x24, 0xfa, 0x5b, 0x25, 0xc9, 0x42, 0x73, 0x13, 0x74, 0x91, 0x3e, 0xae, 0xc3,
+	0x70, 0xa4, 0x65, 0x96, 0x61, 0x19, 0x8d, 0x98, 0xb5, 0xcc, 0x9d, 0xf1, 0xa3, 0xd9, 0x80, 0x95,
+	0x08, 0x75, 0x49, 0xe4, 0x51, 0x4b, 0xdf, 0x5d, 0xce, 0x4b, 0x76, 0x38, 0xe8, 0x24, 0x8b, 0xcc,
+	0x9b, 0x70, 0xcd, 0x1b, 0x44, 0x2e, 0x0b, 0x08, 0xee, 0x78, 0xee, 0x98, 0x5a, 0x2b, 0xbb, 0xda,
+	0x5e, 0xc1, 0x59, 0x4b, 0x9c, 0x47, 0xee, 0x98, 0x9a, 0x15, 0x28, 0x92, 0x21, 0x46, 0x91, 0x65,
+	0xf0, 0x8d, 0x84, 0x71, 0x77, 0x2d, 0xce, 0x51, 0x42, 0xf1, 0xfd, 0x42, 0xa9, 0x54, 0x36, 0xea,
+	0x1b, 0x70, 0x3d, 0xa5, 0x55, 0xe5, 0xe0, 0x67, 0x0d, 0x0c, 0x95, 0x9f, 0xe7, 0x2c, 0x03, 0xdb,
+	0x60, 0xf4, 0xc9, 0xb0, 0x83, 0x09, 0xee, 0x22, 0xa9, 0xbe, 0xd4, 0x27, 0xc3, 0x7b, 0xb1, 0x3d,
+	0xad, 0xb1, 0x7e, 0x1d, 0xd6, 0x95, 0x0a, 0xa5, 0xed, 0x7b, 0x0d, 0x4a, 0x5c, 0x33, 0x46, 0xc3,
+	0x05, 0x4b, 0xcb, 0x1d, 0x56, 0x21, 0x7f, 0x58, 0x19, 0xca, 0x26, 0x94, 0x13, 0x72, 0x8a, 0xf1,
+	0x77, 0xa2, 0x22, 0xef, 0x47, 0x2e, 0xa6, 0x9f, 0x2e, 0xbc, 0x22, 0xb7, 0xc1, 0xc0, 0x68, 0xd8,
+	0x11, 0x05, 0x54, 0xe0, 0xfe, 0x12, 0x46, 0xc3, 0x0f, 0xf3, 0x35, 0x24, 0xab, 0x27, 0xe1, 0xa5,
+	0xf8, 0x7e, 0xa3, 0x81, 0xde, 0xa6, 0x7e, 0x2b, 0xf0, 0x16, 0x4c, 0x75, 0x13, 0x74, 0x37, 0x24,
+	0x03, 0xcc, 0x24, 0x4f, 0x69, 0x65, 0x58, 0x96, 0xe1, 0x65, 0xc1, 0x46, 0x11, 0x7c, 0x22, 0x5a,
+	0xd6, 0xbb, 0xf1, 0x02, 0x74, 0x24, 0xa2, 0xff, 0x1b, 0xa6, 0x15, 0x28, 0x06, 0xd8, 0x43, 0x23,
+	0x49, 0x54, 0x18, 0xa6, 0x09, 0x05, 0xec, 0x86, 0x48, 0x12, 0xe5, 0xcf, 0x93, 0x1b, 0x59, 0x48,
+	0xdd, 0xc8, 0x74, 0xe9, 0x17, 0xff, 0x61, 0xe9, 0xa3, 0x51, 0x3f, 0x88, 0x50, 0xc7, 0x65, 0x96,
+	0x2e, 0x4a, 0x5f, 0x38, 0x0e, 0xb3, 0xa2, 0x45, 0x83, 0x4b, 0x2b, 0x54, 0xea, 0xff, 0x4c, 0x37,
+	0xec, 0x17, 0x44, 0xfd, 0xd3, 0x74, 0x85, 0x74, 0xef, 0xcf, 0xa4, 0x26, 0xe0, 0x99, 0x39, 0x42,
+	0x3d, 0xf4, 0xec, 0x33, 0x33, 0x93, 0x45, 0x7a, 0x2b, 0xc5, 0xe2, 0x77, 0x0d, 0xca, 0xea, 0xf0,
+	0x0e, 0x07, 0xdd, 0xb8, 0x55, 0x2c, 0xfe, 0x84, 0x28, 0x73, 0x23, 0x26, 0x3b, 0x94, 0x30, 0xf8,
+	0x8d, 0xc3, 0x9e, 0x55, 0xe4, 0xbe, 0xf8, 0xd1, 0xdc, 0x81, 0xd5, 0xd3, 0xc0, 0x3f, 0x45, 0x94,
+	0x75, 0x4e, 0x02, 0x8f, 0x9f, 0x82, 0xe1, 0x80, 0x74, 0xc5, 0x17, 0x7e, 0x13, 0xf4, 0x93, 0xc0,
+	0xf3, 0x50, 0xc4, 0x0f, 0xc1, 0x70, 0xa4, 0x95, 0x11, 0x6f, 0x83, 0x95, 0x15, 0x98, 0x55, 0x2f,
+	0xce, 0xe7, 0x05, 0x56, 0x3f, 0x25, 0x50, 0xa9, 0xff, 0x0c, 0xca, 0xaa, 0x2c, 0x9e, 0xb9, 0xf8,
+	0x99, 0x3c, 0xa6, 0xf6, 0x52, 0x3c, 0x86, 0x7c, 0x00, 0x38, 0x46, 0x8c, 0xf5, 0x16, 0x3c, 0x00,
+	0xcc, 0x7c, 0x67, 0x8b, 0x8d, 0x15, 0x9b, 0x87, 0x1a, 0x5c, 0x6b, 0x53, 0xff, 0x03, 0xd2, 0xfd,
+	0xfc, 0x3f, 0x5c, 0xcb, 0xa7, 0x7b, 0x71, 0xa3, 0x9e, 0x3b, 0xee, 0x50, 0xd4, 0x25, 0xd8, 0x9b,
+	0xbc, 0xb8, 0x63, 0xe7, 0xb1, 0xf0, 0x65, 0x78, 0x57, 0x61, 0x63, 0x8a, 0xa1, 0xe2, 0xfe, 0x85,
+	0xe8, 0xb6, 0xb8, 0xf7, 0x3f, 0x91, 0xcf, 0xf0, 0xba, 0x03, 0xd5, 0xcc, 0xf6, 0x09, 0xb3, 0xb8,
+	0x67, 0x0e, 0xb8, 0x3f, 0x6e, 0xa8, 0x9a, 0xe8, 0x99, 0xc2, 0x71, 0xc8, 0x0e, 0x1e, 0x16, 0x61,
+	0xb9, 0x4d, 0x7d, 0xf3, 0x3e, 0xac, 0x4d, 0x8d, 0xf6, 0xaf, 0x4d, 0x37, 0xe9, 0xcc, 0x14, 0x6d,
+	0xdf, 0xba, 0x12, 0x56, 0x5b, 0xbf, 0x07, 0x25, 0x35, 0x60, 0x6f, 0xe5, 0xfe, 0x92, 0x40, 0xf6,
+	0x8d, 0xb9, 0x90, 0x8a, 0xd4, 0x02, 0x5d, 0x8e, 0xa9, 0xd5, 0x39, 0x5b, 0xdb, 0x3b, 0x73, 0x00,
+	0x15, 0xe3, 0x1d, 0x28, 0x8a, 0x71, 0x70, 0x73, 0xc6, 0x7e, 0x18, 0x0d, 0xed, 0xda, 0x6c, 0x7f,
+	0x5a, 0x8e, 0x9a, 0xce, 0xf2, 0x72, 0x12, 0xc8, 0xbe, 0x31, 0x17, 0x52, 0x91, 0xde, 0x86, 0xe5,
+	0xb8, 0x91, 0x54, 0x72, 0x2b, 0x5b, 0x81, 0x67, 0xbf, 0x3a, 0xcb, 0x9b, 0xce, 0x84, 0xbc, 0xaf,
+	0xf9, 0x4c, 0x08, 0xc0, 0xde, 0x99, 0x03, 0xa8, 0x18, 0xf7, 0x00, 0x52, 0x97, 0x6c, 0x3b, 0xb7,
+	0x7c, 0x02, 0xda, 0x37, 0xaf, 0x00, 0x55, 0xbc, 0xb8, 0x7a, 0xd2, 0x95, 0x3f, 0xa3, 0x7a, 0x52,
+	0xb0, 0x7d, 0xeb, 0x4a, 0x38, 0x89, 0x6a, 0x17, 0xbf, 0x8c, 0x3f, 0xfe, 0x5a, 0xaf, 0x3f, 0x3a,
+	0xaf, 0x69, 0x8f, 0xcf, 0x6b, 0xda, 0x93, 0xf3, 0x9a, 0xf6, 0xed, 0x45, 0x6d, 0xe9, 0xf1, 0x45,
+	0x6d, 0xe9, 0xd7, 0x8b, 0xda, 0xd2, 0x27, 0xeb, 0xe9, 0x6f, 0x3f, 0x36, 0xee, 0x23, 0x7a, 0xa2,
+	0xf3, 0xaf, 0xd5, 0x37, 0xff, 0x1a, 0x00, 0xae, 0x7a, 0xf5, 0x6e, 0x67, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1550,6 +1771,8 @@ type MsgClient interface {
 	Transfer(ctx context.Context, in *MsgTransfer, opts ...grpc.CallOption) (*MsgTransferResponse, error)
 	Bid(ctx context.Context, in *MsgBid, opts ...grpc.CallOption) (*MsgBidResponse, error)
 	Settle(ctx context.Context, in *MsgSettle, opts ...grpc.CallOption) (*MsgSettleResponse, error)
+	LockDomain(ctx context.Context, in *MsgLockDomain, opts ...grpc.CallOption) (*MsgLockDomainResponse, error)
+	UnlockDomain(ctx context.Context, in *MsgUnlockDomain, opts ...grpc.CallOption) (*MsgUnlockDomainResponse, error)
 }
 
 type msgClient struct {
@@ -1623,6 +1846,24 @@ func (c *msgClient) Settle(ctx context.Context, in *MsgSettle, opts ...grpc.Call
 	return out, nil
 }
 
+func (c *msgClient) LockDomain(ctx context.Context, in *MsgLockDomain, opts ...grpc.CallOption) (*MsgLockDomainResponse, error) {
+	out := new(MsgLockDomainResponse)
+	err := c.cc.Invoke(ctx, "/lumen.dns.v1.Msg/LockDomain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnlockDomain(ctx context.Context, in *MsgUnlockDomain, opts ...grpc.CallOption) (*MsgUnlockDomainResponse, error) {
+	out := new(MsgUnlockDomainResponse)
+	err := c.cc.Invoke(ctx, "/lumen.dns.v1.Msg/UnlockDomain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
@@ -1632,6 +1873,8 @@ type MsgServer interface {
 	Transfer(context.Context, *MsgTransfer) (*MsgTransferResponse, error)
 	Bid(context.Context, *MsgBid) (*MsgBidResponse, error)
 	Settle(context.Context, *MsgSettle) (*MsgSettleResponse, error)
+	LockDomain(context.Context, *MsgLockDomain) (*MsgLockDomainResponse, error)
+	UnlockDomain(context.Context, *MsgUnlockDomain) (*MsgUnlockDomainResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1659,6 +1902,12 @@ func (*UnimplementedMsgServer) Bid(ctx context.Context, req *MsgBid) (*MsgBidRes
 func (*UnimplementedMsgServer) Settle(ctx context.Context, req *MsgSettle) (*MsgSettleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Settle not implemented")
 }
+func (*UnimplementedMsgServer) LockDomain(ctx context.Context, req *MsgLockDomain) (*MsgLockDomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockDomain not implemented")
+}
+func (*UnimplementedMsgServer) UnlockDomain(ctx context.Context, req *MsgUnlockDomain) (*MsgUnlockDomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockDomain not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1790,6 +2039,42 @@ func _Msg_Settle_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_LockDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLockDomain)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).LockDomain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumen.dns.v1.Msg/LockDomain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LockDomain(ctx, req.(*MsgLockDomain))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnlockDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnlockDomain)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnlockDomain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumen.dns.v1.Msg/UnlockDomain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnlockDomain(ctx, req.(*MsgUnlockDomain))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lumen.dns.v1.Msg",
@@ -1823,6 +2108,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Settle",
 			Handler:    _Msg_Settle_Handler,
 		},
+		{
+			MethodName: "LockDomain",
+			Handler:    _Msg_LockDomain_Handler,
+		},
+		{
+			MethodName: "UnlockDomain",
+			Handler:    _Msg_UnlockDomain_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lumen/dns/v1/tx.proto",
@@ -2850,42 +3143,186 @@ func (m *MsgSettleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgLockDomain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgLockDomain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLockDomain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.DelaySeconds != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DelaySeconds))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	if len(m.Ext) > 0 {
+		i -= len(m.Ext)
+		copy(dAtA[i:], m.Ext)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Ext)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgLockDomainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLockDomainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLockDomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRegister) Size() (n int) {
+func (m *MsgUnlockDomain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnlockDomain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnlockDomain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ext) > 0 {
+		i -= len(m.Ext)
+		copy(dAtA[i:], m.Ext)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Ext)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnlockDomainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnlockDomainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnlockDomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnlockAt != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UnlockAt))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegister) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -3327,6 +3764,72 @@ func (m *MsgSettleResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgLockDomain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Ext)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DelaySeconds != 0 {
+		n += 1 + sovTx(uint64(m.DelaySeconds))
+	}
+	return n
+}
+
+func (m *MsgLockDomainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnlockDomain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Ext)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnlockDomainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UnlockAt != 0 {
+		n += 1 + sovTx(uint64(m.UnlockAt))
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -6400,6 +6903,436 @@ func (m *MsgSettleResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgLockDomain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLockDomain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLockDomain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ext", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ext = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelaySeconds", wireType)
+			}
+			m.DelaySeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelaySeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLockDomainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLockDomainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLockDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnlockDomain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnlockDomain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnlockDomain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ext", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ext = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnlockDomainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnlockDomainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnlockDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockAt", wireType)
+			}
+			m.UnlockAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnlockAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,27 @@
+package keeper
+
+import "context"
+
+// EndBlocker refunds unaccepted contracts and expires timed-out disputes,
+// then runs auto-claims so that a contract released this block can be paid
+// in the same block. Decommissioned gateways are wound down after the
+// claims and silent ones deactivated; the treasury is split last, after this
+// block's commission has come in.
+func (k Keeper) EndBlocker(ctx context.Context) error {
+	if err := k.expirePendingContracts(ctx); err != nil {
+		return err
+	}
+	if err := k.expireDisputes(ctx); err != nil {
+		return err
+	}
+	if err := k.processAutoClaims(ctx); err != nil {
+		return err
+	}
+	if err := k.processDecommissions(ctx); err != nil {
+		return err
+	}
+	if err := k.processLiveness(ctx); err != nil {
+		return err
+	}
+	return k.distributeTreasury(ctx)
+}
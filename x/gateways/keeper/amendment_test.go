@@ -0,0 +1,99 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"lumen/app/denom"
+	"lumen/x/gateways/keeper"
+	"lumen/x/gateways/types"
+)
+
+func TestExtendContractAddsMonthsAndEscrow(t *testing.T) {
+	f := initGatewayFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	_, client, contractID := setupUsageContract(t, f, srv)
+	params := f.keeper.GetParams(f.ctx)
+	balance := f.bank.accountBalance(f.mustAccAddress(client)).AmountOf(denom.BaseDenom)
+
+	_, err := srv.ExtendContract(f.ctx, &types.MsgExtendContract{Client: randomAccAddress(), ContractId: contractID, AdditionalMonths: 2})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	f.withBlockTime(int64(3 * params.MonthSeconds))
+	res, err := srv.ExtendContract(f.ctx, &types.MsgExtendContract{Client: client, ContractId: contractID, AdditionalMonths: 2})
+	require.NoError(t, err)
+	require.Equal(t, uint32(8), res.MonthsTotal)
+	require.Equal(t, "1584000", res.EscrowUlmn, "escrow grows by 2 × 198000")
+	require.Equal(t, balance.SubRaw(396_000+3_960), f.bank.accountBalance(f.mustAccAddress(client)).AmountOf(denom.BaseDenom), "deposit plus 1% send tax")
+
+	contract, err := f.keeper.Contracts.Get(f.ctx, contractID)
+	require.NoError(t, err)
+	require.Equal(t, params.MonthSeconds, contract.NextPayoutTime, "first month still unclaimed")
+
+	f.withBlockTime(int64(8 * params.MonthSeconds))
+	_, err = srv.ExtendContract(f.ctx, &types.MsgExtendContract{Client: client, ContractId: contractID, AdditionalMonths: 1})
+	require.ErrorContains(t, err, "term already ended")
+}
+
+func TestAmendContractNeedsOperatorAcceptance(t *testing.T) {
+	f := initGatewayFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	operator, client, contractID := setupUsageContract(t, f, srv)
+	params := f.keeper.GetParams(f.ctx)
+
+	_, err := srv.AcceptAmendment(f.ctx, &types.MsgAcceptAmendment{Operator: operator, ContractId: contractID})
+	require.ErrorIs(t, err, types.ErrNotFound)
+
+	// Upgrade to 400000 gross (396000 net) per month from month 3 on.
+	_, err = srv.AmendContract(f.ctx, &types.MsgAmendContract{Client: client, ContractId: contractID, PriceUlmn: 400_000, StorageGbPerMonth: 50, NetworkGbPerMonth: 80})
+	require.NoError(t, err)
+	_, err = srv.AcceptAmendment(f.ctx, &types.MsgAcceptAmendment{Operator: client, ContractId: contractID})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	f.withBlockTime(int64(2 * params.MonthSeconds))
+	_, err = srv.AcceptAmendment(f.ctx, &types.MsgAcceptAmendment{Operator: operator, ContractId: contractID})
+	require.ErrorContains(t, err, "claim elapsed months")
+	_, err = srv.ClaimPayment(f.ctx, &types.MsgClaimPayment{Operator: operator, ContractId: contractID})
+	require.NoError(t, err)
+
+	balance := f.bank.accountBalance(f.mustAccAddress(client)).AmountOf(denom.BaseDenom)
+	res, err := srv.AcceptAmendment(f.ctx, &types.MsgAcceptAmendment{Operator: operator, ContractId: contractID})
+	require.NoError(t, err)
+	require.Equal(t, "792000", res.ChargedUlmn, "4 remaining months × 198000")
+	require.Equal(t, balance.SubRaw(792_000+7_920), f.bank.accountBalance(f.mustAccAddress(client)).AmountOf(denom.BaseDenom))
+
+	contract, err := f.keeper.Contracts.Get(f.ctx, contractID)
+	require.NoError(t, err)
+	require.Nil(t, contract.PendingAmendment)
+	require.Equal(t, uint64(396_000), contract.PriceUlmn)
+	require.Equal(t, uint64(50), contract.StorageGbPerMonth)
+	require.Equal(t, "1584000", contract.EscrowUlmn, "4 × 396000")
+	require.Equal(t, 3*params.MonthSeconds, contract.NextPayoutTime)
+
+	// Downgrading back refunds the difference for the remaining months.
+	_, err = srv.AmendContract(f.ctx, &types.MsgAmendContract{Client: client, ContractId: contractID, PriceUlmn: 200_000, StorageGbPerMonth: 10, NetworkGbPerMonth: 20})
+	require.NoError(t, err)
+	res, err = srv.AcceptAmendment(f.ctx, &types.MsgAcceptAmendment{Operator: operator, ContractId: contractID})
+	require.NoError(t, err)
+	require.Equal(t, "792000", res.RefundedUlmn)
+	contract, err = f.keeper.Contracts.Get(f.ctx, contractID)
+	require.NoError(t, err)
+	require.Equal(t, "792000", contract.EscrowUlmn)
+}
+
+func TestAmendContractRefusedWhileDisputed(t *testing.T) {
+	f := initGatewayFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	_, client, contractID := setupUsageContract(t, f, srv)
+
+	_, err := srv.OpenDispute(f.ctx, &types.MsgOpenDispute{Client: client, ContractId: contractID, Reason: "no service"})
+	require.NoError(t, err)
+	_, err = srv.AmendContract(f.ctx, &types.MsgAmendContract{Client: client, ContractId: contractID, PriceUlmn: 400_000})
+	require.ErrorIs(t, err, types.ErrDisputeOpen)
+
+	// The freeze lifts once the dispute times out.
+	f.withBlockTime(int64(f.keeper.GetParams(f.ctx).DisputeTimeout()))
+	_, err = srv.AmendContract(f.ctx, &types.MsgAmendContract{Client: client, ContractId: contractID, PriceUlmn: 400_000})
+	require.NoError(t, err)
+}
@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"lumen/app/denom"
+	"lumen/x/gateways/types"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// gatewayBond returns the bond of a gateway, or an empty bond if the
+// operator never posted one.
+func (k Keeper) gatewayBond(ctx context.Context, gatewayID uint64) (types.GatewayBond, error) {
+	bond, err := k.GatewayBonds.Get(ctx, gatewayID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.GatewayBond{GatewayId: gatewayID}, nil
+	}
+	return bond, err
+}
+
+func (k Keeper) setGatewayBond(ctx context.Context, bond types.GatewayBond) error {
+	return k.GatewayBonds.Set(ctx, bond.GatewayId, bond)
+}
+
+// requiredBond is the bond a gateway must keep bonded. An inactive gateway
+// with no clients left needs none, which lets the operator unbond entirely.
+func requiredBond(params types.Params, gateway types.Gateway) sdkmath.Int {
+	if !gateway.Active && gateway.ActiveClients == 0 {
+		return sdkmath.ZeroInt()
+	}
+	return params.RequiredBond(gateway.ActiveClients)
+}
+
+// slashableBond is the bonded plus unbonding stake of a gateway.
+func (k Keeper) slashableBond(bond types.GatewayBond) sdkmath.Int {
+	return k.safeAmountFromString(bond.BondedUlmn).Add(k.safeAmountFromString(bond.UnbondingUlmn))
+}
+
+// slashGatewayBond moves up to amount of the gateway's stake to the
+// treasury, taking bonded funds before unbonding ones. Whether it is burned
+// later is up to the treasury policy.
+func (k Keeper) slashGatewayBond(ctx context.Context, gatewayID uint64, amount sdkmath.Int, reason string) (sdkmath.Int, error) {
+	bond, err := k.gatewayBond(ctx, gatewayID)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+	bonded := k.safeAmountFromString(bond.BondedUlmn)
+	unbonding := k.safeAmountFromString(bond.UnbondingUlmn)
+	amount = sdkmath.MinInt(amount, bonded.Add(unbonding))
+	if !amount.IsPositive() {
+		return sdkmath.ZeroInt(), nil
+	}
+
+	fromBonded := sdkmath.MinInt(amount, bonded)
+	bond.BondedUlmn = bonded.Sub(fromBonded).String()
+	bond.UnbondingUlmn = unbonding.Sub(amount.Sub(fromBonded)).String()
+	bond.SlashedUlmn = k.safeAmountFromString(bond.SlashedUlmn).Add(amount).String()
+
+	if err := k.creditTreasury(ctx, types.ModuleAccountBond, denom.BaseDenom, amount); err != nil {
+		return sdkmath.Int{}, err
+	}
+	if err := k.setGatewayBond(ctx, bond); err != nil {
+		return sdkmath.Int{}, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			"gateway_bond_slash",
+			sdk.NewAttribute("gateway_id", fmt.Sprintf("%d", gatewayID)),
+			sdk.NewAttribute("amount_ulmn", amount.String()),
+			sdk.NewAttribute("reason", reason),
+		),
+	)
+	return amount, nil
+}
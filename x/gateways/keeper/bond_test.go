@@ -0,0 +1,166 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	gogotypes "github.com/cosmos/gogoproto/types"
+	"github.com/stretchr/testify/require"
+
+	"lumen/app/denom"
+	"lumen/x/gateways/keeper"
+	"lumen/x/gateways/types"
+)
+
+func TestCreateContractRequiresBondScaledByClients(t *testing.T) {
+	f := initGatewayFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	params := f.keeper.GetParams(f.ctx)
+
+	operator := randomAccAddress()
+	f.bank.setAccountBalance(f.mustAccAddress(operator), sdk.NewCoins(sdk.NewCoin(denom.BaseDenom, sdkmath.NewIntFromUint64(f.registerFee()).Add(params.RequiredBond(1)))))
+	gw, err := srv.RegisterGateway(f.ctx, &types.MsgRegisterGateway{Operator: operator})
+	require.NoError(t, err)
+
+	client := randomAccAddress()
+	f.bank.setAccountBalance(f.mustAccAddress(client), sdk.NewCoins(sdk.NewInt64Coin(denom.BaseDenom, 5_000_000)))
+	create := &types.MsgCreateContract{Client: client, GatewayId: gw.Id, PriceUlmn: 200_000, MonthsTotal: 1}
+
+	_, err = srv.CreateContract(f.ctx, create)
+	require.ErrorIs(t, err, types.ErrInsufficientBond)
+
+	_, err = srv.BondGateway(f.ctx, &types.MsgBondGateway{Operator: operator, GatewayId: gw.Id, AmountUlmn: params.RequiredBond(1).String()})
+	require.NoError(t, err)
+	require.Equal(t, params.RequiredBond(1), f.bank.moduleBalance(types.ModuleAccountBond).AmountOf(denom.BaseDenom))
+
+	_, err = srv.CreateContract(f.ctx, create)
+	require.NoError(t, err)
+	_, err = srv.CreateContract(f.ctx, create)
+	require.ErrorIs(t, err, types.ErrInsufficientBond, "a second client needs another bond_per_client_ulmn")
+}
+
+func TestUnbondOnlyAfterDeactivationAndDelay(t *testing.T) {
+	f := initGatewayFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	operator, client, contractID := setupUsageContract(t, f, srv)
+	params := f.keeper.GetParams(f.ctx)
+	contract, err := f.keeper.Contracts.Get(f.ctx, contractID)
+	require.NoError(t, err)
+	gatewayID := contract.GatewayId
+	bonded := params.RequiredBond(10)
+
+	res, err := keeper.NewQueryServerImpl(f.keeper).Gateway(f.ctx, &types.QueryGatewayRequest{Id: gatewayID})
+	require.NoError(t, err)
+	require.Equal(t, bonded.String(), res.Bond.BondedUlmn)
+	require.Equal(t, params.RequiredBond(1).String(), res.RequiredBondUlmn)
+
+	_, err = srv.UnbondGateway(f.ctx, &types.MsgUnbondGateway{Operator: operator, GatewayId: gatewayID, AmountUlmn: bonded.String()})
+	require.ErrorIs(t, err, types.ErrInsufficientBond)
+
+	_, err = srv.UpdateGateway(f.ctx, &types.MsgUpdateGateway{Operator: operator, GatewayId: gatewayID, Active: &gogotypes.BoolValue{Value: false}})
+	require.NoError(t, err)
+	_, err = srv.UnbondGateway(f.ctx, &types.MsgUnbondGateway{Operator: operator, GatewayId: gatewayID, AmountUlmn: bonded.String()})
+	require.ErrorIs(t, err, types.ErrInsufficientBond, "the remaining client still needs coverage")
+
+	_, err = srv.CancelContract(f.ctx, &types.MsgCancelContract{Client: client, ContractId: contractID})
+	require.NoError(t, err)
+	unbond, err := srv.UnbondGateway(f.ctx, &types.MsgUnbondGateway{Operator: operator, GatewayId: gatewayID, AmountUlmn: bonded.String()})
+	require.NoError(t, err)
+	require.Equal(t, params.UnbondingDelaySeconds, unbond.CompleteAt)
+
+	_, err = srv.WithdrawBond(f.ctx, &types.MsgWithdrawBond{Operator: operator, GatewayId: gatewayID})
+	require.ErrorContains(t, err, "unbonding completes at")
+
+	before := f.bank.accountBalance(f.mustAccAddress(operator)).AmountOf(denom.BaseDenom)
+	f.withBlockTime(int64(unbond.CompleteAt))
+	withdraw, err := srv.WithdrawBond(f.ctx, &types.MsgWithdrawBond{Operator: operator, GatewayId: gatewayID})
+	require.NoError(t, err)
+	require.Equal(t, bonded.String(), withdraw.AmountUlmn)
+	require.Equal(t, bonded, f.bank.accountBalance(f.mustAccAddress(operator)).AmountOf(denom.BaseDenom).Sub(before))
+}
+
+func TestLostDisputeSlashesBond(t *testing.T) {
+	f := initGatewayFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	_, client, contractID := setupUsageContract(t, f, srv)
+	params := f.keeper.GetParams(f.ctx)
+
+	open, err := srv.OpenDispute(f.ctx, &types.MsgOpenDispute{Client: client, ContractId: contractID, Reason: "no service"})
+	require.NoError(t, err)
+	treasuryBefore := f.bank.moduleBalance(types.ModuleAccountTreasury).AmountOf(denom.BaseDenom)
+	_, err = srv.ResolveDispute(f.ctx, &types.MsgResolveDispute{
+		Arbiter:         authtypes.NewModuleAddress(types.GovModuleName).String(),
+		DisputeId:       open.DisputeId,
+		ClientRefundBps: 5_000,
+	})
+	require.NoError(t, err)
+
+	// half the dispute_slash_bps for a ruling that refunds the client 50%
+	bonded := params.RequiredBond(10)
+	slash := bonded.MulRaw(int64(params.DisputeSlashBps)).QuoRaw(20_000)
+	contract, err := f.keeper.Contracts.Get(f.ctx, contractID)
+	require.NoError(t, err)
+	bond, err := f.keeper.GatewayBonds.Get(f.ctx, contract.GatewayId)
+	require.NoError(t, err)
+	require.Equal(t, bonded.Sub(slash).String(), bond.BondedUlmn)
+	require.Equal(t, slash.String(), bond.SlashedUlmn)
+	// the treasury also receives the commission on the gateway's share
+	require.True(t, f.bank.moduleBalance(types.ModuleAccountTreasury).AmountOf(denom.BaseDenom).Sub(treasuryBefore).GTE(slash))
+}
+
+func TestExcessCancellationsSlashBond(t *testing.T) {
+	f := initGatewayFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	_, client, contractID := setupUsageContract(t, f, srv)
+
+	params := f.keeper.GetParams(f.ctx)
+	params.MaxCancellations = 1
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	contract, err := f.keeper.Contracts.Get(f.ctx, contractID)
+	require.NoError(t, err)
+	_, err = srv.CancelContract(f.ctx, &types.MsgCancelContract{Client: client, ContractId: contractID})
+	require.NoError(t, err)
+	bond, err := f.keeper.GatewayBonds.Get(f.ctx, contract.GatewayId)
+	require.NoError(t, err)
+	require.Empty(t, bond.SlashedUlmn)
+
+	second, err := srv.CreateContract(f.ctx, &types.MsgCreateContract{Client: client, GatewayId: contract.GatewayId, PriceUlmn: 200_000, MonthsTotal: 2})
+	require.NoError(t, err)
+	_, err = srv.CancelContract(f.ctx, &types.MsgCancelContract{Client: client, ContractId: second.ContractId})
+	require.NoError(t, err)
+
+	bonded := params.RequiredBond(10)
+	slash := bonded.MulRaw(int64(params.CancellationSlashBps)).QuoRaw(10_000)
+	bond, err = f.keeper.GatewayBonds.Get(f.ctx, contract.GatewayId)
+	require.NoError(t, err)
+	require.Equal(t, slash.String(), bond.SlashedUlmn)
+	require.Equal(t, bonded.Sub(slash).String(), bond.BondedUlmn)
+}
+
+func TestCancellationSlashToleratesLowRatio(t *testing.T) {
+	f := initGatewayFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	_, client, contractID := setupUsageContract(t, f, srv)
+
+	params := f.keeper.GetParams(f.ctx)
+	params.MaxCancellations = 1
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	// Two cancellations against eighteen completed contracts stay below 20%.
+	contract, err := f.keeper.Contracts.Get(f.ctx, contractID)
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.GatewayReputations.Set(f.ctx, contract.GatewayId, types.GatewayReputation{GatewayId: contract.GatewayId, ContractsCompleted: 18}))
+	_, err = srv.CancelContract(f.ctx, &types.MsgCancelContract{Client: client, ContractId: contractID})
+	require.NoError(t, err)
+	second, err := srv.CreateContract(f.ctx, &types.MsgCreateContract{Client: client, GatewayId: contract.GatewayId, PriceUlmn: 200_000, MonthsTotal: 2})
+	require.NoError(t, err)
+	_, err = srv.CancelContract(f.ctx, &types.MsgCancelContract{Client: client, ContractId: second.ContractId})
+	require.NoError(t, err)
+
+	bond, err := f.keeper.GatewayBonds.Get(f.ctx, contract.GatewayId)
+	require.NoError(t, err)
+	require.Empty(t, bond.SlashedUlmn)
+}
@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	"context"
+
+	"lumen/x/gateways/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+)
+
+// cancelTerms is how MsgCancelContract settles an active contract's escrow.
+// earned plus penalty is retained for the gateway, split into payout and the
+// treasury fee; refund goes back to the client.
+type cancelTerms struct {
+	policy  types.CancellationPolicy
+	earned  sdkmath.Int
+	penalty sdkmath.Int
+	payout  sdkmath.Int
+	fee     sdkmath.Int
+	refund  sdkmath.Int
+}
+
+// cancellationPolicy returns the policy of the contract's offer, or the
+// params policy when the contract has no offer or the offer sets none.
+func (k Keeper) cancellationPolicy(ctx context.Context, contract types.Contract, params types.Params) types.CancellationPolicy {
+	if contract.OfferId != 0 {
+		offer, err := k.offerByID(ctx, contract.OfferId)
+		if err == nil && offer.CancellationPolicy != nil {
+			return *offer.CancellationPolicy
+		}
+	}
+	return params.CancellationPolicy
+}
+
+// quoteCancel computes the cancellation terms of an active contract at now.
+// The gateway earns the service up to the end of the notice period, by the
+// second under a pro-rata policy or else for every month begun, and always
+// at least the first unclaimed month; months already claimed are deducted.
+// penalty_bps of the escrow left after that is retained as well. Escrow
+// withheld by usage pro-rating always goes back to the client.
+func (k Keeper) quoteCancel(ctx context.Context, contract types.Contract, now uint64) (cancelTerms, error) {
+	params := k.GetParams(ctx)
+	if params.MonthSeconds == 0 {
+		return cancelTerms{}, errorsmod.Wrap(types.ErrInvalidRequest, "invalid month_seconds param")
+	}
+	policy := k.cancellationPolicy(ctx, contract, params)
+
+	escrow := k.safeAmountFromString(contract.EscrowUlmn)
+	available := escrow.Sub(k.safeAmountFromString(contract.UsageWithheldUlmn))
+	if available.IsNegative() {
+		available = sdkmath.ZeroInt()
+	}
+
+	var elapsed uint64
+	if now > contract.StartTime {
+		elapsed = now - contract.StartTime
+	}
+	served, err := k.safeAddUint64(elapsed, policy.NoticeSeconds)
+	if err != nil {
+		return cancelTerms{}, err
+	}
+	term, err := k.safeMulUint64(uint64(contract.MonthsTotal), params.MonthSeconds)
+	if err != nil {
+		return cancelTerms{}, err
+	}
+	if served > term {
+		served = term
+	}
+	price := sdkmath.NewIntFromUint64(contract.PriceUlmn)
+	var due sdkmath.Int
+	if policy.ProRata {
+		due = price.Mul(sdkmath.NewIntFromUint64(served)).Quo(sdkmath.NewIntFromUint64(params.MonthSeconds))
+	} else {
+		months := served / params.MonthSeconds
+		if served%params.MonthSeconds != 0 {
+			months++
+		}
+		if months <= uint64(contract.ClaimedMonths) {
+			months = uint64(contract.ClaimedMonths) + 1
+		}
+		due = price.Mul(sdkmath.NewIntFromUint64(months))
+	}
+	earned := due.Sub(price.MulRaw(int64(contract.ClaimedMonths)))
+	if earned.IsNegative() {
+		earned = sdkmath.ZeroInt()
+	}
+	earned = sdkmath.MinInt(earned, available)
+
+	penalty := k.applyCommission(available.Sub(earned), policy.PenaltyBps)
+	retained := earned.Add(penalty)
+	fee := k.applyCommission(retained, params.PlatformCommissionBps)
+	if fee.GT(retained) {
+		return cancelTerms{}, errorsmod.Wrap(types.ErrOverflow, "penalty commission overflow")
+	}
+	return cancelTerms{
+		policy:  policy,
+		earned:  earned,
+		penalty: penalty,
+		payout:  retained.Sub(fee),
+		fee:     fee,
+		refund:  escrow.Sub(retained),
+	}, nil
+}
@@ -0,0 +1,119 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"lumen/app/denom"
+	"lumen/x/gateways/keeper"
+	"lumen/x/gateways/types"
+)
+
+func TestCancelQuoteMatchesDefaultCancellation(t *testing.T) {
+	f := initGatewayFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	_, client, contractID := setupUsageContract(t, f, srv)
+	params := f.keeper.GetParams(f.ctx)
+
+	// The default policy keeps the month in progress, as before.
+	f.withBlockTime(int64(params.MonthSeconds / 3))
+	quote, err := qs.CancelQuote(f.ctx, &types.QueryCancelQuoteRequest{ContractId: contractID})
+	require.NoError(t, err)
+	require.Equal(t, "990000", quote.RefundUlmn)
+	require.Equal(t, "198000", quote.EarnedUlmn)
+	require.Equal(t, "0", quote.PenaltyUlmn)
+	require.Equal(t, "196020", quote.PayoutUlmn)
+	require.Equal(t, "1980", quote.FeeUlmn)
+	require.Equal(t, denom.BaseDenom, quote.Denom)
+	require.Equal(t, params.CancellationPolicy, quote.Policy)
+
+	res, err := srv.CancelContract(f.ctx, &types.MsgCancelContract{Client: client, ContractId: contractID})
+	require.NoError(t, err)
+	require.Equal(t, quote.RefundUlmn, res.RefundedUlmn)
+
+	_, err = qs.CancelQuote(f.ctx, &types.QueryCancelQuoteRequest{ContractId: contractID})
+	require.ErrorContains(t, err, "contract not active")
+	_, err = qs.CancelQuote(f.ctx, &types.QueryCancelQuoteRequest{ContractId: 99})
+	require.ErrorIs(t, err, types.ErrNotFound)
+}
+
+func TestCancelUnderProRataPolicyWithNoticeAndPenalty(t *testing.T) {
+	f := initGatewayFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	operator, client, contractID := setupUsageContract(t, f, srv)
+	params := f.keeper.GetParams(f.ctx)
+
+	params.CancellationPolicy = types.CancellationPolicy{PenaltyBps: 10_001}
+	require.ErrorContains(t, types.ValidateParams(params), "penalty_bps")
+	params.CancellationPolicy = types.CancellationPolicy{NoticeSeconds: params.MonthSeconds / 4, PenaltyBps: 1_000, ProRata: true}
+	require.NoError(t, f.keeper.SetParams(f.ctx, params))
+
+	// Half a month is earned with the notice period (99_000); 10% of the
+	// remaining 1_089_000 is the penalty and the client gets the rest.
+	f.withBlockTime(int64(params.MonthSeconds / 4))
+	quote, err := qs.CancelQuote(f.ctx, &types.QueryCancelQuoteRequest{ContractId: contractID})
+	require.NoError(t, err)
+	require.Equal(t, "99000", quote.EarnedUlmn)
+	require.Equal(t, "108900", quote.PenaltyUlmn)
+	require.Equal(t, "2079", quote.FeeUlmn)
+	require.Equal(t, "205821", quote.PayoutUlmn)
+	require.Equal(t, "980100", quote.RefundUlmn)
+
+	operatorBefore := f.bank.accountBalance(f.mustAccAddress(operator)).AmountOf(denom.BaseDenom)
+	res, err := srv.CancelContract(f.ctx, &types.MsgCancelContract{Client: client, ContractId: contractID})
+	require.NoError(t, err)
+	require.Equal(t, "980100", res.RefundedUlmn)
+	require.Equal(t, sdkmath.NewInt(205_821), f.bank.accountBalance(f.mustAccAddress(operator)).AmountOf(denom.BaseDenom).Sub(operatorBefore))
+	require.Equal(t, "2079", f.bank.moduleBalance(types.ModuleAccountTreasury).AmountOf(denom.BaseDenom).String())
+	require.True(t, f.bank.moduleBalance(types.ModuleAccountEscrow).IsZero())
+}
+
+func TestOfferCancellationPolicyOverridesParams(t *testing.T) {
+	f := initGatewayFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	params := f.keeper.GetParams(f.ctx)
+
+	operator, gatewayID := f.registerBondedGateway(srv)
+
+	createOffer := &types.MsgCreateOffer{
+		Operator:           operator,
+		GatewayId:          gatewayID,
+		PriceUlmn:          200_000,
+		MinMonths:          1,
+		CancellationPolicy: &types.CancellationPolicy{NoticeSeconds: 400 * 24 * 60 * 60},
+	}
+	_, err := srv.CreateOffer(f.ctx, createOffer)
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+	createOffer.CancellationPolicy = &types.CancellationPolicy{ProRata: true}
+	offer, err := srv.CreateOffer(f.ctx, createOffer)
+	require.NoError(t, err)
+
+	client := randomAccAddress()
+	f.bank.setAccountBalance(f.mustAccAddress(client), sdk.NewCoins(sdk.NewInt64Coin(denom.BaseDenom, 5_000_000)))
+	ct, err := srv.CreateContract(f.ctx, &types.MsgCreateContract{Client: client, GatewayId: gatewayID, OfferId: offer.OfferId, MonthsTotal: 6})
+	require.NoError(t, err)
+
+	f.withBlockTime(int64(params.MonthSeconds / 2))
+	quote, err := qs.CancelQuote(f.ctx, &types.QueryCancelQuoteRequest{ContractId: ct.ContractId})
+	require.NoError(t, err)
+	require.Equal(t, types.CancellationPolicy{ProRata: true}, quote.Policy)
+	require.Equal(t, "99000", quote.EarnedUlmn)
+	require.Equal(t, "1089000", quote.RefundUlmn)
+}
+
+func TestCancelQuoteForPendingContract(t *testing.T) {
+	f := initGatewayFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	_, _, _, contractID := setupPendingContract(t, f, srv)
+
+	quote, err := keeper.NewQueryServerImpl(f.keeper).CancelQuote(f.ctx, &types.QueryCancelQuoteRequest{ContractId: contractID})
+	require.NoError(t, err)
+	require.Equal(t, "1200000", quote.RefundUlmn)
+	require.Equal(t, "0", quote.PayoutUlmn)
+}
@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"context"
+
+	"lumen/x/gateways/types"
+
+	"cosmossdk.io/collections"
+)
+
+// contractOpen reports whether the contract still holds escrow to settle:
+// pending, active, or completed and not finalized yet.
+func contractOpen(contract types.Contract) bool {
+	switch contract.Status {
+	case types.ContractStatus_CONTRACT_STATUS_PENDING,
+		types.ContractStatus_CONTRACT_STATUS_ACTIVE,
+		types.ContractStatus_CONTRACT_STATUS_COMPLETED:
+		return true
+	}
+	return false
+}
+
+// indexContract adds the contract to the client, gateway, status, open and
+// payout indexes that back the Contracts query.
+func (k Keeper) indexContract(ctx context.Context, contract types.Contract) error {
+	if err := k.ContractsByClient.Set(ctx, collections.Join(contract.Client, contract.Id)); err != nil {
+		return err
+	}
+	if err := k.ContractsByGateway.Set(ctx, collections.Join(contract.GatewayId, contract.Id)); err != nil {
+		return err
+	}
+	if err := k.ContractsByStatus.Set(ctx, collections.Join(int32(contract.Status), contract.Id)); err != nil {
+		return err
+	}
+	if contractOpen(contract) {
+		if err := k.OpenContracts.Set(ctx, collections.Join(contract.GatewayId, contract.Id)); err != nil {
+			return err
+		}
+	}
+	if contract.Status != types.ContractStatus_CONTRACT_STATUS_ACTIVE || contract.NextPayoutTime == 0 {
+		return nil
+	}
+	return k.ContractsByPayout.Set(ctx, collections.Join(contract.NextPayoutTime, contract.Id))
+}
+
+func (k Keeper) unindexContract(ctx context.Context, contract types.Contract) error {
+	if err := k.ContractsByClient.Remove(ctx, collections.Join(contract.Client, contract.Id)); err != nil {
+		return err
+	}
+	if err := k.ContractsByGateway.Remove(ctx, collections.Join(contract.GatewayId, contract.Id)); err != nil {
+		return err
+	}
+	if err := k.ContractsByStatus.Remove(ctx, collections.Join(int32(contract.Status), contract.Id)); err != nil {
+		return err
+	}
+	if err := k.OpenContracts.Remove(ctx, collections.Join(contract.GatewayId, contract.Id)); err != nil {
+		return err
+	}
+	return k.ContractsByPayout.Remove(ctx, collections.Join(contract.NextPayoutTime, contract.Id))
+}
+
+// reindexContracts rebuilds every contract index from the stored contracts.
+// Existing entries are left in place; all index writes are idempotent.
+func (k Keeper) reindexContracts(ctx context.Context) error {
+	var contracts []types.Contract
+	err := k.Contracts.Walk(ctx, nil, func(_ uint64, contract types.Contract) (bool, error) {
+		contracts = append(contracts, contract)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, contract := range contracts {
+		if err := k.indexContract(ctx, contract); err != nil {
+			return err
+		}
+		if err := k.schedulePayout(ctx, contract); err != nil {
+			return err
+		}
+		if contract.Status == types.ContractStatus_CONTRACT_STATUS_PENDING {
+			if err := k.PendingDeadlines.Set(ctx, collections.Join(contract.AcceptDeadline, contract.Id)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"lumen/x/gateways/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// checkContractTransferable refuses transfers of contracts that are no longer
+// running or are frozen by an open dispute.
+func (k Keeper) checkContractTransferable(ctx context.Context, contract types.Contract) error {
+	switch contract.Status {
+	case types.ContractStatus_CONTRACT_STATUS_PENDING, types.ContractStatus_CONTRACT_STATUS_ACTIVE:
+	default:
+		return errorsmod.Wrap(types.ErrInvalidRequest, "contract not active")
+	}
+	frozen, err := k.contractFrozen(ctx, contract, uint64(k.nowUnix(ctx)))
+	if err != nil {
+		return err
+	}
+	if frozen {
+		return errorsmod.Wrapf(types.ErrDisputeOpen, "dispute %d", contract.DisputeId)
+	}
+	return nil
+}
+
+// assignContract makes newClient the contract's client. Refunds, cancellation
+// and every other client right follow the contract; an amendment proposed by
+// the previous client is dropped since the new client would have to pay for
+// it.
+func (k Keeper) assignContract(ctx context.Context, contract types.Contract, newClient string) error {
+	if err := k.checkReceivable(newClient, "new client"); err != nil {
+		return err
+	}
+	previous := contract.Client
+	contract.Client = newClient
+	contract.PendingClient = ""
+	contract.PendingAmendment = nil
+	if err := k.setContract(ctx, contract); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			"contract_transfer",
+			sdk.NewAttribute("contract_id", fmt.Sprintf("%d", contract.Id)),
+			sdk.NewAttribute("gateway_id", fmt.Sprintf("%d", contract.GatewayId)),
+			sdk.NewAttribute("previous_client", previous),
+			sdk.NewAttribute("client", newClient),
+		),
+	)
+	return nil
+}
@@ -0,0 +1,125 @@
+package keeper_test
+
+import (
+	"testing"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	gogotypes "github.com/cosmos/gogoproto/types"
+	"github.com/stretchr/testify/require"
+
+	"lumen/app/denom"
+	"lumen/x/gateways/keeper"
+	"lumen/x/gateways/types"
+)
+
+func TestTransferContractMovesClientRights(t *testing.T) {
+	f := initGatewayFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	_, client, contractID := setupUsageContract(t, f, srv)
+	newClient := randomAccAddress()
+
+	_, err := srv.TransferContract(f.ctx, &types.MsgTransferContract{Client: newClient, ContractId: contractID, NewClient: client})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = srv.TransferContract(f.ctx, &types.MsgTransferContract{Client: client, ContractId: contractID})
+	require.ErrorContains(t, err, "no transfer pending")
+
+	f.resetEvents()
+	res, err := srv.TransferContract(f.ctx, &types.MsgTransferContract{Client: client, ContractId: contractID, NewClient: newClient})
+	require.NoError(t, err)
+	require.False(t, res.Pending)
+	var transferred bool
+	for _, ev := range f.ctx.EventManager().Events() {
+		if ev.Type == "contract_transfer" {
+			transferred = true
+		}
+	}
+	require.True(t, transferred)
+
+	contracts, err := qs.Contracts(f.ctx, &types.QueryContractsRequest{Client: newClient})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{contractID}, contractIDs(contracts.Contracts))
+	contracts, err = qs.Contracts(f.ctx, &types.QueryContractsRequest{Client: client})
+	require.NoError(t, err)
+	require.Empty(t, contracts.Contracts)
+
+	// Only the new client may cancel, and the refund goes to them.
+	_, err = srv.CancelContract(f.ctx, &types.MsgCancelContract{Client: client, ContractId: contractID})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	cancel, err := srv.CancelContract(f.ctx, &types.MsgCancelContract{Client: newClient, ContractId: contractID})
+	require.NoError(t, err)
+	require.Equal(t, cancel.RefundedUlmn, f.bank.accountBalance(f.mustAccAddress(newClient)).AmountOf(denom.BaseDenom).String())
+
+	_, err = srv.TransferContract(f.ctx, &types.MsgTransferContract{Client: newClient, ContractId: contractID, NewClient: client})
+	require.ErrorContains(t, err, "contract not active")
+}
+
+func TestTransferContractWaitsForGatewayConsent(t *testing.T) {
+	f := initGatewayFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	operator, client, contractID := setupUsageContract(t, f, srv)
+	contract, err := f.keeper.Contracts.Get(f.ctx, contractID)
+	require.NoError(t, err)
+	_, err = srv.UpdateGateway(f.ctx, &types.MsgUpdateGateway{Operator: operator, GatewayId: contract.GatewayId, ContractTransferConsent: &gogotypes.BoolValue{Value: true}})
+	require.NoError(t, err)
+	newClient := randomAccAddress()
+
+	transfer := &types.MsgTransferContract{Client: client, ContractId: contractID, NewClient: newClient}
+	res, err := srv.TransferContract(f.ctx, transfer)
+	require.NoError(t, err)
+	require.True(t, res.Pending)
+	contract, err = f.keeper.Contracts.Get(f.ctx, contractID)
+	require.NoError(t, err)
+	require.Equal(t, client, contract.Client)
+	require.Equal(t, newClient, contract.PendingClient)
+
+	// The client can withdraw the proposal before the gateway consents.
+	_, err = srv.TransferContract(f.ctx, &types.MsgTransferContract{Client: client, ContractId: contractID})
+	require.NoError(t, err)
+	_, err = srv.ApproveContractTransfer(f.ctx, &types.MsgApproveContractTransfer{Operator: operator, ContractId: contractID})
+	require.ErrorContains(t, err, "no transfer pending")
+
+	_, err = srv.TransferContract(f.ctx, transfer)
+	require.NoError(t, err)
+	_, err = srv.ApproveContractTransfer(f.ctx, &types.MsgApproveContractTransfer{Operator: newClient, ContractId: contractID})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = srv.ApproveContractTransfer(f.ctx, &types.MsgApproveContractTransfer{Operator: operator, ContractId: contractID})
+	require.NoError(t, err)
+
+	contract, err = f.keeper.Contracts.Get(f.ctx, contractID)
+	require.NoError(t, err)
+	require.Equal(t, newClient, contract.Client)
+	require.Empty(t, contract.PendingClient)
+}
+
+func TestTransferContractValidateBasic(t *testing.T) {
+	client := randomAccAddress()
+	require.NoError(t, (&types.MsgTransferContract{Client: client, ContractId: 1}).ValidateBasic())
+	require.Error(t, (&types.MsgTransferContract{Client: client, ContractId: 1, NewClient: client}).ValidateBasic())
+	require.Error(t, (&types.MsgTransferContract{Client: client, ContractId: 1, NewClient: "lmn1bad"}).ValidateBasic())
+	require.Error(t, (&types.MsgTransferContract{Client: client}).ValidateBasic())
+}
+
+func TestTransferContractRejectsModuleAddress(t *testing.T) {
+	f := initGatewayFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	operator, client, contractID := setupUsageContract(t, f, srv)
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName).String()
+
+	// Refunds to a blocked module address would fail forever.
+	_, err := srv.TransferContract(f.ctx, &types.MsgTransferContract{Client: client, ContractId: contractID, NewClient: feeCollector})
+	require.ErrorContains(t, err, "cannot receive funds")
+	contract, err := f.keeper.Contracts.Get(f.ctx, contractID)
+	require.NoError(t, err)
+	require.Equal(t, client, contract.Client)
+
+	// A pending transfer to an address blocked since is refused on approval.
+	_, err = srv.UpdateGateway(f.ctx, &types.MsgUpdateGateway{Operator: operator, GatewayId: contract.GatewayId, ContractTransferConsent: &gogotypes.BoolValue{Value: true}})
+	require.NoError(t, err)
+	newClient := randomAccAddress()
+	_, err = srv.TransferContract(f.ctx, &types.MsgTransferContract{Client: client, ContractId: contractID, NewClient: newClient})
+	require.NoError(t, err)
+	f.bank.blocked[newClient] = true
+	_, err = srv.ApproveContractTransfer(f.ctx, &types.MsgApproveContractTransfer{Operator: operator, ContractId: contractID})
+	require.ErrorContains(t, err, "cannot receive funds")
+}
@@ -0,0 +1,256 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"lumen/x/gateways/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// proRataEarned returns the part of the contract's escrow the gateway has
+// earned by closeAt and not claimed yet: the monthly price pro rata to the
+// seconds served, minus the months already claimed. Escrow withheld by usage
+// pro-rating is never earned.
+func (k Keeper) proRataEarned(contract types.Contract, closeAt, monthSeconds uint64) (sdkmath.Int, error) {
+	if monthSeconds == 0 {
+		return sdkmath.Int{}, errorsmod.Wrap(types.ErrInvalidRequest, "invalid month_seconds param")
+	}
+	endTime, err := k.contractOffsetTime(contract, uint64(contract.MonthsTotal), monthSeconds)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+	if closeAt > endTime {
+		closeAt = endTime
+	}
+	var elapsed uint64
+	if closeAt > contract.StartTime {
+		elapsed = closeAt - contract.StartTime
+	}
+	price := sdkmath.NewIntFromUint64(contract.PriceUlmn)
+	earned := price.Mul(sdkmath.NewIntFromUint64(elapsed)).Quo(sdkmath.NewIntFromUint64(monthSeconds))
+	earned = earned.Sub(price.MulRaw(int64(contract.ClaimedMonths)))
+	if !earned.IsPositive() {
+		return sdkmath.ZeroInt(), nil
+	}
+	available := k.safeAmountFromString(contract.EscrowUlmn).Sub(k.safeAmountFromString(contract.UsageWithheldUlmn))
+	if !available.IsPositive() {
+		return sdkmath.ZeroInt(), nil
+	}
+	return sdkmath.MinInt(earned, available), nil
+}
+
+// closeContractProRata pays the gateway what it earned by closeAt, marks the
+// contract CANCELED and returns the payout together with the remainder that
+// is still held in escrow for the client. The caller refunds or re-escrows
+// the remainder.
+func (k Keeper) closeContractProRata(ctx context.Context, contract types.Contract, closeAt uint64) (payout, remainder sdkmath.Int, err error) {
+	params := k.GetParams(ctx)
+	gross, err := k.proRataEarned(contract, closeAt, params.MonthSeconds)
+	if err != nil {
+		return sdkmath.Int{}, sdkmath.Int{}, err
+	}
+	gateway, err := k.gatewayByID(ctx, contract.GatewayId)
+	if err != nil {
+		return sdkmath.Int{}, sdkmath.Int{}, errorsmod.Wrap(types.ErrNotFound, "gateway not found")
+	}
+
+	commission := k.applyCommission(gross, params.PlatformCommissionBps)
+	if commission.GT(gross) {
+		return sdkmath.Int{}, sdkmath.Int{}, errorsmod.Wrap(types.ErrOverflow, "commission overflow")
+	}
+	payout = gross.Sub(commission)
+	payoutAddr := strings.TrimSpace(gateway.Payout)
+	if payoutAddr == "" {
+		payoutAddr = gateway.Operator
+	}
+	addr, err := k.mustAddress(payoutAddr)
+	if err != nil {
+		return sdkmath.Int{}, sdkmath.Int{}, errorsmod.Wrap(err, "invalid payout address")
+	}
+	if err := k.payFromModule(ctx, types.ModuleAccountEscrow, addr, contract.PaymentDenom(), payout); err != nil {
+		return sdkmath.Int{}, sdkmath.Int{}, err
+	}
+	if err := k.creditTreasury(ctx, types.ModuleAccountEscrow, contract.PaymentDenom(), commission); err != nil {
+		return sdkmath.Int{}, sdkmath.Int{}, err
+	}
+
+	remainder = k.safeAmountFromString(contract.EscrowUlmn).Sub(gross)
+	contract.Status = types.ContractStatus_CONTRACT_STATUS_CANCELED
+	contract.EscrowUlmn = sdkmath.ZeroInt().String()
+	contract.UsageWithheldUlmn = ""
+	contract.NextPayoutTime = 0
+	if err := k.setContract(ctx, contract); err != nil {
+		return sdkmath.Int{}, sdkmath.Int{}, err
+	}
+	if gateway.ActiveClients > 0 {
+		gateway.ActiveClients--
+	}
+	if err := k.setGateway(ctx, gateway); err != nil {
+		return sdkmath.Int{}, sdkmath.Int{}, err
+	}
+	if err := k.releaseOfferSlot(ctx, contract); err != nil {
+		return sdkmath.Int{}, sdkmath.Int{}, err
+	}
+	return payout, remainder, nil
+}
+
+// processDecommissions winds down gateways whose announced shutdown time has
+// passed: pending contracts are refunded and active ones closed pro rata to
+// the shutdown time. Each block visits at most MaxDecommissionGatewaysPerBlock
+// queue entries and MaxDecommissionClosuresPerBlock open contracts, resuming
+// after DecommissionCursor so gateways that cannot be archived yet do not
+// starve the rest. A gateway is archived once none of its contracts is left
+// to settle. Each gateway settles in a cached context; one that fails is
+// reported and retried on the next pass instead of failing the block.
+func (k Keeper) processDecommissions(ctx context.Context) error {
+	now := uint64(k.nowUnix(ctx))
+
+	rng := new(collections.Range[collections.Pair[uint64, uint64]]).EndExclusive(collections.Join(now+1, uint64(0)))
+	cursor, err := k.DecommissionCursor.Get(ctx)
+	hasCursor := err == nil
+	switch {
+	case hasCursor:
+		rng = rng.StartExclusive(cursor)
+	case !errors.Is(err, collections.ErrNotFound):
+		return err
+	}
+	var due []collections.Pair[uint64, uint64]
+	err = k.DecommissionQueue.Walk(ctx, rng, func(key collections.Pair[uint64, uint64]) (bool, error) {
+		due = append(due, key)
+		return len(due) >= types.MaxDecommissionGatewaysPerBlock, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	budget := types.MaxDecommissionClosuresPerBlock
+	for _, key := range due {
+		cacheCtx, write := sdkCtx.CacheContext()
+		visited, err := k.settleDecommission(cacheCtx, key.K2(), key.K1(), budget)
+		if err != nil {
+			sdkCtx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					"gateway_decommission_skip",
+					sdk.NewAttribute("gateway_id", fmt.Sprintf("%d", key.K2())),
+					sdk.NewAttribute("reason", err.Error()),
+				),
+			)
+		} else {
+			write()
+			budget -= visited
+		}
+		if budget <= 0 {
+			// The gateway may have contracts left; the next block starts
+			// with it.
+			break
+		}
+		cursor, hasCursor = key, true
+	}
+
+	// Fewer entries than the cap means the walk reached the end of the due
+	// queue: the next block starts from the front again.
+	if !hasCursor || (budget > 0 && len(due) < types.MaxDecommissionGatewaysPerBlock) {
+		return k.DecommissionCursor.Remove(ctx)
+	}
+	return k.DecommissionCursor.Set(ctx, cursor)
+}
+
+// settleDecommission visits up to budget open contracts of a decommissioned
+// gateway, closing those it can, and archives the gateway when nothing is
+// left open. Contracts frozen by a dispute or fully paid and awaiting
+// finalization keep the gateway unarchived until they settle on their own.
+// It returns the number of contracts visited.
+func (k Keeper) settleDecommission(ctx context.Context, gatewayID, closeAt uint64, budget int) (int, error) {
+	var ids []uint64
+	open := false
+	rng := collections.NewPrefixedPairRange[uint64, uint64](gatewayID)
+	err := k.OpenContracts.Walk(ctx, rng, func(key collections.Pair[uint64, uint64]) (bool, error) {
+		if len(ids) >= budget {
+			open = true
+			return true, nil
+		}
+		ids = append(ids, key.K2())
+		return false, nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	now := uint64(k.nowUnix(ctx))
+	for _, id := range ids {
+		contract, err := k.contractByID(ctx, id)
+		if err != nil {
+			return len(ids), err
+		}
+		switch contract.Status {
+		case types.ContractStatus_CONTRACT_STATUS_PENDING:
+			if _, err := k.refundPendingContract(ctx, contract, "gateway_decommissioned"); err != nil {
+				return len(ids), err
+			}
+			continue
+		case types.ContractStatus_CONTRACT_STATUS_ACTIVE:
+		default:
+			open = true
+			continue
+		}
+		frozen, err := k.contractFrozen(ctx, contract, now)
+		if err != nil {
+			return len(ids), err
+		}
+		if frozen || contract.ClaimedMonths >= contract.MonthsTotal {
+			open = true
+			continue
+		}
+		payout, refund, err := k.closeContractProRata(ctx, contract, closeAt)
+		if err != nil {
+			return len(ids), err
+		}
+		clientAddr, err := k.mustAddress(contract.Client)
+		if err != nil {
+			return len(ids), errorsmod.Wrap(err, "invalid client address")
+		}
+		if err := k.payFromModule(ctx, types.ModuleAccountEscrow, clientAddr, contract.PaymentDenom(), refund); err != nil {
+			return len(ids), err
+		}
+
+		sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+			sdk.NewEvent(
+				"contract_decommission",
+				sdk.NewAttribute("contract_id", fmt.Sprintf("%d", contract.Id)),
+				sdk.NewAttribute("gateway_id", fmt.Sprintf("%d", gatewayID)),
+				sdk.NewAttribute("client", contract.Client),
+				sdk.NewAttribute("payout_ulmn", payout.String()),
+				sdk.NewAttribute("refunded_ulmn", refund.String()),
+			),
+		)
+	}
+	if open {
+		return len(ids), nil
+	}
+
+	gateway, err := k.gatewayByID(ctx, gatewayID)
+	if err != nil {
+		return len(ids), err
+	}
+	gateway.Active = false
+	gateway.Archived = true
+	if err := k.setGateway(ctx, gateway); err != nil {
+		return len(ids), err
+	}
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			"gateway_archive",
+			sdk.NewAttribute("gateway_id", fmt.Sprintf("%d", gatewayID)),
+			sdk.NewAttribute("decommission_at", fmt.Sprintf("%d", closeAt)),
+		),
+	)
+	return len(ids), nil
+}
@@ -0,0 +1,277 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	gogotypes "github.com/cosmos/gogoproto/types"
+	"github.com/stretchr/testify/require"
+
+	"lumen/app/denom"
+	"lumen/x/gateways/keeper"
+	"lumen/x/gateways/types"
+)
+
+func TestDecommissionClosesContractsProRataAndArchives(t *testing.T) {
+	f := initGatewayFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	operator, client, contractID := setupUsageContract(t, f, srv)
+	params := f.keeper.GetParams(f.ctx)
+	contract, err := f.keeper.Contracts.Get(f.ctx, contractID)
+	require.NoError(t, err)
+	gatewayID := contract.GatewayId
+	endTime := params.MonthSeconds + params.MonthSeconds/2
+
+	decommission := &types.MsgDecommissionGateway{Operator: operator, GatewayId: gatewayID}
+	_, err = srv.DecommissionGateway(f.ctx, decommission)
+	require.ErrorContains(t, err, "gateway not decommissioning")
+	decommission.EndTime = endTime
+	_, err = srv.DecommissionGateway(f.ctx, &types.MsgDecommissionGateway{Operator: client, GatewayId: gatewayID, EndTime: endTime})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = srv.DecommissionGateway(f.ctx, decommission)
+	require.NoError(t, err)
+
+	gateway, err := f.keeper.Gateways.Get(f.ctx, gatewayID)
+	require.NoError(t, err)
+	require.False(t, gateway.Active)
+	require.Equal(t, endTime, gateway.DecommissionAt)
+	_, err = srv.UpdateGateway(f.ctx, &types.MsgUpdateGateway{Operator: operator, GatewayId: gatewayID, Active: &gogotypes.BoolValue{Value: true}})
+	require.ErrorContains(t, err, "gateway is decommissioning")
+	_, err = srv.ExtendContract(f.ctx, &types.MsgExtendContract{Client: client, ContractId: contractID, AdditionalMonths: 1})
+	require.ErrorContains(t, err, "gateway is decommissioning")
+
+	// Nothing is closed before the announced end.
+	f.withBlockTime(int64(endTime - 1))
+	require.NoError(t, f.keeper.EndBlocker(f.ctx))
+	contract, err = f.keeper.Contracts.Get(f.ctx, contractID)
+	require.NoError(t, err)
+	require.Equal(t, types.ContractStatus_CONTRACT_STATUS_ACTIVE, contract.Status)
+
+	// Closing late still settles at the announced end: 1.5 months of the
+	// 198_000 net monthly price go to the gateway, the rest to the client.
+	f.withBlockTime(int64(endTime + 100))
+	f.resetEvents()
+	require.NoError(t, f.keeper.EndBlocker(f.ctx))
+	contract, err = f.keeper.Contracts.Get(f.ctx, contractID)
+	require.NoError(t, err)
+	require.Equal(t, types.ContractStatus_CONTRACT_STATUS_CANCELED, contract.Status)
+	require.Equal(t, "0", contract.EscrowUlmn)
+	require.Equal(t, "2970", f.bank.moduleBalance(types.ModuleAccountTreasury).AmountOf(denom.BaseDenom).String())
+	require.Equal(t, "4691000", f.bank.accountBalance(f.mustAccAddress(client)).AmountOf(denom.BaseDenom).String())
+	require.True(t, f.bank.moduleBalance(types.ModuleAccountEscrow).IsZero())
+
+	gateway, err = f.keeper.Gateways.Get(f.ctx, gatewayID)
+	require.NoError(t, err)
+	require.True(t, gateway.Archived)
+	require.Zero(t, gateway.ActiveClients)
+	require.Zero(t, gateway.Cancellations, "a decommission closure is not a cancellation")
+	var closed, archived bool
+	for _, ev := range f.ctx.EventManager().Events() {
+		switch ev.Type {
+		case "contract_decommission":
+			closed = true
+		case "gateway_archive":
+			archived = true
+		}
+	}
+	require.True(t, closed)
+	require.True(t, archived)
+
+	_, err = srv.UpdateGateway(f.ctx, &types.MsgUpdateGateway{Operator: operator, GatewayId: gatewayID, Active: &gogotypes.BoolValue{Value: true}})
+	require.ErrorContains(t, err, "gateway archived")
+	_, err = srv.DecommissionGateway(f.ctx, decommission)
+	require.ErrorContains(t, err, "gateway archived")
+}
+
+func TestWithdrawDecommission(t *testing.T) {
+	f := initGatewayFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	operator, _, contractID := setupUsageContract(t, f, srv)
+	contract, err := f.keeper.Contracts.Get(f.ctx, contractID)
+	require.NoError(t, err)
+
+	_, err = srv.DecommissionGateway(f.ctx, &types.MsgDecommissionGateway{Operator: operator, GatewayId: contract.GatewayId, EndTime: 0})
+	require.ErrorContains(t, err, "gateway not decommissioning")
+	_, err = srv.DecommissionGateway(f.ctx, &types.MsgDecommissionGateway{Operator: operator, GatewayId: contract.GatewayId, EndTime: 1_000})
+	require.NoError(t, err)
+	_, err = srv.DecommissionGateway(f.ctx, &types.MsgDecommissionGateway{Operator: operator, GatewayId: contract.GatewayId, EndTime: 0})
+	require.NoError(t, err)
+
+	f.withBlockTime(2_000)
+	require.NoError(t, f.keeper.EndBlocker(f.ctx))
+	contract, err = f.keeper.Contracts.Get(f.ctx, contractID)
+	require.NoError(t, err)
+	require.Equal(t, types.ContractStatus_CONTRACT_STATUS_ACTIVE, contract.Status)
+
+	_, err = srv.UpdateGateway(f.ctx, &types.MsgUpdateGateway{Operator: operator, GatewayId: contract.GatewayId, Active: &gogotypes.BoolValue{Value: true}})
+	require.NoError(t, err)
+	gateway, err := f.keeper.Gateways.Get(f.ctx, contract.GatewayId)
+	require.NoError(t, err)
+	require.True(t, gateway.Active)
+	require.False(t, gateway.Archived)
+}
+
+func TestMigrateContractMovesRemainingEscrow(t *testing.T) {
+	f := initGatewayFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	operator, client, contractID := setupUsageContract(t, f, srv)
+	params := f.keeper.GetParams(f.ctx)
+	contract, err := f.keeper.Contracts.Get(f.ctx, contractID)
+	require.NoError(t, err)
+	source := contract.GatewayId
+
+	target, targetID := f.registerBondedGateway(srv)
+
+	migrate := &types.MsgMigrateContract{Client: client, ContractId: contractID, NewGatewayId: targetID}
+	_, err = srv.MigrateContract(f.ctx, migrate)
+	require.ErrorContains(t, err, "gateway is not decommissioning")
+
+	_, err = srv.DecommissionGateway(f.ctx, &types.MsgDecommissionGateway{Operator: operator, GatewayId: source, EndTime: 3 * params.MonthSeconds})
+	require.NoError(t, err)
+	f.withBlockTime(int64(params.MonthSeconds / 2))
+
+	_, err = srv.MigrateContract(f.ctx, &types.MsgMigrateContract{Client: target, ContractId: contractID, NewGatewayId: targetID})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = srv.MigrateContract(f.ctx, &types.MsgMigrateContract{Client: client, ContractId: contractID, NewGatewayId: source})
+	require.ErrorContains(t, err, "new gateway must differ")
+	_, err = srv.MigrateContract(f.ctx, &types.MsgMigrateContract{Client: client, ContractId: contractID, NewGatewayId: targetID, PriceUlmn: 2_000_000})
+	require.ErrorIs(t, err, types.ErrInsufficientFunds)
+
+	// Half a month is earned (99_000); the remaining 1_089_000 buys five
+	// months at 198_000 and 99_000 goes back to the client.
+	res, err := srv.MigrateContract(f.ctx, migrate)
+	require.NoError(t, err)
+	require.Equal(t, uint32(5), res.MonthsTotal)
+	require.Equal(t, "99000", res.RefundedUlmn)
+	require.Equal(t, "3899000", f.bank.accountBalance(f.mustAccAddress(client)).AmountOf(denom.BaseDenom).String())
+	require.Equal(t, "990000", f.bank.moduleBalance(types.ModuleAccountEscrow).AmountOf(denom.BaseDenom).String())
+
+	old, err := f.keeper.Contracts.Get(f.ctx, contractID)
+	require.NoError(t, err)
+	require.Equal(t, types.ContractStatus_CONTRACT_STATUS_CANCELED, old.Status)
+	migrated, err := f.keeper.Contracts.Get(f.ctx, res.NewContractId)
+	require.NoError(t, err)
+	require.Equal(t, types.ContractStatus_CONTRACT_STATUS_ACTIVE, migrated.Status)
+	require.Equal(t, targetID, migrated.GatewayId)
+	require.Equal(t, uint64(198_000), migrated.PriceUlmn)
+	require.Equal(t, "990000", migrated.EscrowUlmn)
+	require.Equal(t, params.MonthSeconds/2, migrated.StartTime)
+
+	targetGateway, err := f.keeper.Gateways.Get(f.ctx, targetID)
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), targetGateway.ActiveClients)
+	sourceGateway, err := f.keeper.Gateways.Get(f.ctx, source)
+	require.NoError(t, err)
+	require.Zero(t, sourceGateway.ActiveClients)
+
+	_, err = srv.MigrateContract(f.ctx, migrate)
+	require.ErrorContains(t, err, "contract not active")
+
+	// With nothing left to settle the source is archived at its end date.
+	f.withBlockTime(int64(3 * params.MonthSeconds))
+	require.NoError(t, f.keeper.EndBlocker(f.ctx))
+	sourceGateway, err = f.keeper.Gateways.Get(f.ctx, source)
+	require.NoError(t, err)
+	require.True(t, sourceGateway.Archived)
+	migrated, err = f.keeper.Contracts.Get(f.ctx, res.NewContractId)
+	require.NoError(t, err)
+	require.Equal(t, types.ContractStatus_CONTRACT_STATUS_ACTIVE, migrated.Status)
+}
+
+func TestDecommissionSkipsGatewayThatCannotSettle(t *testing.T) {
+	f := initGatewayFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	operator, _, contractID := setupUsageContract(t, f, srv)
+	contract, err := f.keeper.Contracts.Get(f.ctx, contractID)
+	require.NoError(t, err)
+	gatewayID := contract.GatewayId
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName).String()
+
+	_, err = srv.UpdateGateway(f.ctx, &types.MsgUpdateGateway{Operator: operator, GatewayId: gatewayID, Payout: &gogotypes.StringValue{Value: feeCollector}})
+	require.ErrorContains(t, err, "cannot receive funds")
+
+	endTime := f.keeper.GetParams(f.ctx).MonthSeconds
+	_, err = srv.DecommissionGateway(f.ctx, &types.MsgDecommissionGateway{Operator: operator, GatewayId: gatewayID, EndTime: endTime})
+	require.NoError(t, err)
+
+	// A payout the bank refuses must not halt the chain.
+	f.bank.blocked[operator] = true
+	f.withBlockTime(int64(endTime))
+	f.resetEvents()
+	require.NoError(t, f.keeper.EndBlocker(f.ctx))
+	contract, err = f.keeper.Contracts.Get(f.ctx, contractID)
+	require.NoError(t, err)
+	require.Equal(t, types.ContractStatus_CONTRACT_STATUS_ACTIVE, contract.Status, "the failed settlement leaves no partial state")
+	var skipped bool
+	for _, ev := range f.ctx.EventManager().Events() {
+		if ev.Type == "gateway_decommission_skip" {
+			skipped = true
+		}
+	}
+	require.True(t, skipped)
+
+	delete(f.bank.blocked, operator)
+	require.NoError(t, f.keeper.EndBlocker(f.ctx))
+	gateway, err := f.keeper.Gateways.Get(f.ctx, gatewayID)
+	require.NoError(t, err)
+	require.True(t, gateway.Archived)
+}
+
+func TestDecommissionQueueIsCappedAndResumesAfterCursor(t *testing.T) {
+	f := initGatewayFixture(t)
+	const closeAt = 10
+	stuck := uint64(types.MaxDecommissionGatewaysPerBlock + 1)
+	for id := uint64(1); id <= stuck+1; id++ {
+		require.NoError(t, f.keeper.Gateways.Set(f.ctx, id, types.Gateway{Id: id, Operator: randomAccAddress(), DecommissionAt: closeAt}))
+		require.NoError(t, f.keeper.DecommissionQueue.Set(f.ctx, collections.Join(uint64(closeAt), id)))
+		if id > stuck {
+			continue
+		}
+		// A completed contract awaiting finalization keeps its gateway open.
+		contract := types.Contract{Id: 100 + id, GatewayId: id, Client: randomAccAddress(), Status: types.ContractStatus_CONTRACT_STATUS_COMPLETED}
+		require.NoError(t, f.keeper.Contracts.Set(f.ctx, contract.Id, contract))
+		require.NoError(t, f.keeper.OpenContracts.Set(f.ctx, collections.Join(id, contract.Id)))
+	}
+	archived := func(id uint64) bool {
+		gateway, err := f.keeper.Gateways.Get(f.ctx, id)
+		require.NoError(t, err)
+		return gateway.Archived
+	}
+
+	f.withBlockTime(closeAt)
+	require.NoError(t, f.keeper.EndBlocker(f.ctx))
+	require.False(t, archived(stuck+1), "only the first entries are visited")
+	cursor, err := f.keeper.DecommissionCursor.Get(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(types.MaxDecommissionGatewaysPerBlock), cursor.K2())
+
+	// The stuck gateways at the front do not starve the rest.
+	require.NoError(t, f.keeper.EndBlocker(f.ctx))
+	require.True(t, archived(stuck+1))
+	require.False(t, archived(stuck))
+	has, err := f.keeper.DecommissionCursor.Has(f.ctx)
+	require.NoError(t, err)
+	require.False(t, has, "the next pass starts from the front")
+}
+
+func TestMigrate1to2IndexesOpenContracts(t *testing.T) {
+	f := initGatewayFixture(t)
+	statuses := map[uint64]types.ContractStatus{
+		1: types.ContractStatus_CONTRACT_STATUS_ACTIVE,
+		2: types.ContractStatus_CONTRACT_STATUS_FINALIZED,
+		3: types.ContractStatus_CONTRACT_STATUS_CANCELED,
+		4: types.ContractStatus_CONTRACT_STATUS_PENDING,
+	}
+	for id, status := range statuses {
+		require.NoError(t, f.keeper.Contracts.Set(f.ctx, id, types.Contract{Id: id, GatewayId: 7, Client: randomAccAddress(), Status: status}))
+	}
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate1to2(f.ctx))
+
+	for id, status := range statuses {
+		open, err := f.keeper.OpenContracts.Has(f.ctx, collections.Join(uint64(7), id))
+		require.NoError(t, err)
+		require.Equal(t, id == 1 || id == 4, open, status.String())
+	}
+}
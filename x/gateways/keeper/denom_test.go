@@ -0,0 +1,105 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"lumen/app/denom"
+	"lumen/x/gateways/keeper"
+	"lumen/x/gateways/types"
+)
+
+const ibcUSDC = "ibc/498A0751C798A0D9A389AA3691123DADA57DAA4FE165D5C75894505B876BA6E4"
+
+func whitelistDenom(t *testing.T, f *gatewayFixture, payDenom string, minPrice uint64) {
+	t.Helper()
+	params := f.keeper.GetParams(f.ctx)
+	params.AcceptedDenoms = append(params.AcceptedDenoms, types.AcceptedDenom{Denom: payDenom, MinPricePerMonth: minPrice})
+	require.NoError(t, f.keeper.SetParams(f.ctx, params))
+}
+
+func TestContractPaidInWhitelistedIBCDenom(t *testing.T) {
+	f := initGatewayFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	params := f.keeper.GetParams(f.ctx)
+
+	operator, gatewayID := f.registerBondedGateway(srv)
+
+	client := randomAccAddress()
+	clientAddr := f.mustAccAddress(client)
+	f.bank.setAccountBalance(clientAddr, sdk.NewCoins(sdk.NewInt64Coin(ibcUSDC, 5_000_000)))
+	create := &types.MsgCreateContract{Client: client, GatewayId: gatewayID, PriceUlmn: 200_000, MonthsTotal: 6, Denom: ibcUSDC}
+
+	_, err := srv.CreateContract(f.ctx, create)
+	require.ErrorContains(t, err, "not accepted for payments")
+
+	_, err = srv.SetGatewayDenoms(f.ctx, &types.MsgSetGatewayDenoms{Operator: operator, GatewayId: gatewayID, Denoms: []string{ibcUSDC}})
+	require.ErrorContains(t, err, "not accepted for payments", "gateways may only opt into whitelisted denoms")
+
+	whitelistDenom(t, f, ibcUSDC, 1_000)
+	_, err = srv.CreateContract(f.ctx, create)
+	require.ErrorContains(t, err, "gateway does not accept")
+
+	_, err = srv.SetGatewayDenoms(f.ctx, &types.MsgSetGatewayDenoms{Operator: operator, GatewayId: gatewayID, Denoms: []string{ibcUSDC}})
+	require.NoError(t, err)
+	_, err = srv.CreateContract(f.ctx, &types.MsgCreateContract{Client: client, GatewayId: gatewayID, PriceUlmn: 999, MonthsTotal: 6, Denom: ibcUSDC})
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+
+	ct, err := srv.CreateContract(f.ctx, create)
+	require.NoError(t, err)
+	contract, err := f.keeper.Contracts.Get(f.ctx, ct.ContractId)
+	require.NoError(t, err)
+	require.Equal(t, ibcUSDC, contract.PaymentDenom())
+	require.Equal(t, "1188000", contract.EscrowUlmn)
+	require.Equal(t, "1188000", f.bank.moduleBalance(types.ModuleAccountEscrow).AmountOf(ibcUSDC).String())
+	require.Equal(t, "12000", f.bank.moduleBalance(authtypes.FeeCollectorName).AmountOf(ibcUSDC).String(), "tax is charged in the payment denom")
+	require.Equal(t, "3800000", f.bank.accountBalance(clientAddr).AmountOf(ibcUSDC).String())
+
+	f.withBlockTime(int64(params.MonthSeconds))
+	res, err := srv.ClaimPayment(f.ctx, &types.MsgClaimPayment{Operator: operator, ContractId: ct.ContractId})
+	require.NoError(t, err)
+	require.Equal(t, "196020", res.PaidUlmn)
+	require.Equal(t, "196020", f.bank.accountBalance(f.mustAccAddress(operator)).AmountOf(ibcUSDC).String())
+	require.Equal(t, "1980", f.bank.moduleBalance(types.ModuleAccountTreasury).AmountOf(ibcUSDC).String())
+
+	accounts, err := keeper.NewQueryServerImpl(f.keeper).ModuleAccounts(f.ctx, &types.QueryModuleAccountsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(ibcUSDC, 1_980)), accounts.TreasuryBalance)
+
+	// Delisting the denom stops new contracts; the running one keeps paying out.
+	params.AcceptedDenoms = nil
+	require.NoError(t, f.keeper.SetParams(f.ctx, params))
+	_, err = srv.CreateContract(f.ctx, create)
+	require.ErrorContains(t, err, "not accepted for payments")
+	f.withBlockTime(int64(2 * params.MonthSeconds))
+	_, err = srv.ClaimPayment(f.ctx, &types.MsgClaimPayment{Operator: operator, ContractId: ct.ContractId})
+	require.NoError(t, err)
+	require.Equal(t, "3960", f.bank.moduleBalance(types.ModuleAccountTreasury).AmountOf(ibcUSDC).String())
+}
+
+func TestOfferDenomCarriesToContract(t *testing.T) {
+	f := initGatewayFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	whitelistDenom(t, f, ibcUSDC, 1_000)
+
+	operator, gatewayID := f.registerBondedGateway(srv)
+	_, err := srv.SetGatewayDenoms(f.ctx, &types.MsgSetGatewayDenoms{Operator: operator, GatewayId: gatewayID, Denoms: []string{ibcUSDC}})
+	require.NoError(t, err)
+	offer, err := srv.CreateOffer(f.ctx, &types.MsgCreateOffer{Operator: operator, GatewayId: gatewayID, PriceUlmn: 50_000, MinMonths: 1, Denom: ibcUSDC})
+	require.NoError(t, err)
+
+	client := randomAccAddress()
+	f.bank.setAccountBalance(f.mustAccAddress(client), sdk.NewCoins(sdk.NewInt64Coin(ibcUSDC, 1_000_000)))
+	_, err = srv.CreateContract(f.ctx, &types.MsgCreateContract{Client: client, GatewayId: gatewayID, OfferId: offer.OfferId, MonthsTotal: 2, Denom: denom.BaseDenom})
+	require.ErrorContains(t, err, "offer is priced in")
+
+	ct, err := srv.CreateContract(f.ctx, &types.MsgCreateContract{Client: client, GatewayId: gatewayID, OfferId: offer.OfferId, MonthsTotal: 2})
+	require.NoError(t, err)
+	contract, err := f.keeper.Contracts.Get(f.ctx, ct.ContractId)
+	require.NoError(t, err)
+	require.Equal(t, ibcUSDC, contract.Denom)
+}
@@ -0,0 +1,188 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"lumen/x/gateways/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// nextDisputeID returns ids starting at 1 so that Contract.DisputeId == 0
+// keeps meaning "no dispute".
+func (k Keeper) nextDisputeID(ctx context.Context) (uint64, error) {
+	seq, err := k.DisputeSeq.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return seq + 1, nil
+}
+
+func (k Keeper) disputeByID(ctx context.Context, id uint64) (types.Dispute, error) {
+	dispute, err := k.Disputes.Get(ctx, id)
+	if err != nil {
+		return types.Dispute{}, types.ErrNotFound
+	}
+	return dispute, nil
+}
+
+func (k Keeper) setDispute(ctx context.Context, dispute types.Dispute) error {
+	return k.Disputes.Set(ctx, dispute.Id, dispute)
+}
+
+// openDispute stores a new dispute and queues it for expiry at its deadline.
+func (k Keeper) openDispute(ctx context.Context, dispute types.Dispute) error {
+	if err := k.setDispute(ctx, dispute); err != nil {
+		return err
+	}
+	return k.DisputeDeadlines.Set(ctx, collections.Join(dispute.Deadline, dispute.Id))
+}
+
+// openContractDispute opens a dispute on an active or completed contract,
+// freezing it until an arbiter rules or the dispute times out. A timed-out
+// dispute the EndBlocker has not swept yet is expired first.
+func (k Keeper) openContractDispute(ctx context.Context, contract types.Contract, reason, evidenceHash string) (types.Dispute, error) {
+	if contract.Status != types.ContractStatus_CONTRACT_STATUS_ACTIVE &&
+		contract.Status != types.ContractStatus_CONTRACT_STATUS_COMPLETED {
+		return types.Dispute{}, errorsmod.Wrap(types.ErrInvalidRequest, "contract not active")
+	}
+	if !k.safeAmountFromString(contract.EscrowUlmn).IsPositive() {
+		return types.Dispute{}, errorsmod.Wrap(types.ErrInvalidRequest, "no escrow left to dispute")
+	}
+
+	now := uint64(k.nowUnix(ctx))
+	if contract.DisputeId != 0 {
+		previous, err := k.disputeByID(ctx, contract.DisputeId)
+		if err != nil {
+			return types.Dispute{}, err
+		}
+		if previous.Status == types.DisputeStatus_DISPUTE_STATUS_OPEN {
+			if now < previous.Deadline {
+				return types.Dispute{}, errorsmod.Wrapf(types.ErrDisputeOpen, "dispute %d", previous.Id)
+			}
+			// Timed out but not yet swept by the EndBlocker.
+			if err := k.closeDispute(ctx, previous, types.DisputeStatus_DISPUTE_STATUS_EXPIRED, now); err != nil {
+				return types.Dispute{}, err
+			}
+			if contract, err = k.contractByID(ctx, contract.Id); err != nil {
+				return types.Dispute{}, err
+			}
+		}
+	}
+
+	params := k.GetParams(ctx)
+	deadline, err := k.safeAddUint64(now, params.DisputeTimeout())
+	if err != nil {
+		return types.Dispute{}, err
+	}
+	id, err := k.nextDisputeID(ctx)
+	if err != nil {
+		return types.Dispute{}, err
+	}
+
+	dispute := types.Dispute{
+		Id:         id,
+		ContractId: contract.Id,
+		GatewayId:  contract.GatewayId,
+		Client:     contract.Client,
+		Reason:     reason,
+		Status:     types.DisputeStatus_DISPUTE_STATUS_OPEN,
+		OpenedAt:   now,
+		Deadline:   deadline,
+	}
+	if evidenceHash != "" {
+		dispute.Evidence = []*types.DisputeEvidence{{Submitter: contract.Client, Hash: evidenceHash, SubmittedAt: now}}
+	}
+	if err := k.openDispute(ctx, dispute); err != nil {
+		return types.Dispute{}, err
+	}
+	contract.DisputeId = id
+	if err := k.setContract(ctx, contract); err != nil {
+		return types.Dispute{}, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			"contract_dispute_open",
+			sdk.NewAttribute("dispute_id", fmt.Sprintf("%d", id)),
+			sdk.NewAttribute("contract_id", fmt.Sprintf("%d", contract.Id)),
+			sdk.NewAttribute("gateway_id", fmt.Sprintf("%d", contract.GatewayId)),
+			sdk.NewAttribute("client", contract.Client),
+			sdk.NewAttribute("deadline", fmt.Sprintf("%d", deadline)),
+		),
+	)
+	return dispute, nil
+}
+
+// contractFrozen reports whether the contract has an open dispute whose
+// deadline has not passed yet. Timed-out disputes no longer freeze the
+// contract even before the EndBlocker has marked them expired.
+func (k Keeper) contractFrozen(ctx context.Context, contract types.Contract, now uint64) (bool, error) {
+	if contract.DisputeId == 0 {
+		return false, nil
+	}
+	dispute, err := k.disputeByID(ctx, contract.DisputeId)
+	if err != nil {
+		return false, err
+	}
+	return dispute.Status == types.DisputeStatus_DISPUTE_STATUS_OPEN && now < dispute.Deadline, nil
+}
+
+// closeDispute records the final status of an open dispute, drops it from
+// the deadline queue and clears it from its contract.
+func (k Keeper) closeDispute(ctx context.Context, dispute types.Dispute, status types.DisputeStatus, now uint64) error {
+	if err := k.DisputeDeadlines.Remove(ctx, collections.Join(dispute.Deadline, dispute.Id)); err != nil {
+		return err
+	}
+	dispute.Status = status
+	dispute.ResolvedAt = now
+	if err := k.setDispute(ctx, dispute); err != nil {
+		return err
+	}
+	contract, err := k.contractByID(ctx, dispute.ContractId)
+	if err != nil {
+		return err
+	}
+	if contract.DisputeId == dispute.Id {
+		contract.DisputeId = 0
+		return k.setContract(ctx, contract)
+	}
+	return nil
+}
+
+// expireDisputes releases the freeze of disputes that reached their deadline
+// without a ruling.
+func (k Keeper) expireDisputes(ctx context.Context) error {
+	now := uint64(k.nowUnix(ctx))
+
+	var expired []uint64
+	rng := collections.NewPrefixUntilPairRange[uint64, uint64](now)
+	err := k.DisputeDeadlines.Walk(ctx, rng, func(key collections.Pair[uint64, uint64]) (bool, error) {
+		expired = append(expired, key.K2())
+		return len(expired) >= types.MaxDisputeExpirationsPerBlock, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, id := range expired {
+		dispute, err := k.disputeByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := k.closeDispute(ctx, dispute, types.DisputeStatus_DISPUTE_STATUS_EXPIRED, now); err != nil {
+			return err
+		}
+		sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+			sdk.NewEvent(
+				"contract_dispute_expire",
+				sdk.NewAttribute("dispute_id", fmt.Sprintf("%d", dispute.Id)),
+				sdk.NewAttribute("contract_id", fmt.Sprintf("%d", dispute.ContractId)),
+			),
+		)
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"lumen/app/denom"
+	"lumen/x/gateways/keeper"
+	"lumen/x/gateways/types"
+)
+
+const evidenceHash = "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+
+func TestDisputeFreezesContractUntilArbiterRules(t *testing.T) {
+	f := initGatewayFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	operator, client, contractID := setupUsageContract(t, f, srv)
+
+	arbiter := randomAccAddress()
+	params := f.keeper.GetParams(f.ctx)
+	params.Arbiters = []string{arbiter}
+	params.DisputeTimeoutSeconds = 2 * params.MonthSeconds
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	_, err := srv.OpenDispute(f.ctx, &types.MsgOpenDispute{Client: operator, ContractId: contractID, Reason: "offline"})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	open, err := srv.OpenDispute(f.ctx, &types.MsgOpenDispute{Client: client, ContractId: contractID, Reason: "offline", EvidenceHash: evidenceHash})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), open.DisputeId)
+	require.Equal(t, params.DisputeTimeoutSeconds, open.Deadline)
+
+	_, err = srv.OpenDispute(f.ctx, &types.MsgOpenDispute{Client: client, ContractId: contractID, Reason: "again"})
+	require.ErrorIs(t, err, types.ErrDisputeOpen)
+
+	f.withBlockTime(int64(params.MonthSeconds))
+	_, err = srv.ClaimPayment(f.ctx, &types.MsgClaimPayment{Operator: operator, ContractId: contractID})
+	require.ErrorIs(t, err, types.ErrDisputeOpen)
+	_, err = srv.CancelContract(f.ctx, &types.MsgCancelContract{Client: client, ContractId: contractID})
+	require.ErrorIs(t, err, types.ErrDisputeOpen)
+
+	_, err = srv.SubmitDisputeEvidence(f.ctx, &types.MsgSubmitDisputeEvidence{Submitter: arbiter, DisputeId: open.DisputeId, EvidenceHash: evidenceHash})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = srv.SubmitDisputeEvidence(f.ctx, &types.MsgSubmitDisputeEvidence{Submitter: operator, DisputeId: open.DisputeId, EvidenceHash: evidenceHash})
+	require.ErrorContains(t, err, "evidence already submitted")
+	_, err = srv.SubmitDisputeEvidence(f.ctx, &types.MsgSubmitDisputeEvidence{Submitter: operator, DisputeId: open.DisputeId, EvidenceHash: "aa" + evidenceHash[2:]})
+	require.NoError(t, err)
+
+	_, err = srv.ResolveDispute(f.ctx, &types.MsgResolveDispute{Arbiter: randomAccAddress(), DisputeId: open.DisputeId, ClientRefundBps: 5_000})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	clientBefore := f.bank.accountBalance(f.mustAccAddress(client)).AmountOf(denom.BaseDenom)
+	operatorBefore := f.bank.accountBalance(f.mustAccAddress(operator)).AmountOf(denom.BaseDenom)
+	res, err := srv.ResolveDispute(f.ctx, &types.MsgResolveDispute{Arbiter: arbiter, DisputeId: open.DisputeId, ClientRefundBps: 5_000, Ruling: "partial outage"})
+	require.NoError(t, err)
+	require.Equal(t, "594000", res.ClientRefundUlmn)
+	require.Equal(t, "588060", res.GatewayPayoutUlmn)
+	require.Equal(t, sdkmath.NewInt(594_000), f.bank.accountBalance(f.mustAccAddress(client)).AmountOf(denom.BaseDenom).Sub(clientBefore))
+	require.Equal(t, sdkmath.NewInt(588_060), f.bank.accountBalance(f.mustAccAddress(operator)).AmountOf(denom.BaseDenom).Sub(operatorBefore))
+	require.True(t, f.bank.moduleBalance(types.ModuleAccountEscrow).AmountOf(denom.BaseDenom).IsZero())
+
+	contract, err := f.keeper.Contracts.Get(f.ctx, contractID)
+	require.NoError(t, err)
+	require.Equal(t, types.ContractStatus_CONTRACT_STATUS_CANCELED, contract.Status)
+	require.Zero(t, contract.DisputeId)
+	gateway, err := f.keeper.Gateways.Get(f.ctx, contract.GatewayId)
+	require.NoError(t, err)
+	require.Zero(t, gateway.ActiveClients)
+
+	dispute, err := keeper.NewQueryServerImpl(f.keeper).Dispute(f.ctx, &types.QueryDisputeRequest{Id: open.DisputeId})
+	require.NoError(t, err)
+	require.Equal(t, types.DisputeStatus_DISPUTE_STATUS_RESOLVED, dispute.Dispute.Status)
+	require.Len(t, dispute.Dispute.Evidence, 2)
+	require.Equal(t, arbiter, dispute.Dispute.Arbiter)
+}
+
+func TestDisputeTimeoutReleasesFreeze(t *testing.T) {
+	f := initGatewayFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	operator, client, contractID := setupUsageContract(t, f, srv)
+
+	open, err := srv.OpenDispute(f.ctx, &types.MsgOpenDispute{Client: client, ContractId: contractID, Reason: "slow"})
+	require.NoError(t, err)
+
+	f.withBlockTime(int64(open.Deadline) - 1)
+	require.NoError(t, f.keeper.EndBlocker(f.ctx))
+	_, err = srv.ClaimPayment(f.ctx, &types.MsgClaimPayment{Operator: operator, ContractId: contractID})
+	require.ErrorIs(t, err, types.ErrDisputeOpen)
+
+	f.withBlockTime(int64(open.Deadline))
+	require.NoError(t, f.keeper.EndBlocker(f.ctx))
+
+	disputes, err := keeper.NewQueryServerImpl(f.keeper).Disputes(f.ctx, &types.QueryDisputesRequest{ContractId: contractID, Status: "expired"})
+	require.NoError(t, err)
+	require.Len(t, disputes.Disputes, 1)
+
+	_, err = srv.ResolveDispute(f.ctx, &types.MsgResolveDispute{Arbiter: authtypes.NewModuleAddress(types.GovModuleName).String(), DisputeId: open.DisputeId})
+	require.ErrorContains(t, err, "dispute not open")
+
+	// the default timeout matches month_seconds, so month 1 is claimable now
+	_, err = srv.ClaimPayment(f.ctx, &types.MsgClaimPayment{Operator: operator, ContractId: contractID})
+	require.NoError(t, err)
+}
+
+func TestGovAuthorityCanResolveDispute(t *testing.T) {
+	f := initGatewayFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	_, client, contractID := setupUsageContract(t, f, srv)
+
+	open, err := srv.OpenDispute(f.ctx, &types.MsgOpenDispute{Client: client, ContractId: contractID, Reason: "never delivered"})
+	require.NoError(t, err)
+
+	res, err := srv.ResolveDispute(f.ctx, &types.MsgResolveDispute{
+		Arbiter:         authtypes.NewModuleAddress(types.GovModuleName).String(),
+		DisputeId:       open.DisputeId,
+		ClientRefundBps: 10_000,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "1188000", res.ClientRefundUlmn)
+	require.Equal(t, "0", res.GatewayPayoutUlmn)
+}
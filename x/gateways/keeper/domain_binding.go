@@ -0,0 +1,160 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"lumen/x/gateways/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// gatewayOwnsDomain reports whether the x/dns owner of domain is the gateway
+// operator or payout address and the registration is still active.
+func (k Keeper) gatewayOwnsDomain(ctx context.Context, gateway types.Gateway, domain string) (string, bool, error) {
+	if k.dns == nil {
+		return "", false, fmt.Errorf("dns keeper not set")
+	}
+	owner, active, err := k.dns.DomainOwner(ctx, domain)
+	if err != nil {
+		return "", false, err
+	}
+	if !active || owner == "" {
+		return owner, false, nil
+	}
+	return owner, owner == gateway.Operator || owner == gateway.Payout, nil
+}
+
+func (k Keeper) hasDomainBinding(ctx context.Context, domain string, gatewayID uint64) (bool, error) {
+	return k.DomainBindings.Has(ctx, collections.Join(domain, gatewayID))
+}
+
+func (k Keeper) setDomainBinding(ctx context.Context, binding types.DomainBinding) error {
+	if err := k.DomainBindings.Set(ctx, collections.Join(binding.Domain, binding.GatewayId), binding); err != nil {
+		return err
+	}
+	return k.GatewayDomains.Set(ctx, collections.Join(binding.GatewayId, binding.Domain))
+}
+
+// removeDomainBinding drops the binding and any profile endpoint the gateway
+// advertises on that domain.
+func (k Keeper) removeDomainBinding(ctx context.Context, domain string, gatewayID uint64) error {
+	if err := k.DomainBindings.Remove(ctx, collections.Join(domain, gatewayID)); err != nil {
+		return err
+	}
+	if err := k.GatewayDomains.Remove(ctx, collections.Join(gatewayID, domain)); err != nil {
+		return err
+	}
+	gateway, err := k.gatewayByID(ctx, gatewayID)
+	if err != nil || gateway.Profile == nil {
+		return nil
+	}
+	endpoints := slices.DeleteFunc(slices.Clone(gateway.Profile.Endpoints), func(ep types.GatewayEndpoint) bool {
+		return ep.Host == domain
+	})
+	if len(endpoints) == len(gateway.Profile.Endpoints) {
+		return nil
+	}
+	gateway.Profile.Endpoints = endpoints
+	return k.setGateway(ctx, gateway)
+}
+
+// checkProfileDomains requires every profile endpoint host to be a domain
+// bound to the gateway, so a gateway cannot advertise names it does not own.
+func (k Keeper) checkProfileDomains(ctx context.Context, gatewayID uint64, profile *types.GatewayProfile) error {
+	if profile == nil {
+		return nil
+	}
+	for _, ep := range profile.Endpoints {
+		has, err := k.GatewayDomains.Has(ctx, collections.Join(gatewayID, ep.Host))
+		if err != nil {
+			return err
+		}
+		if !has {
+			return errorsmod.Wrapf(types.ErrInvalidRequest, "endpoint %s is not a domain bound to the gateway", ep.Host)
+		}
+	}
+	return nil
+}
+
+// liveGatewayDomainCount counts the gateway's bindings that still pass the
+// ownership check and drops the others, so expired or transferred domains do
+// not take up the MaxDomainsPerGateway slots.
+func (k Keeper) liveGatewayDomainCount(ctx context.Context, gateway types.Gateway) (int, error) {
+	n := 0
+	var stale []string
+	rng := collections.NewPrefixedPairRange[uint64, string](gateway.Id)
+	err := k.GatewayDomains.Walk(ctx, rng, func(key collections.Pair[uint64, string]) (bool, error) {
+		if _, ok, err := k.gatewayOwnsDomain(ctx, gateway, key.K2()); err == nil && ok {
+			n++
+		} else {
+			stale = append(stale, key.K2())
+		}
+		return false, nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	for _, domain := range stale {
+		if err := k.removeDomainBinding(ctx, domain, gateway.Id); err != nil {
+			return 0, err
+		}
+		emitDomainUnbind(ctx, domain, gateway.Id, "dns_inactive")
+	}
+	return n, nil
+}
+
+// verifiedGatewayDomains lists the domains bound to a gateway that still pass
+// the ownership check.
+func (k Keeper) verifiedGatewayDomains(ctx context.Context, gateway types.Gateway) ([]string, error) {
+	var out []string
+	rng := collections.NewPrefixedPairRange[uint64, string](gateway.Id)
+	err := k.GatewayDomains.Walk(ctx, rng, func(key collections.Pair[uint64, string]) (bool, error) {
+		if _, ok, err := k.gatewayOwnsDomain(ctx, gateway, key.K2()); err == nil && ok {
+			out = append(out, key.K2())
+		}
+		return false, nil
+	})
+	return out, err
+}
+
+// dropUncontrolledDomains removes the gateway's bindings whose x/dns owner is
+// neither its operator nor its payout address, e.g. after a transfer.
+func (k Keeper) dropUncontrolledDomains(ctx context.Context, gateway types.Gateway, reason string) error {
+	var stale []string
+	rng := collections.NewPrefixedPairRange[uint64, string](gateway.Id)
+	err := k.GatewayDomains.Walk(ctx, rng, func(key collections.Pair[uint64, string]) (bool, error) {
+		binding, err := k.DomainBindings.Get(ctx, collections.Join(key.K2(), gateway.Id))
+		if err != nil {
+			return true, err
+		}
+		if binding.Owner != gateway.Operator && binding.Owner != gateway.Payout {
+			stale = append(stale, key.K2())
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, domain := range stale {
+		if err := k.removeDomainBinding(ctx, domain, gateway.Id); err != nil {
+			return err
+		}
+		emitDomainUnbind(ctx, domain, gateway.Id, reason)
+	}
+	return nil
+}
+
+func emitDomainUnbind(ctx context.Context, domain string, gatewayID uint64, reason string) {
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			"gateway_domain_unbind",
+			sdk.NewAttribute("domain", domain),
+			sdk.NewAttribute("gateway_id", fmt.Sprintf("%d", gatewayID)),
+			sdk.NewAttribute("reason", reason),
+		),
+	)
+}
@@ -0,0 +1,114 @@
+package keeper_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"lumen/app/denom"
+	"lumen/x/gateways/keeper"
+	"lumen/x/gateways/types"
+)
+
+type mockDnsKeeper struct {
+	owners map[string]string
+	active map[string]bool
+}
+
+func newMockDnsKeeper() *mockDnsKeeper {
+	return &mockDnsKeeper{owners: map[string]string{}, active: map[string]bool{}}
+}
+
+func (m *mockDnsKeeper) setDomain(name, owner string, active bool) {
+	m.owners[name] = owner
+	m.active[name] = active
+}
+
+func (m *mockDnsKeeper) DomainOwner(_ context.Context, name string) (string, bool, error) {
+	return m.owners[name], m.active[name], nil
+}
+
+func TestBindDomainRequiresOwnershipAndFollowsDnsTransfers(t *testing.T) {
+	f := initGatewayFixture(t)
+	dns := newMockDnsKeeper()
+	f.keeper.SetDnsKeeper(dns)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	operator := randomAccAddress()
+	f.bank.setAccountBalance(f.mustAccAddress(operator), sdk.NewCoins(sdk.NewCoin(denom.BaseDenom, sdkmath.NewIntFromUint64(f.registerFee()+10_000))))
+	resp, err := srv.RegisterGateway(f.ctx, &types.MsgRegisterGateway{Operator: operator})
+	require.NoError(t, err)
+
+	stranger := randomAccAddress()
+	dns.setDomain("example.lumen", stranger, true)
+	_, err = srv.BindDomain(f.ctx, &types.MsgBindDomain{Operator: operator, GatewayId: resp.Id, Domain: "example.lumen"})
+	require.ErrorIs(t, err, types.ErrDomainNotOwned)
+
+	dns.setDomain("example.lumen", operator, true)
+	f.resetEvents()
+	_, err = srv.BindDomain(f.ctx, &types.MsgBindDomain{Operator: operator, GatewayId: resp.Id, Domain: "Example.Lumen"})
+	require.NoError(t, err)
+	require.Equal(t, "gateway_domain_bind", f.ctx.EventManager().Events()[len(f.ctx.EventManager().Events())-1].Type)
+
+	gw, err := qs.Gateway(f.ctx, &types.QueryGatewayRequest{Id: resp.Id})
+	require.NoError(t, err)
+	require.Equal(t, []string{"example.lumen"}, gw.Domains)
+
+	bound, err := qs.DomainGateways(f.ctx, &types.QueryDomainGatewaysRequest{Domain: "example.lumen"})
+	require.NoError(t, err)
+	require.Len(t, bound.Gateways, 1)
+	require.Equal(t, operator, bound.Bindings[0].Owner)
+
+	// expired registrations are hidden without touching state
+	dns.setDomain("example.lumen", operator, false)
+	bound, err = qs.DomainGateways(f.ctx, &types.QueryDomainGatewaysRequest{Domain: "example.lumen"})
+	require.NoError(t, err)
+	require.Empty(t, bound.Gateways)
+	dns.setDomain("example.lumen", operator, true)
+
+	// an x/dns transfer to an unrelated owner drops the binding
+	dns.setDomain("example.lumen", stranger, true)
+	require.NoError(t, f.keeper.DnsHooks().AfterDomainOwnerChanged(f.ctx, "example.lumen", operator, stranger))
+	genesis, err := f.keeper.ExportGenesis(f.ctx)
+	require.NoError(t, err)
+	require.Empty(t, genesis.DomainBindings)
+
+	_, err = srv.UnbindDomain(f.ctx, &types.MsgUnbindDomain{Operator: operator, GatewayId: resp.Id, Domain: "example.lumen"})
+	require.ErrorIs(t, err, types.ErrNotFound)
+}
+
+func TestExpiredDomainsFreeBindingSlots(t *testing.T) {
+	f := initGatewayFixture(t)
+	dns := newMockDnsKeeper()
+	f.keeper.SetDnsKeeper(dns)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	operator := randomAccAddress()
+	f.bank.setAccountBalance(f.mustAccAddress(operator), sdk.NewCoins(sdk.NewCoin(denom.BaseDenom, sdkmath.NewIntFromUint64(f.registerFee()+100_000))))
+	resp, err := srv.RegisterGateway(f.ctx, &types.MsgRegisterGateway{Operator: operator})
+	require.NoError(t, err)
+
+	for i := 0; i < types.MaxDomainsPerGateway; i++ {
+		name := fmt.Sprintf("gw%d.lumen", i)
+		dns.setDomain(name, operator, true)
+		_, err = srv.BindDomain(f.ctx, &types.MsgBindDomain{Operator: operator, GatewayId: resp.Id, Domain: name})
+		require.NoError(t, err)
+	}
+	dns.setDomain("extra.lumen", operator, true)
+	_, err = srv.BindDomain(f.ctx, &types.MsgBindDomain{Operator: operator, GatewayId: resp.Id, Domain: "extra.lumen"})
+	require.ErrorIs(t, err, types.ErrOutOfBounds)
+
+	// Once a registration lapses its binding no longer takes up a slot.
+	dns.setDomain("gw0.lumen", operator, false)
+	_, err = srv.BindDomain(f.ctx, &types.MsgBindDomain{Operator: operator, GatewayId: resp.Id, Domain: "extra.lumen"})
+	require.NoError(t, err)
+	has, err := f.keeper.DomainBindings.Has(f.ctx, collections.Join("gw0.lumen", resp.Id))
+	require.NoError(t, err)
+	require.False(t, has)
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 
 	"lumen/x/gateways/types"
+
+	"cosmossdk.io/collections"
 )
 
 func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) error {
@@ -23,6 +25,87 @@ func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) er
 		if err := k.setContract(ctx, *ct); err != nil {
 			return err
 		}
+		if ct.Status == types.ContractStatus_CONTRACT_STATUS_PENDING {
+			if err := k.PendingDeadlines.Set(ctx, collections.Join(ct.AcceptDeadline, ct.Id)); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, binding := range genState.DomainBindings {
+		if err := k.setDomainBinding(ctx, *binding); err != nil {
+			return err
+		}
+	}
+
+	for _, report := range genState.UsageReports {
+		if err := k.setUsageReport(ctx, *report); err != nil {
+			return err
+		}
+	}
+
+	var maxDispute uint64
+	for _, dispute := range genState.Disputes {
+		var err error
+		if dispute.Status == types.DisputeStatus_DISPUTE_STATUS_OPEN {
+			err = k.openDispute(ctx, *dispute)
+		} else {
+			err = k.setDispute(ctx, *dispute)
+		}
+		if err != nil {
+			return err
+		}
+		if dispute.Id > maxDispute {
+			maxDispute = dispute.Id
+		}
+	}
+	if genState.DisputeCount > maxDispute {
+		maxDispute = genState.DisputeCount
+	}
+	if err := k.DisputeSeq.Set(ctx, maxDispute); err != nil {
+		return err
+	}
+
+	for _, bond := range genState.Bonds {
+		if err := k.setGatewayBond(ctx, *bond); err != nil {
+			return err
+		}
+	}
+
+	for _, rep := range genState.Reputations {
+		if err := k.setGatewayReputation(ctx, *rep); err != nil {
+			return err
+		}
+	}
+
+	var maxOffer uint64
+	for _, offer := range genState.Offers {
+		if err := k.setOffer(ctx, *offer); err != nil {
+			return err
+		}
+		if offer.Id > maxOffer {
+			maxOffer = offer.Id
+		}
+	}
+	if genState.OfferCount > maxOffer {
+		maxOffer = genState.OfferCount
+	}
+	if err := k.OfferSeq.Set(ctx, maxOffer); err != nil {
+		return err
+	}
+
+	for _, coin := range genState.TreasuryBalance {
+		if err := k.TreasuryBalance.Set(ctx, coin.Denom, coin.Amount); err != nil {
+			return err
+		}
+	}
+	if err := k.setTreasuryFlows(ctx, genState.TreasuryFlows); err != nil {
+		return err
+	}
+	if genState.TreasuryDistributedAt > 0 {
+		if err := k.TreasuryDistributedAt.Set(ctx, genState.TreasuryDistributedAt); err != nil {
+			return err
+		}
 	}
 
 	if genState.GatewayCount > 0 {
@@ -77,8 +160,68 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 	})
 	genesis.Contracts = contracts
 
+	bindings := make([]*types.DomainBinding, 0)
+	_ = k.DomainBindings.Walk(ctx, nil, func(_ collections.Pair[string, uint64], binding types.DomainBinding) (bool, error) {
+		b := binding
+		bindings = append(bindings, &b)
+		return false, nil
+	})
+	genesis.DomainBindings = bindings
+
+	reports := make([]*types.UsageReport, 0)
+	_ = k.UsageReports.Walk(ctx, nil, func(_ collections.Pair[uint64, uint32], report types.UsageReport) (bool, error) {
+		r := report
+		reports = append(reports, &r)
+		return false, nil
+	})
+	genesis.UsageReports = reports
+
+	disputes := make([]*types.Dispute, 0)
+	_ = k.Disputes.Walk(ctx, nil, func(_ uint64, dispute types.Dispute) (bool, error) {
+		d := dispute
+		disputes = append(disputes, &d)
+		return false, nil
+	})
+	genesis.Disputes = disputes
+
+	bonds := make([]*types.GatewayBond, 0)
+	_ = k.GatewayBonds.Walk(ctx, nil, func(_ uint64, bond types.GatewayBond) (bool, error) {
+		b := bond
+		bonds = append(bonds, &b)
+		return false, nil
+	})
+	genesis.Bonds = bonds
+
+	reputations := make([]*types.GatewayReputation, 0)
+	_ = k.GatewayReputations.Walk(ctx, nil, func(_ uint64, rep types.GatewayReputation) (bool, error) {
+		r := rep
+		reputations = append(reputations, &r)
+		return false, nil
+	})
+	genesis.Reputations = reputations
+
+	offers := make([]*types.Offer, 0)
+	_ = k.Offers.Walk(ctx, nil, func(_ uint64, offer types.Offer) (bool, error) {
+		o := offer
+		offers = append(offers, &o)
+		return false, nil
+	})
+	genesis.Offers = offers
+
+	treasury, err := k.treasuryBalance(ctx)
+	if err != nil {
+		return nil, err
+	}
+	genesis.TreasuryBalance = treasury
+	if genesis.TreasuryFlows, err = k.treasuryFlows(ctx); err != nil {
+		return nil, err
+	}
+	genesis.TreasuryDistributedAt, _ = k.TreasuryDistributedAt.Get(ctx)
+
 	lastGateway, _ := k.GatewaySeq.Peek(ctx)
 	lastContract, _ := k.ContractSeq.Peek(ctx)
+	genesis.DisputeCount, _ = k.DisputeSeq.Peek(ctx)
+	genesis.OfferCount, _ = k.OfferSeq.Peek(ctx)
 
 	genesis.GatewayCount = lastGateway
 	genesis.ContractCount = lastContract
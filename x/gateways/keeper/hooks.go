@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+
+	dnstypes "lumen/x/dns/types"
+	"lumen/x/gateways/types"
+
+	"cosmossdk.io/collections"
+)
+
+// DnsHooks keeps gateway domain bindings in sync with x/dns ownership.
+type DnsHooks struct{ k Keeper }
+
+var _ dnstypes.DnsHooks = DnsHooks{}
+
+func (k Keeper) DnsHooks() DnsHooks { return DnsHooks{k: k} }
+
+// AfterDomainOwnerChanged drops every binding of name whose gateway is no
+// longer controlled by the new owner.
+func (h DnsHooks) AfterDomainOwnerChanged(ctx context.Context, name, _, newOwner string) error {
+	var bindings []types.DomainBinding
+	rng := collections.NewPrefixedPairRange[string, uint64](name)
+	if err := h.k.DomainBindings.Walk(ctx, rng, func(_ collections.Pair[string, uint64], b types.DomainBinding) (bool, error) {
+		bindings = append(bindings, b)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, binding := range bindings {
+		gateway, err := h.k.gatewayByID(ctx, binding.GatewayId)
+		if err == nil && (gateway.Operator == newOwner || gateway.Payout == newOwner) {
+			binding.Owner = newOwner
+			if err := h.k.setDomainBinding(ctx, binding); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := h.k.removeDomainBinding(ctx, binding.Domain, binding.GatewayId); err != nil {
+			return err
+		}
+		emitDomainUnbind(ctx, binding.Domain, binding.GatewayId, "dns_owner_changed")
+	}
+	return nil
+}
@@ -2,19 +2,24 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/bits"
 	"strings"
 
 	"lumen/app/denom"
 	"lumen/x/gateways/types"
+	tokenomicstypes "lumen/x/tokenomics/types"
 
 	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
 	"cosmossdk.io/core/address"
 	corestore "cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -31,10 +36,72 @@ type Keeper struct {
 	Contracts   collections.Map[uint64, types.Contract]
 	ContractSeq collections.Sequence
 
+	// Secondary contract indexes for the Contracts query, kept in line by
+	// setContract; see contract_index.go. ContractsByPayout holds
+	// (next payout, id) for every ACTIVE contract.
+	ContractsByClient  collections.KeySet[collections.Pair[string, uint64]]
+	ContractsByGateway collections.KeySet[collections.Pair[uint64, uint64]]
+	ContractsByStatus  collections.KeySet[collections.Pair[int32, uint64]]
+	ContractsByPayout  collections.KeySet[collections.Pair[uint64, uint64]]
+	// OpenContracts holds (gateway, id) for every contract that is not
+	// canceled or finalized yet, so a decommission skips finished ones.
+	OpenContracts collections.KeySet[collections.Pair[uint64, uint64]]
+
+	// DomainBindings is keyed by (domain, gateway id); GatewayDomains is the
+	// reverse index used to list and cap a gateway's bindings.
+	DomainBindings collections.Map[collections.Pair[string, uint64], types.DomainBinding]
+	GatewayDomains collections.KeySet[collections.Pair[uint64, string]]
+
+	// UsageReports is keyed by (contract id, 1-based contract month).
+	UsageReports collections.Map[collections.Pair[uint64, uint32], types.UsageReport]
+
+	// DisputeDeadlines indexes open disputes by (deadline, id) so the
+	// EndBlocker can release timed-out freezes in order.
+	Disputes         collections.Map[uint64, types.Dispute]
+	DisputeSeq       collections.Sequence
+	DisputeDeadlines collections.KeySet[collections.Pair[uint64, uint64]]
+
+	GatewayBonds       collections.Map[uint64, types.GatewayBond]
+	GatewayReputations collections.Map[uint64, types.GatewayReputation]
+
+	// GatewayOffers indexes every offer, retired or not, by (gateway, offer).
+	Offers        collections.Map[uint64, types.Offer]
+	OfferSeq      collections.Sequence
+	GatewayOffers collections.KeySet[collections.Pair[uint64, uint64]]
+
+	// GatewayPayouts indexes running contracts by (gateway, next payout,
+	// contract) for MsgClaimAll. AutoClaimQueue holds (next payout, contract)
+	// for gateways that opted into auto-claim; entries are re-checked when
+	// popped, so a stale one is simply dropped.
+	GatewayPayouts collections.KeySet[collections.Triple[uint64, uint64, uint64]]
+	AutoClaimQueue collections.KeySet[collections.Pair[uint64, uint64]]
+
+	// PendingDeadlines indexes PENDING contracts by (accept deadline, id).
+	PendingDeadlines collections.KeySet[collections.Pair[uint64, uint64]]
+
+	// DecommissionQueue holds (decommission time, gateway id) for every
+	// gateway that announced a shutdown and is not archived yet; setGateway
+	// keeps it in line.
+	DecommissionQueue collections.KeySet[collections.Pair[uint64, uint64]]
+	// DecommissionCursor is the last queue entry visited when the previous
+	// block ran out of budget; the next block resumes after it.
+	DecommissionCursor collections.Item[collections.Pair[uint64, uint64]]
+	// LivenessQueue holds (last heartbeat, gateway id) for every active
+	// gateway so the EndBlocker can find silent ones in order.
+	LivenessQueue collections.KeySet[collections.Pair[uint64, uint64]]
+
+	// TreasuryBalance tracks commission and slashing income credited to the
+	// treasury module account, per denom. TreasuryFlows totals it by
+	// (flow, denom); see treasury.go.
+	TreasuryBalance       collections.Map[string, sdkmath.Int]
+	TreasuryFlows         collections.Map[collections.Pair[string, string], sdkmath.Int]
+	TreasuryDistributedAt collections.Item[uint64]
+
 	bank       types.BankKeeper
 	ak         types.AccountKeeper
 	tokenomics types.TokenomicsKeeper
 	dk         types.DistrKeeper
+	dns        types.DnsKeeper
 }
 
 func NewKeeper(
@@ -60,6 +127,46 @@ func NewKeeper(
 		GatewaySeq:  collections.NewSequence(sb, types.GatewaySeqKey, "gateway_seq"),
 		Contracts:   collections.NewMap(sb, types.ContractKey, "contract", collections.Uint64Key, codec.CollValue[types.Contract](cdc)),
 		ContractSeq: collections.NewSequence(sb, types.ContractSeqKey, "contract_seq"),
+
+		ContractsByClient:  collections.NewKeySet(sb, types.ContractClientKey, "contract_client", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)),
+		ContractsByGateway: collections.NewKeySet(sb, types.ContractGatewayKey, "contract_gateway", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key)),
+		ContractsByStatus:  collections.NewKeySet(sb, types.ContractStatusKey, "contract_status", collections.PairKeyCodec(collections.Int32Key, collections.Uint64Key)),
+		ContractsByPayout:  collections.NewKeySet(sb, types.ContractPayoutKey, "contract_payout", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key)),
+		OpenContracts:      collections.NewKeySet(sb, types.OpenContractKey, "open_contract", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key)),
+
+		DomainBindings: collections.NewMap(sb, types.DomainBindingKey, "domain_binding", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), codec.CollValue[types.DomainBinding](cdc)),
+		GatewayDomains: collections.NewKeySet(sb, types.GatewayDomainKey, "gateway_domain", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey)),
+
+		UsageReports: collections.NewMap(sb, types.UsageReportKey, "usage_report", collections.PairKeyCodec(collections.Uint64Key, collections.Uint32Key), codec.CollValue[types.UsageReport](cdc)),
+
+		Disputes:         collections.NewMap(sb, types.DisputeKey, "dispute", collections.Uint64Key, codec.CollValue[types.Dispute](cdc)),
+		DisputeSeq:       collections.NewSequence(sb, types.DisputeSeqKey, "dispute_seq"),
+		DisputeDeadlines: collections.NewKeySet(sb, types.DisputeDeadlineKey, "dispute_deadline", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key)),
+
+		GatewayBonds:       collections.NewMap(sb, types.GatewayBondKey, "gateway_bond", collections.Uint64Key, codec.CollValue[types.GatewayBond](cdc)),
+		GatewayReputations: collections.NewMap(sb, types.ReputationKey, "gateway_reputation", collections.Uint64Key, codec.CollValue[types.GatewayReputation](cdc)),
+
+		Offers:        collections.NewMap(sb, types.OfferKey, "offer", collections.Uint64Key, codec.CollValue[types.Offer](cdc)),
+		OfferSeq:      collections.NewSequence(sb, types.OfferSeqKey, "offer_seq"),
+		GatewayOffers: collections.NewKeySet(sb, types.GatewayOfferKey, "gateway_offer", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key)),
+
+		GatewayPayouts: collections.NewKeySet(sb, types.GatewayPayoutKey, "gateway_payout", collections.TripleKeyCodec(collections.Uint64Key, collections.Uint64Key, collections.Uint64Key)),
+		AutoClaimQueue: collections.NewKeySet(sb, types.AutoClaimKey, "auto_claim", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key)),
+
+		PendingDeadlines: collections.NewKeySet(sb, types.PendingDeadlineKey, "pending_deadline", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key)),
+
+		DecommissionQueue: collections.NewKeySet(sb, types.DecommissionKey, "decommission", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key)),
+		DecommissionCursor: collections.NewItem(
+			sb,
+			types.DecommissionCursorKey,
+			"decommission_cursor",
+			collcodec.KeyToValueCodec(collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key)),
+		),
+		LivenessQueue: collections.NewKeySet(sb, types.LivenessKey, "liveness", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key)),
+
+		TreasuryBalance:       collections.NewMap(sb, types.TreasuryBalanceKey, "treasury_balance", collections.StringKey, sdk.IntValue),
+		TreasuryFlows:         collections.NewMap(sb, types.TreasuryFlowKey, "treasury_flow", collections.PairKeyCodec(collections.StringKey, collections.StringKey), sdk.IntValue),
+		TreasuryDistributedAt: collections.NewItem(sb, types.TreasuryDistributedKey, "treasury_distributed_at", collections.Uint64Value),
 	}
 
 	schema, err := sb.Build()
@@ -76,6 +183,7 @@ func (k *Keeper) SetTokenomicsKeeper(tk types.TokenomicsKeeper) {
 	k.tokenomics = tk
 }
 func (k *Keeper) SetDistrKeeper(dk types.DistrKeeper) { k.dk = dk }
+func (k *Keeper) SetDnsKeeper(dns types.DnsKeeper)    { k.dns = dns }
 
 func (k Keeper) GetAuthority() []byte { return k.authority }
 
@@ -118,6 +226,19 @@ func (k Keeper) mustAddress(bech32 string) (sdk.AccAddress, error) {
 	return sdk.AccAddress(bz), nil
 }
 
+// checkReceivable refuses addresses the bank module will not send to, such as
+// module accounts: refunds and payouts to them would fail every time.
+func (k Keeper) checkReceivable(bech32, field string) error {
+	addr, err := k.mustAddress(bech32)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid %s", field)
+	}
+	if k.bank != nil && k.bank.BlockedAddr(addr) {
+		return errorsmod.Wrapf(types.ErrInvalidRequest, "%s %s cannot receive funds", field, bech32)
+	}
+	return nil
+}
+
 func (k Keeper) gatewayByID(ctx context.Context, id uint64) (types.Gateway, error) {
 	gateway, err := k.Gateways.Get(ctx, id)
 	if err != nil {
@@ -134,35 +255,80 @@ func (k Keeper) contractByID(ctx context.Context, id uint64) (types.Contract, er
 	return contract, nil
 }
 
+// setGateway stores the gateway and keeps its decommission and liveness
+// queue entries in line with its shutdown time, activity and last heartbeat.
 func (k Keeper) setGateway(ctx context.Context, gateway types.Gateway) error {
-	return k.Gateways.Set(ctx, gateway.Id, gateway)
+	prev, err := k.Gateways.Get(ctx, gateway.Id)
+	switch {
+	case err == nil:
+		if err := k.DecommissionQueue.Remove(ctx, collections.Join(prev.DecommissionAt, prev.Id)); err != nil {
+			return err
+		}
+		if err := k.LivenessQueue.Remove(ctx, collections.Join(prev.LastHeartbeatAt, prev.Id)); err != nil {
+			return err
+		}
+	case !errors.Is(err, collections.ErrNotFound):
+		return err
+	}
+	if err := k.Gateways.Set(ctx, gateway.Id, gateway); err != nil {
+		return err
+	}
+	if gateway.Active {
+		if err := k.LivenessQueue.Set(ctx, collections.Join(gateway.LastHeartbeatAt, gateway.Id)); err != nil {
+			return err
+		}
+	}
+	if gateway.DecommissionAt == 0 || gateway.Archived {
+		return nil
+	}
+	return k.DecommissionQueue.Set(ctx, collections.Join(gateway.DecommissionAt, gateway.Id))
 }
 
+// setContract stores the contract and keeps its payout schedule and query
+// index entries in line with its client, status and next payout time.
 func (k Keeper) setContract(ctx context.Context, contract types.Contract) error {
-	return k.Contracts.Set(ctx, contract.Id, contract)
+	prev, err := k.Contracts.Get(ctx, contract.Id)
+	switch {
+	case err == nil:
+		if err := k.unschedulePayout(ctx, prev); err != nil {
+			return err
+		}
+		if err := k.unindexContract(ctx, prev); err != nil {
+			return err
+		}
+	case !errors.Is(err, collections.ErrNotFound):
+		return err
+	}
+	if err := k.Contracts.Set(ctx, contract.Id, contract); err != nil {
+		return err
+	}
+	if err := k.indexContract(ctx, contract); err != nil {
+		return err
+	}
+	return k.schedulePayout(ctx, contract)
 }
 
-func (k Keeper) payFromModule(ctx context.Context, module string, to sdk.AccAddress, amount sdkmath.Int) error {
+func (k Keeper) payFromModule(ctx context.Context, module string, to sdk.AccAddress, coinDenom string, amount sdkmath.Int) error {
 	if !amount.IsPositive() {
 		return nil
 	}
-	coins := sdk.NewCoins(sdk.NewCoin(denom.BaseDenom, amount))
+	coins := sdk.NewCoins(sdk.NewCoin(coinDenom, amount))
 	return k.bank.SendCoinsFromModuleToAccount(ctx, module, to, coins)
 }
 
-func (k Keeper) moveToModule(ctx context.Context, from sdk.AccAddress, module string, amount sdkmath.Int) error {
+func (k Keeper) moveToModule(ctx context.Context, from sdk.AccAddress, module string, coinDenom string, amount sdkmath.Int) error {
 	if !amount.IsPositive() {
 		return nil
 	}
-	coins := sdk.NewCoins(sdk.NewCoin(denom.BaseDenom, amount))
+	coins := sdk.NewCoins(sdk.NewCoin(coinDenom, amount))
 	return k.bank.SendCoinsFromAccountToModule(ctx, from, module, coins)
 }
 
-func (k Keeper) moveModuleToModule(ctx context.Context, fromModule, toModule string, amount sdkmath.Int) error {
+func (k Keeper) moveModuleToModule(ctx context.Context, fromModule, toModule string, coinDenom string, amount sdkmath.Int) error {
 	if !amount.IsPositive() {
 		return nil
 	}
-	coins := sdk.NewCoins(sdk.NewCoin(denom.BaseDenom, amount))
+	coins := sdk.NewCoins(sdk.NewCoin(coinDenom, amount))
 	return k.bank.SendCoinsFromModuleToModule(ctx, fromModule, toModule, coins)
 }
 
@@ -183,7 +349,7 @@ func (k Keeper) collectGatewayFee(ctx context.Context, payer string, amount uint
 	case k.dk != nil:
 		return k.dk.FundCommunityPool(ctx, coins, addr)
 	case k.bank != nil:
-		return k.moveToModule(ctx, addr, authtypes.FeeCollectorName, fee)
+		return k.moveToModule(ctx, addr, authtypes.FeeCollectorName, denom.BaseDenom, fee)
 	default:
 		return fmt.Errorf("bank keeper not set")
 	}
@@ -199,6 +365,30 @@ func (k Keeper) collectRegisterFee(ctx context.Context, payer string) error {
 	return k.collectGatewayFee(ctx, payer, params.RegisterGatewayFeeUlmn)
 }
 
+// txTaxRateBps is the send tax charged on contract deposits.
+func (k Keeper) txTaxRateBps(ctx context.Context) uint32 {
+	if k.tokenomics == nil {
+		return tokenomicstypes.DefaultTxTaxRateBps
+	}
+	if rate := tokenomicstypes.GetTxTaxRateBps(k.tokenomics.GetParams(ctx)); rate != 0 {
+		return rate
+	}
+	return tokenomicstypes.DefaultTxTaxRateBps
+}
+
+// depositToEscrow moves net into escrow and charges the send tax on top of
+// it, for deposits made after a contract was created.
+func (k Keeper) depositToEscrow(ctx context.Context, client sdk.AccAddress, coinDenom string, net sdkmath.Int) (sdkmath.Int, error) {
+	tax := net.MulRaw(int64(k.txTaxRateBps(ctx))).QuoRaw(10_000)
+	if err := k.moveToModule(ctx, client, authtypes.FeeCollectorName, coinDenom, tax); err != nil {
+		return sdkmath.Int{}, err
+	}
+	if err := k.moveToModule(ctx, client, types.ModuleAccountEscrow, coinDenom, net); err != nil {
+		return sdkmath.Int{}, err
+	}
+	return tax, nil
+}
+
 func (k Keeper) applyCommission(amount sdkmath.Int, bps uint32) sdkmath.Int {
 	if bps == 0 {
 		return sdkmath.ZeroInt()
@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"lumen/x/gateways/types"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// heartbeatSpacing is the least time between two heartbeats of a gateway:
+// half the heartbeat interval, and never under MinHeartbeatSpacingSeconds.
+func heartbeatSpacing(params types.Params) uint64 {
+	spacing := uint64(types.MinHeartbeatSpacingSeconds)
+	if half := params.HeartbeatIntervalSeconds / 2; half > spacing {
+		spacing = half
+	}
+	return spacing
+}
+
+// processLiveness deactivates active gateways whose last heartbeat is older
+// than the heartbeat grace period, at most MaxLivenessDeactivationsPerBlock
+// per block. They are flagged offline so that their next heartbeat brings
+// them back.
+func (k Keeper) processLiveness(ctx context.Context) error {
+	grace := k.GetParams(ctx).HeartbeatGrace()
+	now := uint64(k.nowUnix(ctx))
+	if grace == 0 || now < grace {
+		return nil
+	}
+
+	var silent []uint64
+	rng := collections.NewPrefixUntilPairRange[uint64, uint64](now - grace)
+	err := k.LivenessQueue.Walk(ctx, rng, func(key collections.Pair[uint64, uint64]) (bool, error) {
+		silent = append(silent, key.K2())
+		return len(silent) >= types.MaxLivenessDeactivationsPerBlock, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, id := range silent {
+		gateway, err := k.gatewayByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		gateway.Active = false
+		gateway.Offline = true
+		if err := k.setGateway(ctx, gateway); err != nil {
+			return err
+		}
+		sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+			sdk.NewEvent(
+				"gateway_offline",
+				sdk.NewAttribute("gateway_id", fmt.Sprintf("%d", id)),
+				sdk.NewAttribute("last_heartbeat_at", fmt.Sprintf("%d", gateway.LastHeartbeatAt)),
+			),
+		)
+	}
+	return nil
+}
+
+// reindexGateways starts the first heartbeat window of gateways that never
+// sent one at the current block and rebuilds the gateway queues.
+func (k Keeper) reindexGateways(ctx context.Context) error {
+	var gateways []types.Gateway
+	err := k.Gateways.Walk(ctx, nil, func(_ uint64, gateway types.Gateway) (bool, error) {
+		gateways = append(gateways, gateway)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+	now := uint64(k.nowUnix(ctx))
+	for _, gateway := range gateways {
+		if gateway.LastHeartbeatAt == 0 {
+			gateway.LastHeartbeatAt = now
+		}
+		if err := k.setGateway(ctx, gateway); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogotypes "github.com/cosmos/gogoproto/types"
+	"github.com/stretchr/testify/require"
+
+	"lumen/app/denom"
+	"lumen/x/gateways/keeper"
+	"lumen/x/gateways/types"
+)
+
+const heartbeatInterval = 3_600
+
+func setupLivenessGateway(t *testing.T, f *gatewayFixture, srv types.MsgServer) (operator string, gatewayID uint64) {
+	t.Helper()
+
+	params := f.keeper.GetParams(f.ctx)
+	params.HeartbeatIntervalSeconds = heartbeatInterval
+	params.HeartbeatMissedWindows = 3
+	require.NoError(t, types.ValidateParams(params))
+	require.NoError(t, f.keeper.SetParams(f.ctx, params))
+
+	f.withBlockTime(1_000)
+	return f.registerBondedGateway(srv)
+}
+
+func TestGatewayHeartbeatIsRateLimited(t *testing.T) {
+	f := initGatewayFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	operator, gatewayID := setupLivenessGateway(t, f, srv)
+
+	heartbeat := &types.MsgGatewayHeartbeat{Operator: operator, GatewayId: gatewayID}
+	_, err := srv.GatewayHeartbeat(f.ctx, &types.MsgGatewayHeartbeat{Operator: randomAccAddress(), GatewayId: gatewayID})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = srv.GatewayHeartbeat(f.ctx, heartbeat)
+	require.ErrorContains(t, err, "heartbeat too soon")
+
+	f.withBlockTime(1_000 + heartbeatInterval/2)
+	res, err := srv.GatewayHeartbeat(f.ctx, heartbeat)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1_000+heartbeatInterval/2+3*heartbeatInterval), res.OfflineAt)
+
+	gateway, err := f.keeper.Gateways.Get(f.ctx, gatewayID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1_000+heartbeatInterval/2), gateway.LastHeartbeatAt)
+	_, err = srv.GatewayHeartbeat(f.ctx, heartbeat)
+	require.ErrorContains(t, err, "heartbeat too soon")
+}
+
+func TestSilentGatewayGoesOfflineAndHeartbeatRestoresIt(t *testing.T) {
+	f := initGatewayFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	operator, gatewayID := setupLivenessGateway(t, f, srv)
+
+	// Still within the three missed windows.
+	f.withBlockTime(1_000 + 3*heartbeatInterval - 1)
+	require.NoError(t, f.keeper.EndBlocker(f.ctx))
+	gateway, err := f.keeper.Gateways.Get(f.ctx, gatewayID)
+	require.NoError(t, err)
+	require.True(t, gateway.Active)
+
+	f.withBlockTime(1_000 + 3*heartbeatInterval)
+	f.resetEvents()
+	require.NoError(t, f.keeper.EndBlocker(f.ctx))
+	gateway, err = f.keeper.Gateways.Get(f.ctx, gatewayID)
+	require.NoError(t, err)
+	require.False(t, gateway.Active)
+	require.True(t, gateway.Offline)
+	var offline bool
+	for _, ev := range f.ctx.EventManager().Events() {
+		if ev.Type == "gateway_offline" {
+			offline = true
+		}
+	}
+	require.True(t, offline)
+
+	client := randomAccAddress()
+	f.bank.setAccountBalance(f.mustAccAddress(client), sdk.NewCoins(sdk.NewInt64Coin(denom.BaseDenom, 5_000_000)))
+	createContract := &types.MsgCreateContract{Client: client, GatewayId: gatewayID, PriceUlmn: 200_000, MonthsTotal: 1}
+	_, err = srv.CreateContract(f.ctx, createContract)
+	require.ErrorContains(t, err, "gateway inactive")
+
+	_, err = srv.GatewayHeartbeat(f.ctx, &types.MsgGatewayHeartbeat{Operator: operator, GatewayId: gatewayID})
+	require.NoError(t, err)
+	gateway, err = f.keeper.Gateways.Get(f.ctx, gatewayID)
+	require.NoError(t, err)
+	require.True(t, gateway.Active)
+	require.False(t, gateway.Offline)
+	_, err = srv.CreateContract(f.ctx, createContract)
+	require.NoError(t, err)
+}
+
+func TestHeartbeatDoesNotReactivatePausedGateway(t *testing.T) {
+	f := initGatewayFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	operator, gatewayID := setupLivenessGateway(t, f, srv)
+
+	_, err := srv.UpdateGateway(f.ctx, &types.MsgUpdateGateway{Operator: operator, GatewayId: gatewayID, Active: &gogotypes.BoolValue{Value: false}})
+	require.NoError(t, err)
+
+	// A paused gateway is not tracked and stays paused after a heartbeat.
+	f.withBlockTime(1_000 + 10*heartbeatInterval)
+	require.NoError(t, f.keeper.EndBlocker(f.ctx))
+	_, err = srv.GatewayHeartbeat(f.ctx, &types.MsgGatewayHeartbeat{Operator: operator, GatewayId: gatewayID})
+	require.NoError(t, err)
+	gateway, err := f.keeper.Gateways.Get(f.ctx, gatewayID)
+	require.NoError(t, err)
+	require.False(t, gateway.Active)
+	require.False(t, gateway.Offline)
+}
+
+func TestMigrate2to3StartsHeartbeatWindows(t *testing.T) {
+	f := initGatewayFixture(t)
+	params := f.keeper.GetParams(f.ctx)
+	params.HeartbeatIntervalSeconds = heartbeatInterval
+	require.NoError(t, f.keeper.SetParams(f.ctx, params))
+
+	// A gateway written before heartbeats existed.
+	require.NoError(t, f.keeper.Gateways.Set(f.ctx, 1, types.Gateway{Id: 1, Operator: randomAccAddress(), Active: true}))
+	f.withBlockTime(50_000)
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate2to3(f.ctx))
+
+	gateway, err := f.keeper.Gateways.Get(f.ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(50_000), gateway.LastHeartbeatAt)
+
+	f.withBlockTime(50_000 + 3*heartbeatInterval)
+	require.NoError(t, f.keeper.EndBlocker(f.ctx))
+	gateway, err = f.keeper.Gateways.Get(f.ctx, 1)
+	require.NoError(t, err)
+	require.True(t, gateway.Offline)
+}
@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"lumen/x/gateways/types"
+)
+
+// Migrator runs the gateways store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+func NewMigrator(k Keeper) Migrator {
+	return Migrator{keeper: k}
+}
+
+// Migrate1to2 builds the contract query indexes (client, gateway, status,
+// next payout and open contracts per gateway) and the payout and acceptance
+// queues for contracts stored before they were introduced. Params added since
+// store version 1 read as zero on an upgraded chain, which would disable or
+// change their features, so they get their defaults. The treasury balance
+// starts from what the treasury account already holds.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	if err := m.migrateParams1to2(ctx); err != nil {
+		return err
+	}
+	if err := m.seedTreasuryBalance(ctx); err != nil {
+		return err
+	}
+	return m.keeper.reindexContracts(ctx)
+}
+
+// seedTreasuryBalance records the commission the treasury account collected
+// before its balance was tracked, so it can be distributed and spent.
+func (m Migrator) seedTreasuryBalance(ctx sdk.Context) error {
+	if m.keeper.bank == nil {
+		return nil
+	}
+	held := m.keeper.bank.GetAllBalances(ctx, authtypes.NewModuleAddress(types.ModuleAccountTreasury))
+	for _, coin := range held {
+		if err := m.keeper.TreasuryBalance.Set(ctx, coin.Denom, coin.Amount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// migrateParams1to2 keeps the params of store version 1 and sets every other
+// field to its default.
+func (m Migrator) migrateParams1to2(ctx sdk.Context) error {
+	old, err := m.keeper.Params.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return m.keeper.SetParams(ctx, types.DefaultParams())
+	}
+	if err != nil {
+		return err
+	}
+	params := types.DefaultParams()
+	params.PlatformCommissionBps = old.PlatformCommissionBps
+	params.MonthSeconds = old.MonthSeconds
+	params.FinalizeDelayMonths = old.FinalizeDelayMonths
+	params.FinalizerRewardBps = old.FinalizerRewardBps
+	params.MinPriceUlmnPerMonth = old.MinPriceUlmnPerMonth
+	params.MaxActiveContractsPerGateway = old.MaxActiveContractsPerGateway
+	params.ActionFeeUlmn = old.ActionFeeUlmn
+	params.RegisterGatewayFeeUlmn = old.RegisterGatewayFeeUlmn
+	// The dispute window may not outlast a month shorter than the default.
+	params.UsageDisputeWindowSeconds = min(params.UsageDisputeWindowSeconds, params.MonthSeconds)
+	if err := types.ValidateParams(params); err != nil {
+		return fmt.Errorf("migrated params: %w", err)
+	}
+	return m.keeper.SetParams(ctx, params)
+}
+
+// Migrate2to3 starts heartbeat tracking: gateways stored before it get their
+// first heartbeat window from the upgrade block and are added to the
+// liveness queue.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return m.keeper.reindexGateways(ctx)
+}
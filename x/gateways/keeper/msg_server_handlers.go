@@ -4,12 +4,13 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"math"
 	"strings"
 
 	"lumen/app/denom"
 	"lumen/x/gateways/types"
-	tokenomicstypes "lumen/x/tokenomics/types"
 
+	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -46,10 +47,22 @@ func (m msgServer) RegisterGateway(ctx context.Context, msg *types.MsgRegisterGa
 	} else if _, err := m.addressCodec.StringToBytes(payout); err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid payout")
 	}
+	if err := m.checkReceivable(payout, "payout"); err != nil {
+		return nil, err
+	}
 
 	metadata := strings.TrimSpace(msg.Metadata)
-	if len(metadata) > 512 {
-		metadata = metadata[:512]
+	if len(metadata) > types.GatewayMetadataMaxLen {
+		return nil, errorsmod.Wrapf(types.ErrInvalidRequest, "metadata too long: %d > %d", len(metadata), types.GatewayMetadataMaxLen)
+	}
+	profile, err := types.NormalizeGatewayProfile(msg.Profile)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, err.Error())
+	}
+	// A new gateway has no bound domains yet; endpoints are added with
+	// update-gateway once their domains are bound.
+	if profile != nil && len(profile.Endpoints) > 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "endpoints must be domains bound to the gateway; bind them after registering")
 	}
 
 	if err := m.collectRegisterFee(ctx, msg.Operator); err != nil {
@@ -68,6 +81,9 @@ func (m msgServer) RegisterGateway(ctx context.Context, msg *types.MsgRegisterGa
 		Active:    true,
 		Metadata:  metadata,
 		CreatedAt: uint64(m.nowUnix(ctx)),
+		Profile:   profile,
+		// Registration opens the first heartbeat window.
+		LastHeartbeatAt: uint64(m.nowUnix(ctx)),
 	}
 	if err := m.setGateway(ctx, gateway); err != nil {
 		return nil, err
@@ -100,6 +116,9 @@ func (m msgServer) UpdateGateway(ctx context.Context, msg *types.MsgUpdateGatewa
 	if gateway.Operator != msg.Operator {
 		return nil, errorsmod.Wrap(types.ErrUnauthorized, "operator mismatch")
 	}
+	if gateway.Archived {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "gateway archived")
+	}
 
 	if msg.Payout != nil {
 		payout := strings.TrimSpace(msg.Payout.Value)
@@ -109,17 +128,47 @@ func (m msgServer) UpdateGateway(ctx context.Context, msg *types.MsgUpdateGatewa
 		if _, err := m.addressCodec.StringToBytes(payout); err != nil {
 			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid payout")
 		}
+		if err := m.checkReceivable(payout, "payout"); err != nil {
+			return nil, err
+		}
 		gateway.Payout = payout
 	}
 	if msg.Metadata != nil {
 		meta := strings.TrimSpace(msg.Metadata.Value)
-		if len(meta) > 512 {
-			meta = meta[:512]
+		if len(meta) > types.GatewayMetadataMaxLen {
+			return nil, errorsmod.Wrapf(types.ErrInvalidRequest, "metadata too long: %d > %d", len(meta), types.GatewayMetadataMaxLen)
 		}
 		gateway.Metadata = meta
 	}
+	if msg.Profile != nil {
+		profile, err := types.NormalizeGatewayProfile(msg.Profile)
+		if err != nil {
+			return nil, errorsmod.Wrap(types.ErrInvalidRequest, err.Error())
+		}
+		if err := m.checkProfileDomains(ctx, gateway.Id, profile); err != nil {
+			return nil, err
+		}
+		gateway.Profile = profile
+	}
 	if msg.Active != nil {
+		if msg.Active.Value && gateway.DecommissionAt != 0 {
+			return nil, errorsmod.Wrap(types.ErrInvalidRequest, "gateway is decommissioning")
+		}
+		// Re-activating starts a fresh heartbeat window.
+		if msg.Active.Value && !gateway.Active {
+			gateway.LastHeartbeatAt = uint64(m.nowUnix(ctx))
+		}
 		gateway.Active = msg.Active.Value
+		gateway.Offline = false
+	}
+	if msg.ContractTransferConsent != nil {
+		gateway.ContractTransferConsent = msg.ContractTransferConsent.Value
+	}
+	if msg.AutoClaim != nil && msg.AutoClaim.Value != gateway.AutoClaim {
+		gateway.AutoClaim = msg.AutoClaim.Value
+		if err := m.setAutoClaim(ctx, gateway.Id, gateway.AutoClaim); err != nil {
+			return nil, err
+		}
 	}
 
 	if err := m.setGateway(ctx, gateway); err != nil {
@@ -145,27 +194,76 @@ func (m msgServer) CreateContract(ctx context.Context, msg *types.MsgCreateContr
 		return nil, errorsmod.Wrapf(types.ErrOutOfBounds, "gateway reached max active contracts (%d)", params.MaxActiveContractsPerGateway)
 	}
 
-	price := sdkmath.NewIntFromUint64(msg.PriceUlmn)
+	// A gateway that publishes offers only takes contracts on its own terms.
+	priceUlmn, storageGb, networkGb := msg.PriceUlmn, msg.StorageGbPerMonth, msg.NetworkGbPerMonth
+	payDenom := msg.Denom
+	if payDenom == "" {
+		payDenom = denom.BaseDenom
+	}
+	var offer types.Offer
+	if msg.OfferId != 0 {
+		offer, err = m.offerByID(ctx, msg.OfferId)
+		if err != nil {
+			return nil, errorsmod.Wrap(types.ErrNotFound, "offer not found")
+		}
+		if offer.GatewayId != gateway.Id {
+			return nil, errorsmod.Wrap(types.ErrInvalidRequest, "offer belongs to another gateway")
+		}
+		if offer.Retired {
+			return nil, errorsmod.Wrap(types.ErrInvalidRequest, "offer retired")
+		}
+		if !offer.HasCapacity() {
+			return nil, errorsmod.Wrapf(types.ErrOutOfBounds, "offer out of capacity (%d slots)", offer.CapacitySlots)
+		}
+		if !offer.AcceptsMonths(msg.MonthsTotal) {
+			return nil, errorsmod.Wrapf(types.ErrOutOfBounds, "months_total outside offer range %d-%d", offer.MinMonths, offer.MaxMonths)
+		}
+		if (priceUlmn != 0 && priceUlmn != offer.PriceUlmn) ||
+			(storageGb != 0 && storageGb != offer.StorageGbPerMonth) ||
+			(networkGb != 0 && networkGb != offer.NetworkGbPerMonth) {
+			return nil, errorsmod.Wrap(types.ErrInvalidRequest, "contract terms do not match offer")
+		}
+		if msg.Denom != "" && msg.Denom != offer.PaymentDenom() {
+			return nil, errorsmod.Wrapf(types.ErrInvalidRequest, "offer is priced in %s", offer.PaymentDenom())
+		}
+		priceUlmn, storageGb, networkGb = offer.PriceUlmn, offer.StorageGbPerMonth, offer.NetworkGbPerMonth
+		payDenom = offer.PaymentDenom()
+	} else {
+		offers, err := m.activeOfferCount(ctx, gateway.Id)
+		if err != nil {
+			return nil, err
+		}
+		if offers > 0 {
+			return nil, errorsmod.Wrap(types.ErrInvalidRequest, "gateway only accepts contracts from its offers")
+		}
+	}
+
+	minPricePerMonth, ok := params.MinPricePerMonth(payDenom)
+	if !ok {
+		return nil, errorsmod.Wrapf(types.ErrInvalidRequest, "denom %s not accepted for payments", payDenom)
+	}
+	if !gateway.AcceptsDenom(payDenom) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidRequest, "gateway does not accept %s", payDenom)
+	}
+	price := sdkmath.NewIntFromUint64(priceUlmn)
 	if !price.IsPositive() {
 		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "price must be positive")
 	}
-	minPrice := sdkmath.NewIntFromUint64(params.MinPriceUlmnPerMonth)
-	if price.LT(minPrice) {
-		return nil, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "price below minimum %d %s", params.MinPriceUlmnPerMonth, denom.BaseDenom)
+	if price.LT(sdkmath.NewIntFromUint64(minPricePerMonth)) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "price below minimum %d %s", minPricePerMonth, payDenom)
 	}
 	if msg.MonthsTotal == 0 {
 		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "months_total must be > 0")
 	}
-	total := price.MulRaw(int64(msg.MonthsTotal))
-	rateBps := tokenomicstypes.DefaultTxTaxRateBps
-	if m.tokenomics != nil {
-		tokenomicsParams := m.tokenomics.GetParams(ctx)
-		rateBps = tokenomicstypes.GetTxTaxRateBps(tokenomicsParams)
-		if rateBps == 0 {
-			rateBps = tokenomicstypes.DefaultTxTaxRateBps
-		}
+	bond, err := m.gatewayBond(ctx, gateway.Id)
+	if err != nil {
+		return nil, err
+	}
+	if required := params.RequiredBond(gateway.ActiveClients + 1); m.safeAmountFromString(bond.BondedUlmn).LT(required) {
+		return nil, errorsmod.Wrapf(types.ErrInsufficientBond, "gateway must bond at least %s%s", required, denom.BaseDenom)
 	}
-	tax := total.MulRaw(int64(rateBps)).QuoRaw(10_000)
+	total := price.MulRaw(int64(msg.MonthsTotal))
+	tax := total.MulRaw(int64(m.txTaxRateBps(ctx))).QuoRaw(10_000)
 	net := total.Sub(tax)
 	netPerMonth := net.QuoRaw(int64(msg.MonthsTotal))
 
@@ -173,11 +271,20 @@ func (m msgServer) CreateContract(ctx context.Context, msg *types.MsgCreateContr
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "invalid client address")
 	}
-	if err := m.moveToModule(ctx, clientAddr, authtypes.FeeCollectorName, tax); err != nil {
-		return nil, err
-	}
-	if err := m.moveToModule(ctx, clientAddr, types.ModuleAccountEscrow, net); err != nil {
-		return nil, err
+	// With an acceptance window the tax is held in escrow with the net amount
+	// so that a rejected or expired contract can be refunded in full.
+	pending := params.AcceptanceTimeoutSeconds > 0
+	if pending {
+		if err := m.moveToModule(ctx, clientAddr, types.ModuleAccountEscrow, payDenom, total); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := m.moveToModule(ctx, clientAddr, authtypes.FeeCollectorName, payDenom, tax); err != nil {
+			return nil, err
+		}
+		if err := m.moveToModule(ctx, clientAddr, types.ModuleAccountEscrow, payDenom, net); err != nil {
+			return nil, err
+		}
 	}
 
 	id, err := m.nextContractID(ctx)
@@ -203,8 +310,8 @@ func (m msgServer) CreateContract(ctx context.Context, msg *types.MsgCreateContr
 		Client:            msg.Client,
 		GatewayId:         msg.GatewayId,
 		PriceUlmn:         netPerMonth.Uint64(),
-		StorageGbPerMonth: msg.StorageGbPerMonth,
-		NetworkGbPerMonth: msg.NetworkGbPerMonth,
+		StorageGbPerMonth: storageGb,
+		NetworkGbPerMonth: networkGb,
 		MonthsTotal:       msg.MonthsTotal,
 		StartTime:         now,
 		EscrowUlmn:        net.String(),
@@ -212,13 +319,37 @@ func (m msgServer) CreateContract(ctx context.Context, msg *types.MsgCreateContr
 		Status:            types.ContractStatus_CONTRACT_STATUS_ACTIVE,
 		Metadata:          metadata,
 		NextPayoutTime:    next,
+		OfferId:           msg.OfferId,
+		Denom:             payDenom,
+	}
+	if pending {
+		deadline, err := m.safeAddUint64(now, params.AcceptanceTimeoutSeconds)
+		if err != nil {
+			return nil, err
+		}
+		contract.Status = types.ContractStatus_CONTRACT_STATUS_PENDING
+		contract.StartTime = 0
+		contract.NextPayoutTime = 0
+		contract.AcceptDeadline = deadline
+		contract.PendingTaxUlmn = tax.String()
+		if err := m.PendingDeadlines.Set(ctx, collections.Join(deadline, id)); err != nil {
+			return nil, err
+		}
 	}
 	if err := m.setContract(ctx, contract); err != nil {
 		return nil, err
 	}
-	gateway.ActiveClients++
-	if err := m.setGateway(ctx, gateway); err != nil {
-		return nil, err
+	if offer.Id != 0 {
+		offer.UsedSlots++
+		if err := m.setOffer(ctx, offer); err != nil {
+			return nil, err
+		}
+	}
+	if !pending {
+		gateway.ActiveClients++
+		if err := m.setGateway(ctx, gateway); err != nil {
+			return nil, err
+		}
 	}
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -229,6 +360,7 @@ func (m msgServer) CreateContract(ctx context.Context, msg *types.MsgCreateContr
 			sdk.NewAttribute("months_total", fmt.Sprintf("%d", msg.MonthsTotal)),
 			sdk.NewAttribute("tax_ulmn", tax.String()),
 			sdk.NewAttribute("net_ulmn", net.String()),
+			sdk.NewAttribute("denom", payDenom),
 			sdk.NewAttribute("payer", msg.Client),
 		),
 		sdk.NewEvent(
@@ -238,6 +370,8 @@ func (m msgServer) CreateContract(ctx context.Context, msg *types.MsgCreateContr
 			sdk.NewAttribute("client", msg.Client),
 			sdk.NewAttribute("price_ulmn", price.String()),
 			sdk.NewAttribute("months_total", fmt.Sprintf("%d", msg.MonthsTotal)),
+			sdk.NewAttribute("offer_id", fmt.Sprintf("%d", msg.OfferId)),
+			sdk.NewAttribute("status", contract.Status.String()),
 		),
 	})
 
@@ -260,97 +394,10 @@ func (m msgServer) ClaimPayment(ctx context.Context, msg *types.MsgClaimPayment)
 	if gateway.Operator != msg.Operator {
 		return nil, errorsmod.Wrap(types.ErrUnauthorized, "operator mismatch")
 	}
-	if contract.Status != types.ContractStatus_CONTRACT_STATUS_ACTIVE &&
-		contract.Status != types.ContractStatus_CONTRACT_STATUS_COMPLETED {
-		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "contract not active")
-	}
-	params := m.GetParams(ctx)
-	if params.MonthSeconds == 0 {
-		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "invalid month_seconds param")
-	}
-	if contract.ClaimedMonths >= contract.MonthsTotal {
-		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "all payments claimed")
-	}
-
-	now := uint64(m.nowUnix(ctx))
-	var eligible uint64
-	if now > contract.StartTime {
-		eligible = (now - contract.StartTime) / params.MonthSeconds
-	}
-	if eligible > uint64(contract.MonthsTotal) {
-		eligible = uint64(contract.MonthsTotal)
-	}
-	claimed := uint64(contract.ClaimedMonths)
-	if eligible <= claimed {
-		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "no payout due")
-	}
-	monthsDue := eligible - claimed
-
-	price := sdkmath.NewIntFromUint64(contract.PriceUlmn)
-	gross := price.MulRaw(int64(monthsDue))
-	if !gross.IsPositive() {
-		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "no payout due")
-	}
-	escrow := m.safeAmountFromString(contract.EscrowUlmn)
-	if escrow.LT(gross) {
-		return nil, errorsmod.Wrap(types.ErrInsufficientFunds, "insufficient escrow")
-	}
-
-	commission := m.applyCommission(gross, params.PlatformCommissionBps)
-	if commission.GT(gross) {
-		return nil, errorsmod.Wrap(types.ErrOverflow, "commission overflow")
-	}
-	payout := gross.Sub(commission)
-
-	payoutAddr := gateway.Payout
-	if strings.TrimSpace(payoutAddr) == "" {
-		payoutAddr = gateway.Operator
-	}
-	addr, err := m.mustAddress(payoutAddr)
+	payout, err := m.claimDue(ctx, contract, gateway)
 	if err != nil {
-		return nil, errorsmod.Wrap(err, "invalid payout address")
-	}
-
-	if err := m.payFromModule(ctx, types.ModuleAccountEscrow, addr, payout); err != nil {
-		return nil, err
-	}
-	if commission.IsPositive() {
-		if err := m.moveModuleToModule(ctx, types.ModuleAccountEscrow, types.ModuleAccountTreasury, commission); err != nil {
-			return nil, err
-		}
-	}
-
-	contract.ClaimedMonths = uint32(claimed + monthsDue)
-	contract.EscrowUlmn = escrow.Sub(gross).String()
-	if contract.ClaimedMonths >= contract.MonthsTotal {
-		contract.Status = types.ContractStatus_CONTRACT_STATUS_COMPLETED
-		contract.NextPayoutTime = 0
-	} else {
-		nextMonths := uint64(contract.ClaimedMonths) + 1
-		nextTime, err := m.contractOffsetTime(contract, nextMonths, params.MonthSeconds)
-		if err != nil {
-			return nil, err
-		}
-		contract.NextPayoutTime = nextTime
-	}
-
-	if err := m.setContract(ctx, contract); err != nil {
 		return nil, err
 	}
-
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	sdkCtx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			"payment_claim",
-			sdk.NewAttribute("contract_id", fmt.Sprintf("%d", contract.Id)),
-			sdk.NewAttribute("gateway_id", fmt.Sprintf("%d", contract.GatewayId)),
-			sdk.NewAttribute("operator", msg.Operator),
-			sdk.NewAttribute("months_paid", fmt.Sprintf("%d", monthsDue)),
-			sdk.NewAttribute("pay_amount_ulmn", payout.String()),
-			sdk.NewAttribute("fee_ulmn", commission.String()),
-		),
-	)
-
 	return &types.MsgClaimPaymentResponse{PaidUlmn: payout.String()}, nil
 }
 
@@ -365,60 +412,45 @@ func (m msgServer) CancelContract(ctx context.Context, msg *types.MsgCancelContr
 	if contract.Client != msg.Client {
 		return nil, errorsmod.Wrap(types.ErrUnauthorized, "not contract owner")
 	}
+	// A contract the gateway never accepted is withdrawn without penalty.
+	if contract.Status == types.ContractStatus_CONTRACT_STATUS_PENDING {
+		refund, err := m.refundPendingContract(ctx, contract, "client_cancel")
+		if err != nil {
+			return nil, err
+		}
+		return &types.MsgCancelContractResponse{RefundedUlmn: refund.String()}, nil
+	}
 	if contract.Status != types.ContractStatus_CONTRACT_STATUS_ACTIVE {
 		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "contract not active")
 	}
 	if contract.ClaimedMonths >= contract.MonthsTotal {
 		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "contract already completed")
 	}
-
-	gateway, err := m.gatewayByID(ctx, contract.GatewayId)
+	frozen, err := m.contractFrozen(ctx, contract, uint64(m.nowUnix(ctx)))
 	if err != nil {
-		return nil, errorsmod.Wrap(types.ErrNotFound, "gateway not found")
+		return nil, err
 	}
-
-	escrow := m.safeAmountFromString(contract.EscrowUlmn)
-	price := sdkmath.NewIntFromUint64(contract.PriceUlmn)
-
-	totalMonths := uint64(contract.MonthsTotal)
-	claimedMonths := uint64(contract.ClaimedMonths)
-	if claimedMonths >= totalMonths {
-		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "nothing left to cancel")
+	if frozen {
+		return nil, errorsmod.Wrapf(types.ErrDisputeOpen, "dispute %d", contract.DisputeId)
 	}
-	remainingMonths := totalMonths - claimedMonths
 
-	var refundMonths uint64
-	if remainingMonths > 1 {
-		refundMonths = remainingMonths - 1
+	gateway, err := m.gatewayByID(ctx, contract.GatewayId)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrNotFound, "gateway not found")
 	}
 
-	refund := price.MulRaw(int64(refundMonths))
-	if escrow.LT(refund) {
-		return nil, errorsmod.Wrap(types.ErrInsufficientFunds, "escrow mismatch")
+	terms, err := m.quoteCancel(ctx, contract, uint64(m.nowUnix(ctx)))
+	if err != nil {
+		return nil, err
 	}
-	penalty := escrow.Sub(refund)
-
 	clientAddr, err := m.mustAddress(msg.Client)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "invalid client address")
 	}
-	if refund.IsPositive() {
-		if err := m.payFromModule(ctx, types.ModuleAccountEscrow, clientAddr, refund); err != nil {
-			return nil, err
-		}
+	if err := m.payFromModule(ctx, types.ModuleAccountEscrow, clientAddr, contract.PaymentDenom(), terms.refund); err != nil {
+		return nil, err
 	}
-
-	params := m.GetParams(ctx)
-
-	payoutAmt := sdkmath.ZeroInt()
-	feeAmt := sdkmath.ZeroInt()
-	if penalty.IsPositive() {
-		feeAmt = m.applyCommission(penalty, params.PlatformCommissionBps)
-		if feeAmt.GT(penalty) {
-			return nil, errorsmod.Wrap(types.ErrOverflow, "penalty commission overflow")
-		}
-		payoutAmt = penalty.Sub(feeAmt)
-
+	if terms.payout.IsPositive() {
 		payoutAddr := strings.TrimSpace(gateway.Payout)
 		if payoutAddr == "" {
 			payoutAddr = gateway.Operator
@@ -427,18 +459,18 @@ func (m msgServer) CancelContract(ctx context.Context, msg *types.MsgCancelContr
 		if err != nil {
 			return nil, errorsmod.Wrap(err, "invalid gateway payout address")
 		}
-		if err := m.payFromModule(ctx, types.ModuleAccountEscrow, gatewayAddr, payoutAmt); err != nil {
+		if err := m.payFromModule(ctx, types.ModuleAccountEscrow, gatewayAddr, contract.PaymentDenom(), terms.payout); err != nil {
 			return nil, err
 		}
-		if feeAmt.IsPositive() {
-			if err := m.moveModuleToModule(ctx, types.ModuleAccountEscrow, types.ModuleAccountTreasury, feeAmt); err != nil {
-				return nil, err
-			}
-		}
 	}
+	if err := m.creditTreasury(ctx, types.ModuleAccountEscrow, contract.PaymentDenom(), terms.fee); err != nil {
+		return nil, err
+	}
+	params := m.GetParams(ctx)
 
 	contract.Status = types.ContractStatus_CONTRACT_STATUS_CANCELED
 	contract.EscrowUlmn = sdkmath.ZeroInt().String()
+	contract.UsageWithheldUlmn = ""
 	contract.NextPayoutTime = 0
 
 	if err := m.setContract(ctx, contract); err != nil {
@@ -451,6 +483,28 @@ func (m msgServer) CancelContract(ctx context.Context, msg *types.MsgCancelContr
 	if err := m.setGateway(ctx, gateway); err != nil {
 		return nil, err
 	}
+	if err := m.releaseOfferSlot(ctx, contract); err != nil {
+		return nil, err
+	}
+	if err := m.recordOutcome(ctx, gateway.Id, func(rep *types.GatewayReputation) {
+		rep.ContractsCancelled++
+	}); err != nil {
+		return nil, err
+	}
+	rep, err := m.gatewayReputation(ctx, gateway)
+	if err != nil {
+		return nil, err
+	}
+	if params.CancellationSlashBps > 0 && params.CancellationsExcessive(rep, gateway.ActiveClients) {
+		bond, err := m.gatewayBond(ctx, gateway.Id)
+		if err != nil {
+			return nil, err
+		}
+		slash := m.applyCommission(m.slashableBond(bond), params.CancellationSlashBps)
+		if _, err := m.slashGatewayBond(ctx, gateway.Id, slash, "cancellations"); err != nil {
+			return nil, err
+		}
+	}
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	sdkCtx.EventManager().EmitEvent(
@@ -459,14 +513,15 @@ func (m msgServer) CancelContract(ctx context.Context, msg *types.MsgCancelContr
 			sdk.NewAttribute("contract_id", fmt.Sprintf("%d", contract.Id)),
 			sdk.NewAttribute("gateway_id", fmt.Sprintf("%d", gateway.Id)),
 			sdk.NewAttribute("client", msg.Client),
-			sdk.NewAttribute("refunded_ulmn", refund.String()),
-			sdk.NewAttribute("payout_ulmn", payoutAmt.String()),
-			sdk.NewAttribute("penalty_ulmn", penalty.String()),
-			sdk.NewAttribute("fee_ulmn", feeAmt.String()),
+			sdk.NewAttribute("refunded_ulmn", terms.refund.String()),
+			sdk.NewAttribute("earned_ulmn", terms.earned.String()),
+			sdk.NewAttribute("penalty_ulmn", terms.penalty.String()),
+			sdk.NewAttribute("payout_ulmn", terms.payout.String()),
+			sdk.NewAttribute("fee_ulmn", terms.fee.String()),
 		),
 	)
 
-	return &types.MsgCancelContractResponse{RefundedUlmn: refund.String()}, nil
+	return &types.MsgCancelContractResponse{RefundedUlmn: terms.refund.String()}, nil
 }
 
 func (m msgServer) FinalizeContract(ctx context.Context, msg *types.MsgFinalizeContract) (*types.MsgFinalizeContractResponse, error) {
@@ -507,6 +562,13 @@ func (m msgServer) FinalizeContract(ctx context.Context, msg *types.MsgFinalizeC
 	if now < readyTime {
 		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "finalization delay not satisfied")
 	}
+	frozen, err := m.contractFrozen(ctx, contract, now)
+	if err != nil {
+		return nil, err
+	}
+	if frozen {
+		return nil, errorsmod.Wrapf(types.ErrDisputeOpen, "dispute %d", contract.DisputeId)
+	}
 
 	gateway, err := m.gatewayByID(ctx, contract.GatewayId)
 	if err != nil {
@@ -514,10 +576,16 @@ func (m msgServer) FinalizeContract(ctx context.Context, msg *types.MsgFinalizeC
 	}
 
 	escrow := m.safeAmountFromString(contract.EscrowUlmn)
+	withheld := m.safeAmountFromString(contract.UsageWithheldUlmn)
+	if withheld.GT(escrow) {
+		withheld = escrow
+	}
 
-	reward := m.applyCommission(escrow, params.FinalizerRewardBps)
-	if reward.GT(escrow) {
-		reward = escrow
+	// The finalizer reward never comes out of escrow withheld for undelivered usage.
+	leftover := escrow.Sub(withheld)
+	reward := m.applyCommission(leftover, params.FinalizerRewardBps)
+	if reward.GT(leftover) {
+		reward = leftover
 	}
 	refund := escrow.Sub(reward)
 
@@ -525,7 +593,7 @@ func (m msgServer) FinalizeContract(ctx context.Context, msg *types.MsgFinalizeC
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "invalid finalizer address")
 	}
-	if err := m.payFromModule(ctx, types.ModuleAccountEscrow, finalizerAddr, reward); err != nil {
+	if err := m.payFromModule(ctx, types.ModuleAccountEscrow, finalizerAddr, contract.PaymentDenom(), reward); err != nil {
 		return nil, err
 	}
 
@@ -534,13 +602,17 @@ func (m msgServer) FinalizeContract(ctx context.Context, msg *types.MsgFinalizeC
 		if err != nil {
 			return nil, errorsmod.Wrap(err, "invalid client address")
 		}
-		if err := m.payFromModule(ctx, types.ModuleAccountEscrow, clientAddr, refund); err != nil {
+		if err := m.payFromModule(ctx, types.ModuleAccountEscrow, clientAddr, contract.PaymentDenom(), refund); err != nil {
 			return nil, err
 		}
 	}
 
+	// A contract still ACTIVE here never passed through ClaimPayment's
+	// completion, so its outcome has not been counted yet.
+	uncounted := contract.Status == types.ContractStatus_CONTRACT_STATUS_ACTIVE
 	contract.Status = types.ContractStatus_CONTRACT_STATUS_FINALIZED
 	contract.EscrowUlmn = sdkmath.ZeroInt().String()
+	contract.UsageWithheldUlmn = ""
 	contract.NextPayoutTime = 0
 
 	if err := m.setContract(ctx, contract); err != nil {
@@ -552,6 +624,16 @@ func (m msgServer) FinalizeContract(ctx context.Context, msg *types.MsgFinalizeC
 	if err := m.setGateway(ctx, gateway); err != nil {
 		return nil, err
 	}
+	if err := m.releaseOfferSlot(ctx, contract); err != nil {
+		return nil, err
+	}
+	if uncounted {
+		if err := m.recordOutcome(ctx, gateway.Id, func(rep *types.GatewayReputation) {
+			rep.ContractsCompleted++
+		}); err != nil {
+			return nil, err
+		}
+	}
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	sdkCtx.EventManager().EmitEvent(
@@ -567,3 +649,1744 @@ func (m msgServer) FinalizeContract(ctx context.Context, msg *types.MsgFinalizeC
 
 	return &types.MsgFinalizeContractResponse{RewardUlmn: reward.String()}, nil
 }
+
+func (m msgServer) BindDomain(ctx context.Context, msg *types.MsgBindDomain) (*types.MsgBindDomainResponse, error) {
+	if _, err := m.addressCodec.StringToBytes(msg.Operator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid operator")
+	}
+	domain, err := types.NormalizeGatewayEndpoint(msg.Domain)
+	if err != nil {
+		return nil, err
+	}
+	if domain == "" {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "domain required")
+	}
+
+	gateway, err := m.gatewayByID(ctx, msg.GatewayId)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrNotFound, "gateway not found")
+	}
+	if gateway.Operator != msg.Operator {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "operator mismatch")
+	}
+
+	owner, ok, err := m.gatewayOwnsDomain(ctx, gateway, domain)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrDomainNotOwned, err.Error())
+	}
+	if !ok {
+		return nil, errorsmod.Wrapf(types.ErrDomainNotOwned, "%s must be active and owned by the gateway operator or payout", domain)
+	}
+
+	has, err := m.hasDomainBinding(ctx, domain, gateway.Id)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		count, err := m.liveGatewayDomainCount(ctx, gateway)
+		if err != nil {
+			return nil, err
+		}
+		if count >= types.MaxDomainsPerGateway {
+			return nil, errorsmod.Wrapf(types.ErrOutOfBounds, "gateway already binds %d domains", types.MaxDomainsPerGateway)
+		}
+	}
+
+	if err := m.collectActionFee(ctx, msg.Operator); err != nil {
+		return nil, err
+	}
+
+	binding := types.DomainBinding{
+		Domain:    domain,
+		GatewayId: gateway.Id,
+		Owner:     owner,
+		BoundAt:   uint64(m.nowUnix(ctx)),
+	}
+	if err := m.setDomainBinding(ctx, binding); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			"gateway_domain_bind",
+			sdk.NewAttribute("domain", domain),
+			sdk.NewAttribute("gateway_id", fmt.Sprintf("%d", gateway.Id)),
+			sdk.NewAttribute("owner", owner),
+		),
+	)
+	return &types.MsgBindDomainResponse{}, nil
+}
+
+func (m msgServer) UnbindDomain(ctx context.Context, msg *types.MsgUnbindDomain) (*types.MsgUnbindDomainResponse, error) {
+	if _, err := m.addressCodec.StringToBytes(msg.Operator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid operator")
+	}
+	domain, err := types.NormalizeGatewayEndpoint(msg.Domain)
+	if err != nil {
+		return nil, err
+	}
+
+	gateway, err := m.gatewayByID(ctx, msg.GatewayId)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrNotFound, "gateway not found")
+	}
+	if gateway.Operator != msg.Operator {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "operator mismatch")
+	}
+	has, err := m.hasDomainBinding(ctx, domain, gateway.Id)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, errorsmod.Wrap(types.ErrNotFound, "domain binding not found")
+	}
+
+	if err := m.removeDomainBinding(ctx, domain, gateway.Id); err != nil {
+		return nil, err
+	}
+	emitDomainUnbind(ctx, domain, gateway.Id, "operator")
+	return &types.MsgUnbindDomainResponse{}, nil
+}
+
+func (m msgServer) SubmitUsageReport(ctx context.Context, msg *types.MsgSubmitUsageReport) (*types.MsgSubmitUsageReportResponse, error) {
+	if _, err := m.addressCodec.StringToBytes(msg.Operator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid operator")
+	}
+	if err := types.ValidateEvidenceHash(msg.EvidenceHash); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, err.Error())
+	}
+
+	contract, err := m.contractByID(ctx, msg.ContractId)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrNotFound, "contract not found")
+	}
+	gateway, err := m.gatewayByID(ctx, contract.GatewayId)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrNotFound, "gateway not found")
+	}
+	if gateway.Operator != msg.Operator {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "operator mismatch")
+	}
+	if contract.Status != types.ContractStatus_CONTRACT_STATUS_ACTIVE &&
+		contract.Status != types.ContractStatus_CONTRACT_STATUS_COMPLETED {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "contract not active")
+	}
+	if msg.Month == 0 || msg.Month > contract.MonthsTotal {
+		return nil, errorsmod.Wrap(types.ErrOutOfBounds, "month outside contract term")
+	}
+	if msg.Month <= contract.ClaimedMonths {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "month already claimed")
+	}
+
+	params := m.GetParams(ctx)
+	if params.MonthSeconds == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "invalid month_seconds param")
+	}
+	monthEnd, err := m.contractOffsetTime(contract, uint64(msg.Month), params.MonthSeconds)
+	if err != nil {
+		return nil, err
+	}
+	now := uint64(m.nowUnix(ctx))
+	if now < monthEnd {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "month not yet ended")
+	}
+
+	existing, found, err := m.usageReport(ctx, contract.Id, msg.Month)
+	if err != nil {
+		return nil, err
+	}
+	if found && existing.Status == types.UsageReportStatus_USAGE_REPORT_STATUS_ACCEPTED {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "usage report already accepted")
+	}
+
+	deadline, err := m.safeAddUint64(now, params.UsageDisputeWindowSeconds)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.collectActionFee(ctx, msg.Operator); err != nil {
+		return nil, err
+	}
+
+	// A resubmission replaces a pending or disputed report and reopens the window.
+	report := types.UsageReport{
+		ContractId:      contract.Id,
+		Month:           msg.Month,
+		StorageGb:       msg.StorageGb,
+		NetworkGb:       msg.NetworkGb,
+		EvidenceHash:    msg.EvidenceHash,
+		Status:          types.UsageReportStatus_USAGE_REPORT_STATUS_PENDING,
+		SubmittedAt:     now,
+		DisputeDeadline: deadline,
+	}
+	if err := m.setUsageReport(ctx, report); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			"usage_report_submit",
+			sdk.NewAttribute("contract_id", fmt.Sprintf("%d", contract.Id)),
+			sdk.NewAttribute("gateway_id", fmt.Sprintf("%d", contract.GatewayId)),
+			sdk.NewAttribute("month", fmt.Sprintf("%d", report.Month)),
+			sdk.NewAttribute("storage_gb", fmt.Sprintf("%d", report.StorageGb)),
+			sdk.NewAttribute("network_gb", fmt.Sprintf("%d", report.NetworkGb)),
+			sdk.NewAttribute("evidence_hash", report.EvidenceHash),
+			sdk.NewAttribute("dispute_deadline", fmt.Sprintf("%d", report.DisputeDeadline)),
+		),
+	)
+	return &types.MsgSubmitUsageReportResponse{DisputeDeadline: deadline}, nil
+}
+
+func (m msgServer) AcknowledgeUsageReport(ctx context.Context, msg *types.MsgAcknowledgeUsageReport) (*types.MsgAcknowledgeUsageReportResponse, error) {
+	if _, err := m.addressCodec.StringToBytes(msg.Client); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid client")
+	}
+	contract, report, err := m.clientUsageReport(ctx, msg.Client, msg.ContractId, msg.Month)
+	if err != nil {
+		return nil, err
+	}
+	if report.Status != types.UsageReportStatus_USAGE_REPORT_STATUS_PENDING {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "usage report not pending")
+	}
+
+	report.Status = types.UsageReportStatus_USAGE_REPORT_STATUS_ACCEPTED
+	if err := m.setUsageReport(ctx, report); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			"usage_report_acknowledge",
+			sdk.NewAttribute("contract_id", fmt.Sprintf("%d", contract.Id)),
+			sdk.NewAttribute("month", fmt.Sprintf("%d", report.Month)),
+			sdk.NewAttribute("client", msg.Client),
+		),
+	)
+	return &types.MsgAcknowledgeUsageReportResponse{}, nil
+}
+
+func (m msgServer) DisputeUsageReport(ctx context.Context, msg *types.MsgDisputeUsageReport) (*types.MsgDisputeUsageReportResponse, error) {
+	if _, err := m.addressCodec.StringToBytes(msg.Client); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid client")
+	}
+	contract, report, err := m.clientUsageReport(ctx, msg.Client, msg.ContractId, msg.Month)
+	if err != nil {
+		return nil, err
+	}
+	if report.Status != types.UsageReportStatus_USAGE_REPORT_STATUS_PENDING {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "usage report not pending")
+	}
+	now := uint64(m.nowUnix(ctx))
+	if now >= report.DisputeDeadline {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "dispute window closed")
+	}
+	// The dispute goes to the arbiters as a contract dispute, which freezes
+	// claims until a ruling settles the escrow. If it times out unruled the
+	// month settles at the reported usage.
+	var dispute types.Dispute
+	frozen, err := m.contractFrozen(ctx, contract, now)
+	if err != nil {
+		return nil, err
+	}
+	if frozen {
+		dispute, err = m.disputeByID(ctx, contract.DisputeId)
+	} else {
+		reason := fmt.Sprintf("usage report for month %d", report.Month)
+		if msg.Reason != "" {
+			reason += ": " + msg.Reason
+		}
+		dispute, err = m.openContractDispute(ctx, contract, reason, "")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	report.Status = types.UsageReportStatus_USAGE_REPORT_STATUS_DISPUTED
+	report.DisputeReason = msg.Reason
+	report.DisputeDeadline = dispute.Deadline
+	if err := m.setUsageReport(ctx, report); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			"usage_report_dispute",
+			sdk.NewAttribute("contract_id", fmt.Sprintf("%d", contract.Id)),
+			sdk.NewAttribute("gateway_id", fmt.Sprintf("%d", contract.GatewayId)),
+			sdk.NewAttribute("month", fmt.Sprintf("%d", report.Month)),
+			sdk.NewAttribute("client", msg.Client),
+			sdk.NewAttribute("dispute_id", fmt.Sprintf("%d", dispute.Id)),
+			sdk.NewAttribute("dispute_deadline", fmt.Sprintf("%d", dispute.Deadline)),
+		),
+	)
+	return &types.MsgDisputeUsageReportResponse{DisputeId: dispute.Id}, nil
+}
+
+func (m msgServer) OpenDispute(ctx context.Context, msg *types.MsgOpenDispute) (*types.MsgOpenDisputeResponse, error) {
+	if _, err := m.addressCodec.StringToBytes(msg.Client); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid client")
+	}
+	if err := types.ValidateEvidenceHash(msg.EvidenceHash); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, err.Error())
+	}
+	contract, err := m.contractByID(ctx, msg.ContractId)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrNotFound, "contract not found")
+	}
+	if contract.Client != msg.Client {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "not contract owner")
+	}
+	dispute, err := m.openContractDispute(ctx, contract, msg.Reason, msg.EvidenceHash)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgOpenDisputeResponse{DisputeId: dispute.Id, Deadline: dispute.Deadline}, nil
+}
+
+func (m msgServer) SubmitDisputeEvidence(ctx context.Context, msg *types.MsgSubmitDisputeEvidence) (*types.MsgSubmitDisputeEvidenceResponse, error) {
+	if _, err := m.addressCodec.StringToBytes(msg.Submitter); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid submitter")
+	}
+	if msg.EvidenceHash == "" {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "evidence_hash required")
+	}
+	if err := types.ValidateEvidenceHash(msg.EvidenceHash); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, err.Error())
+	}
+	dispute, err := m.disputeByID(ctx, msg.DisputeId)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrNotFound, "dispute not found")
+	}
+	now := uint64(m.nowUnix(ctx))
+	if dispute.Status != types.DisputeStatus_DISPUTE_STATUS_OPEN || now >= dispute.Deadline {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "dispute not open")
+	}
+	if msg.Submitter != dispute.Client {
+		gateway, err := m.gatewayByID(ctx, dispute.GatewayId)
+		if err != nil {
+			return nil, errorsmod.Wrap(types.ErrNotFound, "gateway not found")
+		}
+		if msg.Submitter != gateway.Operator {
+			return nil, errorsmod.Wrap(types.ErrUnauthorized, "only the client or gateway operator may submit evidence")
+		}
+	}
+	if len(dispute.Evidence) >= types.MaxDisputeEvidence {
+		return nil, errorsmod.Wrapf(types.ErrOutOfBounds, "dispute already holds %d evidence entries", types.MaxDisputeEvidence)
+	}
+	for _, ev := range dispute.Evidence {
+		if ev.Hash == msg.EvidenceHash {
+			return nil, errorsmod.Wrap(types.ErrInvalidRequest, "evidence already submitted")
+		}
+	}
+
+	dispute.Evidence = append(dispute.Evidence, &types.DisputeEvidence{Submitter: msg.Submitter, Hash: msg.EvidenceHash, SubmittedAt: now})
+	if err := m.setDispute(ctx, dispute); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			"contract_dispute_evidence",
+			sdk.NewAttribute("dispute_id", fmt.Sprintf("%d", dispute.Id)),
+			sdk.NewAttribute("submitter", msg.Submitter),
+			sdk.NewAttribute("evidence_hash", msg.EvidenceHash),
+		),
+	)
+	return &types.MsgSubmitDisputeEvidenceResponse{}, nil
+}
+
+func (m msgServer) ResolveDispute(ctx context.Context, msg *types.MsgResolveDispute) (*types.MsgResolveDisputeResponse, error) {
+	arbiter, err := m.addressCodec.StringToBytes(msg.Arbiter)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid arbiter")
+	}
+	if msg.ClientRefundBps > 10_000 {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "client_refund_bps must be <= 10000")
+	}
+	params := m.GetParams(ctx)
+	if !bytes.Equal(m.GetAuthority(), arbiter) && !params.IsArbiter(msg.Arbiter) {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "not an arbiter")
+	}
+
+	dispute, err := m.disputeByID(ctx, msg.DisputeId)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrNotFound, "dispute not found")
+	}
+	now := uint64(m.nowUnix(ctx))
+	if dispute.Status != types.DisputeStatus_DISPUTE_STATUS_OPEN || now >= dispute.Deadline {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "dispute not open")
+	}
+	contract, err := m.contractByID(ctx, dispute.ContractId)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrNotFound, "contract not found")
+	}
+	gateway, err := m.gatewayByID(ctx, contract.GatewayId)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrNotFound, "gateway not found")
+	}
+	if msg.Arbiter == contract.Client || msg.Arbiter == gateway.Operator || msg.Arbiter == gateway.Payout {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "arbiter is a party to the dispute")
+	}
+
+	// Escrow withheld for undelivered usage is the client's regardless of the
+	// ruling; the arbiter splits the remainder.
+	escrow := m.safeAmountFromString(contract.EscrowUlmn)
+	withheld := m.safeAmountFromString(contract.UsageWithheldUlmn)
+	if withheld.GT(escrow) {
+		withheld = escrow
+	}
+	contested := escrow.Sub(withheld)
+	clientShare := contested.MulRaw(int64(msg.ClientRefundBps)).QuoRaw(10_000)
+	refund := withheld.Add(clientShare)
+	gross := contested.Sub(clientShare)
+
+	commission := m.applyCommission(gross, params.PlatformCommissionBps)
+	if commission.GT(gross) {
+		return nil, errorsmod.Wrap(types.ErrOverflow, "commission overflow")
+	}
+	payout := gross.Sub(commission)
+
+	clientAddr, err := m.mustAddress(contract.Client)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid client address")
+	}
+	if err := m.payFromModule(ctx, types.ModuleAccountEscrow, clientAddr, contract.PaymentDenom(), refund); err != nil {
+		return nil, err
+	}
+	if payout.IsPositive() {
+		payoutAddr := strings.TrimSpace(gateway.Payout)
+		if payoutAddr == "" {
+			payoutAddr = gateway.Operator
+		}
+		gatewayAddr, err := m.mustAddress(payoutAddr)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "invalid gateway payout address")
+		}
+		if err := m.payFromModule(ctx, types.ModuleAccountEscrow, gatewayAddr, contract.PaymentDenom(), payout); err != nil {
+			return nil, err
+		}
+	}
+	if err := m.creditTreasury(ctx, types.ModuleAccountEscrow, contract.PaymentDenom(), commission); err != nil {
+		return nil, err
+	}
+
+	dispute.ClientRefundBps = msg.ClientRefundBps
+	dispute.Arbiter = msg.Arbiter
+	dispute.Ruling = msg.Ruling
+	if err := m.closeDispute(ctx, dispute, types.DisputeStatus_DISPUTE_STATUS_RESOLVED, now); err != nil {
+		return nil, err
+	}
+
+	// The ruling settles the contract: whatever escrow was left is now paid out.
+	contract, err = m.contractByID(ctx, contract.Id)
+	if err != nil {
+		return nil, err
+	}
+	wasOpen := contract.Status == types.ContractStatus_CONTRACT_STATUS_ACTIVE ||
+		contract.Status == types.ContractStatus_CONTRACT_STATUS_COMPLETED
+	contract.Status = types.ContractStatus_CONTRACT_STATUS_CANCELED
+	contract.EscrowUlmn = sdkmath.ZeroInt().String()
+	contract.UsageWithheldUlmn = ""
+	contract.NextPayoutTime = 0
+	if err := m.setContract(ctx, contract); err != nil {
+		return nil, err
+	}
+	if wasOpen {
+		if gateway.ActiveClients > 0 {
+			gateway.ActiveClients--
+			if err := m.setGateway(ctx, gateway); err != nil {
+				return nil, err
+			}
+		}
+		if err := m.releaseOfferSlot(ctx, contract); err != nil {
+			return nil, err
+		}
+	}
+
+	// The gateway's stake is slashed in proportion to how far the ruling
+	// went against it.
+	slashed := sdkmath.ZeroInt()
+	if params.DisputeSlashBps > 0 && msg.ClientRefundBps > 0 {
+		bond, err := m.gatewayBond(ctx, gateway.Id)
+		if err != nil {
+			return nil, err
+		}
+		slash := m.slashableBond(bond).MulRaw(int64(params.DisputeSlashBps)).MulRaw(int64(msg.ClientRefundBps)).QuoRaw(10_000 * 10_000)
+		if slashed, err = m.slashGatewayBond(ctx, gateway.Id, slash, "dispute"); err != nil {
+			return nil, err
+		}
+	}
+	// A ruling that returns most of the contested escrow counts as a lost
+	// dispute for the gateway's reputation.
+	if msg.ClientRefundBps > 5_000 {
+		if err := m.recordOutcome(ctx, gateway.Id, func(rep *types.GatewayReputation) {
+			rep.DisputesLost++
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			"contract_dispute_resolve",
+			sdk.NewAttribute("dispute_id", fmt.Sprintf("%d", dispute.Id)),
+			sdk.NewAttribute("contract_id", fmt.Sprintf("%d", contract.Id)),
+			sdk.NewAttribute("gateway_id", fmt.Sprintf("%d", contract.GatewayId)),
+			sdk.NewAttribute("arbiter", msg.Arbiter),
+			sdk.NewAttribute("client_refund_bps", fmt.Sprintf("%d", msg.ClientRefundBps)),
+			sdk.NewAttribute("client_refund_ulmn", refund.String()),
+			sdk.NewAttribute("gateway_payout_ulmn", payout.String()),
+			sdk.NewAttribute("fee_ulmn", commission.String()),
+			sdk.NewAttribute("slashed_ulmn", slashed.String()),
+		),
+	)
+	return &types.MsgResolveDisputeResponse{ClientRefundUlmn: refund.String(), GatewayPayoutUlmn: payout.String()}, nil
+}
+
+func (m msgServer) BondGateway(ctx context.Context, msg *types.MsgBondGateway) (*types.MsgBondGatewayResponse, error) {
+	if _, err := m.addressCodec.StringToBytes(msg.Operator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid operator")
+	}
+	amount, err := types.ParseBondAmount(msg.AmountUlmn)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, err.Error())
+	}
+	gateway, err := m.gatewayByID(ctx, msg.GatewayId)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrNotFound, "gateway not found")
+	}
+	if gateway.Operator != msg.Operator {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "operator mismatch")
+	}
+
+	operatorAddr, err := m.mustAddress(msg.Operator)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid operator address")
+	}
+	if err := m.moveToModule(ctx, operatorAddr, types.ModuleAccountBond, denom.BaseDenom, amount); err != nil {
+		return nil, err
+	}
+
+	bond, err := m.gatewayBond(ctx, gateway.Id)
+	if err != nil {
+		return nil, err
+	}
+	bond.BondedUlmn = m.safeAmountFromString(bond.BondedUlmn).Add(amount).String()
+	if err := m.setGatewayBond(ctx, bond); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			"gateway_bond",
+			sdk.NewAttribute("gateway_id", fmt.Sprintf("%d", gateway.Id)),
+			sdk.NewAttribute("amount_ulmn", amount.String()),
+			sdk.NewAttribute("bonded_ulmn", bond.BondedUlmn),
+		),
+	)
+	return &types.MsgBondGatewayResponse{BondedUlmn: bond.BondedUlmn}, nil
+}
+
+func (m msgServer) UnbondGateway(ctx context.Context, msg *types.MsgUnbondGateway) (*types.MsgUnbondGatewayResponse, error) {
+	if _, err := m.addressCodec.StringToBytes(msg.Operator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid operator")
+	}
+	amount, err := types.ParseBondAmount(msg.AmountUlmn)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, err.Error())
+	}
+	gateway, err := m.gatewayByID(ctx, msg.GatewayId)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrNotFound, "gateway not found")
+	}
+	if gateway.Operator != msg.Operator {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "operator mismatch")
+	}
+
+	params := m.GetParams(ctx)
+	bond, err := m.gatewayBond(ctx, gateway.Id)
+	if err != nil {
+		return nil, err
+	}
+	bonded := m.safeAmountFromString(bond.BondedUlmn)
+	required := requiredBond(params, gateway)
+	if bonded.Sub(amount).LT(required) {
+		return nil, errorsmod.Wrapf(types.ErrInsufficientBond, "gateway must keep %s%s bonded", required, denom.BaseDenom)
+	}
+
+	// Further unbonding restarts the delay for the whole unbonding amount.
+	completeAt, err := m.safeAddUint64(uint64(m.nowUnix(ctx)), params.UnbondingDelaySeconds)
+	if err != nil {
+		return nil, err
+	}
+	bond.BondedUlmn = bonded.Sub(amount).String()
+	bond.UnbondingUlmn = m.safeAmountFromString(bond.UnbondingUlmn).Add(amount).String()
+	bond.UnbondingCompleteAt = completeAt
+	if err := m.setGatewayBond(ctx, bond); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			"gateway_unbond",
+			sdk.NewAttribute("gateway_id", fmt.Sprintf("%d", gateway.Id)),
+			sdk.NewAttribute("amount_ulmn", amount.String()),
+			sdk.NewAttribute("complete_at", fmt.Sprintf("%d", completeAt)),
+		),
+	)
+	return &types.MsgUnbondGatewayResponse{CompleteAt: completeAt}, nil
+}
+
+func (m msgServer) WithdrawBond(ctx context.Context, msg *types.MsgWithdrawBond) (*types.MsgWithdrawBondResponse, error) {
+	if _, err := m.addressCodec.StringToBytes(msg.Operator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid operator")
+	}
+	gateway, err := m.gatewayByID(ctx, msg.GatewayId)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrNotFound, "gateway not found")
+	}
+	if gateway.Operator != msg.Operator {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "operator mismatch")
+	}
+	bond, err := m.gatewayBond(ctx, gateway.Id)
+	if err != nil {
+		return nil, err
+	}
+	amount := m.safeAmountFromString(bond.UnbondingUlmn)
+	if !amount.IsPositive() {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "nothing unbonding")
+	}
+	if uint64(m.nowUnix(ctx)) < bond.UnbondingCompleteAt {
+		return nil, errorsmod.Wrapf(types.ErrInvalidRequest, "unbonding completes at %d", bond.UnbondingCompleteAt)
+	}
+
+	operatorAddr, err := m.mustAddress(msg.Operator)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid operator address")
+	}
+	if err := m.payFromModule(ctx, types.ModuleAccountBond, operatorAddr, denom.BaseDenom, amount); err != nil {
+		return nil, err
+	}
+	bond.UnbondingUlmn = sdkmath.ZeroInt().String()
+	bond.UnbondingCompleteAt = 0
+	if err := m.setGatewayBond(ctx, bond); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			"gateway_bond_withdraw",
+			sdk.NewAttribute("gateway_id", fmt.Sprintf("%d", gateway.Id)),
+			sdk.NewAttribute("amount_ulmn", amount.String()),
+		),
+	)
+	return &types.MsgWithdrawBondResponse{AmountUlmn: amount.String()}, nil
+}
+
+func (m msgServer) CreateOffer(ctx context.Context, msg *types.MsgCreateOffer) (*types.MsgCreateOfferResponse, error) {
+	if _, err := m.addressCodec.StringToBytes(msg.Operator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid operator")
+	}
+	if err := types.ValidateOfferTerms(msg.PriceUlmn, msg.MinMonths, msg.MaxMonths, msg.Regions); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, err.Error())
+	}
+	if msg.CancellationPolicy != nil {
+		if err := types.ValidateCancellationPolicy(*msg.CancellationPolicy); err != nil {
+			return nil, errorsmod.Wrapf(types.ErrInvalidRequest, "invalid cancellation policy: %s", err)
+		}
+	}
+	gateway, err := m.gatewayByID(ctx, msg.GatewayId)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrNotFound, "gateway not found")
+	}
+	if gateway.Operator != msg.Operator {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "operator mismatch")
+	}
+	offerDenom := msg.Denom
+	if offerDenom == "" {
+		offerDenom = denom.BaseDenom
+	}
+	params := m.GetParams(ctx)
+	minPricePerMonth, ok := params.MinPricePerMonth(offerDenom)
+	if !ok {
+		return nil, errorsmod.Wrapf(types.ErrInvalidRequest, "denom %s not accepted for payments", offerDenom)
+	}
+	if !gateway.AcceptsDenom(offerDenom) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidRequest, "gateway does not accept %s", offerDenom)
+	}
+	if msg.PriceUlmn < minPricePerMonth {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "price below minimum %d %s", minPricePerMonth, offerDenom)
+	}
+	active, err := m.activeOfferCount(ctx, gateway.Id)
+	if err != nil {
+		return nil, err
+	}
+	if active >= types.MaxActiveOffersPerGateway {
+		return nil, errorsmod.Wrapf(types.ErrOutOfBounds, "gateway already has %d active offers", active)
+	}
+
+	id, err := m.nextOfferID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	offer := types.Offer{
+		Id:                 id,
+		GatewayId:          gateway.Id,
+		PriceUlmn:          msg.PriceUlmn,
+		StorageGbPerMonth:  msg.StorageGbPerMonth,
+		NetworkGbPerMonth:  msg.NetworkGbPerMonth,
+		MinMonths:          msg.MinMonths,
+		MaxMonths:          msg.MaxMonths,
+		Regions:            msg.Regions,
+		CapacitySlots:      msg.CapacitySlots,
+		CreatedAt:          uint64(m.nowUnix(ctx)),
+		Denom:              offerDenom,
+		CancellationPolicy: msg.CancellationPolicy,
+	}
+	if err := m.setOffer(ctx, offer); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			"gateway_offer_create",
+			sdk.NewAttribute("offer_id", fmt.Sprintf("%d", id)),
+			sdk.NewAttribute("gateway_id", fmt.Sprintf("%d", gateway.Id)),
+			sdk.NewAttribute("price_ulmn", fmt.Sprintf("%d", msg.PriceUlmn)),
+			sdk.NewAttribute("denom", offerDenom),
+			sdk.NewAttribute("capacity_slots", fmt.Sprintf("%d", msg.CapacitySlots)),
+		),
+	)
+	return &types.MsgCreateOfferResponse{OfferId: id}, nil
+}
+
+func (m msgServer) RetireOffer(ctx context.Context, msg *types.MsgRetireOffer) (*types.MsgRetireOfferResponse, error) {
+	if _, err := m.addressCodec.StringToBytes(msg.Operator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid operator")
+	}
+	offer, err := m.offerByID(ctx, msg.OfferId)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrNotFound, "offer not found")
+	}
+	gateway, err := m.gatewayByID(ctx, offer.GatewayId)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrNotFound, "gateway not found")
+	}
+	if gateway.Operator != msg.Operator {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "operator mismatch")
+	}
+	if offer.Retired {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "offer already retired")
+	}
+	offer.Retired = true
+	if err := m.setOffer(ctx, offer); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			"gateway_offer_retire",
+			sdk.NewAttribute("offer_id", fmt.Sprintf("%d", offer.Id)),
+			sdk.NewAttribute("gateway_id", fmt.Sprintf("%d", offer.GatewayId)),
+		),
+	)
+	return &types.MsgRetireOfferResponse{}, nil
+}
+
+func (m msgServer) ExtendContract(ctx context.Context, msg *types.MsgExtendContract) (*types.MsgExtendContractResponse, error) {
+	if _, err := m.addressCodec.StringToBytes(msg.Client); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid client")
+	}
+	if msg.AdditionalMonths == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "additional_months must be > 0")
+	}
+	contract, err := m.contractByID(ctx, msg.ContractId)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrNotFound, "contract not found")
+	}
+	if contract.Client != msg.Client {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "not contract owner")
+	}
+	if contract.Status != types.ContractStatus_CONTRACT_STATUS_ACTIVE {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "contract not active")
+	}
+	params := m.GetParams(ctx)
+	if params.MonthSeconds == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "invalid month_seconds param")
+	}
+	now := uint64(m.nowUnix(ctx))
+	frozen, err := m.contractFrozen(ctx, contract, now)
+	if err != nil {
+		return nil, err
+	}
+	if frozen {
+		return nil, errorsmod.Wrapf(types.ErrDisputeOpen, "dispute %d", contract.DisputeId)
+	}
+	// Extending only continues service without a gap; a contract that has
+	// already run out needs a new one.
+	endTime, err := m.contractOffsetTime(contract, uint64(contract.MonthsTotal), params.MonthSeconds)
+	if err != nil {
+		return nil, err
+	}
+	if now >= endTime {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "contract term already ended")
+	}
+	gateway, err := m.gatewayByID(ctx, contract.GatewayId)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrNotFound, "gateway not found")
+	}
+	if gateway.DecommissionAt != 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "gateway is decommissioning")
+	}
+	monthsTotal := uint64(contract.MonthsTotal) + uint64(msg.AdditionalMonths)
+	if monthsTotal > math.MaxUint32 {
+		return nil, errorsmod.Wrap(types.ErrOverflow, "months_total overflow")
+	}
+	if contract.OfferId != 0 {
+		offer, err := m.offerByID(ctx, contract.OfferId)
+		if err != nil {
+			return nil, errorsmod.Wrap(types.ErrNotFound, "offer not found")
+		}
+		if offer.MaxMonths != 0 && monthsTotal > uint64(offer.MaxMonths) {
+			return nil, errorsmod.Wrapf(types.ErrOutOfBounds, "offer allows at most %d months", offer.MaxMonths)
+		}
+	}
+
+	clientAddr, err := m.mustAddress(msg.Client)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid client address")
+	}
+	deposit := sdkmath.NewIntFromUint64(contract.PriceUlmn).MulRaw(int64(msg.AdditionalMonths))
+	tax, err := m.depositToEscrow(ctx, clientAddr, contract.PaymentDenom(), deposit)
+	if err != nil {
+		return nil, err
+	}
+
+	contract.MonthsTotal = uint32(monthsTotal)
+	contract.EscrowUlmn = m.safeAmountFromString(contract.EscrowUlmn).Add(deposit).String()
+	contract.NextPayoutTime, err = m.contractOffsetTime(contract, uint64(contract.ClaimedMonths)+1, params.MonthSeconds)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.setContract(ctx, contract); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			"contract_extend",
+			sdk.NewAttribute("contract_id", fmt.Sprintf("%d", contract.Id)),
+			sdk.NewAttribute("gateway_id", fmt.Sprintf("%d", contract.GatewayId)),
+			sdk.NewAttribute("client", msg.Client),
+			sdk.NewAttribute("additional_months", fmt.Sprintf("%d", msg.AdditionalMonths)),
+			sdk.NewAttribute("months_total", fmt.Sprintf("%d", contract.MonthsTotal)),
+			sdk.NewAttribute("deposit_ulmn", deposit.String()),
+			sdk.NewAttribute("tax_ulmn", tax.String()),
+		),
+	)
+	return &types.MsgExtendContractResponse{MonthsTotal: contract.MonthsTotal, EscrowUlmn: contract.EscrowUlmn}, nil
+}
+
+func (m msgServer) AmendContract(ctx context.Context, msg *types.MsgAmendContract) (*types.MsgAmendContractResponse, error) {
+	if _, err := m.addressCodec.StringToBytes(msg.Client); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid client")
+	}
+	contract, err := m.contractByID(ctx, msg.ContractId)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrNotFound, "contract not found")
+	}
+	if contract.Client != msg.Client {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "not contract owner")
+	}
+	if contract.Status != types.ContractStatus_CONTRACT_STATUS_ACTIVE {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "contract not active")
+	}
+	frozen, err := m.contractFrozen(ctx, contract, uint64(m.nowUnix(ctx)))
+	if err != nil {
+		return nil, err
+	}
+	if frozen {
+		return nil, errorsmod.Wrapf(types.ErrDisputeOpen, "dispute %d", contract.DisputeId)
+	}
+	params := m.GetParams(ctx)
+	minPricePerMonth, ok := params.MinPricePerMonth(contract.PaymentDenom())
+	if !ok {
+		return nil, errorsmod.Wrapf(types.ErrInvalidRequest, "denom %s no longer accepted for payments", contract.PaymentDenom())
+	}
+	if msg.PriceUlmn == 0 || msg.PriceUlmn < minPricePerMonth {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "price below minimum %d %s", minPricePerMonth, contract.PaymentDenom())
+	}
+
+	// The amendment is priced like a new contract: net of the send tax.
+	price := sdkmath.NewIntFromUint64(msg.PriceUlmn)
+	net := price.Sub(price.MulRaw(int64(m.txTaxRateBps(ctx))).QuoRaw(10_000))
+	contract.PendingAmendment = &types.ContractAmendment{
+		PriceUlmn:         net.Uint64(),
+		StorageGbPerMonth: msg.StorageGbPerMonth,
+		NetworkGbPerMonth: msg.NetworkGbPerMonth,
+		ProposedAt:        uint64(m.nowUnix(ctx)),
+	}
+	if err := m.setContract(ctx, contract); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			"contract_amend_propose",
+			sdk.NewAttribute("contract_id", fmt.Sprintf("%d", contract.Id)),
+			sdk.NewAttribute("gateway_id", fmt.Sprintf("%d", contract.GatewayId)),
+			sdk.NewAttribute("client", msg.Client),
+			sdk.NewAttribute("price_ulmn", net.String()),
+			sdk.NewAttribute("storage_gb_per_month", fmt.Sprintf("%d", msg.StorageGbPerMonth)),
+			sdk.NewAttribute("network_gb_per_month", fmt.Sprintf("%d", msg.NetworkGbPerMonth)),
+		),
+	)
+	return &types.MsgAmendContractResponse{}, nil
+}
+
+func (m msgServer) AcceptAmendment(ctx context.Context, msg *types.MsgAcceptAmendment) (*types.MsgAcceptAmendmentResponse, error) {
+	if _, err := m.addressCodec.StringToBytes(msg.Operator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid operator")
+	}
+	contract, err := m.contractByID(ctx, msg.ContractId)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrNotFound, "contract not found")
+	}
+	gateway, err := m.gatewayByID(ctx, contract.GatewayId)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrNotFound, "gateway not found")
+	}
+	if gateway.Operator != msg.Operator {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "operator mismatch")
+	}
+	if contract.Status != types.ContractStatus_CONTRACT_STATUS_ACTIVE {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "contract not active")
+	}
+	amendment := contract.PendingAmendment
+	if amendment == nil {
+		return nil, errorsmod.Wrap(types.ErrNotFound, "no pending amendment")
+	}
+	params := m.GetParams(ctx)
+	if params.MonthSeconds == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "invalid month_seconds param")
+	}
+	now := uint64(m.nowUnix(ctx))
+	frozen, err := m.contractFrozen(ctx, contract, now)
+	if err != nil {
+		return nil, err
+	}
+	if frozen {
+		return nil, errorsmod.Wrapf(types.ErrDisputeOpen, "dispute %d", contract.DisputeId)
+	}
+	// Elapsed months keep the old terms, so they must be claimed before the
+	// new price applies to the rest of the contract.
+	var elapsed uint64
+	if now > contract.StartTime {
+		elapsed = min((now-contract.StartTime)/params.MonthSeconds, uint64(contract.MonthsTotal))
+	}
+	if elapsed > uint64(contract.ClaimedMonths) {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "claim elapsed months before amending")
+	}
+	remaining := int64(contract.MonthsTotal - contract.ClaimedMonths)
+
+	oldPrice := sdkmath.NewIntFromUint64(contract.PriceUlmn)
+	newPrice := sdkmath.NewIntFromUint64(amendment.PriceUlmn)
+	escrow := m.safeAmountFromString(contract.EscrowUlmn)
+	charged, refunded := sdkmath.ZeroInt(), sdkmath.ZeroInt()
+	clientAddr, err := m.mustAddress(contract.Client)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid client address")
+	}
+	switch {
+	case newPrice.GT(oldPrice):
+		charged = newPrice.Sub(oldPrice).MulRaw(remaining)
+		if _, err := m.depositToEscrow(ctx, clientAddr, contract.PaymentDenom(), charged); err != nil {
+			return nil, errorsmod.Wrap(types.ErrInsufficientFunds, err.Error())
+		}
+		escrow = escrow.Add(charged)
+	case newPrice.LT(oldPrice):
+		refunded = oldPrice.Sub(newPrice).MulRaw(remaining)
+		if escrow.LT(refunded) {
+			return nil, errorsmod.Wrap(types.ErrInsufficientFunds, "escrow mismatch")
+		}
+		if err := m.payFromModule(ctx, types.ModuleAccountEscrow, clientAddr, contract.PaymentDenom(), refunded); err != nil {
+			return nil, err
+		}
+		escrow = escrow.Sub(refunded)
+	}
+
+	contract.PriceUlmn = amendment.PriceUlmn
+	contract.StorageGbPerMonth = amendment.StorageGbPerMonth
+	contract.NetworkGbPerMonth = amendment.NetworkGbPerMonth
+	contract.EscrowUlmn = escrow.String()
+	contract.PendingAmendment = nil
+	contract.NextPayoutTime, err = m.contractOffsetTime(contract, uint64(contract.ClaimedMonths)+1, params.MonthSeconds)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.setContract(ctx, contract); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			"contract_amend",
+			sdk.NewAttribute("contract_id", fmt.Sprintf("%d", contract.Id)),
+			sdk.NewAttribute("gateway_id", fmt.Sprintf("%d", contract.GatewayId)),
+			sdk.NewAttribute("operator", msg.Operator),
+			sdk.NewAttribute("price_ulmn", fmt.Sprintf("%d", contract.PriceUlmn)),
+			sdk.NewAttribute("charged_ulmn", charged.String()),
+			sdk.NewAttribute("refunded_ulmn", refunded.String()),
+		),
+	)
+	return &types.MsgAcceptAmendmentResponse{ChargedUlmn: charged.String(), RefundedUlmn: refunded.String()}, nil
+}
+
+func (m msgServer) ClaimAll(ctx context.Context, msg *types.MsgClaimAll) (*types.MsgClaimAllResponse, error) {
+	if _, err := m.addressCodec.StringToBytes(msg.Operator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid operator")
+	}
+	gateway, err := m.gatewayByID(ctx, msg.GatewayId)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrNotFound, "gateway not found")
+	}
+	if gateway.Operator != msg.Operator {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "operator mismatch")
+	}
+
+	ids, err := m.dueContracts(ctx, gateway.Id, uint64(m.nowUnix(ctx)), clampLimit(uint64(msg.Limit)))
+	if err != nil {
+		return nil, err
+	}
+	resp := &types.MsgClaimAllResponse{}
+	paid := sdkmath.ZeroInt()
+	for _, id := range ids {
+		contract, err := m.contractByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		amount, err := m.tryClaim(ctx, contract, gateway)
+		if err != nil {
+			resp.Skipped++
+			continue
+		}
+		resp.Claimed++
+		paid = paid.Add(amount)
+	}
+	resp.PaidUlmn = paid.String()
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			"payment_claim_all",
+			sdk.NewAttribute("gateway_id", fmt.Sprintf("%d", gateway.Id)),
+			sdk.NewAttribute("operator", msg.Operator),
+			sdk.NewAttribute("claimed", fmt.Sprintf("%d", resp.Claimed)),
+			sdk.NewAttribute("skipped", fmt.Sprintf("%d", resp.Skipped)),
+			sdk.NewAttribute("pay_amount_ulmn", resp.PaidUlmn),
+		),
+	)
+	return resp, nil
+}
+
+func (m msgServer) AcceptContract(ctx context.Context, msg *types.MsgAcceptContract) (*types.MsgAcceptContractResponse, error) {
+	if _, err := m.addressCodec.StringToBytes(msg.Operator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid operator")
+	}
+	contract, err := m.contractByID(ctx, msg.ContractId)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrNotFound, "contract not found")
+	}
+	gateway, err := m.gatewayByID(ctx, contract.GatewayId)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrNotFound, "gateway not found")
+	}
+	if gateway.Operator != msg.Operator {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "operator mismatch")
+	}
+	if contract.Status != types.ContractStatus_CONTRACT_STATUS_PENDING {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "contract not pending")
+	}
+	now := uint64(m.nowUnix(ctx))
+	if now >= contract.AcceptDeadline {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "acceptance deadline passed")
+	}
+	if !gateway.Active {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "gateway inactive")
+	}
+
+	// Capacity and bond are checked again: the gateway may have taken other
+	// contracts or unbonded since the client created this one.
+	params := m.GetParams(ctx)
+	if params.MaxActiveContractsPerGateway > 0 && gateway.ActiveClients >= params.MaxActiveContractsPerGateway {
+		return nil, errorsmod.Wrapf(types.ErrOutOfBounds, "gateway reached max active contracts (%d)", params.MaxActiveContractsPerGateway)
+	}
+	bond, err := m.gatewayBond(ctx, gateway.Id)
+	if err != nil {
+		return nil, err
+	}
+	if required := params.RequiredBond(gateway.ActiveClients + 1); m.safeAmountFromString(bond.BondedUlmn).LT(required) {
+		return nil, errorsmod.Wrapf(types.ErrInsufficientBond, "gateway must bond at least %s%s", required, denom.BaseDenom)
+	}
+
+	tax := m.safeAmountFromString(contract.PendingTaxUlmn)
+	if err := m.moveModuleToModule(ctx, types.ModuleAccountEscrow, authtypes.FeeCollectorName, contract.PaymentDenom(), tax); err != nil {
+		return nil, err
+	}
+	if err := m.PendingDeadlines.Remove(ctx, collections.Join(contract.AcceptDeadline, contract.Id)); err != nil {
+		return nil, err
+	}
+
+	contract.Status = types.ContractStatus_CONTRACT_STATUS_ACTIVE
+	contract.StartTime = now
+	contract.AcceptDeadline = 0
+	contract.PendingTaxUlmn = ""
+	contract.NextPayoutTime = 0
+	if params.MonthSeconds > 0 {
+		contract.NextPayoutTime, err = m.safeAddUint64(now, params.MonthSeconds)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := m.setContract(ctx, contract); err != nil {
+		return nil, err
+	}
+	gateway.ActiveClients++
+	if err := m.setGateway(ctx, gateway); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			"contract_accept",
+			sdk.NewAttribute("contract_id", fmt.Sprintf("%d", contract.Id)),
+			sdk.NewAttribute("gateway_id", fmt.Sprintf("%d", contract.GatewayId)),
+			sdk.NewAttribute("operator", msg.Operator),
+			sdk.NewAttribute("start_time", fmt.Sprintf("%d", now)),
+			sdk.NewAttribute("tax_ulmn", tax.String()),
+		),
+	)
+	return &types.MsgAcceptContractResponse{StartTime: now}, nil
+}
+
+func (m msgServer) RejectContract(ctx context.Context, msg *types.MsgRejectContract) (*types.MsgRejectContractResponse, error) {
+	if _, err := m.addressCodec.StringToBytes(msg.Operator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid operator")
+	}
+	contract, err := m.contractByID(ctx, msg.ContractId)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrNotFound, "contract not found")
+	}
+	gateway, err := m.gatewayByID(ctx, contract.GatewayId)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrNotFound, "gateway not found")
+	}
+	if gateway.Operator != msg.Operator {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "operator mismatch")
+	}
+	if contract.Status != types.ContractStatus_CONTRACT_STATUS_PENDING {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "contract not pending")
+	}
+	refund, err := m.refundPendingContract(ctx, contract, strings.TrimSpace(msg.Reason))
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgRejectContractResponse{RefundedUlmn: refund.String()}, nil
+}
+
+func (m msgServer) SetGatewayDenoms(ctx context.Context, msg *types.MsgSetGatewayDenoms) (*types.MsgSetGatewayDenomsResponse, error) {
+	if _, err := m.addressCodec.StringToBytes(msg.Operator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid operator")
+	}
+	if err := types.ValidateGatewayDenoms(msg.Denoms); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, err.Error())
+	}
+	gateway, err := m.gatewayByID(ctx, msg.GatewayId)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrNotFound, "gateway not found")
+	}
+	if gateway.Operator != msg.Operator {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "operator mismatch")
+	}
+	params := m.GetParams(ctx)
+	for _, d := range msg.Denoms {
+		if _, ok := params.MinPricePerMonth(d); !ok {
+			return nil, errorsmod.Wrapf(types.ErrInvalidRequest, "denom %s not accepted for payments", d)
+		}
+	}
+	if err := m.collectActionFee(ctx, msg.Operator); err != nil {
+		return nil, err
+	}
+
+	gateway.AcceptedDenoms = msg.Denoms
+	if err := m.setGateway(ctx, gateway); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			"gateway_denoms",
+			sdk.NewAttribute("gateway_id", fmt.Sprintf("%d", gateway.Id)),
+			sdk.NewAttribute("operator", msg.Operator),
+			sdk.NewAttribute("denoms", strings.Join(msg.Denoms, ",")),
+		),
+	)
+	return &types.MsgSetGatewayDenomsResponse{}, nil
+}
+
+func (m msgServer) TreasurySpend(ctx context.Context, msg *types.MsgTreasurySpend) (*types.MsgTreasurySpendResponse, error) {
+	authority, err := m.addressCodec.StringToBytes(msg.Authority)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid authority address")
+	}
+	if !bytes.Equal(m.GetAuthority(), authority) {
+		expected, _ := m.addressCodec.BytesToString(m.GetAuthority())
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "invalid authority; expected %s, got %s", expected, msg.Authority)
+	}
+	recipient, err := m.addressCodec.StringToBytes(msg.Recipient)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid recipient")
+	}
+	if err := msg.Amount.Validate(); err != nil || msg.Amount.IsZero() {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "amount must be positive")
+	}
+	if err := m.spendTreasury(ctx, recipient, msg.Amount); err != nil {
+		return nil, err
+	}
+	return &types.MsgTreasurySpendResponse{}, nil
+}
+
+func (m msgServer) TransferGateway(ctx context.Context, msg *types.MsgTransferGateway) (*types.MsgTransferGatewayResponse, error) {
+	if _, err := m.addressCodec.StringToBytes(msg.Operator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid operator")
+	}
+	newOperator := strings.TrimSpace(msg.NewOperator)
+	if newOperator != "" {
+		if _, err := m.addressCodec.StringToBytes(newOperator); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid new operator")
+		}
+		if newOperator == msg.Operator {
+			return nil, errorsmod.Wrap(types.ErrInvalidRequest, "new operator must differ from the operator")
+		}
+	}
+	gateway, err := m.gatewayByID(ctx, msg.GatewayId)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrNotFound, "gateway not found")
+	}
+	if gateway.Operator != msg.Operator {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "operator mismatch")
+	}
+
+	eventType := "gateway_transfer_propose"
+	if newOperator == "" {
+		if gateway.PendingOperator == "" {
+			return nil, errorsmod.Wrap(types.ErrInvalidRequest, "no transfer pending")
+		}
+		eventType = "gateway_transfer_cancel"
+	} else if gateway.TransferredAt > 0 {
+		params := m.GetParams(ctx)
+		readyAt, err := m.safeAddUint64(gateway.TransferredAt, params.GatewayTransferCooldownSeconds)
+		if err != nil {
+			return nil, err
+		}
+		if uint64(m.nowUnix(ctx)) < readyAt {
+			return nil, errorsmod.Wrapf(types.ErrInvalidRequest, "gateway was transferred recently; next transfer allowed at %d", readyAt)
+		}
+	}
+	if err := m.collectActionFee(ctx, msg.Operator); err != nil {
+		return nil, err
+	}
+
+	gateway.PendingOperator = newOperator
+	if err := m.setGateway(ctx, gateway); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute("gateway_id", fmt.Sprintf("%d", gateway.Id)),
+			sdk.NewAttribute("operator", msg.Operator),
+			sdk.NewAttribute("new_operator", newOperator),
+		),
+	)
+	return &types.MsgTransferGatewayResponse{}, nil
+}
+
+// AcceptGatewayTransfer hands operator rights, and with them the payout of
+// every future claim, to the proposed operator. Contracts, offers and the
+// bond stay with the gateway.
+func (m msgServer) AcceptGatewayTransfer(ctx context.Context, msg *types.MsgAcceptGatewayTransfer) (*types.MsgAcceptGatewayTransferResponse, error) {
+	if _, err := m.addressCodec.StringToBytes(msg.NewOperator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid new operator")
+	}
+	gateway, err := m.gatewayByID(ctx, msg.GatewayId)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrNotFound, "gateway not found")
+	}
+	if gateway.PendingOperator == "" || gateway.PendingOperator != msg.NewOperator {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "no transfer pending to signer")
+	}
+
+	previous := gateway.Operator
+	gateway.Operator = msg.NewOperator
+	gateway.Payout = msg.NewOperator
+	gateway.PendingOperator = ""
+	gateway.TransferredAt = uint64(m.nowUnix(ctx))
+	if err := m.setGateway(ctx, gateway); err != nil {
+		return nil, err
+	}
+	if err := m.dropUncontrolledDomains(ctx, gateway, "gateway_transferred"); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			"gateway_transfer_accept",
+			sdk.NewAttribute("gateway_id", fmt.Sprintf("%d", gateway.Id)),
+			sdk.NewAttribute("previous_operator", previous),
+			sdk.NewAttribute("operator", gateway.Operator),
+		),
+	)
+	return &types.MsgAcceptGatewayTransferResponse{}, nil
+}
+
+// DecommissionGateway announces or withdraws the gateway's shutdown. The
+// gateway stops taking contracts at once; the EndBlocker closes whatever is
+// still running at end_time.
+func (m msgServer) DecommissionGateway(ctx context.Context, msg *types.MsgDecommissionGateway) (*types.MsgDecommissionGatewayResponse, error) {
+	if _, err := m.addressCodec.StringToBytes(msg.Operator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid operator")
+	}
+	gateway, err := m.gatewayByID(ctx, msg.GatewayId)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrNotFound, "gateway not found")
+	}
+	if gateway.Operator != msg.Operator {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "operator mismatch")
+	}
+	if gateway.Archived {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "gateway archived")
+	}
+	now := uint64(m.nowUnix(ctx))
+	if gateway.DecommissionAt != 0 && now >= gateway.DecommissionAt {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "decommission already in effect")
+	}
+
+	eventType := "gateway_decommission"
+	if msg.EndTime == 0 {
+		if gateway.DecommissionAt == 0 {
+			return nil, errorsmod.Wrap(types.ErrInvalidRequest, "gateway not decommissioning")
+		}
+		eventType = "gateway_decommission_cancel"
+	} else if msg.EndTime <= now {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "end_time must be in the future")
+	}
+	if err := m.collectActionFee(ctx, msg.Operator); err != nil {
+		return nil, err
+	}
+
+	// Withdrawing leaves the gateway inactive; the operator re-activates it
+	// with MsgUpdateGateway.
+	gateway.DecommissionAt = msg.EndTime
+	gateway.Active = false
+	gateway.Offline = false
+	if err := m.setGateway(ctx, gateway); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute("gateway_id", fmt.Sprintf("%d", gateway.Id)),
+			sdk.NewAttribute("operator", msg.Operator),
+			sdk.NewAttribute("end_time", fmt.Sprintf("%d", msg.EndTime)),
+		),
+	)
+	return &types.MsgDecommissionGatewayResponse{}, nil
+}
+
+// MigrateContract closes a contract with a decommissioning gateway pro rata
+// and re-escrows the client's remainder with another gateway without a
+// second send tax.
+func (m msgServer) MigrateContract(ctx context.Context, msg *types.MsgMigrateContract) (*types.MsgMigrateContractResponse, error) {
+	if _, err := m.addressCodec.StringToBytes(msg.Client); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid client")
+	}
+	contract, err := m.contractByID(ctx, msg.ContractId)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrNotFound, "contract not found")
+	}
+	if contract.Client != msg.Client {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "not contract owner")
+	}
+	if contract.Status != types.ContractStatus_CONTRACT_STATUS_ACTIVE {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "contract not active")
+	}
+	if contract.ClaimedMonths >= contract.MonthsTotal {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "contract already completed")
+	}
+	now := uint64(m.nowUnix(ctx))
+	frozen, err := m.contractFrozen(ctx, contract, now)
+	if err != nil {
+		return nil, err
+	}
+	if frozen {
+		return nil, errorsmod.Wrapf(types.ErrDisputeOpen, "dispute %d", contract.DisputeId)
+	}
+	source, err := m.gatewayByID(ctx, contract.GatewayId)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrNotFound, "gateway not found")
+	}
+	if source.DecommissionAt == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "gateway is not decommissioning")
+	}
+	closeAt := now
+	if closeAt > source.DecommissionAt {
+		closeAt = source.DecommissionAt
+	}
+
+	if msg.NewGatewayId == source.Id {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "new gateway must differ")
+	}
+	gateway, err := m.gatewayByID(ctx, msg.NewGatewayId)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrNotFound, "new gateway not found")
+	}
+	if !gateway.Active {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "new gateway inactive")
+	}
+	params := m.GetParams(ctx)
+	if params.MaxActiveContractsPerGateway > 0 && gateway.ActiveClients >= params.MaxActiveContractsPerGateway {
+		return nil, errorsmod.Wrapf(types.ErrOutOfBounds, "gateway reached max active contracts (%d)", params.MaxActiveContractsPerGateway)
+	}
+
+	// The remainder stays in the contract's denom, so the new gateway and
+	// offer must take it.
+	payDenom := contract.PaymentDenom()
+	priceUlmn, storageGb, networkGb := contract.PriceUlmn, contract.StorageGbPerMonth, contract.NetworkGbPerMonth
+	if msg.PriceUlmn != 0 {
+		priceUlmn = msg.PriceUlmn
+	}
+	var offer types.Offer
+	if msg.OfferId != 0 {
+		offer, err = m.offerByID(ctx, msg.OfferId)
+		if err != nil {
+			return nil, errorsmod.Wrap(types.ErrNotFound, "offer not found")
+		}
+		if offer.GatewayId != gateway.Id {
+			return nil, errorsmod.Wrap(types.ErrInvalidRequest, "offer belongs to another gateway")
+		}
+		if offer.Retired {
+			return nil, errorsmod.Wrap(types.ErrInvalidRequest, "offer retired")
+		}
+		if !offer.HasCapacity() {
+			return nil, errorsmod.Wrapf(types.ErrOutOfBounds, "offer out of capacity (%d slots)", offer.CapacitySlots)
+		}
+		if msg.PriceUlmn != 0 && msg.PriceUlmn != offer.PriceUlmn {
+			return nil, errorsmod.Wrap(types.ErrInvalidRequest, "contract terms do not match offer")
+		}
+		if offer.PaymentDenom() != payDenom {
+			return nil, errorsmod.Wrapf(types.ErrInvalidRequest, "offer is priced in %s", offer.PaymentDenom())
+		}
+		priceUlmn, storageGb, networkGb = offer.PriceUlmn, offer.StorageGbPerMonth, offer.NetworkGbPerMonth
+	} else {
+		offers, err := m.activeOfferCount(ctx, gateway.Id)
+		if err != nil {
+			return nil, err
+		}
+		if offers > 0 {
+			return nil, errorsmod.Wrap(types.ErrInvalidRequest, "gateway only accepts contracts from its offers")
+		}
+	}
+	minPricePerMonth, ok := params.MinPricePerMonth(payDenom)
+	if !ok {
+		return nil, errorsmod.Wrapf(types.ErrInvalidRequest, "denom %s not accepted for payments", payDenom)
+	}
+	if !gateway.AcceptsDenom(payDenom) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidRequest, "gateway does not accept %s", payDenom)
+	}
+	price := sdkmath.NewIntFromUint64(priceUlmn)
+	if !price.IsPositive() {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "price must be positive")
+	}
+	if price.LT(sdkmath.NewIntFromUint64(minPricePerMonth)) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "price below minimum %d %s", minPricePerMonth, payDenom)
+	}
+	bond, err := m.gatewayBond(ctx, gateway.Id)
+	if err != nil {
+		return nil, err
+	}
+	if required := params.RequiredBond(gateway.ActiveClients + 1); m.safeAmountFromString(bond.BondedUlmn).LT(required) {
+		return nil, errorsmod.Wrapf(types.ErrInsufficientBond, "gateway must bond at least %s%s", required, denom.BaseDenom)
+	}
+
+	earned, err := m.proRataEarned(contract, closeAt, params.MonthSeconds)
+	if err != nil {
+		return nil, err
+	}
+	months := m.safeAmountFromString(contract.EscrowUlmn).Sub(earned).Quo(price)
+	if !months.IsPositive() {
+		return nil, errorsmod.Wrap(types.ErrInsufficientFunds, "remaining escrow covers less than one month at the new price")
+	}
+	if !months.LTE(sdkmath.NewIntFromUint64(math.MaxUint32)) {
+		return nil, errorsmod.Wrap(types.ErrOverflow, "months_total overflow")
+	}
+	monthsTotal := uint32(months.Uint64())
+	if offer.Id != 0 && !offer.AcceptsMonths(monthsTotal) {
+		return nil, errorsmod.Wrapf(types.ErrOutOfBounds, "months_total outside offer range %d-%d", offer.MinMonths, offer.MaxMonths)
+	}
+
+	payout, remainder, err := m.closeContractProRata(ctx, contract, closeAt)
+	if err != nil {
+		return nil, err
+	}
+	escrow := price.MulRaw(int64(monthsTotal))
+	refund := remainder.Sub(escrow)
+	clientAddr, err := m.mustAddress(msg.Client)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid client address")
+	}
+	if err := m.payFromModule(ctx, types.ModuleAccountEscrow, clientAddr, payDenom, refund); err != nil {
+		return nil, err
+	}
+
+	id, err := m.nextContractID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	migrated := types.Contract{
+		Id:                id,
+		Client:            msg.Client,
+		GatewayId:         gateway.Id,
+		PriceUlmn:         priceUlmn,
+		StorageGbPerMonth: storageGb,
+		NetworkGbPerMonth: networkGb,
+		MonthsTotal:       monthsTotal,
+		StartTime:         now,
+		EscrowUlmn:        escrow.String(),
+		Status:            types.ContractStatus_CONTRACT_STATUS_ACTIVE,
+		Metadata:          contract.Metadata,
+		OfferId:           msg.OfferId,
+		Denom:             contract.Denom,
+	}
+	if params.MonthSeconds > 0 {
+		migrated.NextPayoutTime, err = m.safeAddUint64(now, params.MonthSeconds)
+		if err != nil {
+			return nil, err
+		}
+	}
+	// Under an acceptance window the new gateway still gets to accept or
+	// reject; the tax was paid on the original contract, so none is held.
+	pending := params.AcceptanceTimeoutSeconds > 0
+	if pending {
+		deadline, err := m.safeAddUint64(now, params.AcceptanceTimeoutSeconds)
+		if err != nil {
+			return nil, err
+		}
+		migrated.Status = types.ContractStatus_CONTRACT_STATUS_PENDING
+		migrated.StartTime = 0
+		migrated.NextPayoutTime = 0
+		migrated.AcceptDeadline = deadline
+		if err := m.PendingDeadlines.Set(ctx, collections.Join(deadline, id)); err != nil {
+			return nil, err
+		}
+	}
+	if err := m.setContract(ctx, migrated); err != nil {
+		return nil, err
+	}
+	if offer.Id != 0 {
+		offer.UsedSlots++
+		if err := m.setOffer(ctx, offer); err != nil {
+			return nil, err
+		}
+	}
+	if !pending {
+		gateway.ActiveClients++
+		if err := m.setGateway(ctx, gateway); err != nil {
+			return nil, err
+		}
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			"contract_migrate",
+			sdk.NewAttribute("contract_id", fmt.Sprintf("%d", contract.Id)),
+			sdk.NewAttribute("gateway_id", fmt.Sprintf("%d", source.Id)),
+			sdk.NewAttribute("new_contract_id", fmt.Sprintf("%d", id)),
+			sdk.NewAttribute("new_gateway_id", fmt.Sprintf("%d", gateway.Id)),
+			sdk.NewAttribute("client", msg.Client),
+			sdk.NewAttribute("payout_ulmn", payout.String()),
+			sdk.NewAttribute("escrow_ulmn", escrow.String()),
+			sdk.NewAttribute("refunded_ulmn", refund.String()),
+			sdk.NewAttribute("status", migrated.Status.String()),
+		),
+	)
+	return &types.MsgMigrateContractResponse{NewContractId: id, MonthsTotal: monthsTotal, RefundedUlmn: refund.String()}, nil
+}
+
+// GatewayHeartbeat records that the gateway is online. It charges no action
+// fee but is refused more often than once per heartbeatSpacing.
+func (m msgServer) GatewayHeartbeat(ctx context.Context, msg *types.MsgGatewayHeartbeat) (*types.MsgGatewayHeartbeatResponse, error) {
+	if _, err := m.addressCodec.StringToBytes(msg.Operator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid operator")
+	}
+	gateway, err := m.gatewayByID(ctx, msg.GatewayId)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrNotFound, "gateway not found")
+	}
+	if gateway.Operator != msg.Operator {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "operator mismatch")
+	}
+	if gateway.Archived {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "gateway archived")
+	}
+	params := m.GetParams(ctx)
+	now := uint64(m.nowUnix(ctx))
+	if gateway.LastHeartbeatAt != 0 {
+		next, err := m.safeAddUint64(gateway.LastHeartbeatAt, heartbeatSpacing(params))
+		if err != nil {
+			return nil, err
+		}
+		if now < next {
+			return nil, errorsmod.Wrapf(types.ErrInvalidRequest, "heartbeat too soon; next allowed at %d", next)
+		}
+	}
+
+	gateway.LastHeartbeatAt = now
+	reactivated := false
+	if gateway.Offline {
+		gateway.Offline = false
+		gateway.Active = true
+		reactivated = true
+	}
+	if err := m.setGateway(ctx, gateway); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			"gateway_heartbeat",
+			sdk.NewAttribute("gateway_id", fmt.Sprintf("%d", gateway.Id)),
+			sdk.NewAttribute("reactivated", fmt.Sprintf("%t", reactivated)),
+		),
+	)
+	var offlineAt uint64
+	if grace := params.HeartbeatGrace(); grace > 0 && gateway.Active {
+		offlineAt, err = m.safeAddUint64(now, grace)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &types.MsgGatewayHeartbeatResponse{OfflineAt: offlineAt}, nil
+}
+
+// TransferContract assigns the contract to new_client, or proposes it when
+// the gateway requires consent to contract transfers.
+func (m msgServer) TransferContract(ctx context.Context, msg *types.MsgTransferContract) (*types.MsgTransferContractResponse, error) {
+	if _, err := m.addressCodec.StringToBytes(msg.Client); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid client")
+	}
+	newClient := strings.TrimSpace(msg.NewClient)
+	if newClient != "" {
+		if _, err := m.addressCodec.StringToBytes(newClient); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid new client")
+		}
+		if newClient == msg.Client {
+			return nil, errorsmod.Wrap(types.ErrInvalidRequest, "new client must differ from the client")
+		}
+		if err := m.checkReceivable(newClient, "new client"); err != nil {
+			return nil, err
+		}
+	}
+	contract, err := m.contractByID(ctx, msg.ContractId)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrNotFound, "contract not found")
+	}
+	if contract.Client != msg.Client {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "not contract owner")
+	}
+
+	if newClient == "" {
+		if contract.PendingClient == "" {
+			return nil, errorsmod.Wrap(types.ErrInvalidRequest, "no transfer pending")
+		}
+		withdrawn := contract.PendingClient
+		contract.PendingClient = ""
+		if err := m.setContract(ctx, contract); err != nil {
+			return nil, err
+		}
+		sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+			sdk.NewEvent(
+				"contract_transfer_cancel",
+				sdk.NewAttribute("contract_id", fmt.Sprintf("%d", contract.Id)),
+				sdk.NewAttribute("client", msg.Client),
+				sdk.NewAttribute("new_client", withdrawn),
+			),
+		)
+		return &types.MsgTransferContractResponse{}, nil
+	}
+
+	if err := m.checkContractTransferable(ctx, contract); err != nil {
+		return nil, err
+	}
+	gateway, err := m.gatewayByID(ctx, contract.GatewayId)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrNotFound, "gateway not found")
+	}
+	if err := m.collectActionFee(ctx, msg.Client); err != nil {
+		return nil, err
+	}
+
+	if !gateway.ContractTransferConsent {
+		if err := m.assignContract(ctx, contract, newClient); err != nil {
+			return nil, err
+		}
+		return &types.MsgTransferContractResponse{}, nil
+	}
+
+	contract.PendingClient = newClient
+	if err := m.setContract(ctx, contract); err != nil {
+		return nil, err
+	}
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			"contract_transfer_propose",
+			sdk.NewAttribute("contract_id", fmt.Sprintf("%d", contract.Id)),
+			sdk.NewAttribute("gateway_id", fmt.Sprintf("%d", contract.GatewayId)),
+			sdk.NewAttribute("client", msg.Client),
+			sdk.NewAttribute("new_client", newClient),
+		),
+	)
+	return &types.MsgTransferContractResponse{Pending: true}, nil
+}
+
+// ApproveContractTransfer gives the gateway's consent to a pending contract
+// transfer and completes it.
+func (m msgServer) ApproveContractTransfer(ctx context.Context, msg *types.MsgApproveContractTransfer) (*types.MsgApproveContractTransferResponse, error) {
+	if _, err := m.addressCodec.StringToBytes(msg.Operator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid operator")
+	}
+	contract, err := m.contractByID(ctx, msg.ContractId)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrNotFound, "contract not found")
+	}
+	gateway, err := m.gatewayByID(ctx, contract.GatewayId)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrNotFound, "gateway not found")
+	}
+	if gateway.Operator != msg.Operator {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "operator mismatch")
+	}
+	if contract.PendingClient == "" {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "no transfer pending")
+	}
+	if err := m.checkContractTransferable(ctx, contract); err != nil {
+		return nil, err
+	}
+
+	if err := m.assignContract(ctx, contract, contract.PendingClient); err != nil {
+		return nil, err
+	}
+	return &types.MsgApproveContractTransferResponse{}, nil
+}
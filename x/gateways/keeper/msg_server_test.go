@@ -16,6 +16,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/stretchr/testify/require"
 
 	"lumen/app/denom"
@@ -55,7 +56,10 @@ func initGatewayFixture(t *testing.T) *gatewayFixture {
 	k.SetAccountKeeper(mockAccountKeeper{codec: addressCodec})
 	k.SetTokenomicsKeeper(tokenomics)
 
-	if err := k.Params.Set(ctx, types.DefaultParams()); err != nil {
+	// Contracts start active unless a test opts into the acceptance window.
+	params := types.DefaultParams()
+	params.AcceptanceTimeoutSeconds = 0
+	if err := k.Params.Set(ctx, params); err != nil {
 		t.Fatalf("failed to set default params: %v", err)
 	}
 
@@ -86,6 +90,28 @@ func (f *gatewayFixture) registerFee() uint64 {
 	return f.keeper.GetParams(f.ctx).RegisterGatewayFeeUlmn
 }
 
+// bondGateway funds the operator and bonds enough for a handful of clients,
+// leaving the operator's balance unchanged.
+func (f *gatewayFixture) bondGateway(srv types.MsgServer, operator string, gatewayID uint64) {
+	f.t.Helper()
+	amount := f.keeper.GetParams(f.ctx).RequiredBond(10)
+	f.bank.addBalance(f.bank.keyAccount(f.mustAccAddress(operator)), sdk.NewCoins(sdk.NewCoin(denom.BaseDenom, amount)))
+	_, err := srv.BondGateway(f.ctx, &types.MsgBondGateway{Operator: operator, GatewayId: gatewayID, AmountUlmn: amount.String()})
+	require.NoError(f.t, err)
+}
+
+// registerBondedGateway registers a gateway for a fresh operator holding the
+// register fee plus 100_000 ulmn and bonds it.
+func (f *gatewayFixture) registerBondedGateway(srv types.MsgServer) (operator string, gatewayID uint64) {
+	f.t.Helper()
+	operator = randomAccAddress()
+	f.bank.setAccountBalance(f.mustAccAddress(operator), sdk.NewCoins(sdk.NewCoin(denom.BaseDenom, sdkmath.NewIntFromUint64(f.registerFee()+100_000))))
+	gw, err := srv.RegisterGateway(f.ctx, &types.MsgRegisterGateway{Operator: operator})
+	require.NoError(f.t, err)
+	f.bondGateway(srv, operator, gw.Id)
+	return operator, gw.Id
+}
+
 func (f *gatewayFixture) mustAccAddress(addr string) sdk.AccAddress {
 	bz, err := f.addressCodec.StringToBytes(addr)
 	require.NoError(f.t, err, "invalid address %s", addr)
@@ -108,6 +134,7 @@ func TestCreateContractTracksEscrowAndGatewayCount(t *testing.T) {
 	require.Equal(t, sdk.NewCoins(sdk.NewCoin(denom.BaseDenom, expectedRegisterFee)), f.bank.moduleBalance(authtypes.FeeCollectorName))
 
 	gatewayID := resp.Id
+	f.bondGateway(srv, operator, gatewayID)
 	client := randomAccAddress()
 	clientAddr := f.mustAccAddress(client)
 	initialCoins := sdk.NewCoins(sdk.NewInt64Coin(denom.BaseDenom, 5_000_000))
@@ -211,6 +238,7 @@ func TestCreateContractSplitsTaxAndEscrow(t *testing.T) {
 	resp, err := srv.RegisterGateway(f.ctx, &types.MsgRegisterGateway{Operator: operator})
 	require.NoError(t, err)
 	gatewayID := resp.Id
+	f.bondGateway(srv, operator, gatewayID)
 
 	client := randomAccAddress()
 	clientAddr := f.mustAccAddress(client)
@@ -252,6 +280,7 @@ func TestCreateContractAppliesTaxOnTotalMonths(t *testing.T) {
 	resp, err := srv.RegisterGateway(f.ctx, &types.MsgRegisterGateway{Operator: operator})
 	require.NoError(t, err)
 	gatewayID := resp.Id
+	f.bondGateway(srv, operator, gatewayID)
 
 	client := randomAccAddress()
 	clientAddr := f.mustAccAddress(client)
@@ -293,6 +322,7 @@ func TestCreateContractEmitsPaymentEvent(t *testing.T) {
 	resp, err := srv.RegisterGateway(f.ctx, &types.MsgRegisterGateway{Operator: operator})
 	require.NoError(t, err)
 	gatewayID := resp.Id
+	f.bondGateway(srv, operator, gatewayID)
 
 	client := randomAccAddress()
 	clientAddr := f.mustAccAddress(client)
@@ -342,6 +372,7 @@ func TestClaimPaymentPaysGatewayAndTreasury(t *testing.T) {
 	resp, err := srv.RegisterGateway(f.ctx, &types.MsgRegisterGateway{Operator: operator})
 	require.NoError(t, err)
 	gatewayID := resp.Id
+	f.bondGateway(srv, operator, gatewayID)
 	expectedRegisterFee := sdkmath.NewIntFromUint64(registerFee)
 	require.Equal(t, sdk.NewCoins(sdk.NewCoin(denom.BaseDenom, expectedRegisterFee)), f.bank.moduleBalance(authtypes.FeeCollectorName))
 
@@ -402,6 +433,7 @@ func TestCancelContractRefundsAndPenalizes(t *testing.T) {
 	resp, err := srv.RegisterGateway(f.ctx, &types.MsgRegisterGateway{Operator: operator})
 	require.NoError(t, err)
 	gatewayID := resp.Id
+	f.bondGateway(srv, operator, gatewayID)
 
 	client := randomAccAddress()
 	clientAddr := f.mustAccAddress(client)
@@ -463,6 +495,7 @@ func TestFinalizeContractRewardsAfterDelay(t *testing.T) {
 	resp, err := srv.RegisterGateway(f.ctx, &types.MsgRegisterGateway{Operator: operator})
 	require.NoError(t, err)
 	gatewayID := resp.Id
+	f.bondGateway(srv, operator, gatewayID)
 
 	client := randomAccAddress()
 	clientAddr := f.mustAccAddress(client)
@@ -537,10 +570,15 @@ func (m mockAccountKeeper) AddressCodec() address.Codec {
 
 type mockBankKeeper struct {
 	balances map[string]sdk.Coins
+	burned   sdk.Coins
+	blocked  map[string]bool
 }
 
 func newMockBankKeeper() *mockBankKeeper {
-	return &mockBankKeeper{balances: make(map[string]sdk.Coins)}
+	return &mockBankKeeper{
+		balances: make(map[string]sdk.Coins),
+		blocked:  map[string]bool{authtypes.NewModuleAddress(authtypes.FeeCollectorName).String(): true},
+	}
 }
 
 func (m *mockBankKeeper) keyAccount(addr sdk.AccAddress) string {
@@ -617,7 +655,25 @@ func (m *mockBankKeeper) SendCoinsFromAccountToModule(ctx context.Context, addr
 	return m.transfer(m.keyAccount(addr), m.keyModule(module), coins)
 }
 
+// GetAllBalances resolves the treasury module address to its module balance,
+// as FundCommunityPool does.
+func (m *mockBankKeeper) GetAllBalances(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	if addr.Equals(authtypes.NewModuleAddress(types.ModuleAccountTreasury)) {
+		return m.moduleBalance(types.ModuleAccountTreasury)
+	}
+	return m.accountBalance(addr)
+}
+
+// BlockedAddr mirrors the bank keeper, which refuses to send to blocked
+// (module) addresses.
+func (m *mockBankKeeper) BlockedAddr(addr sdk.AccAddress) bool {
+	return m.blocked[addr.String()]
+}
+
 func (m *mockBankKeeper) SendCoinsFromModuleToAccount(ctx context.Context, module string, addr sdk.AccAddress, coins sdk.Coins) error {
+	if m.BlockedAddr(addr) {
+		return fmt.Errorf("%s is not allowed to receive funds", addr)
+	}
 	return m.transfer(m.keyModule(module), m.keyAccount(addr), coins)
 }
 
@@ -625,6 +681,29 @@ func (m *mockBankKeeper) SendCoinsFromModuleToModule(ctx context.Context, sender
 	return m.transfer(m.keyModule(senderModule), m.keyModule(recipientModule), coins)
 }
 
+func (m *mockBankKeeper) BurnCoins(ctx context.Context, module string, coins sdk.Coins) error {
+	if err := m.EnsureBalance(m.keyModule(module), coins); err != nil {
+		return err
+	}
+	m.balances[m.keyModule(module)] = m.getBalance(m.keyModule(module)).Sub(coins...).Sort()
+	m.burned = m.burned.Add(coins...)
+	return nil
+}
+
+type mockDistrKeeper struct {
+	bank *mockBankKeeper
+}
+
+// FundCommunityPool books the treasury module address against its module
+// balance, as the real bank keeper does.
+func (m mockDistrKeeper) FundCommunityPool(ctx context.Context, coins sdk.Coins, sender sdk.AccAddress) error {
+	from := m.bank.keyAccount(sender)
+	if sender.Equals(authtypes.NewModuleAddress(types.ModuleAccountTreasury)) {
+		from = m.bank.keyModule(types.ModuleAccountTreasury)
+	}
+	return m.bank.transfer(from, m.bank.keyModule(distrtypes.ModuleName), coins)
+}
+
 type mockTokenomicsKeeper struct {
 	params tokenomicstypes.Params
 }
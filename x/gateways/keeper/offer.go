@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"context"
+
+	"lumen/x/gateways/types"
+
+	"cosmossdk.io/collections"
+)
+
+// nextOfferID returns ids starting at 1 so that Contract.OfferId == 0 keeps
+// meaning "no offer".
+func (k Keeper) nextOfferID(ctx context.Context) (uint64, error) {
+	seq, err := k.OfferSeq.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return seq + 1, nil
+}
+
+func (k Keeper) offerByID(ctx context.Context, id uint64) (types.Offer, error) {
+	offer, err := k.Offers.Get(ctx, id)
+	if err != nil {
+		return types.Offer{}, types.ErrNotFound
+	}
+	return offer, nil
+}
+
+func (k Keeper) setOffer(ctx context.Context, offer types.Offer) error {
+	if err := k.Offers.Set(ctx, offer.Id, offer); err != nil {
+		return err
+	}
+	return k.GatewayOffers.Set(ctx, collections.Join(offer.GatewayId, offer.Id))
+}
+
+// activeOfferCount counts the gateway's offers that still accept contracts.
+func (k Keeper) activeOfferCount(ctx context.Context, gatewayID uint64) (int, error) {
+	var count int
+	rng := collections.NewPrefixedPairRange[uint64, uint64](gatewayID)
+	err := k.GatewayOffers.Walk(ctx, rng, func(key collections.Pair[uint64, uint64]) (bool, error) {
+		offer, err := k.offerByID(ctx, key.K2())
+		if err != nil {
+			return true, err
+		}
+		if !offer.Retired {
+			count++
+		}
+		return false, nil
+	})
+	return count, err
+}
+
+// releaseOfferSlot frees the capacity slot a contract held on its offer.
+// It is called wherever the contract stops counting as an active client.
+func (k Keeper) releaseOfferSlot(ctx context.Context, contract types.Contract) error {
+	if contract.OfferId == 0 {
+		return nil
+	}
+	offer, err := k.offerByID(ctx, contract.OfferId)
+	if err != nil {
+		return err
+	}
+	if offer.UsedSlots > 0 {
+		offer.UsedSlots--
+	}
+	return k.setOffer(ctx, offer)
+}
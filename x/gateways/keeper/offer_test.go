@@ -0,0 +1,97 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"lumen/app/denom"
+	"lumen/x/gateways/keeper"
+	"lumen/x/gateways/types"
+)
+
+func TestContractFromOfferUsesOfferTermsAndCapacity(t *testing.T) {
+	f := initGatewayFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	operator := randomAccAddress()
+	f.bank.setAccountBalance(f.mustAccAddress(operator), sdk.NewCoins(sdk.NewCoin(denom.BaseDenom, sdkmath.NewIntFromUint64(f.registerFee()))))
+	gw, err := srv.RegisterGateway(f.ctx, &types.MsgRegisterGateway{Operator: operator})
+	require.NoError(t, err)
+	f.bondGateway(srv, operator, gw.Id)
+
+	_, err = srv.CreateOffer(f.ctx, &types.MsgCreateOffer{Operator: randomAccAddress(), GatewayId: gw.Id, PriceUlmn: 300_000, MinMonths: 1})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	offer, err := srv.CreateOffer(f.ctx, &types.MsgCreateOffer{
+		Operator:          operator,
+		GatewayId:         gw.Id,
+		PriceUlmn:         300_000,
+		StorageGbPerMonth: 50,
+		NetworkGbPerMonth: 100,
+		MinMonths:         2,
+		MaxMonths:         6,
+		Regions:           []string{"eu-west"},
+		CapacitySlots:     1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), offer.OfferId)
+
+	client := randomAccAddress()
+	f.bank.setAccountBalance(f.mustAccAddress(client), sdk.NewCoins(sdk.NewInt64Coin(denom.BaseDenom, 10_000_000)))
+
+	_, err = srv.CreateContract(f.ctx, &types.MsgCreateContract{Client: client, GatewayId: gw.Id, PriceUlmn: 100_000, MonthsTotal: 3})
+	require.ErrorContains(t, err, "only accepts contracts from its offers")
+	_, err = srv.CreateContract(f.ctx, &types.MsgCreateContract{Client: client, GatewayId: gw.Id, OfferId: offer.OfferId, PriceUlmn: 100_000, MonthsTotal: 3})
+	require.ErrorContains(t, err, "do not match offer")
+	_, err = srv.CreateContract(f.ctx, &types.MsgCreateContract{Client: client, GatewayId: gw.Id, OfferId: offer.OfferId, MonthsTotal: 12})
+	require.ErrorIs(t, err, types.ErrOutOfBounds)
+
+	ct, err := srv.CreateContract(f.ctx, &types.MsgCreateContract{Client: client, GatewayId: gw.Id, OfferId: offer.OfferId, MonthsTotal: 3})
+	require.NoError(t, err)
+	contract, err := f.keeper.Contracts.Get(f.ctx, ct.ContractId)
+	require.NoError(t, err)
+	require.Equal(t, offer.OfferId, contract.OfferId)
+	require.Equal(t, uint64(50), contract.StorageGbPerMonth)
+	require.Equal(t, uint64(100), contract.NetworkGbPerMonth)
+	require.Equal(t, "891000", contract.EscrowUlmn, "3 × 300000 minus the 1% send tax")
+
+	_, err = srv.CreateContract(f.ctx, &types.MsgCreateContract{Client: client, GatewayId: gw.Id, OfferId: offer.OfferId, MonthsTotal: 3})
+	require.ErrorContains(t, err, "out of capacity")
+
+	_, err = srv.CancelContract(f.ctx, &types.MsgCancelContract{Client: client, ContractId: ct.ContractId})
+	require.NoError(t, err)
+	res, err := qs.Offer(f.ctx, &types.QueryOfferRequest{Id: offer.OfferId})
+	require.NoError(t, err)
+	require.Zero(t, res.Offer.UsedSlots, "cancel frees the slot")
+
+	_, err = srv.RetireOffer(f.ctx, &types.MsgRetireOffer{Operator: operator, OfferId: offer.OfferId})
+	require.NoError(t, err)
+	_, err = srv.CreateContract(f.ctx, &types.MsgCreateContract{Client: client, GatewayId: gw.Id, OfferId: offer.OfferId, MonthsTotal: 3})
+	require.ErrorContains(t, err, "offer retired")
+
+	list, err := qs.Offers(f.ctx, &types.QueryOffersRequest{GatewayId: gw.Id})
+	require.NoError(t, err)
+	require.Empty(t, list.Offers)
+	list, err = qs.Offers(f.ctx, &types.QueryOffersRequest{GatewayId: gw.Id, IncludeRetired: true})
+	require.NoError(t, err)
+	require.Len(t, list.Offers, 1)
+
+	// With no live offer left the gateway takes ad-hoc contracts again.
+	_, err = srv.CreateContract(f.ctx, &types.MsgCreateContract{Client: client, GatewayId: gw.Id, PriceUlmn: 100_000, MonthsTotal: 3})
+	require.NoError(t, err)
+}
+
+func TestCreateOfferValidatesTerms(t *testing.T) {
+	msg := types.MsgCreateOffer{Operator: randomAccAddress(), GatewayId: 1, PriceUlmn: 1, MinMonths: 3, MaxMonths: 2}
+	require.ErrorContains(t, msg.ValidateBasic(), "max_months")
+	msg.MaxMonths = 0
+	msg.Regions = []string{"EU"}
+	require.ErrorContains(t, msg.ValidateBasic(), "lowercase")
+	msg.Regions = []string{"eu", "eu"}
+	require.ErrorContains(t, msg.ValidateBasic(), "duplicate region")
+	msg.Regions = []string{"eu", "us-east-1"}
+	require.NoError(t, msg.ValidateBasic())
+}
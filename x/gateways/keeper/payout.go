@@ -0,0 +1,269 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"lumen/x/gateways/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// claimDue pays the gateway for every settled month of the contract that is
+// due by now and returns the amount paid after commission. ClaimPayment,
+// ClaimAll and the auto-claim EndBlocker all settle through it.
+func (k Keeper) claimDue(ctx context.Context, contract types.Contract, gateway types.Gateway) (sdkmath.Int, error) {
+	if contract.Status != types.ContractStatus_CONTRACT_STATUS_ACTIVE &&
+		contract.Status != types.ContractStatus_CONTRACT_STATUS_COMPLETED {
+		return sdkmath.Int{}, errorsmod.Wrap(types.ErrInvalidRequest, "contract not active")
+	}
+	params := k.GetParams(ctx)
+	if params.MonthSeconds == 0 {
+		return sdkmath.Int{}, errorsmod.Wrap(types.ErrInvalidRequest, "invalid month_seconds param")
+	}
+	if contract.ClaimedMonths >= contract.MonthsTotal {
+		return sdkmath.Int{}, errorsmod.Wrap(types.ErrInvalidRequest, "all payments claimed")
+	}
+
+	now := uint64(k.nowUnix(ctx))
+	frozen, err := k.contractFrozen(ctx, contract, now)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+	if frozen {
+		return sdkmath.Int{}, errorsmod.Wrapf(types.ErrDisputeOpen, "dispute %d", contract.DisputeId)
+	}
+	var eligible uint64
+	if now > contract.StartTime {
+		eligible = (now - contract.StartTime) / params.MonthSeconds
+	}
+	if eligible > uint64(contract.MonthsTotal) {
+		eligible = uint64(contract.MonthsTotal)
+	}
+	claimed := uint64(contract.ClaimedMonths)
+	if eligible <= claimed {
+		return sdkmath.Int{}, errorsmod.Wrap(types.ErrInvalidRequest, "no payout due")
+	}
+
+	// Settle months in order and stop at the first one whose usage is not
+	// settled yet; later months wait for it.
+	price := sdkmath.NewIntFromUint64(contract.PriceUlmn)
+	gross := sdkmath.ZeroInt()
+	withheld := sdkmath.ZeroInt()
+	var monthsDue uint64
+	for month := claimed + 1; month <= eligible; month++ {
+		amount, err := k.monthPayout(ctx, contract, uint32(month), params, now)
+		if errors.Is(err, types.ErrUsageUnsettled) && monthsDue > 0 {
+			break
+		}
+		if err != nil {
+			return sdkmath.Int{}, err
+		}
+		gross = gross.Add(amount)
+		withheld = withheld.Add(price.Sub(amount))
+		monthsDue++
+	}
+	escrow := k.safeAmountFromString(contract.EscrowUlmn)
+	if escrow.LT(gross) {
+		return sdkmath.Int{}, errorsmod.Wrap(types.ErrInsufficientFunds, "insufficient escrow")
+	}
+
+	commission := k.applyCommission(gross, params.PlatformCommissionBps)
+	if commission.GT(gross) {
+		return sdkmath.Int{}, errorsmod.Wrap(types.ErrOverflow, "commission overflow")
+	}
+	payout := gross.Sub(commission)
+
+	payoutAddr := gateway.Payout
+	if strings.TrimSpace(payoutAddr) == "" {
+		payoutAddr = gateway.Operator
+	}
+	addr, err := k.mustAddress(payoutAddr)
+	if err != nil {
+		return sdkmath.Int{}, errorsmod.Wrap(err, "invalid payout address")
+	}
+
+	if err := k.payFromModule(ctx, types.ModuleAccountEscrow, addr, contract.PaymentDenom(), payout); err != nil {
+		return sdkmath.Int{}, err
+	}
+	if commission.IsPositive() {
+		if err := k.creditTreasury(ctx, types.ModuleAccountEscrow, contract.PaymentDenom(), commission); err != nil {
+			return sdkmath.Int{}, err
+		}
+	}
+
+	contract.ClaimedMonths = uint32(claimed + monthsDue)
+	contract.EscrowUlmn = escrow.Sub(gross).String()
+	if withheld.IsPositive() {
+		contract.UsageWithheldUlmn = k.safeAmountFromString(contract.UsageWithheldUlmn).Add(withheld).String()
+	}
+	if contract.ClaimedMonths >= contract.MonthsTotal {
+		contract.Status = types.ContractStatus_CONTRACT_STATUS_COMPLETED
+		contract.NextPayoutTime = 0
+	} else {
+		nextMonths := uint64(contract.ClaimedMonths) + 1
+		nextTime, err := k.contractOffsetTime(contract, nextMonths, params.MonthSeconds)
+		if err != nil {
+			return sdkmath.Int{}, err
+		}
+		contract.NextPayoutTime = nextTime
+	}
+
+	if err := k.setContract(ctx, contract); err != nil {
+		return sdkmath.Int{}, err
+	}
+	completed := contract.Status == types.ContractStatus_CONTRACT_STATUS_COMPLETED
+	if err := k.recordOutcome(ctx, gateway.Id, func(rep *types.GatewayReputation) {
+		rep.MonthsDelivered += monthsDue
+		if completed {
+			rep.ContractsCompleted++
+		}
+	}); err != nil {
+		return sdkmath.Int{}, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"payment_claim",
+			sdk.NewAttribute("contract_id", fmt.Sprintf("%d", contract.Id)),
+			sdk.NewAttribute("gateway_id", fmt.Sprintf("%d", contract.GatewayId)),
+			sdk.NewAttribute("operator", gateway.Operator),
+			sdk.NewAttribute("months_paid", fmt.Sprintf("%d", monthsDue)),
+			sdk.NewAttribute("pay_amount_ulmn", payout.String()),
+			sdk.NewAttribute("fee_ulmn", commission.String()),
+			sdk.NewAttribute("withheld_ulmn", withheld.String()),
+		),
+	)
+
+	return payout, nil
+}
+
+// schedulePayout indexes a running contract under its next payout time.
+func (k Keeper) schedulePayout(ctx context.Context, contract types.Contract) error {
+	if contract.Status != types.ContractStatus_CONTRACT_STATUS_ACTIVE || contract.NextPayoutTime == 0 {
+		return nil
+	}
+	if err := k.GatewayPayouts.Set(ctx, collections.Join3(contract.GatewayId, contract.NextPayoutTime, contract.Id)); err != nil {
+		return err
+	}
+	gateway, err := k.gatewayByID(ctx, contract.GatewayId)
+	if err != nil || !gateway.AutoClaim {
+		return nil
+	}
+	return k.AutoClaimQueue.Set(ctx, collections.Join(contract.NextPayoutTime, contract.Id))
+}
+
+func (k Keeper) unschedulePayout(ctx context.Context, contract types.Contract) error {
+	if err := k.GatewayPayouts.Remove(ctx, collections.Join3(contract.GatewayId, contract.NextPayoutTime, contract.Id)); err != nil {
+		return err
+	}
+	return k.AutoClaimQueue.Remove(ctx, collections.Join(contract.NextPayoutTime, contract.Id))
+}
+
+// setAutoClaim adds the gateway's running contracts to the auto-claim queue,
+// or takes them out when the operator opts out.
+func (k Keeper) setAutoClaim(ctx context.Context, gatewayID uint64, enabled bool) error {
+	var keys []collections.Pair[uint64, uint64]
+	rng := collections.NewPrefixedTripleRange[uint64, uint64, uint64](gatewayID)
+	err := k.GatewayPayouts.Walk(ctx, rng, func(key collections.Triple[uint64, uint64, uint64]) (bool, error) {
+		keys = append(keys, collections.Join(key.K2(), key.K3()))
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if enabled {
+			err = k.AutoClaimQueue.Set(ctx, key)
+		} else {
+			err = k.AutoClaimQueue.Remove(ctx, key)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// dueContracts lists up to limit contracts of a gateway whose next payout
+// time has passed, oldest first.
+func (k Keeper) dueContracts(ctx context.Context, gatewayID, now, limit uint64) ([]uint64, error) {
+	var ids []uint64
+	rng := collections.NewPrefixedTripleRange[uint64, uint64, uint64](gatewayID)
+	err := k.GatewayPayouts.Walk(ctx, rng, func(key collections.Triple[uint64, uint64, uint64]) (bool, error) {
+		if key.K2() > now {
+			return true, nil
+		}
+		ids = append(ids, key.K3())
+		return uint64(len(ids)) >= limit, nil
+	})
+	return ids, err
+}
+
+// tryClaim settles a contract in a cached context so that a contract that
+// cannot be claimed leaves no partial state behind.
+func (k Keeper) tryClaim(ctx context.Context, contract types.Contract, gateway types.Gateway) (sdkmath.Int, error) {
+	cacheCtx, write := sdk.UnwrapSDKContext(ctx).CacheContext()
+	paid, err := k.claimDue(cacheCtx, contract, gateway)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+	write()
+	return paid, nil
+}
+
+// processAutoClaims pays due contracts of auto-claim gateways, oldest payout
+// first, up to params.AutoClaimsPerBlock per block. A contract that cannot
+// be claimed yet is retried after AutoClaimRetrySeconds.
+func (k Keeper) processAutoClaims(ctx context.Context) error {
+	budget := k.GetParams(ctx).AutoClaimsPerBlock
+	if budget == 0 {
+		return nil
+	}
+	now := uint64(k.nowUnix(ctx))
+
+	var due []collections.Pair[uint64, uint64]
+	rng := collections.NewPrefixUntilPairRange[uint64, uint64](now)
+	err := k.AutoClaimQueue.Walk(ctx, rng, func(key collections.Pair[uint64, uint64]) (bool, error) {
+		due = append(due, key)
+		return len(due) >= int(budget), nil
+	})
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, key := range due {
+		if err := k.AutoClaimQueue.Remove(ctx, key); err != nil {
+			return err
+		}
+		contract, err := k.contractByID(ctx, key.K2())
+		if err != nil || contract.Status != types.ContractStatus_CONTRACT_STATUS_ACTIVE || contract.NextPayoutTime > now {
+			continue
+		}
+		gateway, err := k.gatewayByID(ctx, contract.GatewayId)
+		if err != nil || !gateway.AutoClaim {
+			continue
+		}
+		if _, err := k.tryClaim(ctx, contract, gateway); err != nil {
+			if err := k.AutoClaimQueue.Set(ctx, collections.Join(now+types.AutoClaimRetrySeconds, contract.Id)); err != nil {
+				return err
+			}
+			sdkCtx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					"payment_auto_claim_skip",
+					sdk.NewAttribute("contract_id", fmt.Sprintf("%d", contract.Id)),
+					sdk.NewAttribute("gateway_id", fmt.Sprintf("%d", contract.GatewayId)),
+					sdk.NewAttribute("reason", err.Error()),
+				),
+			)
+		}
+	}
+	return nil
+}
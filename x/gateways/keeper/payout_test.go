@@ -0,0 +1,85 @@
+package keeper_test
+
+import (
+	"testing"
+
+	gogotypes "github.com/cosmos/gogoproto/types"
+	"github.com/stretchr/testify/require"
+
+	"lumen/app/denom"
+	"lumen/x/gateways/keeper"
+	"lumen/x/gateways/types"
+)
+
+func TestEndBlockerAutoClaimsOptedInGateways(t *testing.T) {
+	f := initGatewayFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	operator, _, contractID := setupUsageContract(t, f, srv)
+	params := f.keeper.GetParams(f.ctx)
+	contract, err := f.keeper.Contracts.Get(f.ctx, contractID)
+	require.NoError(t, err)
+
+	f.withBlockTime(int64(params.MonthSeconds))
+	require.NoError(t, f.keeper.EndBlocker(f.ctx))
+	contract, err = f.keeper.Contracts.Get(f.ctx, contractID)
+	require.NoError(t, err)
+	require.Zero(t, contract.ClaimedMonths, "gateway has not opted in")
+
+	_, err = srv.UpdateGateway(f.ctx, &types.MsgUpdateGateway{Operator: operator, GatewayId: contract.GatewayId, AutoClaim: &gogotypes.BoolValue{Value: true}})
+	require.NoError(t, err)
+	f.resetEvents()
+	require.NoError(t, f.keeper.EndBlocker(f.ctx))
+	contract, err = f.keeper.Contracts.Get(f.ctx, contractID)
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), contract.ClaimedMonths)
+	require.Equal(t, 2*params.MonthSeconds, contract.NextPayoutTime)
+	require.Equal(t, "1980", f.bank.moduleBalance(types.ModuleAccountTreasury).AmountOf(denom.BaseDenom).String(), "1% commission as with ClaimPayment")
+
+	// The next payout is queued again; nothing is due before it.
+	require.NoError(t, f.keeper.EndBlocker(f.ctx))
+	contract, err = f.keeper.Contracts.Get(f.ctx, contractID)
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), contract.ClaimedMonths)
+
+	_, err = srv.UpdateGateway(f.ctx, &types.MsgUpdateGateway{Operator: operator, GatewayId: contract.GatewayId, AutoClaim: &gogotypes.BoolValue{Value: false}})
+	require.NoError(t, err)
+	f.withBlockTime(int64(3 * params.MonthSeconds))
+	require.NoError(t, f.keeper.EndBlocker(f.ctx))
+	contract, err = f.keeper.Contracts.Get(f.ctx, contractID)
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), contract.ClaimedMonths, "opting out stops auto-claims")
+}
+
+func TestClaimAllSkipsFrozenContracts(t *testing.T) {
+	f := initGatewayFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	operator, client, first := setupUsageContract(t, f, srv)
+	params := f.keeper.GetParams(f.ctx)
+	contract, err := f.keeper.Contracts.Get(f.ctx, first)
+	require.NoError(t, err)
+	second, err := srv.CreateContract(f.ctx, &types.MsgCreateContract{Client: client, GatewayId: contract.GatewayId, PriceUlmn: 200_000, MonthsTotal: 6})
+	require.NoError(t, err)
+	params.DisputeTimeoutSeconds = 2 * params.MonthSeconds
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+	_, err = srv.OpenDispute(f.ctx, &types.MsgOpenDispute{Client: client, ContractId: second.ContractId, Reason: "offline", EvidenceHash: evidenceHash})
+	require.NoError(t, err)
+
+	_, err = srv.ClaimAll(f.ctx, &types.MsgClaimAll{Operator: client, GatewayId: contract.GatewayId})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	res, err := srv.ClaimAll(f.ctx, &types.MsgClaimAll{Operator: operator, GatewayId: contract.GatewayId})
+	require.NoError(t, err)
+	require.Zero(t, res.Claimed+res.Skipped, "nothing due yet")
+
+	f.withBlockTime(int64(params.MonthSeconds))
+	res, err = srv.ClaimAll(f.ctx, &types.MsgClaimAll{Operator: operator, GatewayId: contract.GatewayId})
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), res.Claimed)
+	require.Equal(t, uint32(1), res.Skipped)
+	require.Equal(t, "196020", res.PaidUlmn, "198000 minus 1% commission")
+
+	frozen, err := f.keeper.Contracts.Get(f.ctx, second.ContractId)
+	require.NoError(t, err)
+	require.Zero(t, frozen.ClaimedMonths)
+	require.Equal(t, "1188000", frozen.EscrowUlmn, "skipped contract left untouched")
+}
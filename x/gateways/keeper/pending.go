@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"lumen/x/gateways/types"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// refundPendingContract returns the whole deposit of a contract the gateway
+// never accepted, held send tax included, and cancels it. The gateway's
+// reputation and cancellation count are left untouched.
+func (k Keeper) refundPendingContract(ctx context.Context, contract types.Contract, reason string) (sdkmath.Int, error) {
+	refund := k.safeAmountFromString(contract.EscrowUlmn).Add(k.safeAmountFromString(contract.PendingTaxUlmn))
+	clientAddr, err := k.mustAddress(contract.Client)
+	if err != nil {
+		return sdkmath.ZeroInt(), err
+	}
+	if err := k.payFromModule(ctx, types.ModuleAccountEscrow, clientAddr, contract.PaymentDenom(), refund); err != nil {
+		return sdkmath.ZeroInt(), err
+	}
+	if err := k.PendingDeadlines.Remove(ctx, collections.Join(contract.AcceptDeadline, contract.Id)); err != nil {
+		return sdkmath.ZeroInt(), err
+	}
+	if err := k.releaseOfferSlot(ctx, contract); err != nil {
+		return sdkmath.ZeroInt(), err
+	}
+
+	contract.Status = types.ContractStatus_CONTRACT_STATUS_CANCELED
+	contract.EscrowUlmn = "0"
+	contract.PendingTaxUlmn = ""
+	contract.AcceptDeadline = 0
+	if err := k.setContract(ctx, contract); err != nil {
+		return sdkmath.ZeroInt(), err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			"contract_reject",
+			sdk.NewAttribute("contract_id", fmt.Sprintf("%d", contract.Id)),
+			sdk.NewAttribute("gateway_id", fmt.Sprintf("%d", contract.GatewayId)),
+			sdk.NewAttribute("client", contract.Client),
+			sdk.NewAttribute("refunded_ulmn", refund.String()),
+			sdk.NewAttribute("reason", reason),
+		),
+	)
+	return refund, nil
+}
+
+// expirePendingContracts refunds contracts whose acceptance deadline has
+// passed, at most MaxPendingExpirationsPerBlock per block. Each refund runs in
+// a cached context; one that cannot be paid drops the deadline and is
+// reported instead of failing the block, leaving the client to withdraw.
+func (k Keeper) expirePendingContracts(ctx context.Context) error {
+	now := uint64(k.nowUnix(ctx))
+
+	var expired []collections.Pair[uint64, uint64]
+	rng := collections.NewPrefixUntilPairRange[uint64, uint64](now)
+	err := k.PendingDeadlines.Walk(ctx, rng, func(key collections.Pair[uint64, uint64]) (bool, error) {
+		expired = append(expired, key)
+		return len(expired) >= types.MaxPendingExpirationsPerBlock, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, key := range expired {
+		contract, err := k.contractByID(ctx, key.K2())
+		if err == nil {
+			cacheCtx, write := sdkCtx.CacheContext()
+			if _, err = k.refundPendingContract(cacheCtx, contract, "acceptance_timeout"); err == nil {
+				write()
+				sdkCtx.EventManager().EmitEvent(
+					sdk.NewEvent(
+						"contract_expire",
+						sdk.NewAttribute("contract_id", fmt.Sprintf("%d", contract.Id)),
+						sdk.NewAttribute("gateway_id", fmt.Sprintf("%d", contract.GatewayId)),
+					),
+				)
+				continue
+			}
+		}
+		reason := err.Error()
+		if err := k.PendingDeadlines.Remove(ctx, key); err != nil {
+			return err
+		}
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"contract_expire_skip",
+				sdk.NewAttribute("contract_id", fmt.Sprintf("%d", key.K2())),
+				sdk.NewAttribute("reason", reason),
+			),
+		)
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"lumen/app/denom"
+	"lumen/x/gateways/keeper"
+	"lumen/x/gateways/types"
+)
+
+const pendingTimeout = 3 * 24 * 60 * 60
+
+// setupPendingContract creates a 6-month contract at 200_000/month under a
+// non-zero acceptance window: 1_200_000 paid, 12_000 of it held as tax.
+func setupPendingContract(t *testing.T, f *gatewayFixture, srv types.MsgServer) (operator, client string, gatewayID, contractID uint64) {
+	t.Helper()
+
+	params := f.keeper.GetParams(f.ctx)
+	params.AcceptanceTimeoutSeconds = pendingTimeout
+	require.NoError(t, f.keeper.SetParams(f.ctx, params))
+
+	operator, gatewayID = f.registerBondedGateway(srv)
+
+	client = randomAccAddress()
+	f.bank.setAccountBalance(f.mustAccAddress(client), sdk.NewCoins(sdk.NewInt64Coin(denom.BaseDenom, 5_000_000)))
+	f.bank.setModuleBalance(types.ModuleAccountEscrow, sdk.NewCoins())
+	f.bank.setModuleBalance(authtypes.FeeCollectorName, sdk.NewCoins())
+
+	ct, err := srv.CreateContract(f.ctx, &types.MsgCreateContract{Client: client, GatewayId: gatewayID, PriceUlmn: 200_000, MonthsTotal: 6})
+	require.NoError(t, err)
+	return operator, client, gatewayID, ct.ContractId
+}
+
+func TestCreateContractStartsPendingUnderAcceptanceWindow(t *testing.T) {
+	f := initGatewayFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	f.withBlockTime(1_000)
+	_, _, gatewayID, contractID := setupPendingContract(t, f, srv)
+
+	contract, err := f.keeper.Contracts.Get(f.ctx, contractID)
+	require.NoError(t, err)
+	require.Equal(t, types.ContractStatus_CONTRACT_STATUS_PENDING, contract.Status)
+	require.Equal(t, uint64(1_000+pendingTimeout), contract.AcceptDeadline)
+	require.Zero(t, contract.StartTime)
+	require.Zero(t, contract.NextPayoutTime)
+	require.Equal(t, "1188000", contract.EscrowUlmn)
+	require.Equal(t, "12000", contract.PendingTaxUlmn)
+	require.Equal(t, "1200000", f.bank.moduleBalance(types.ModuleAccountEscrow).AmountOf(denom.BaseDenom).String(), "tax is held with the net amount")
+	require.True(t, f.bank.moduleBalance(authtypes.FeeCollectorName).IsZero())
+
+	gateway, err := f.keeper.Gateways.Get(f.ctx, gatewayID)
+	require.NoError(t, err)
+	require.Zero(t, gateway.ActiveClients, "pending contracts do not count as active clients")
+}
+
+func TestAcceptContractStartsTermAndPaysTax(t *testing.T) {
+	f := initGatewayFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	operator, client, gatewayID, contractID := setupPendingContract(t, f, srv)
+	params := f.keeper.GetParams(f.ctx)
+
+	_, err := srv.ClaimPayment(f.ctx, &types.MsgClaimPayment{Operator: operator, ContractId: contractID})
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+	_, err = srv.AcceptContract(f.ctx, &types.MsgAcceptContract{Operator: client, ContractId: contractID})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	f.withBlockTime(500)
+	res, err := srv.AcceptContract(f.ctx, &types.MsgAcceptContract{Operator: operator, ContractId: contractID})
+	require.NoError(t, err)
+	require.Equal(t, uint64(500), res.StartTime)
+
+	contract, err := f.keeper.Contracts.Get(f.ctx, contractID)
+	require.NoError(t, err)
+	require.Equal(t, types.ContractStatus_CONTRACT_STATUS_ACTIVE, contract.Status)
+	require.Equal(t, uint64(500), contract.StartTime)
+	require.Equal(t, 500+params.MonthSeconds, contract.NextPayoutTime)
+	require.Zero(t, contract.AcceptDeadline)
+	require.Empty(t, contract.PendingTaxUlmn)
+	require.Equal(t, "12000", f.bank.moduleBalance(authtypes.FeeCollectorName).AmountOf(denom.BaseDenom).String())
+	require.Equal(t, "1188000", f.bank.moduleBalance(types.ModuleAccountEscrow).AmountOf(denom.BaseDenom).String())
+
+	gateway, err := f.keeper.Gateways.Get(f.ctx, gatewayID)
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), gateway.ActiveClients)
+
+	_, err = srv.AcceptContract(f.ctx, &types.MsgAcceptContract{Operator: operator, ContractId: contractID})
+	require.ErrorContains(t, err, "contract not pending")
+
+	// The expiry queue no longer holds the accepted contract.
+	f.withBlockTime(pendingTimeout + 1)
+	require.NoError(t, f.keeper.EndBlocker(f.ctx))
+	contract, err = f.keeper.Contracts.Get(f.ctx, contractID)
+	require.NoError(t, err)
+	require.Equal(t, types.ContractStatus_CONTRACT_STATUS_ACTIVE, contract.Status)
+}
+
+func TestRejectContractRefundsClientInFull(t *testing.T) {
+	f := initGatewayFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	operator, client, gatewayID, contractID := setupPendingContract(t, f, srv)
+	clientAddr := f.mustAccAddress(client)
+
+	res, err := srv.RejectContract(f.ctx, &types.MsgRejectContract{Operator: operator, ContractId: contractID, Reason: "no capacity in region"})
+	require.NoError(t, err)
+	require.Equal(t, "1200000", res.RefundedUlmn)
+	require.Equal(t, "5000000", f.bank.accountBalance(clientAddr).AmountOf(denom.BaseDenom).String())
+	require.True(t, f.bank.moduleBalance(types.ModuleAccountEscrow).IsZero())
+
+	contract, err := f.keeper.Contracts.Get(f.ctx, contractID)
+	require.NoError(t, err)
+	require.Equal(t, types.ContractStatus_CONTRACT_STATUS_CANCELED, contract.Status)
+	require.Equal(t, "0", contract.EscrowUlmn)
+
+	gateway, err := f.keeper.Gateways.Get(f.ctx, gatewayID)
+	require.NoError(t, err)
+	require.Zero(t, gateway.ActiveClients)
+	require.Zero(t, gateway.Cancellations, "a rejection is not a cancellation")
+
+	_, err = srv.AcceptContract(f.ctx, &types.MsgAcceptContract{Operator: operator, ContractId: contractID})
+	require.ErrorContains(t, err, "contract not pending")
+}
+
+func TestPendingContractTimeoutRefundsClient(t *testing.T) {
+	f := initGatewayFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	operator, client, _, contractID := setupPendingContract(t, f, srv)
+
+	f.withBlockTime(pendingTimeout - 1)
+	require.NoError(t, f.keeper.EndBlocker(f.ctx))
+	contract, err := f.keeper.Contracts.Get(f.ctx, contractID)
+	require.NoError(t, err)
+	require.Equal(t, types.ContractStatus_CONTRACT_STATUS_PENDING, contract.Status)
+
+	f.withBlockTime(pendingTimeout)
+	_, err = srv.AcceptContract(f.ctx, &types.MsgAcceptContract{Operator: operator, ContractId: contractID})
+	require.ErrorContains(t, err, "acceptance deadline passed")
+
+	f.resetEvents()
+	require.NoError(t, f.keeper.EndBlocker(f.ctx))
+	contract, err = f.keeper.Contracts.Get(f.ctx, contractID)
+	require.NoError(t, err)
+	require.Equal(t, types.ContractStatus_CONTRACT_STATUS_CANCELED, contract.Status)
+	require.Equal(t, "5000000", f.bank.accountBalance(f.mustAccAddress(client)).AmountOf(denom.BaseDenom).String())
+
+	var expired bool
+	for _, ev := range f.ctx.EventManager().Events() {
+		if ev.Type == "contract_expire" {
+			expired = true
+		}
+	}
+	require.True(t, expired)
+}
+
+func TestClientCancelsPendingContractWithoutPenalty(t *testing.T) {
+	f := initGatewayFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	_, client, _, contractID := setupPendingContract(t, f, srv)
+
+	res, err := srv.CancelContract(f.ctx, &types.MsgCancelContract{Client: client, ContractId: contractID})
+	require.NoError(t, err)
+	require.Equal(t, "1200000", res.RefundedUlmn)
+	require.True(t, f.bank.moduleBalance(types.ModuleAccountTreasury).IsZero())
+}
+
+func TestPendingTimeoutSkipsUnpayableRefund(t *testing.T) {
+	f := initGatewayFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	_, client, _, contractID := setupPendingContract(t, f, srv)
+	f.bank.blocked[client] = true
+
+	f.withBlockTime(pendingTimeout)
+	f.resetEvents()
+	require.NoError(t, f.keeper.EndBlocker(f.ctx), "a failed refund must not fail the block")
+	contract, err := f.keeper.Contracts.Get(f.ctx, contractID)
+	require.NoError(t, err)
+	require.Equal(t, types.ContractStatus_CONTRACT_STATUS_PENDING, contract.Status)
+	require.Equal(t, "1200000", f.bank.moduleBalance(types.ModuleAccountEscrow).AmountOf(denom.BaseDenom).String())
+	var skipped bool
+	for _, ev := range f.ctx.EventManager().Events() {
+		if ev.Type == "contract_expire_skip" {
+			skipped = true
+		}
+	}
+	require.True(t, skipped)
+	has, err := f.keeper.PendingDeadlines.Has(f.ctx, collections.Join(contract.AcceptDeadline, contractID))
+	require.NoError(t, err)
+	require.False(t, has, "the deadline is dropped, not retried every block")
+}
@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogotypes "github.com/cosmos/gogoproto/types"
+	"github.com/stretchr/testify/require"
+
+	"lumen/app/denom"
+	"lumen/x/gateways/keeper"
+	"lumen/x/gateways/types"
+)
+
+func TestGatewayProfileRegisterUpdateAndFilters(t *testing.T) {
+	f := initGatewayFixture(t)
+	dns := newMockDnsKeeper()
+	f.keeper.SetDnsKeeper(dns)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	q := keeper.NewQueryServerImpl(f.keeper)
+
+	operator := randomAccAddress()
+	f.bank.setAccountBalance(f.mustAccAddress(operator), sdk.NewCoins(sdk.NewCoin(denom.BaseDenom, sdkmath.NewIntFromUint64(3*f.registerFee()+1_000_000))))
+
+	_, err := srv.RegisterGateway(f.ctx, &types.MsgRegisterGateway{Operator: operator, Metadata: strings.Repeat("a", types.GatewayMetadataMaxLen+1)})
+	require.ErrorContains(t, err, "metadata too long", "oversized metadata is rejected, not truncated")
+
+	ipfs := &types.GatewayProfile{
+		Endpoints: []types.GatewayEndpoint{{Host: "IPFS.Lumen", Protocol: types.GatewayProtocol_GATEWAY_PROTOCOL_IPFS, Port: 4001}},
+		Regions:   []string{"eu-west", "us-east"},
+		Protocols: []types.GatewayProtocol{types.GatewayProtocol_GATEWAY_PROTOCOL_IPFS},
+		Capacity:  &types.GatewayCapacity{StorageGb: 5_000, MaxClients: 100},
+		Contact:   "ops@ipfs.lumen",
+	}
+	_, err = srv.RegisterGateway(f.ctx, &types.MsgRegisterGateway{Operator: operator, Profile: &types.GatewayProfile{Regions: []string{"EU"}}})
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+
+	// Endpoints must be bound domains, so a new gateway cannot list any.
+	_, err = srv.RegisterGateway(f.ctx, &types.MsgRegisterGateway{Operator: operator, Profile: ipfs})
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+	first, err := srv.RegisterGateway(f.ctx, &types.MsgRegisterGateway{Operator: operator})
+	require.NoError(t, err)
+	second, err := srv.RegisterGateway(f.ctx, &types.MsgRegisterGateway{Operator: operator})
+	require.NoError(t, err)
+
+	dns.setDomain("ipfs.lumen", operator, true)
+	_, err = srv.UpdateGateway(f.ctx, &types.MsgUpdateGateway{Operator: operator, GatewayId: first.Id, Profile: ipfs})
+	require.ErrorIs(t, err, types.ErrInvalidRequest, "an unbound endpoint host is rejected")
+	_, err = srv.BindDomain(f.ctx, &types.MsgBindDomain{Operator: operator, GatewayId: first.Id, Domain: "ipfs.lumen"})
+	require.NoError(t, err)
+	_, err = srv.UpdateGateway(f.ctx, &types.MsgUpdateGateway{Operator: operator, GatewayId: first.Id, Profile: ipfs})
+	require.NoError(t, err)
+
+	gateway, err := f.keeper.Gateways.Get(f.ctx, first.Id)
+	require.NoError(t, err)
+	require.Equal(t, "ipfs.lumen", gateway.Profile.Endpoints[0].Host)
+	require.Equal(t, uint64(5_000), gateway.Profile.Capacity.StorageGb)
+
+	// Updating metadata alone keeps the profile; a new profile replaces it.
+	_, err = srv.UpdateGateway(f.ctx, &types.MsgUpdateGateway{Operator: operator, GatewayId: first.Id, Metadata: &gogotypes.StringValue{Value: "hello"}})
+	require.NoError(t, err)
+	dns.setDomain("s3gateway.lumen", operator, true)
+	_, err = srv.BindDomain(f.ctx, &types.MsgBindDomain{Operator: operator, GatewayId: second.Id, Domain: "s3gateway.lumen"})
+	require.NoError(t, err)
+	_, err = srv.UpdateGateway(f.ctx, &types.MsgUpdateGateway{Operator: operator, GatewayId: second.Id, Profile: &types.GatewayProfile{
+		Endpoints: []types.GatewayEndpoint{{Host: "s3gateway.lumen", Protocol: types.GatewayProtocol_GATEWAY_PROTOCOL_S3}},
+		Protocols: []types.GatewayProtocol{types.GatewayProtocol_GATEWAY_PROTOCOL_S3},
+		Regions:   []string{"eu-west"},
+	}})
+	require.NoError(t, err)
+	gateway, err = f.keeper.Gateways.Get(f.ctx, first.Id)
+	require.NoError(t, err)
+	require.Equal(t, "hello", gateway.Metadata)
+	require.NotNil(t, gateway.Profile)
+
+	ids := func(res *types.QueryGatewaysResponse) []uint64 {
+		var out []uint64
+		for _, gw := range res.Gateways {
+			out = append(out, gw.Id)
+		}
+		return out
+	}
+	res, err := q.Gateways(f.ctx, &types.QueryGatewaysRequest{Region: "eu-west"})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{first.Id, second.Id}, ids(res))
+	res, err = q.Gateways(f.ctx, &types.QueryGatewaysRequest{Region: "us-east"})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{first.Id}, ids(res))
+	res, err = q.Gateways(f.ctx, &types.QueryGatewaysRequest{Region: "eu-west", Protocol: types.GatewayProtocol_GATEWAY_PROTOCOL_S3})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{second.Id}, ids(res))
+	require.Equal(t, uint64(1), res.Total)
+	res, err = q.Gateways(f.ctx, &types.QueryGatewaysRequest{Protocol: types.GatewayProtocol_GATEWAY_PROTOCOL_HTTP, SortByScore: true})
+	require.NoError(t, err)
+	require.Empty(t, res.Gateways)
+
+	// Unbinding a domain drops the endpoints advertised on it.
+	_, err = srv.UnbindDomain(f.ctx, &types.MsgUnbindDomain{Operator: operator, GatewayId: first.Id, Domain: "ipfs.lumen"})
+	require.NoError(t, err)
+	gateway, err = f.keeper.Gateways.Get(f.ctx, first.Id)
+	require.NoError(t, err)
+	require.Empty(t, gateway.Profile.Endpoints)
+	require.Equal(t, []string{"eu-west", "us-east"}, gateway.Profile.Regions)
+}
@@ -2,11 +2,14 @@ package keeper
 
 import (
 	"context"
+	"sort"
 	"strings"
 
 	"lumen/x/gateways/types"
 
+	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -26,6 +29,17 @@ func clampLimit(requested uint64) uint64 {
 	return limit
 }
 
+// pageRequest returns the request's PageRequest with its limit clamped, or
+// builds one from the legacy offset/limit fields when none was sent.
+func pageRequest(pagination *query.PageRequest, offset, limit uint64) *query.PageRequest {
+	if pagination == nil {
+		return &query.PageRequest{Offset: offset, Limit: clampLimit(limit), CountTotal: true}
+	}
+	req := *pagination
+	req.Limit = clampLimit(req.Limit)
+	return &req
+}
+
 type queryServer struct{ Keeper }
 
 func NewQueryServerImpl(k Keeper) types.QueryServer {
@@ -45,13 +59,42 @@ func (q queryServer) Authority(ctx context.Context, _ *types.QueryAuthorityReque
 func (q queryServer) ModuleAccounts(ctx context.Context, _ *types.QueryModuleAccountsRequest) (*types.QueryModuleAccountsResponse, error) {
 	treasury := authtypes.NewModuleAddress(types.ModuleAccountTreasury)
 	escrow := authtypes.NewModuleAddress(types.ModuleAccountEscrow)
+	bond := authtypes.NewModuleAddress(types.ModuleAccountBond)
 
 	ts, _ := q.ak.AddressCodec().BytesToString(treasury)
 	es, _ := q.ak.AddressCodec().BytesToString(escrow)
+	bs, _ := q.ak.AddressCodec().BytesToString(bond)
 
+	balance, err := q.treasuryBalance(ctx)
+	if err != nil {
+		return nil, err
+	}
 	return &types.QueryModuleAccountsResponse{
-		Escrow:   es,
-		Treasury: ts,
+		Escrow:          es,
+		Treasury:        ts,
+		Bond:            bs,
+		TreasuryBalance: balance,
+	}, nil
+}
+
+func (q queryServer) Treasury(ctx context.Context, _ *types.QueryTreasuryRequest) (*types.QueryTreasuryResponse, error) {
+	balance, err := q.treasuryBalance(ctx)
+	if err != nil {
+		return nil, err
+	}
+	flows, err := q.treasuryFlows(ctx)
+	if err != nil {
+		return nil, err
+	}
+	last, next, err := q.nextTreasuryDistribution(ctx, q.GetParams(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryTreasuryResponse{
+		Balance:            balance,
+		Flows:              flows,
+		LastDistributionAt: last,
+		NextDistributionAt: next,
 	}, nil
 }
 
@@ -60,32 +103,167 @@ func (q queryServer) Gateway(ctx context.Context, req *types.QueryGatewayRequest
 	if err != nil {
 		return nil, errorsmod.Wrap(types.ErrNotFound, "gateway not found")
 	}
-	return &types.QueryGatewayResponse{Gateway: &gateway}, nil
+	domains, err := q.verifiedGatewayDomains(ctx, gateway)
+	if err != nil {
+		return nil, err
+	}
+	bond, err := q.gatewayBond(ctx, gateway.Id)
+	if err != nil {
+		return nil, err
+	}
+	reputation, err := q.gatewayReputation(ctx, gateway)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryGatewayResponse{
+		Gateway:          &gateway,
+		Domains:          domains,
+		Bond:             &bond,
+		RequiredBondUlmn: requiredBond(q.GetParams(ctx), gateway).String(),
+		Reputation:       &reputation,
+	}, nil
+}
+
+// DomainGateways lists the gateways bound to a domain. Bindings whose domain
+// expired or changed hands since binding are skipped.
+func (q queryServer) DomainGateways(ctx context.Context, req *types.QueryDomainGatewaysRequest) (*types.QueryDomainGatewaysResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "empty request")
+	}
+	domain, err := types.NormalizeGatewayEndpoint(req.Domain)
+	if err != nil {
+		return nil, err
+	}
+	if domain == "" {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "domain required")
+	}
+
+	resp := &types.QueryDomainGatewaysResponse{}
+	rng := collections.NewPrefixedPairRange[string, uint64](domain)
+	err = q.Keeper.DomainBindings.Walk(ctx, rng, func(_ collections.Pair[string, uint64], binding types.DomainBinding) (bool, error) {
+		gateway, err := q.gatewayByID(ctx, binding.GatewayId)
+		if err != nil {
+			return false, nil
+		}
+		if _, ok, err := q.gatewayOwnsDomain(ctx, gateway, domain); err != nil || !ok {
+			return false, nil
+		}
+		gw, b := gateway, binding
+		resp.Gateways = append(resp.Gateways, &gw)
+		resp.Bindings = append(resp.Bindings, &b)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
 }
 
 func (q queryServer) Gateways(ctx context.Context, req *types.QueryGatewaysRequest) (*types.QueryGatewaysResponse, error) {
-	limit := clampLimit(req.Limit)
+	pageReq := pageRequest(req.Pagination, req.Offset, req.Limit)
+	if req.SortByScore || req.MinScore > 0 {
+		return q.gatewaysByScore(ctx, req, pageReq)
+	}
 
-	offset := req.Offset
-	total := uint64(0)
-	collected := make([]*types.Gateway, 0, limit)
+	var match func(uint64, types.Gateway) (bool, error)
+	if req.Region != "" || req.Protocol != types.GatewayProtocol_GATEWAY_PROTOCOL_UNSPECIFIED {
+		match = func(_ uint64, gateway types.Gateway) (bool, error) {
+			return gatewayMatchesProfile(req, gateway), nil
+		}
+	}
+	gateways, pageRes, err := query.CollectionFilteredPaginate(ctx, q.Keeper.Gateways, pageReq, match,
+		func(_ uint64, gateway types.Gateway) (*types.Gateway, error) {
+			return &gateway, nil
+		},
+	)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, err.Error())
+	}
 
-	_ = q.Keeper.Gateways.Walk(ctx, nil, func(_ uint64, gateway types.Gateway) (bool, error) {
-		total++
-		if total <= offset {
+	reputations := make([]*types.GatewayReputation, 0, len(gateways))
+	for _, gw := range gateways {
+		rep, err := q.gatewayReputation(ctx, *gw)
+		if err != nil {
+			return nil, err
+		}
+		reputations = append(reputations, &rep)
+	}
+
+	return &types.QueryGatewaysResponse{
+		Gateways:    gateways,
+		Total:       pageRes.Total,
+		Reputations: reputations,
+		Pagination:  pageRes,
+	}, nil
+}
+
+// gatewayMatchesProfile applies the region and protocol filters of a
+// Gateways request.
+func gatewayMatchesProfile(req *types.QueryGatewaysRequest, gateway types.Gateway) bool {
+	if req.Region != "" && !gateway.ServesRegion(strings.ToLower(strings.TrimSpace(req.Region))) {
+		return false
+	}
+	if req.Protocol != types.GatewayProtocol_GATEWAY_PROTOCOL_UNSPECIFIED && !gateway.SupportsProtocol(req.Protocol) {
+		return false
+	}
+	return true
+}
+
+// gatewaysByScore scores every gateway, drops those under min_score and, when
+// asked, orders the rest by descending score (ties by id) before paging.
+// Scores are not stored, so only offset paging is supported here.
+func (q queryServer) gatewaysByScore(ctx context.Context, req *types.QueryGatewaysRequest, pageReq *query.PageRequest) (*types.QueryGatewaysResponse, error) {
+	if len(pageReq.Key) > 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "key pagination is not supported with sort_by_score or min_score")
+	}
+	var (
+		gateways    []*types.Gateway
+		reputations []*types.GatewayReputation
+	)
+	err := q.Keeper.Gateways.Walk(ctx, nil, func(_ uint64, gateway types.Gateway) (bool, error) {
+		if !gatewayMatchesProfile(req, gateway) {
 			return false, nil
 		}
-		if uint64(len(collected)) >= limit {
-			return true, nil
+		rep, err := q.gatewayReputation(ctx, gateway)
+		if err != nil {
+			return true, err
+		}
+		if rep.Score < req.MinScore {
+			return false, nil
 		}
 		gw := gateway
-		collected = append(collected, &gw)
+		gateways = append(gateways, &gw)
+		reputations = append(reputations, &rep)
 		return false, nil
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	if req.SortByScore {
+		order := make([]int, len(gateways))
+		for i := range order {
+			order[i] = i
+		}
+		sort.SliceStable(order, func(a, b int) bool {
+			return reputations[order[a]].Score > reputations[order[b]].Score
+		})
+		sortedGw := make([]*types.Gateway, len(order))
+		sortedRep := make([]*types.GatewayReputation, len(order))
+		for i, idx := range order {
+			sortedGw[i], sortedRep[i] = gateways[idx], reputations[idx]
+		}
+		gateways, reputations = sortedGw, sortedRep
+	}
 
+	total := uint64(len(gateways))
+	start := min(pageReq.Offset, total)
+	end := min(start+pageReq.Limit, total)
 	return &types.QueryGatewaysResponse{
-		Gateways: collected,
-		Total:    total,
+		Gateways:    gateways[start:end],
+		Total:       total,
+		Reputations: reputations[start:end],
+		Pagination:  &query.PageResponse{Total: total},
 	}, nil
 }
 
@@ -97,24 +275,185 @@ func (q queryServer) Contract(ctx context.Context, req *types.QueryContractReque
 	return &types.QueryContractResponse{Contract: &contract}, nil
 }
 
+// CancelQuote reports what MsgCancelContract would refund and retain if the
+// client cancelled in the current block.
+func (q queryServer) CancelQuote(ctx context.Context, req *types.QueryCancelQuoteRequest) (*types.QueryCancelQuoteResponse, error) {
+	contract, err := q.Keeper.Contracts.Get(ctx, req.ContractId)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrNotFound, "contract not found")
+	}
+	params := q.Keeper.GetParams(ctx)
+	res := &types.QueryCancelQuoteResponse{
+		Denom:  contract.PaymentDenom(),
+		Policy: q.Keeper.cancellationPolicy(ctx, contract, params),
+	}
+	if contract.Status == types.ContractStatus_CONTRACT_STATUS_PENDING {
+		refund := q.Keeper.safeAmountFromString(contract.EscrowUlmn).Add(q.Keeper.safeAmountFromString(contract.PendingTaxUlmn))
+		res.RefundUlmn, res.EarnedUlmn, res.PenaltyUlmn, res.PayoutUlmn, res.FeeUlmn = refund.String(), "0", "0", "0", "0"
+		return res, nil
+	}
+	if contract.Status != types.ContractStatus_CONTRACT_STATUS_ACTIVE {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "contract not active")
+	}
+	if contract.ClaimedMonths >= contract.MonthsTotal {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "contract already completed")
+	}
+	now := uint64(q.Keeper.nowUnix(ctx))
+	frozen, err := q.Keeper.contractFrozen(ctx, contract, now)
+	if err != nil {
+		return nil, err
+	}
+	if frozen {
+		return nil, errorsmod.Wrapf(types.ErrDisputeOpen, "dispute %d", contract.DisputeId)
+	}
+	terms, err := q.Keeper.quoteCancel(ctx, contract, now)
+	if err != nil {
+		return nil, err
+	}
+	res.RefundUlmn = terms.refund.String()
+	res.EarnedUlmn = terms.earned.String()
+	res.PenaltyUlmn = terms.penalty.String()
+	res.PayoutUlmn = terms.payout.String()
+	res.FeeUlmn = terms.fee.String()
+	return res, nil
+}
+
+// Contracts pages through the most selective index for the request's
+// filters (next payout, client, gateway, then status) and checks the
+// remaining filters against each contract.
 func (q queryServer) Contracts(ctx context.Context, req *types.QueryContractsRequest) (*types.QueryContractsResponse, error) {
+	pageReq := pageRequest(req.Pagination, req.Offset, req.Limit)
+	client := strings.TrimSpace(req.Client)
+	gatewayID := req.GatewayId
+	status := int32(-1)
+	if name := strings.TrimSpace(strings.ToUpper(req.Status)); name != "" {
+		value, ok := types.ContractStatus_value["CONTRACT_STATUS_"+name]
+		if !ok {
+			return nil, errorsmod.Wrapf(types.ErrInvalidRequest, "unknown contract status %q", req.Status)
+		}
+		status = value
+	}
+	match := func(contract types.Contract) bool {
+		switch {
+		case status >= 0 && int32(contract.Status) != status:
+			return false
+		case client != "" && contract.Client != client:
+			return false
+		case gatewayID != 0 && contract.GatewayId != gatewayID:
+			return false
+		case req.DueBefore > 0 && contract.NextPayoutTime > req.DueBefore:
+			return false
+		}
+		return true
+	}
+
+	var (
+		contracts []*types.Contract
+		pageRes   *query.PageResponse
+		err       error
+	)
+	switch {
+	case req.DueBefore > 0:
+		contracts, pageRes, err = paginateContracts(ctx, q.Keeper, q.ContractsByPayout, pageReq, match)
+	case client != "":
+		contracts, pageRes, err = paginateContracts(ctx, q.Keeper, q.ContractsByClient, pageReq, match,
+			query.WithCollectionPaginationPairPrefix[string, uint64](client))
+	case gatewayID != 0:
+		contracts, pageRes, err = paginateContracts(ctx, q.Keeper, q.ContractsByGateway, pageReq, match,
+			query.WithCollectionPaginationPairPrefix[uint64, uint64](gatewayID))
+	case status >= 0:
+		contracts, pageRes, err = paginateContracts(ctx, q.Keeper, q.ContractsByStatus, pageReq, match,
+			query.WithCollectionPaginationPairPrefix[int32, uint64](status))
+	default:
+		contracts, pageRes, err = query.CollectionPaginate(ctx, q.Keeper.Contracts, pageReq,
+			func(_ uint64, contract types.Contract) (*types.Contract, error) {
+				return &contract, nil
+			},
+		)
+	}
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, err.Error())
+	}
+
+	return &types.QueryContractsResponse{
+		Contracts:  contracts,
+		Total:      pageRes.Total,
+		Pagination: pageRes,
+	}, nil
+}
+
+// paginateContracts pages through a (key, contract id) index, keeping the
+// contracts that match.
+func paginateContracts[K any](
+	ctx context.Context,
+	k Keeper,
+	index collections.KeySet[collections.Pair[K, uint64]],
+	pageReq *query.PageRequest,
+	match func(types.Contract) bool,
+	opts ...func(*query.CollectionsPaginateOptions[collections.Pair[K, uint64]]),
+) ([]*types.Contract, *query.PageResponse, error) {
+	return query.CollectionFilteredPaginate(ctx, index, pageReq,
+		func(key collections.Pair[K, uint64], _ collections.NoValue) (bool, error) {
+			contract, err := k.Contracts.Get(ctx, key.K2())
+			if err != nil {
+				return false, err
+			}
+			return match(contract), nil
+		},
+		func(key collections.Pair[K, uint64], _ collections.NoValue) (*types.Contract, error) {
+			contract, err := k.Contracts.Get(ctx, key.K2())
+			if err != nil {
+				return nil, err
+			}
+			return &contract, nil
+		},
+		opts...,
+	)
+}
+
+func (q queryServer) UsageReport(ctx context.Context, req *types.QueryUsageReportRequest) (*types.QueryUsageReportResponse, error) {
+	report, err := q.Keeper.UsageReports.Get(ctx, collections.Join(req.ContractId, req.Month))
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrNotFound, "usage report not found")
+	}
+	return &types.QueryUsageReportResponse{Report: &report}, nil
+}
+
+func (q queryServer) UsageReports(ctx context.Context, req *types.QueryUsageReportsRequest) (*types.QueryUsageReportsResponse, error) {
+	reports := make([]*types.UsageReport, 0)
+	rng := collections.NewPrefixedPairRange[uint64, uint32](req.ContractId)
+	err := q.Keeper.UsageReports.Walk(ctx, rng, func(_ collections.Pair[uint64, uint32], report types.UsageReport) (bool, error) {
+		r := report
+		reports = append(reports, &r)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryUsageReportsResponse{Reports: reports}, nil
+}
+
+func (q queryServer) Dispute(ctx context.Context, req *types.QueryDisputeRequest) (*types.QueryDisputeResponse, error) {
+	dispute, err := q.Keeper.Disputes.Get(ctx, req.Id)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrNotFound, "dispute not found")
+	}
+	return &types.QueryDisputeResponse{Dispute: &dispute}, nil
+}
+
+func (q queryServer) Disputes(ctx context.Context, req *types.QueryDisputesRequest) (*types.QueryDisputesResponse, error) {
 	limit := clampLimit(req.Limit)
 	offset := req.Offset
 	status := strings.TrimSpace(strings.ToUpper(req.Status))
-	client := strings.TrimSpace(req.Client)
-	gatewayID := req.GatewayId
 
 	total := uint64(0)
-	collected := make([]*types.Contract, 0, limit)
+	collected := make([]*types.Dispute, 0, limit)
 
-	_ = q.Keeper.Contracts.Walk(ctx, nil, func(_ uint64, contract types.Contract) (bool, error) {
-		if status != "" && contract.Status.String() != "CONTRACT_STATUS_"+status {
-			return false, nil
-		}
-		if client != "" && contract.Client != client {
+	_ = q.Keeper.Disputes.Walk(ctx, nil, func(_ uint64, dispute types.Dispute) (bool, error) {
+		if req.ContractId != 0 && dispute.ContractId != req.ContractId {
 			return false, nil
 		}
-		if gatewayID != 0 && contract.GatewayId != gatewayID {
+		if status != "" && dispute.Status.String() != "DISPUTE_STATUS_"+status {
 			return false, nil
 		}
 		total++
@@ -124,13 +463,66 @@ func (q queryServer) Contracts(ctx context.Context, req *types.QueryContractsReq
 		if uint64(len(collected)) >= limit {
 			return true, nil
 		}
-		ct := contract
-		collected = append(collected, &ct)
+		d := dispute
+		collected = append(collected, &d)
 		return false, nil
 	})
 
-	return &types.QueryContractsResponse{
-		Contracts: collected,
-		Total:     total,
+	return &types.QueryDisputesResponse{
+		Disputes: collected,
+		Total:    total,
+	}, nil
+}
+
+func (q queryServer) Offer(ctx context.Context, req *types.QueryOfferRequest) (*types.QueryOfferResponse, error) {
+	offer, err := q.Keeper.Offers.Get(ctx, req.Id)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrNotFound, "offer not found")
+	}
+	return &types.QueryOfferResponse{Offer: &offer}, nil
+}
+
+// Offers lists offers, by default only those still accepting contracts. A
+// gateway_id filter walks that gateway's offer index instead of every offer.
+func (q queryServer) Offers(ctx context.Context, req *types.QueryOffersRequest) (*types.QueryOffersResponse, error) {
+	limit := clampLimit(req.Limit)
+	offset := req.Offset
+
+	total := uint64(0)
+	collected := make([]*types.Offer, 0, limit)
+	visit := func(offer types.Offer) bool {
+		if offer.Retired && !req.IncludeRetired {
+			return false
+		}
+		total++
+		if total <= offset {
+			return false
+		}
+		if uint64(len(collected)) >= limit {
+			return true
+		}
+		o := offer
+		collected = append(collected, &o)
+		return false
+	}
+
+	if req.GatewayId != 0 {
+		rng := collections.NewPrefixedPairRange[uint64, uint64](req.GatewayId)
+		_ = q.Keeper.GatewayOffers.Walk(ctx, rng, func(key collections.Pair[uint64, uint64]) (bool, error) {
+			offer, err := q.offerByID(ctx, key.K2())
+			if err != nil {
+				return false, nil
+			}
+			return visit(offer), nil
+		})
+	} else {
+		_ = q.Keeper.Offers.Walk(ctx, nil, func(_ uint64, offer types.Offer) (bool, error) {
+			return visit(offer), nil
+		})
+	}
+
+	return &types.QueryOffersResponse{
+		Offers: collected,
+		Total:  total,
 	}, nil
 }
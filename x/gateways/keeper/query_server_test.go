@@ -3,6 +3,8 @@ package keeper_test
 import (
 	"testing"
 
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/stretchr/testify/require"
 
 	"lumen/x/gateways/keeper"
@@ -53,3 +55,163 @@ func TestContractsQueryClamp(t *testing.T) {
 	require.NoError(t, err)
 	require.Len(t, resp.Contracts, int(keeper.MaxQueryLimit))
 }
+
+// indexedContracts stores 30 contracts through genesis: clients alternate
+// between two addresses, gateways cycle through 1..3 and every third
+// contract is completed. Active contracts pay out at 1_000 + id.
+func indexedContracts(t *testing.T, f *gatewayFixture) (clientA, clientB string) {
+	t.Helper()
+	clientA, clientB = randomAccAddress(), randomAccAddress()
+	genesis := types.DefaultGenesis()
+	for i := uint64(1); i <= 30; i++ {
+		ct := &types.Contract{Id: i, Client: clientA, GatewayId: i%3 + 1, Status: types.ContractStatus_CONTRACT_STATUS_ACTIVE, NextPayoutTime: 1_000 + i}
+		if i%2 == 0 {
+			ct.Client = clientB
+		}
+		if i%3 == 0 {
+			ct.Status = types.ContractStatus_CONTRACT_STATUS_COMPLETED
+			ct.NextPayoutTime = 0
+		}
+		genesis.Contracts = append(genesis.Contracts, ct)
+	}
+	require.NoError(t, f.keeper.InitGenesis(f.ctx, *genesis))
+	return clientA, clientB
+}
+
+func contractIDs(contracts []*types.Contract) []uint64 {
+	ids := make([]uint64, 0, len(contracts))
+	for _, ct := range contracts {
+		ids = append(ids, ct.Id)
+	}
+	return ids
+}
+
+func TestContractsQueryFiltersThroughIndexes(t *testing.T) {
+	f := initGatewayFixture(t)
+	q := keeper.NewQueryServerImpl(f.keeper)
+	clientA, _ := indexedContracts(t, f)
+
+	resp, err := q.Contracts(f.ctx, &types.QueryContractsRequest{Client: clientA, GatewayId: 2, Status: "active"})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 7, 13, 19, 25}, contractIDs(resp.Contracts))
+	require.Equal(t, uint64(5), resp.Total)
+
+	resp, err = q.Contracts(f.ctx, &types.QueryContractsRequest{Status: "completed", Limit: 3, Offset: 1})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{6, 9, 12}, contractIDs(resp.Contracts))
+	require.Equal(t, uint64(10), resp.Total)
+
+	resp, err = q.Contracts(f.ctx, &types.QueryContractsRequest{DueBefore: 1_005, GatewayId: 3})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{2, 5}, contractIDs(resp.Contracts))
+	resp, err = q.Contracts(f.ctx, &types.QueryContractsRequest{DueBefore: 1_030, GatewayId: 1})
+	require.NoError(t, err)
+	require.Empty(t, resp.Contracts, "completed contracts are not scheduled")
+
+	_, err = q.Contracts(f.ctx, &types.QueryContractsRequest{Status: "bogus"})
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+
+	// Completing a contract moves it between the status indexes.
+	contract, err := f.keeper.Contracts.Get(f.ctx, 1)
+	require.NoError(t, err)
+	contract.Status = types.ContractStatus_CONTRACT_STATUS_COMPLETED
+	contract.NextPayoutTime = 0
+	genesis := types.DefaultGenesis()
+	genesis.Contracts = []*types.Contract{&contract}
+	require.NoError(t, f.keeper.InitGenesis(f.ctx, *genesis))
+	resp, err = q.Contracts(f.ctx, &types.QueryContractsRequest{Client: clientA, GatewayId: 2, Status: "active"})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{7, 13, 19, 25}, contractIDs(resp.Contracts))
+	resp, err = q.Contracts(f.ctx, &types.QueryContractsRequest{DueBefore: 1_002})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{2}, contractIDs(resp.Contracts))
+}
+
+func TestContractsQueryKeyPagination(t *testing.T) {
+	f := initGatewayFixture(t)
+	q := keeper.NewQueryServerImpl(f.keeper)
+	_, clientB := indexedContracts(t, f)
+
+	var ids []uint64
+	page := &query.PageRequest{Limit: 4}
+	for {
+		resp, err := q.Contracts(f.ctx, &types.QueryContractsRequest{Client: clientB, Pagination: page})
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(resp.Contracts), 4)
+		ids = append(ids, contractIDs(resp.Contracts)...)
+		if len(resp.Pagination.NextKey) == 0 {
+			break
+		}
+		page = &query.PageRequest{Key: resp.Pagination.NextKey, Limit: 4}
+	}
+	require.Len(t, ids, 15)
+	for i, id := range ids {
+		require.Equal(t, uint64(2*(i+1)), id)
+	}
+
+	gwResp, err := q.Gateways(f.ctx, &types.QueryGatewaysRequest{SortByScore: true, Pagination: &query.PageRequest{Key: []byte{1}}})
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+	require.Nil(t, gwResp)
+}
+
+func TestMigrate1to2BuildsContractIndexes(t *testing.T) {
+	f := initGatewayFixture(t)
+	q := keeper.NewQueryServerImpl(f.keeper)
+
+	// Contracts written before the indexes existed.
+	client := randomAccAddress()
+	for i := uint64(1); i <= 3; i++ {
+		require.NoError(t, f.keeper.Contracts.Set(f.ctx, i, types.Contract{
+			Id:             i,
+			Client:         client,
+			GatewayId:      7,
+			Status:         types.ContractStatus_CONTRACT_STATUS_ACTIVE,
+			NextPayoutTime: 500 + i,
+		}))
+	}
+	resp, err := q.Contracts(f.ctx, &types.QueryContractsRequest{Client: client})
+	require.NoError(t, err)
+	require.Empty(t, resp.Contracts)
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate1to2(f.ctx))
+
+	resp, err = q.Contracts(f.ctx, &types.QueryContractsRequest{Client: client})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 2, 3}, contractIDs(resp.Contracts))
+	resp, err = q.Contracts(f.ctx, &types.QueryContractsRequest{GatewayId: 7, DueBefore: 502})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 2}, contractIDs(resp.Contracts))
+	has, err := f.keeper.GatewayPayouts.Has(f.ctx, collections.Join3(uint64(7), uint64(503), uint64(3)))
+	require.NoError(t, err)
+	require.True(t, has)
+}
+
+func TestMigrate1to2DefaultsNewParams(t *testing.T) {
+	f := initGatewayFixture(t)
+	// Params as stored by store version 1.
+	require.NoError(t, f.keeper.Params.Set(f.ctx, types.Params{
+		PlatformCommissionBps:        250,
+		MonthSeconds:                 24 * 60 * 60,
+		FinalizeDelayMonths:          3,
+		FinalizerRewardBps:           100,
+		MinPriceUlmnPerMonth:         5_000,
+		MaxActiveContractsPerGateway: 7,
+		ActionFeeUlmn:                10,
+		RegisterGatewayFeeUlmn:       20,
+	}))
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate1to2(f.ctx))
+
+	params := f.keeper.GetParams(f.ctx)
+	defaults := types.DefaultParams()
+	require.Equal(t, uint32(250), params.PlatformCommissionBps)
+	require.Equal(t, uint32(7), params.MaxActiveContractsPerGateway)
+	require.Equal(t, uint64(24*60*60), params.UsageDisputeWindowSeconds, "clamped to month_seconds")
+	require.Equal(t, defaults.AcceptanceTimeoutSeconds, params.AcceptanceTimeoutSeconds)
+	require.Equal(t, defaults.MinBondUlmn, params.MinBondUlmn)
+	require.Equal(t, defaults.BondPerClientUlmn, params.BondPerClientUlmn)
+	require.Equal(t, defaults.AutoClaimsPerBlock, params.AutoClaimsPerBlock)
+	require.Equal(t, defaults.HeartbeatMissedWindows, params.HeartbeatMissedWindows)
+	require.Equal(t, defaults.CancellationPolicy, params.CancellationPolicy)
+	require.NoError(t, types.ValidateParams(params))
+}
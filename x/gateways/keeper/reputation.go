@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"lumen/x/gateways/types"
+
+	"cosmossdk.io/collections"
+)
+
+// gatewayReputation returns the stored reputation of a gateway with its
+// score recomputed for the current block, since the age component drifts
+// between contract outcomes.
+func (k Keeper) gatewayReputation(ctx context.Context, gateway types.Gateway) (types.GatewayReputation, error) {
+	rep, err := k.GatewayReputations.Get(ctx, gateway.Id)
+	if errors.Is(err, collections.ErrNotFound) {
+		rep = types.GatewayReputation{GatewayId: gateway.Id}
+	} else if err != nil {
+		return types.GatewayReputation{}, err
+	}
+	rep.Score = types.ReputationScore(rep, gateway.CreatedAt, uint64(k.nowUnix(ctx)))
+	return rep, nil
+}
+
+func (k Keeper) setGatewayReputation(ctx context.Context, rep types.GatewayReputation) error {
+	return k.GatewayReputations.Set(ctx, rep.GatewayId, rep)
+}
+
+// recordOutcome applies update to the gateway's reputation and stores it
+// with a fresh score.
+func (k Keeper) recordOutcome(ctx context.Context, gatewayID uint64, update func(*types.GatewayReputation)) error {
+	gateway, err := k.gatewayByID(ctx, gatewayID)
+	if err != nil {
+		return err
+	}
+	rep, err := k.gatewayReputation(ctx, gateway)
+	if err != nil {
+		return err
+	}
+	update(&rep)
+	now := uint64(k.nowUnix(ctx))
+	rep.Score = types.ReputationScore(rep, gateway.CreatedAt, now)
+	rep.UpdatedAt = now
+	return k.setGatewayReputation(ctx, rep)
+}
@@ -0,0 +1,78 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"lumen/app/denom"
+	"lumen/x/gateways/keeper"
+	"lumen/x/gateways/types"
+)
+
+func TestReputationTracksClaimsAndCancellations(t *testing.T) {
+	f := initGatewayFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	operator, client, contractID := setupUsageContract(t, f, srv)
+	params := f.keeper.GetParams(f.ctx)
+	contract, err := f.keeper.Contracts.Get(f.ctx, contractID)
+	require.NoError(t, err)
+
+	res, err := qs.Gateway(f.ctx, &types.QueryGatewayRequest{Id: contract.GatewayId})
+	require.NoError(t, err)
+	require.Equal(t, uint32(3_000), res.Reputation.Score, "a new gateway starts at neutral reliability")
+
+	f.withBlockTime(int64(2 * params.MonthSeconds))
+	_, err = srv.ClaimPayment(f.ctx, &types.MsgClaimPayment{Operator: operator, ContractId: contractID})
+	require.NoError(t, err)
+	_, err = srv.CancelContract(f.ctx, &types.MsgCancelContract{Client: client, ContractId: contractID})
+	require.NoError(t, err)
+
+	res, err = qs.Gateway(f.ctx, &types.QueryGatewayRequest{Id: contract.GatewayId})
+	require.NoError(t, err)
+	rep := res.Reputation
+	require.Equal(t, uint64(2), rep.MonthsDelivered)
+	require.Equal(t, uint64(1), rep.ContractsCancelled)
+	require.Zero(t, rep.ContractsCompleted)
+	require.Equal(t, uint64(2*params.MonthSeconds), rep.UpdatedAt)
+	require.Equal(t, types.ReputationScore(*rep, 0, 2*params.MonthSeconds), rep.Score)
+	require.Less(t, rep.Score, uint32(3_000))
+}
+
+func TestGatewaysQuerySortsAndFiltersByScore(t *testing.T) {
+	f := initGatewayFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	ids := make([]uint64, 3)
+	for i := range ids {
+		operator := randomAccAddress()
+		f.bank.setAccountBalance(f.mustAccAddress(operator), sdk.NewCoins(sdk.NewCoin(denom.BaseDenom, sdkmath.NewIntFromUint64(f.registerFee()))))
+		gw, err := srv.RegisterGateway(f.ctx, &types.MsgRegisterGateway{Operator: operator})
+		require.NoError(t, err)
+		ids[i] = gw.Id
+	}
+	require.NoError(t, f.keeper.GatewayReputations.Set(f.ctx, ids[0], types.GatewayReputation{GatewayId: ids[0], ContractsCancelled: 4}))
+	require.NoError(t, f.keeper.GatewayReputations.Set(f.ctx, ids[2], types.GatewayReputation{GatewayId: ids[2], ContractsCompleted: 4, MonthsDelivered: 24}))
+
+	res, err := qs.Gateways(f.ctx, &types.QueryGatewaysRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Reputations, len(res.Gateways))
+	require.Equal(t, ids[0], res.Gateways[0].Id, "default order stays by id")
+
+	res, err = qs.Gateways(f.ctx, &types.QueryGatewaysRequest{SortByScore: true})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), res.Total)
+	require.Equal(t, []uint64{ids[2], ids[1], ids[0]}, []uint64{res.Gateways[0].Id, res.Gateways[1].Id, res.Gateways[2].Id})
+	require.Equal(t, uint32(8_500), res.Reputations[0].Score)
+	require.Equal(t, res.Gateways[1].Id, res.Reputations[1].GatewayId)
+
+	res, err = qs.Gateways(f.ctx, &types.QueryGatewaysRequest{SortByScore: true, MinScore: 1, Offset: 1, Limit: 5})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), res.Total, "zero-score gateway filtered out")
+	require.Len(t, res.Gateways, 1)
+	require.Equal(t, ids[1], res.Gateways[0].Id)
+}
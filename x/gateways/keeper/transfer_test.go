@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"lumen/app/denom"
+	"lumen/x/gateways/keeper"
+	"lumen/x/gateways/types"
+)
+
+func TestTransferGatewayMovesOperatorAndFutureClaims(t *testing.T) {
+	f := initGatewayFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	operator, _, contractID := setupUsageContract(t, f, srv)
+	contract, err := f.keeper.Contracts.Get(f.ctx, contractID)
+	require.NoError(t, err)
+	gatewayID := contract.GatewayId
+	params := f.keeper.GetParams(f.ctx)
+	f.bank.setAccountBalance(f.mustAccAddress(operator), sdk.NewCoins(sdk.NewInt64Coin(denom.BaseDenom, 100_000)))
+
+	buyer := randomAccAddress()
+	_, err = srv.TransferGateway(f.ctx, &types.MsgTransferGateway{Operator: buyer, GatewayId: gatewayID, NewOperator: randomAccAddress()})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = srv.TransferGateway(f.ctx, &types.MsgTransferGateway{Operator: operator, GatewayId: gatewayID})
+	require.ErrorContains(t, err, "no transfer pending")
+
+	_, err = srv.TransferGateway(f.ctx, &types.MsgTransferGateway{Operator: operator, GatewayId: gatewayID, NewOperator: buyer})
+	require.NoError(t, err)
+	gateway, err := f.keeper.Gateways.Get(f.ctx, gatewayID)
+	require.NoError(t, err)
+	require.Equal(t, operator, gateway.Operator, "rights move only on acceptance")
+	require.Equal(t, buyer, gateway.PendingOperator)
+
+	_, err = srv.AcceptGatewayTransfer(f.ctx, &types.MsgAcceptGatewayTransfer{NewOperator: randomAccAddress(), GatewayId: gatewayID})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	f.withBlockTime(100)
+	f.resetEvents()
+	_, err = srv.AcceptGatewayTransfer(f.ctx, &types.MsgAcceptGatewayTransfer{NewOperator: buyer, GatewayId: gatewayID})
+	require.NoError(t, err)
+	gateway, err = f.keeper.Gateways.Get(f.ctx, gatewayID)
+	require.NoError(t, err)
+	require.Equal(t, buyer, gateway.Operator)
+	require.Equal(t, buyer, gateway.Payout)
+	require.Empty(t, gateway.PendingOperator)
+	require.Equal(t, uint64(100), gateway.TransferredAt)
+	var accepted bool
+	for _, ev := range f.ctx.EventManager().Events() {
+		if ev.Type == "gateway_transfer_accept" {
+			accepted = true
+		}
+	}
+	require.True(t, accepted)
+
+	// The running contract is untouched and pays the new operator.
+	f.withBlockTime(int64(params.MonthSeconds))
+	_, err = srv.ClaimPayment(f.ctx, &types.MsgClaimPayment{Operator: operator, ContractId: contractID})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = srv.ClaimPayment(f.ctx, &types.MsgClaimPayment{Operator: buyer, ContractId: contractID})
+	require.NoError(t, err)
+	require.Equal(t, "196020", f.bank.accountBalance(f.mustAccAddress(buyer)).AmountOf(denom.BaseDenom).String())
+
+	// Another transfer waits for the cooldown.
+	f.bank.setAccountBalance(f.mustAccAddress(buyer), sdk.NewCoins(sdk.NewInt64Coin(denom.BaseDenom, 100_000)))
+	next := randomAccAddress()
+	_, err = srv.TransferGateway(f.ctx, &types.MsgTransferGateway{Operator: buyer, GatewayId: gatewayID, NewOperator: next})
+	require.ErrorContains(t, err, "transferred recently")
+	f.withBlockTime(int64(100 + params.GatewayTransferCooldownSeconds))
+	_, err = srv.TransferGateway(f.ctx, &types.MsgTransferGateway{Operator: buyer, GatewayId: gatewayID, NewOperator: next})
+	require.NoError(t, err)
+
+	// Withdrawing the proposal leaves nothing to accept.
+	_, err = srv.TransferGateway(f.ctx, &types.MsgTransferGateway{Operator: buyer, GatewayId: gatewayID})
+	require.NoError(t, err)
+	_, err = srv.AcceptGatewayTransfer(f.ctx, &types.MsgAcceptGatewayTransfer{NewOperator: next, GatewayId: gatewayID})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+}
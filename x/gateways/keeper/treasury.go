@@ -0,0 +1,276 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"lumen/app/denom"
+	"lumen/x/gateways/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// Treasury flow names, the first part of TreasuryFlows keys.
+const (
+	flowInflow        = "inflow"
+	flowCommunityPool = "community_pool"
+	flowBurned        = "burned"
+	flowStakers       = "stakers"
+	flowSpent         = "spent"
+)
+
+// creditTreasury moves amount from a module account into the treasury and
+// records it in the per-denom treasury balance.
+func (k Keeper) creditTreasury(ctx context.Context, fromModule string, coinDenom string, amount sdkmath.Int) error {
+	if !amount.IsPositive() {
+		return nil
+	}
+	if err := k.moveModuleToModule(ctx, fromModule, types.ModuleAccountTreasury, coinDenom, amount); err != nil {
+		return err
+	}
+	balance, err := k.treasuryAmount(ctx, coinDenom)
+	if err != nil {
+		return err
+	}
+	if err := k.TreasuryBalance.Set(ctx, coinDenom, balance.Add(amount)); err != nil {
+		return err
+	}
+	return k.recordTreasuryFlow(ctx, flowInflow, coinDenom, amount)
+}
+
+// debitTreasury books an outflow that has already left the treasury account.
+func (k Keeper) debitTreasury(ctx context.Context, flow, coinDenom string, amount sdkmath.Int) error {
+	if !amount.IsPositive() {
+		return nil
+	}
+	balance, err := k.treasuryAmount(ctx, coinDenom)
+	if err != nil {
+		return err
+	}
+	if balance.LT(amount) {
+		return errorsmod.Wrapf(types.ErrInsufficientFunds, "treasury holds %s%s", balance, coinDenom)
+	}
+	if err := k.TreasuryBalance.Set(ctx, coinDenom, balance.Sub(amount)); err != nil {
+		return err
+	}
+	return k.recordTreasuryFlow(ctx, flow, coinDenom, amount)
+}
+
+func (k Keeper) recordTreasuryFlow(ctx context.Context, flow, coinDenom string, amount sdkmath.Int) error {
+	key := collections.Join(flow, coinDenom)
+	total, err := k.TreasuryFlows.Get(ctx, key)
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+		total = sdkmath.ZeroInt()
+	}
+	return k.TreasuryFlows.Set(ctx, key, total.Add(amount))
+}
+
+func (k Keeper) treasuryAmount(ctx context.Context, coinDenom string) (sdkmath.Int, error) {
+	balance, err := k.TreasuryBalance.Get(ctx, coinDenom)
+	if errors.Is(err, collections.ErrNotFound) {
+		return sdkmath.ZeroInt(), nil
+	}
+	return balance, err
+}
+
+// treasuryBalance returns the recorded treasury balance as coins.
+func (k Keeper) treasuryBalance(ctx context.Context) (sdk.Coins, error) {
+	coins := sdk.NewCoins()
+	err := k.TreasuryBalance.Walk(ctx, nil, func(coinDenom string, amount sdkmath.Int) (bool, error) {
+		coins = coins.Add(sdk.NewCoin(coinDenom, amount))
+		return false, nil
+	})
+	return coins, err
+}
+
+// treasuryFlows returns the inflow and outflow totals of the treasury.
+func (k Keeper) treasuryFlows(ctx context.Context) (types.TreasuryFlows, error) {
+	flows := types.TreasuryFlows{}
+	err := k.TreasuryFlows.Walk(ctx, nil, func(key collections.Pair[string, string], amount sdkmath.Int) (bool, error) {
+		coin := sdk.NewCoin(key.K2(), amount)
+		switch key.K1() {
+		case flowInflow:
+			flows.Inflow = flows.Inflow.Add(coin)
+		case flowCommunityPool:
+			flows.CommunityPool = flows.CommunityPool.Add(coin)
+		case flowBurned:
+			flows.Burned = flows.Burned.Add(coin)
+		case flowStakers:
+			flows.Stakers = flows.Stakers.Add(coin)
+		case flowSpent:
+			flows.Spent = flows.Spent.Add(coin)
+		}
+		return false, nil
+	})
+	return flows, err
+}
+
+func (k Keeper) setTreasuryFlows(ctx context.Context, flows types.TreasuryFlows) error {
+	for _, f := range []struct {
+		flow  string
+		coins sdk.Coins
+	}{
+		{flowInflow, flows.Inflow},
+		{flowCommunityPool, flows.CommunityPool},
+		{flowBurned, flows.Burned},
+		{flowStakers, flows.Stakers},
+		{flowSpent, flows.Spent},
+	} {
+		for _, coin := range f.coins {
+			if err := k.TreasuryFlows.Set(ctx, collections.Join(f.flow, coin.Denom), coin.Amount); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// nextTreasuryDistribution returns when the EndBlocker next splits the
+// treasury, or 0 when the policy is disabled.
+func (k Keeper) nextTreasuryDistribution(ctx context.Context, params types.Params) (last, next uint64, err error) {
+	last, err = k.TreasuryDistributedAt.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return 0, 0, err
+	}
+	if params.TreasuryDistributionIntervalSeconds == 0 {
+		return last, 0, nil
+	}
+	next, err = k.safeAddUint64(last, params.TreasuryDistributionIntervalSeconds)
+	return last, next, err
+}
+
+// distributeTreasury applies the governance treasury policy once per
+// interval: shares of every denom go to the community pool, are burned or
+// are handed to stakers through the fee collector.
+func (k Keeper) distributeTreasury(ctx context.Context) error {
+	params := k.GetParams(ctx)
+	now := uint64(k.nowUnix(ctx))
+	// The first interval starts counting at the first block that sees the
+	// policy rather than at unix time zero.
+	has, err := k.TreasuryDistributedAt.Has(ctx)
+	if err != nil {
+		return err
+	}
+	if !has {
+		return k.TreasuryDistributedAt.Set(ctx, now)
+	}
+	_, next, err := k.nextTreasuryDistribution(ctx, params)
+	if err != nil {
+		return err
+	}
+	if next == 0 || now < next {
+		return nil
+	}
+	if err := k.TreasuryDistributedAt.Set(ctx, now); err != nil {
+		return err
+	}
+
+	balance, err := k.treasuryBalance(ctx)
+	if err != nil {
+		return err
+	}
+	// Each denom is split in its own cache context: a transfer the bank or
+	// distribution module refuses skips that denom until the next interval
+	// instead of failing the block.
+	for _, coin := range balance {
+		cacheCtx, write := sdk.UnwrapSDKContext(ctx).CacheContext()
+		if err := k.distributeTreasuryCoin(cacheCtx, params, coin); err != nil {
+			sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+				sdk.NewEvent(
+					"treasury_distribute_skip",
+					sdk.NewAttribute("denom", coin.Denom),
+					sdk.NewAttribute("reason", err.Error()),
+				),
+			)
+			continue
+		}
+		write()
+	}
+	return nil
+}
+
+// distributeTreasuryCoin applies the treasury policy shares to one denom of
+// the treasury balance.
+func (k Keeper) distributeTreasuryCoin(ctx context.Context, params types.Params, coin sdk.Coin) error {
+	treasuryAddr := authtypes.NewModuleAddress(types.ModuleAccountTreasury)
+	community := k.applyCommission(coin.Amount, params.TreasuryCommunityPoolBps)
+	burn := k.applyCommission(coin.Amount, params.TreasuryBurnBps)
+	stakers := k.applyCommission(coin.Amount, params.TreasuryStakersBps)
+	// Burning an IBC voucher would strand its counterparty escrow.
+	if coin.Denom != denom.BaseDenom {
+		community = community.Add(burn)
+		burn = sdkmath.ZeroInt()
+	}
+	// Without a distribution keeper the community share stays put.
+	if k.dk != nil && community.IsPositive() {
+		if err := k.dk.FundCommunityPool(ctx, sdk.NewCoins(sdk.NewCoin(coin.Denom, community)), treasuryAddr); err != nil {
+			return err
+		}
+		if err := k.debitTreasury(ctx, flowCommunityPool, coin.Denom, community); err != nil {
+			return err
+		}
+	} else {
+		community = sdkmath.ZeroInt()
+	}
+	if burn.IsPositive() {
+		if err := k.bank.BurnCoins(ctx, types.ModuleAccountTreasury, sdk.NewCoins(sdk.NewCoin(coin.Denom, burn))); err != nil {
+			return err
+		}
+		if err := k.debitTreasury(ctx, flowBurned, coin.Denom, burn); err != nil {
+			return err
+		}
+	}
+	if err := k.moveModuleToModule(ctx, types.ModuleAccountTreasury, authtypes.FeeCollectorName, coin.Denom, stakers); err != nil {
+		return err
+	}
+	if err := k.debitTreasury(ctx, flowStakers, coin.Denom, stakers); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			"treasury_distribute",
+			sdk.NewAttribute("denom", coin.Denom),
+			sdk.NewAttribute("community_pool", community.String()),
+			sdk.NewAttribute("burned", burn.String()),
+			sdk.NewAttribute("stakers", stakers.String()),
+		),
+	)
+	return nil
+}
+
+// spendTreasury pays coins out of the treasury for MsgTreasurySpend.
+func (k Keeper) spendTreasury(ctx context.Context, recipient sdk.AccAddress, amount sdk.Coins) error {
+	for _, coin := range amount {
+		balance, err := k.treasuryAmount(ctx, coin.Denom)
+		if err != nil {
+			return err
+		}
+		if balance.LT(coin.Amount) {
+			return errorsmod.Wrapf(types.ErrInsufficientFunds, "treasury holds %s%s", balance, coin.Denom)
+		}
+	}
+	for _, coin := range amount {
+		if err := k.payFromModule(ctx, types.ModuleAccountTreasury, recipient, coin.Denom, coin.Amount); err != nil {
+			return err
+		}
+		if err := k.debitTreasury(ctx, flowSpent, coin.Denom, coin.Amount); err != nil {
+			return err
+		}
+	}
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			"treasury_spend",
+			sdk.NewAttribute("recipient", recipient.String()),
+			sdk.NewAttribute("amount", amount.String()),
+		),
+	)
+	return nil
+}
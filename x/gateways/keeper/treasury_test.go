@@ -0,0 +1,154 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/stretchr/testify/require"
+
+	"lumen/app/denom"
+	"lumen/x/gateways/keeper"
+	"lumen/x/gateways/types"
+)
+
+func setTreasuryPolicy(t *testing.T, f *gatewayFixture, communityBps, burnBps, stakersBps uint32, interval uint64) {
+	t.Helper()
+	params := f.keeper.GetParams(f.ctx)
+	params.TreasuryCommunityPoolBps = communityBps
+	params.TreasuryBurnBps = burnBps
+	params.TreasuryStakersBps = stakersBps
+	params.TreasuryDistributionIntervalSeconds = interval
+	require.NoError(t, f.keeper.SetParams(f.ctx, params))
+}
+
+func TestTreasuryDistributionAndSpend(t *testing.T) {
+	f := initGatewayFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	operator, _, contractID := setupUsageContract(t, f, srv)
+	f.keeper.SetDistrKeeper(mockDistrKeeper{bank: f.bank})
+	setTreasuryPolicy(t, f, 5_000, 2_000, 2_000, 1_000)
+	params := f.keeper.GetParams(f.ctx)
+
+	// The first EndBlocker only starts the interval.
+	require.NoError(t, f.keeper.EndBlocker(f.ctx))
+
+	f.withBlockTime(int64(params.MonthSeconds))
+	_, err := srv.ClaimPayment(f.ctx, &types.MsgClaimPayment{Operator: operator, ContractId: contractID})
+	require.NoError(t, err)
+	require.Equal(t, "1980", f.bank.moduleBalance(types.ModuleAccountTreasury).AmountOf(denom.BaseDenom).String())
+
+	require.NoError(t, f.keeper.EndBlocker(f.ctx))
+	require.Equal(t, "198", f.bank.moduleBalance(types.ModuleAccountTreasury).AmountOf(denom.BaseDenom).String(), "10% is kept")
+	require.Equal(t, "990", f.bank.moduleBalance(distrtypes.ModuleName).AmountOf(denom.BaseDenom).String())
+	require.Equal(t, "396", f.bank.burned.AmountOf(denom.BaseDenom).String())
+
+	// Nothing more happens until the interval has passed again.
+	require.NoError(t, f.keeper.EndBlocker(f.ctx))
+	require.Equal(t, "198", f.bank.moduleBalance(types.ModuleAccountTreasury).AmountOf(denom.BaseDenom).String())
+
+	recipient := randomAccAddress()
+	spend := &types.MsgTreasurySpend{
+		Authority: operator,
+		Recipient: recipient,
+		Amount:    sdk.NewCoins(sdk.NewInt64Coin(denom.BaseDenom, 100)),
+	}
+	_, err = srv.TreasurySpend(f.ctx, spend)
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	spend.Authority = sdk.AccAddress(f.keeper.GetAuthority()).String()
+	_, err = srv.TreasurySpend(f.ctx, spend)
+	require.NoError(t, err)
+	require.Equal(t, "100", f.bank.accountBalance(f.mustAccAddress(recipient)).AmountOf(denom.BaseDenom).String())
+	_, err = srv.TreasurySpend(f.ctx, spend)
+	require.ErrorIs(t, err, types.ErrInsufficientFunds)
+
+	res, err := keeper.NewQueryServerImpl(f.keeper).Treasury(f.ctx, &types.QueryTreasuryRequest{})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(denom.BaseDenom, 98)), res.Balance)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(denom.BaseDenom, 1_980)), res.Flows.Inflow)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(denom.BaseDenom, 990)), res.Flows.CommunityPool)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(denom.BaseDenom, 396)), res.Flows.Burned)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(denom.BaseDenom, 396)), res.Flows.Stakers)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(denom.BaseDenom, 100)), res.Flows.Spent)
+	require.Equal(t, params.MonthSeconds, res.LastDistributionAt)
+	require.Equal(t, params.MonthSeconds+1_000, res.NextDistributionAt)
+}
+
+func TestTreasuryNeverBurnsIBCVouchers(t *testing.T) {
+	f := initGatewayFixture(t)
+	f.keeper.SetDistrKeeper(mockDistrKeeper{bank: f.bank})
+
+	genesis := types.DefaultGenesis()
+	genesis.Params.TreasuryBurnBps = 5_000
+	genesis.Params.TreasuryDistributionIntervalSeconds = 10
+	genesis.TreasuryBalance = sdk.NewCoins(sdk.NewInt64Coin(ibcUSDC, 1_000))
+	genesis.TreasuryDistributedAt = 1
+	require.NoError(t, types.ValidateGenesis(genesis))
+	require.NoError(t, f.keeper.InitGenesis(f.ctx, *genesis))
+	f.bank.setModuleBalance(types.ModuleAccountTreasury, genesis.TreasuryBalance)
+
+	f.withBlockTime(11)
+	require.NoError(t, f.keeper.EndBlocker(f.ctx))
+	require.True(t, f.bank.burned.IsZero())
+	require.Equal(t, "500", f.bank.moduleBalance(distrtypes.ModuleName).AmountOf(ibcUSDC).String())
+
+	exported, err := f.keeper.ExportGenesis(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(ibcUSDC, 500)), exported.TreasuryBalance)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(ibcUSDC, 500)), exported.TreasuryFlows.CommunityPool)
+	require.Equal(t, uint64(11), exported.TreasuryDistributedAt)
+}
+
+func TestTreasuryDistributionSkipsFailingDenom(t *testing.T) {
+	f := initGatewayFixture(t)
+	f.keeper.SetDistrKeeper(mockDistrKeeper{bank: f.bank})
+
+	genesis := types.DefaultGenesis()
+	genesis.Params.TreasuryCommunityPoolBps = 5_000
+	genesis.Params.TreasuryDistributionIntervalSeconds = 10
+	genesis.TreasuryBalance = sdk.NewCoins(sdk.NewInt64Coin(denom.BaseDenom, 1_000), sdk.NewInt64Coin(ibcUSDC, 1_000))
+	genesis.TreasuryDistributedAt = 1
+	require.NoError(t, f.keeper.InitGenesis(f.ctx, *genesis))
+	// The recorded ulmn was never funded, so the community pool transfer fails.
+	f.bank.setModuleBalance(types.ModuleAccountTreasury, sdk.NewCoins(sdk.NewInt64Coin(ibcUSDC, 1_000)))
+
+	f.withBlockTime(11)
+	f.resetEvents()
+	require.NoError(t, f.keeper.EndBlocker(f.ctx))
+	require.Equal(t, "500", f.bank.moduleBalance(distrtypes.ModuleName).AmountOf(ibcUSDC).String())
+	skipped := false
+	for _, event := range f.ctx.EventManager().Events() {
+		if event.Type == "treasury_distribute_skip" {
+			skipped = true
+		}
+	}
+	require.True(t, skipped)
+
+	exported, err := f.keeper.ExportGenesis(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(denom.BaseDenom, 1_000), sdk.NewInt64Coin(ibcUSDC, 500)), exported.TreasuryBalance)
+	require.Equal(t, uint64(11), exported.TreasuryDistributedAt)
+}
+
+func TestMigrate1to2SeedsTreasuryBalance(t *testing.T) {
+	f := initGatewayFixture(t)
+	f.keeper.SetDistrKeeper(mockDistrKeeper{bank: f.bank})
+	held := sdk.NewCoins(sdk.NewInt64Coin(denom.BaseDenom, 4_000), sdk.NewInt64Coin(ibcUSDC, 30))
+	f.bank.setModuleBalance(types.ModuleAccountTreasury, held)
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate1to2(f.ctx))
+	res, err := keeper.NewQueryServerImpl(f.keeper).Treasury(f.ctx, &types.QueryTreasuryRequest{})
+	require.NoError(t, err)
+	require.Equal(t, held, res.Balance)
+
+	// Commission collected before the upgrade can be spent.
+	recipient := randomAccAddress()
+	_, err = keeper.NewMsgServerImpl(f.keeper).TreasurySpend(f.ctx, &types.MsgTreasurySpend{
+		Authority: sdk.AccAddress(f.keeper.GetAuthority()).String(),
+		Recipient: recipient,
+		Amount:    sdk.NewCoins(sdk.NewInt64Coin(denom.BaseDenom, 4_000)),
+	})
+	require.NoError(t, err)
+	require.Equal(t, "4000", f.bank.accountBalance(f.mustAccAddress(recipient)).AmountOf(denom.BaseDenom).String())
+}
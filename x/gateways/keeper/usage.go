@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"lumen/x/gateways/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+)
+
+func (k Keeper) usageReport(ctx context.Context, contractID uint64, month uint32) (types.UsageReport, bool, error) {
+	report, err := k.UsageReports.Get(ctx, collections.Join(contractID, month))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.UsageReport{}, false, nil
+	}
+	if err != nil {
+		return types.UsageReport{}, false, err
+	}
+	return report, true, nil
+}
+
+func (k Keeper) setUsageReport(ctx context.Context, report types.UsageReport) error {
+	return k.UsageReports.Set(ctx, collections.Join(report.ContractId, report.Month), report)
+}
+
+// monthPayout returns the amount owed to the gateway for one contract month.
+// Accepted reports, pending reports whose dispute window has elapsed and
+// disputed reports whose contract dispute timed out without a ruling are
+// pro-rated to the usage they declare; a ruling settles the escrow instead.
+// Months without a report are paid in full unless the params require reports. ErrUsageUnsettled is returned while
+// the month cannot be settled yet.
+func (k Keeper) monthPayout(ctx context.Context, contract types.Contract, month uint32, params types.Params, now uint64) (sdkmath.Int, error) {
+	price := sdkmath.NewIntFromUint64(contract.PriceUlmn)
+	report, found, err := k.usageReport(ctx, contract.Id, month)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+	if !found {
+		if params.RequireUsageReports {
+			return sdkmath.Int{}, errorsmod.Wrapf(types.ErrUsageUnsettled, "month %d has no usage report", month)
+		}
+		return price, nil
+	}
+	switch report.Status {
+	case types.UsageReportStatus_USAGE_REPORT_STATUS_ACCEPTED:
+	case types.UsageReportStatus_USAGE_REPORT_STATUS_PENDING:
+		if now < report.DisputeDeadline {
+			return sdkmath.Int{}, errorsmod.Wrapf(types.ErrUsageUnsettled, "month %d is within its dispute window", month)
+		}
+	case types.UsageReportStatus_USAGE_REPORT_STATUS_DISPUTED:
+		if now < report.DisputeDeadline {
+			return sdkmath.Int{}, errorsmod.Wrapf(types.ErrUsageUnsettled, "month %d usage is disputed", month)
+		}
+	default:
+		return sdkmath.Int{}, errorsmod.Wrapf(types.ErrUsageUnsettled, "month %d usage report has no status", month)
+	}
+	return types.ProRatedAmount(price, contract, report), nil
+}
+
+// clientUsageReport loads a report for a contract owned by client.
+func (k Keeper) clientUsageReport(ctx context.Context, client string, contractID uint64, month uint32) (types.Contract, types.UsageReport, error) {
+	contract, err := k.contractByID(ctx, contractID)
+	if err != nil {
+		return types.Contract{}, types.UsageReport{}, errorsmod.Wrap(types.ErrNotFound, "contract not found")
+	}
+	if contract.Client != client {
+		return types.Contract{}, types.UsageReport{}, errorsmod.Wrap(types.ErrUnauthorized, "not contract owner")
+	}
+	report, found, err := k.usageReport(ctx, contractID, month)
+	if err != nil {
+		return types.Contract{}, types.UsageReport{}, err
+	}
+	if !found {
+		return types.Contract{}, types.UsageReport{}, errorsmod.Wrap(types.ErrNotFound, "usage report not found")
+	}
+	return contract, report, nil
+}
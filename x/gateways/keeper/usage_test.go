@@ -0,0 +1,175 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"lumen/app/denom"
+	"lumen/x/gateways/keeper"
+	"lumen/x/gateways/types"
+)
+
+// setupUsageContract registers a gateway and opens a 6 month contract for
+// 10 GB storage / 20 GB network at 198_000 ulmn per month after tax.
+func setupUsageContract(t *testing.T, f *gatewayFixture, srv types.MsgServer) (operator, client string, contractID uint64) {
+	t.Helper()
+
+	operator, gatewayID := f.registerBondedGateway(srv)
+
+	client = randomAccAddress()
+	f.bank.setAccountBalance(f.mustAccAddress(client), sdk.NewCoins(sdk.NewInt64Coin(denom.BaseDenom, 5_000_000)))
+	f.bank.setModuleBalance(types.ModuleAccountEscrow, sdk.NewCoins())
+	f.bank.setModuleBalance(types.ModuleAccountTreasury, sdk.NewCoins())
+
+	ct, err := srv.CreateContract(f.ctx, &types.MsgCreateContract{
+		Client:            client,
+		GatewayId:         gatewayID,
+		PriceUlmn:         200_000,
+		StorageGbPerMonth: 10,
+		NetworkGbPerMonth: 20,
+		MonthsTotal:       6,
+	})
+	require.NoError(t, err)
+	return operator, client, ct.ContractId
+}
+
+func TestClaimPaymentProRatesAcknowledgedUsage(t *testing.T) {
+	f := initGatewayFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	operator, client, contractID := setupUsageContract(t, f, srv)
+	params := f.keeper.GetParams(f.ctx)
+
+	_, err := srv.SubmitUsageReport(f.ctx, &types.MsgSubmitUsageReport{Operator: operator, ContractId: contractID, Month: 1, StorageGb: 5, NetworkGb: 20})
+	require.ErrorContains(t, err, "month not yet ended")
+
+	f.withBlockTime(int64(params.MonthSeconds))
+	_, err = srv.SubmitUsageReport(f.ctx, &types.MsgSubmitUsageReport{Operator: client, ContractId: contractID, Month: 1})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	res, err := srv.SubmitUsageReport(f.ctx, &types.MsgSubmitUsageReport{Operator: operator, ContractId: contractID, Month: 1, StorageGb: 5, NetworkGb: 40})
+	require.NoError(t, err)
+	require.Equal(t, params.MonthSeconds+params.UsageDisputeWindowSeconds, res.DisputeDeadline)
+
+	_, err = srv.ClaimPayment(f.ctx, &types.MsgClaimPayment{Operator: operator, ContractId: contractID})
+	require.ErrorIs(t, err, types.ErrUsageUnsettled)
+
+	_, err = srv.AcknowledgeUsageReport(f.ctx, &types.MsgAcknowledgeUsageReport{Client: operator, ContractId: contractID, Month: 1})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = srv.AcknowledgeUsageReport(f.ctx, &types.MsgAcknowledgeUsageReport{Client: client, ContractId: contractID, Month: 1})
+	require.NoError(t, err)
+
+	// storage 5/10 and network capped at 20/20 average to 75% of 198_000.
+	claim, err := srv.ClaimPayment(f.ctx, &types.MsgClaimPayment{Operator: operator, ContractId: contractID})
+	require.NoError(t, err)
+	require.Equal(t, "147015", claim.PaidUlmn)
+
+	contract, err := f.keeper.Contracts.Get(f.ctx, contractID)
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), contract.ClaimedMonths)
+	require.Equal(t, "1039500", contract.EscrowUlmn)
+	require.Equal(t, "49500", contract.UsageWithheldUlmn)
+
+	_, err = srv.SubmitUsageReport(f.ctx, &types.MsgSubmitUsageReport{Operator: operator, ContractId: contractID, Month: 1})
+	require.ErrorContains(t, err, "month already claimed")
+
+	// Cancelling keeps one month for the gateway and returns the withheld usage.
+	clientBefore := f.bank.accountBalance(f.mustAccAddress(client)).AmountOf(denom.BaseDenom)
+	_, err = srv.CancelContract(f.ctx, &types.MsgCancelContract{Client: client, ContractId: contractID})
+	require.NoError(t, err)
+	refund := f.bank.accountBalance(f.mustAccAddress(client)).AmountOf(denom.BaseDenom).Sub(clientBefore)
+	require.Equal(t, sdkmath.NewInt(4*198_000+49_500), refund)
+}
+
+func TestDisputedUsageFreezesContractUntilTimeout(t *testing.T) {
+	f := initGatewayFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	operator, client, contractID := setupUsageContract(t, f, srv)
+	params := f.keeper.GetParams(f.ctx)
+
+	disputedAt := 2 * params.MonthSeconds
+	f.withBlockTime(int64(disputedAt))
+	_, err := srv.SubmitUsageReport(f.ctx, &types.MsgSubmitUsageReport{Operator: operator, ContractId: contractID, Month: 1, StorageGb: 10, NetworkGb: 20})
+	require.NoError(t, err)
+	disputed, err := srv.DisputeUsageReport(f.ctx, &types.MsgDisputeUsageReport{Client: client, ContractId: contractID, Month: 1, Reason: "gateway offline"})
+	require.NoError(t, err)
+
+	// The usage dispute is a contract dispute: claims freeze until a ruling.
+	_, err = srv.ClaimPayment(f.ctx, &types.MsgClaimPayment{Operator: operator, ContractId: contractID})
+	require.ErrorIs(t, err, types.ErrDisputeOpen)
+	dispute, err := qs.Dispute(f.ctx, &types.QueryDisputeRequest{Id: disputed.DisputeId})
+	require.NoError(t, err)
+	require.Equal(t, "usage report for month 1: gateway offline", dispute.Dispute.Reason)
+
+	report, err := qs.UsageReport(f.ctx, &types.QueryUsageReportRequest{ContractId: contractID, Month: 1})
+	require.NoError(t, err)
+	require.Equal(t, types.UsageReportStatus_USAGE_REPORT_STATUS_DISPUTED, report.Report.Status)
+	require.Equal(t, "gateway offline", report.Report.DisputeReason)
+	require.Equal(t, dispute.Dispute.Deadline, report.Report.DisputeDeadline)
+
+	// A corrected report reopens the usage window but leaves the contract
+	// dispute to the arbiters.
+	_, err = srv.SubmitUsageReport(f.ctx, &types.MsgSubmitUsageReport{Operator: operator, ContractId: contractID, Month: 1, StorageGb: 0, NetworkGb: 10})
+	require.NoError(t, err)
+	f.withBlockTime(int64(disputedAt + params.UsageDisputeWindowSeconds))
+	_, err = srv.DisputeUsageReport(f.ctx, &types.MsgDisputeUsageReport{Client: client, ContractId: contractID, Month: 1})
+	require.ErrorContains(t, err, "dispute window closed")
+	_, err = srv.ClaimPayment(f.ctx, &types.MsgClaimPayment{Operator: operator, ContractId: contractID})
+	require.ErrorIs(t, err, types.ErrDisputeOpen)
+
+	// Nobody ruled: once the dispute times out the corrected report settles.
+	f.withBlockTime(int64(dispute.Dispute.Deadline))
+	claim, err := srv.ClaimPayment(f.ctx, &types.MsgClaimPayment{Operator: operator, ContractId: contractID})
+	require.NoError(t, err)
+	// month 1: 25% of 198_000, months 2 and 3: 198_000 each, minus 1% commission.
+	require.Equal(t, "441045", claim.PaidUlmn)
+
+	reports, err := qs.UsageReports(f.ctx, &types.QueryUsageReportsRequest{ContractId: contractID})
+	require.NoError(t, err)
+	require.Len(t, reports.Reports, 1)
+}
+
+func TestUsageDisputeRulingChangesPayout(t *testing.T) {
+	f := initGatewayFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	operator, client, contractID := setupUsageContract(t, f, srv)
+	params := f.keeper.GetParams(f.ctx)
+
+	// Without the dispute the gateway would be paid two full months.
+	f.withBlockTime(int64(2 * params.MonthSeconds))
+	_, err := srv.SubmitUsageReport(f.ctx, &types.MsgSubmitUsageReport{Operator: operator, ContractId: contractID, Month: 1, StorageGb: 10, NetworkGb: 20})
+	require.NoError(t, err)
+	disputed, err := srv.DisputeUsageReport(f.ctx, &types.MsgDisputeUsageReport{Client: client, ContractId: contractID, Month: 1})
+	require.NoError(t, err)
+
+	clientBefore := f.bank.accountBalance(f.mustAccAddress(client)).AmountOf(denom.BaseDenom)
+	res, err := srv.ResolveDispute(f.ctx, &types.MsgResolveDispute{
+		Arbiter:         authtypes.NewModuleAddress(types.GovModuleName).String(),
+		DisputeId:       disputed.DisputeId,
+		ClientRefundBps: 10_000,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "0", res.GatewayPayoutUlmn)
+	require.Equal(t, "1188000", res.ClientRefundUlmn, "6 × 198000")
+	require.Equal(t, sdkmath.NewInt(1_188_000), f.bank.accountBalance(f.mustAccAddress(client)).AmountOf(denom.BaseDenom).Sub(clientBefore))
+
+	_, err = srv.ClaimPayment(f.ctx, &types.MsgClaimPayment{Operator: operator, ContractId: contractID})
+	require.Error(t, err)
+}
+
+func TestClaimPaymentRequiresReportsWhenConfigured(t *testing.T) {
+	f := initGatewayFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	operator, _, contractID := setupUsageContract(t, f, srv)
+
+	params := f.keeper.GetParams(f.ctx)
+	params.RequireUsageReports = true
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	f.withBlockTime(int64(params.MonthSeconds))
+	_, err := srv.ClaimPayment(f.ctx, &types.MsgClaimPayment{Operator: operator, ContractId: contractID})
+	require.ErrorIs(t, err, types.ErrUsageUnsettled)
+}
@@ -16,12 +16,22 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 				{RpcMethod: "Gateway", Use: "gateway [id]", Short: "Get gateway by id", PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}}},
 				{RpcMethod: "Contracts", Use: "contracts", Short: "List contracts"},
 				{RpcMethod: "Contract", Use: "contract [id]", Short: "Get contract by id", PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}}},
+				{RpcMethod: "CancelQuote", Use: "cancel-quote [contract_id]", Short: "Show the refund and penalty of cancelling a contract now", PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "contract_id"}}},
+				{RpcMethod: "DomainGateways", Use: "domain-gateways [domain]", Short: "List gateways bound to a domain", PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "domain"}}},
+				{RpcMethod: "UsageReport", Use: "usage-report [contract_id] [month]", Short: "Show a contract's usage report for a month", PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "contract_id"}, {ProtoField: "month"}}},
+				{RpcMethod: "UsageReports", Use: "usage-reports [contract_id]", Short: "List a contract's usage reports", PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "contract_id"}}},
+				{RpcMethod: "Dispute", Use: "dispute [id]", Short: "Show a contract dispute", PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}}},
+				{RpcMethod: "Disputes", Use: "disputes", Short: "List contract disputes (filter by --contract-id/--status)"},
+				{RpcMethod: "Offer", Use: "offer [id]", Short: "Show a gateway offer", PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}}},
+				{RpcMethod: "Offers", Use: "offers", Short: "List gateway offers (filter by --gateway-id, --include-retired)"},
 				{RpcMethod: "Authority", Use: "authority", Short: "Show module authority"},
 				{RpcMethod: "ModuleAccounts", Use: "module-accounts", Short: "Show module escrow/treasury accounts"},
+				{RpcMethod: "Treasury", Use: "treasury", Short: "Show the treasury balance, inflow/outflow totals and next distribution"},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
-			Service: types.Msg_serviceDesc.ServiceName,
+			Service:              types.Msg_serviceDesc.ServiceName,
+			EnhanceCustomCommand: true, // lumend registers its own PQC-signing gateways tx command
 			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
 				{RpcMethod: "RegisterGateway", Use: "register-gateway [payout]", Short: "Register a gateway"},
 				{RpcMethod: "UpdateGateway", Use: "update-gateway [gateway_id]", Short: "Update a gateway"},
@@ -29,7 +39,35 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 				{RpcMethod: "ClaimPayment", Use: "claim-payment [contract_id]", Short: "Claim a monthly payout"},
 				{RpcMethod: "CancelContract", Use: "cancel-contract [contract_id]", Short: "Cancel a contract"},
 				{RpcMethod: "FinalizeContract", Use: "finalize-contract [contract_id]", Short: "Finalize a completed contract"},
+				{RpcMethod: "BindDomain", Use: "bind-domain [gateway_id] [domain]", Short: "Bind an owned x/dns domain to a gateway", PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "gateway_id"}, {ProtoField: "domain"}}},
+				{RpcMethod: "UnbindDomain", Use: "unbind-domain [gateway_id] [domain]", Short: "Remove a domain binding from a gateway", PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "gateway_id"}, {ProtoField: "domain"}}},
+				{RpcMethod: "SubmitUsageReport", Use: "submit-usage-report [contract_id] [month] [storage_gb] [network_gb]", Short: "Submit a monthly usage report for a contract", PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "contract_id"}, {ProtoField: "month"}, {ProtoField: "storage_gb"}, {ProtoField: "network_gb"}}},
+				{RpcMethod: "AcknowledgeUsageReport", Use: "acknowledge-usage-report [contract_id] [month]", Short: "Co-sign a pending usage report", PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "contract_id"}, {ProtoField: "month"}}},
+				{RpcMethod: "DisputeUsageReport", Use: "dispute-usage-report [contract_id] [month]", Short: "Dispute a pending usage report", PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "contract_id"}, {ProtoField: "month"}}},
+				{RpcMethod: "OpenDispute", Use: "open-dispute [contract_id] [reason]", Short: "Open a dispute freezing a contract's payouts", PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "contract_id"}, {ProtoField: "reason"}}},
+				{RpcMethod: "SubmitDisputeEvidence", Use: "submit-dispute-evidence [dispute_id] [evidence_hash]", Short: "Attach an evidence hash to an open dispute", PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "dispute_id"}, {ProtoField: "evidence_hash"}}},
+				{RpcMethod: "ResolveDispute", Use: "resolve-dispute [dispute_id] [client_refund_bps]", Short: "Rule on a dispute (arbiters or gov authority)", PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "dispute_id"}, {ProtoField: "client_refund_bps"}}},
+				{RpcMethod: "BondGateway", Use: "bond-gateway [gateway_id] [amount_ulmn]", Short: "Add to a gateway's staking bond", PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "gateway_id"}, {ProtoField: "amount_ulmn"}}},
+				{RpcMethod: "UnbondGateway", Use: "unbond-gateway [gateway_id] [amount_ulmn]", Short: "Start unbonding part of a gateway's bond", PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "gateway_id"}, {ProtoField: "amount_ulmn"}}},
+				{RpcMethod: "WithdrawBond", Use: "withdraw-bond [gateway_id]", Short: "Withdraw a gateway's matured unbonding funds", PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "gateway_id"}}},
+				{RpcMethod: "CreateOffer", Use: "create-offer [gateway_id] [price_ulmn] [min_months]", Short: "Publish a gateway offer (quotas, --max-months, --regions, --capacity-slots, --cancellation-policy via flags)", PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "gateway_id"}, {ProtoField: "price_ulmn"}, {ProtoField: "min_months"}}},
+				{RpcMethod: "RetireOffer", Use: "retire-offer [offer_id]", Short: "Stop new contracts on an offer", PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "offer_id"}}},
+				{RpcMethod: "ExtendContract", Use: "extend-contract [contract_id] [additional_months]", Short: "Add months to a running contract at its current price", PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "contract_id"}, {ProtoField: "additional_months"}}},
+				{RpcMethod: "AmendContract", Use: "amend-contract [contract_id] [price_ulmn] [storage_gb] [network_gb]", Short: "Propose new quotas and price for the rest of a contract", PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "contract_id"}, {ProtoField: "price_ulmn"}, {ProtoField: "storage_gb_per_month"}, {ProtoField: "network_gb_per_month"}}},
+				{RpcMethod: "AcceptAmendment", Use: "accept-amendment [contract_id]", Short: "Accept a client's pending contract amendment", PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "contract_id"}}},
+				{RpcMethod: "ClaimAll", Use: "claim-all [gateway_id]", Short: "Claim every due contract of a gateway", PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "gateway_id"}}},
+				{RpcMethod: "AcceptContract", Use: "accept-contract [contract_id]", Short: "Accept a pending contract and start its term", PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "contract_id"}}},
+				{RpcMethod: "RejectContract", Use: "reject-contract [contract_id]", Short: "Reject a pending contract and refund the client", PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "contract_id"}}},
+				{RpcMethod: "SetGatewayDenoms", Use: "set-gateway-denoms [gateway_id] [denoms]", Short: "Set the whitelisted denoms a gateway accepts besides ulmn", PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "gateway_id"}, {ProtoField: "denoms", Varargs: true}}},
 				{RpcMethod: "UpdateParams", Use: "update-params", Short: "Update module params (gov authority only)"},
+				{RpcMethod: "TreasurySpend", Use: "treasury-spend [recipient] [amount]", Short: "Pay out of the gateways treasury (gov authority only)", PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "recipient"}, {ProtoField: "amount", Varargs: true}}},
+				{RpcMethod: "TransferGateway", Use: "transfer-gateway [gateway_id] [new_operator]", Short: "Propose a new gateway operator (empty new_operator withdraws the proposal)", PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "gateway_id"}, {ProtoField: "new_operator", Optional: true}}},
+				{RpcMethod: "AcceptGatewayTransfer", Use: "accept-gateway-transfer [gateway_id]", Short: "Take over a gateway as its proposed operator", PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "gateway_id"}}},
+				{RpcMethod: "DecommissionGateway", Use: "decommission-gateway [gateway_id] [end_time]", Short: "Announce the gateway's shutdown at end_time (unix seconds; 0 withdraws the announcement)", PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "gateway_id"}, {ProtoField: "end_time"}}},
+				{RpcMethod: "MigrateContract", Use: "migrate-contract [contract_id] [new_gateway_id]", Short: "Move a contract's remaining escrow from a decommissioning gateway to another gateway", PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "contract_id"}, {ProtoField: "new_gateway_id"}}},
+				{RpcMethod: "GatewayHeartbeat", Use: "gateway-heartbeat [gateway_id]", Short: "Report that a gateway is online", PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "gateway_id"}}},
+				{RpcMethod: "TransferContract", Use: "transfer-contract [contract_id] [new_client]", Short: "Hand a contract to another client, or withdraw a pending transfer", PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "contract_id"}, {ProtoField: "new_client", Optional: true}}},
+				{RpcMethod: "ApproveContractTransfer", Use: "approve-contract-transfer [contract_id]", Short: "Consent to a pending contract transfer as the gateway operator", PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "contract_id"}}},
 			},
 		},
 	}
@@ -33,6 +33,7 @@ type ModuleInputs struct {
 	BankKeeper       types.BankKeeper
 	TokenomicsKeeper types.TokenomicsKeeper
 	DistrKeeper      types.DistrKeeper
+	DnsKeeper        types.DnsKeeper
 }
 
 type ModuleOutputs struct {
@@ -52,6 +53,7 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 	k.SetAccountKeeper(in.AuthKeeper)
 	k.SetTokenomicsKeeper(in.TokenomicsKeeper)
 	k.SetDistrKeeper(in.DistrKeeper)
+	k.SetDnsKeeper(in.DnsKeeper)
 	m := NewAppModule(in.Cdc, k, in.AuthKeeper, in.BankKeeper)
 	return ModuleOutputs{GatewaysKeeper: k, Module: m}
 }
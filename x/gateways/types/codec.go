@@ -14,5 +14,33 @@ func RegisterInterfaces(registrar codectypes.InterfaceRegistry) {
 		&MsgClaimPayment{},
 		&MsgCancelContract{},
 		&MsgFinalizeContract{},
+		&MsgBindDomain{},
+		&MsgUnbindDomain{},
+		&MsgSubmitUsageReport{},
+		&MsgAcknowledgeUsageReport{},
+		&MsgDisputeUsageReport{},
+		&MsgOpenDispute{},
+		&MsgSubmitDisputeEvidence{},
+		&MsgResolveDispute{},
+		&MsgBondGateway{},
+		&MsgUnbondGateway{},
+		&MsgWithdrawBond{},
+		&MsgCreateOffer{},
+		&MsgRetireOffer{},
+		&MsgExtendContract{},
+		&MsgAmendContract{},
+		&MsgAcceptAmendment{},
+		&MsgClaimAll{},
+		&MsgAcceptContract{},
+		&MsgRejectContract{},
+		&MsgSetGatewayDenoms{},
+		&MsgTreasurySpend{},
+		&MsgTransferGateway{},
+		&MsgAcceptGatewayTransfer{},
+		&MsgDecommissionGateway{},
+		&MsgMigrateContract{},
+		&MsgGatewayHeartbeat{},
+		&MsgTransferContract{},
+		&MsgApproveContractTransfer{},
 	)
 }
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"fmt"
+
+	"lumen/app/denom"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PaymentDenom is the denom of the contract's price, escrow and tax amounts.
+// Contracts created before multi-denom payments carry no denom and are ulmn.
+func (c Contract) PaymentDenom() string {
+	return paymentDenom(c.Denom)
+}
+
+// PaymentDenom is the denom the offer is priced in.
+func (o Offer) PaymentDenom() string {
+	return paymentDenom(o.Denom)
+}
+
+// AcceptsDenom reports whether the gateway takes payments in the denom; ulmn
+// is always accepted.
+func (g Gateway) AcceptsDenom(payDenom string) bool {
+	if payDenom == denom.BaseDenom {
+		return true
+	}
+	for _, d := range g.AcceptedDenoms {
+		if d == payDenom {
+			return true
+		}
+	}
+	return false
+}
+
+func paymentDenom(d string) string {
+	if d == "" {
+		return denom.BaseDenom
+	}
+	return d
+}
+
+// ValidateGatewayDenoms checks the list a gateway opts into: valid, distinct,
+// non-ulmn denoms, at most MaxAcceptedDenoms of them.
+func ValidateGatewayDenoms(denoms []string) error {
+	if len(denoms) > MaxAcceptedDenoms {
+		return fmt.Errorf("too many denoms: %d > %d", len(denoms), MaxAcceptedDenoms)
+	}
+	seen := make(map[string]struct{}, len(denoms))
+	for _, d := range denoms {
+		if err := sdk.ValidateDenom(d); err != nil {
+			return fmt.Errorf("invalid denom %q: %w", d, err)
+		}
+		if d == denom.BaseDenom {
+			return fmt.Errorf("%s is always accepted", denom.BaseDenom)
+		}
+		if _, ok := seen[d]; ok {
+			return fmt.Errorf("duplicate denom %s", d)
+		}
+		seen[d] = struct{}{}
+	}
+	return nil
+}
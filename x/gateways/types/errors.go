@@ -9,4 +9,8 @@ var (
 	ErrOverflow          = errorsmod.Register(ModuleName, 4, "overflow")
 	ErrInsufficientFunds = errorsmod.Register(ModuleName, 5, "insufficient funds")
 	ErrOutOfBounds       = errorsmod.Register(ModuleName, 6, "out of bounds")
+	ErrDomainNotOwned    = errorsmod.Register(ModuleName, 7, "domain not owned by gateway")
+	ErrUsageUnsettled    = errorsmod.Register(ModuleName, 8, "usage report not settled")
+	ErrDisputeOpen       = errorsmod.Register(ModuleName, 9, "contract under dispute")
+	ErrInsufficientBond  = errorsmod.Register(ModuleName, 10, "insufficient gateway bond")
 )
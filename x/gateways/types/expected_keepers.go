@@ -20,9 +20,12 @@ type AccountKeeper interface {
 
 type BankKeeper interface {
 	SpendableCoins(context.Context, sdk.AccAddress) sdk.Coins
+	GetAllBalances(context.Context, sdk.AccAddress) sdk.Coins
 	SendCoinsFromAccountToModule(context.Context, sdk.AccAddress, string, sdk.Coins) error
 	SendCoinsFromModuleToAccount(context.Context, string, sdk.AccAddress, sdk.Coins) error
 	SendCoinsFromModuleToModule(context.Context, string, string, sdk.Coins) error
+	BurnCoins(context.Context, string, sdk.Coins) error
+	BlockedAddr(sdk.AccAddress) bool
 }
 
 type TokenomicsKeeper interface {
@@ -32,3 +35,8 @@ type TokenomicsKeeper interface {
 type DistrKeeper interface {
 	FundCommunityPool(context.Context, sdk.Coins, sdk.AccAddress) error
 }
+
+// DnsKeeper is the subset of x/dns used to verify gateway domain bindings.
+type DnsKeeper interface {
+	DomainOwner(ctx context.Context, name string) (owner string, active bool, err error)
+}
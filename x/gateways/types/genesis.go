@@ -1,15 +1,26 @@
 package types
 
-import "fmt"
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 func DefaultGenesis() *GenesisState {
 	def := DefaultParams()
 	return &GenesisState{
-		Params:        &def,
-		Gateways:      []*Gateway{},
-		Contracts:     []*Contract{},
-		GatewayCount:  0,
-		ContractCount: 0,
+		Params:         &def,
+		Gateways:       []*Gateway{},
+		Contracts:      []*Contract{},
+		GatewayCount:   0,
+		ContractCount:  0,
+		DomainBindings: []*DomainBinding{},
+		UsageReports:   []*UsageReport{},
+		Disputes:       []*Dispute{},
+		Bonds:          []*GatewayBond{},
+		Reputations:    []*GatewayReputation{},
+		Offers:         []*Offer{},
 	}
 }
 
@@ -23,6 +34,15 @@ func ValidateGenesis(gs *GenesisState) error {
 			return fmt.Errorf("duplicate gateway id %d", g.Id)
 		}
 		seenGw[g.Id] = struct{}{}
+		if _, err := NormalizeGatewayProfile(g.Profile); err != nil {
+			return fmt.Errorf("gateway %d: invalid profile: %w", g.Id, err)
+		}
+		if g.Active && (g.DecommissionAt != 0 || g.Archived) {
+			return fmt.Errorf("gateway %d: decommissioned gateway cannot be active", g.Id)
+		}
+		if g.Active && g.Offline {
+			return fmt.Errorf("gateway %d: offline gateway cannot be active", g.Id)
+		}
 	}
 
 	seenCt := make(map[uint64]struct{})
@@ -33,6 +53,156 @@ func ValidateGenesis(gs *GenesisState) error {
 		seenCt[c.Id] = struct{}{}
 	}
 
+	seenBinding := make(map[string]struct{})
+	for _, b := range gs.DomainBindings {
+		if b == nil {
+			return fmt.Errorf("nil domain binding")
+		}
+		if _, ok := seenGw[b.GatewayId]; !ok {
+			return fmt.Errorf("domain binding %s references unknown gateway %d", b.Domain, b.GatewayId)
+		}
+		if err := ValidateEndpoint(b.Domain); err != nil {
+			return fmt.Errorf("domain binding %s: %w", b.Domain, err)
+		}
+		key := fmt.Sprintf("%s/%d", b.Domain, b.GatewayId)
+		if _, ok := seenBinding[key]; ok {
+			return fmt.Errorf("duplicate domain binding %s", key)
+		}
+		seenBinding[key] = struct{}{}
+	}
+
+	seenReport := make(map[string]struct{})
+	for _, r := range gs.UsageReports {
+		if r == nil {
+			return fmt.Errorf("nil usage report")
+		}
+		if _, ok := seenCt[r.ContractId]; !ok {
+			return fmt.Errorf("usage report references unknown contract %d", r.ContractId)
+		}
+		if r.Month == 0 {
+			return fmt.Errorf("usage report for contract %d has month 0", r.ContractId)
+		}
+		if err := ValidateEvidenceHash(r.EvidenceHash); err != nil {
+			return fmt.Errorf("usage report %d/%d: %w", r.ContractId, r.Month, err)
+		}
+		key := fmt.Sprintf("%d/%d", r.ContractId, r.Month)
+		if _, ok := seenReport[key]; ok {
+			return fmt.Errorf("duplicate usage report %s", key)
+		}
+		seenReport[key] = struct{}{}
+	}
+
+	seenDispute := make(map[uint64]struct{})
+	for _, d := range gs.Disputes {
+		if d == nil {
+			return fmt.Errorf("nil dispute")
+		}
+		if d.Id == 0 {
+			return fmt.Errorf("dispute id must be > 0")
+		}
+		if _, ok := seenDispute[d.Id]; ok {
+			return fmt.Errorf("duplicate dispute id %d", d.Id)
+		}
+		seenDispute[d.Id] = struct{}{}
+		if _, ok := seenCt[d.ContractId]; !ok {
+			return fmt.Errorf("dispute %d references unknown contract %d", d.Id, d.ContractId)
+		}
+		if d.ClientRefundBps > 10_000 {
+			return fmt.Errorf("dispute %d client_refund_bps must be <= 10000", d.Id)
+		}
+		if gs.DisputeCount > 0 && d.Id > gs.DisputeCount {
+			return fmt.Errorf("dispute id %d exceeds dispute_count %d", d.Id, gs.DisputeCount)
+		}
+	}
+
+	seenBond := make(map[uint64]struct{})
+	for _, b := range gs.Bonds {
+		if b == nil {
+			return fmt.Errorf("nil gateway bond")
+		}
+		if _, ok := seenGw[b.GatewayId]; !ok {
+			return fmt.Errorf("bond references unknown gateway %d", b.GatewayId)
+		}
+		if _, ok := seenBond[b.GatewayId]; ok {
+			return fmt.Errorf("duplicate bond for gateway %d", b.GatewayId)
+		}
+		seenBond[b.GatewayId] = struct{}{}
+		for _, amt := range []string{b.BondedUlmn, b.UnbondingUlmn, b.SlashedUlmn} {
+			if amt == "" {
+				continue
+			}
+			if v, ok := sdkmath.NewIntFromString(amt); !ok || v.IsNegative() {
+				return fmt.Errorf("bond for gateway %d has invalid amount %q", b.GatewayId, amt)
+			}
+		}
+	}
+
+	seenReputation := make(map[uint64]struct{})
+	for _, r := range gs.Reputations {
+		if r == nil {
+			return fmt.Errorf("nil gateway reputation")
+		}
+		if _, ok := seenGw[r.GatewayId]; !ok {
+			return fmt.Errorf("reputation references unknown gateway %d", r.GatewayId)
+		}
+		if _, ok := seenReputation[r.GatewayId]; ok {
+			return fmt.Errorf("duplicate reputation for gateway %d", r.GatewayId)
+		}
+		seenReputation[r.GatewayId] = struct{}{}
+		if r.Score > 10_000 {
+			return fmt.Errorf("reputation score for gateway %d must be <= 10000", r.GatewayId)
+		}
+	}
+
+	seenOffer := make(map[uint64]struct{})
+	for _, o := range gs.Offers {
+		if o == nil {
+			return fmt.Errorf("nil offer")
+		}
+		if o.Id == 0 {
+			return fmt.Errorf("offer id must be > 0")
+		}
+		if _, ok := seenOffer[o.Id]; ok {
+			return fmt.Errorf("duplicate offer id %d", o.Id)
+		}
+		seenOffer[o.Id] = struct{}{}
+		if _, ok := seenGw[o.GatewayId]; !ok {
+			return fmt.Errorf("offer %d references unknown gateway %d", o.Id, o.GatewayId)
+		}
+		if err := ValidateOfferTerms(o.PriceUlmn, o.MinMonths, o.MaxMonths, o.Regions); err != nil {
+			return fmt.Errorf("offer %d: %w", o.Id, err)
+		}
+		if o.CapacitySlots > 0 && o.UsedSlots > o.CapacitySlots {
+			return fmt.Errorf("offer %d uses %d of %d slots", o.Id, o.UsedSlots, o.CapacitySlots)
+		}
+		if gs.OfferCount > 0 && o.Id > gs.OfferCount {
+			return fmt.Errorf("offer id %d exceeds offer_count %d", o.Id, gs.OfferCount)
+		}
+	}
+	for _, c := range gs.Contracts {
+		if c.OfferId == 0 {
+			continue
+		}
+		if _, ok := seenOffer[c.OfferId]; !ok {
+			return fmt.Errorf("contract %d references unknown offer %d", c.Id, c.OfferId)
+		}
+	}
+
+	if err := gs.TreasuryBalance.Validate(); err != nil {
+		return fmt.Errorf("invalid treasury_balance: %w", err)
+	}
+	for name, coins := range map[string]sdk.Coins{
+		"inflow":         gs.TreasuryFlows.Inflow,
+		"community_pool": gs.TreasuryFlows.CommunityPool,
+		"burned":         gs.TreasuryFlows.Burned,
+		"stakers":        gs.TreasuryFlows.Stakers,
+		"spent":          gs.TreasuryFlows.Spent,
+	} {
+		if err := coins.Validate(); err != nil {
+			return fmt.Errorf("invalid treasury_flows.%s: %w", name, err)
+		}
+	}
+
 	if gs.Params != nil {
 		if err := ValidateParams(*gs.Params); err != nil {
 			return err
@@ -5,6 +5,9 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -23,11 +26,22 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type GenesisState struct {
-	Params        *Params     `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
-	Gateways      []*Gateway  `protobuf:"bytes,2,rep,name=gateways,proto3" json:"gateways,omitempty"`
-	Contracts     []*Contract `protobuf:"bytes,3,rep,name=contracts,proto3" json:"contracts,omitempty"`
-	GatewayCount  uint64      `protobuf:"varint,4,opt,name=gateway_count,json=gatewayCount,proto3" json:"gateway_count,omitempty"`
-	ContractCount uint64      `protobuf:"varint,5,opt,name=contract_count,json=contractCount,proto3" json:"contract_count,omitempty"`
+	Params                *Params                                  `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	Gateways              []*Gateway                               `protobuf:"bytes,2,rep,name=gateways,proto3" json:"gateways,omitempty"`
+	Contracts             []*Contract                              `protobuf:"bytes,3,rep,name=contracts,proto3" json:"contracts,omitempty"`
+	GatewayCount          uint64                                   `protobuf:"varint,4,opt,name=gateway_count,json=gatewayCount,proto3" json:"gateway_count,omitempty"`
+	ContractCount         uint64                                   `protobuf:"varint,5,opt,name=contract_count,json=contractCount,proto3" json:"contract_count,omitempty"`
+	DomainBindings        []*DomainBinding                         `protobuf:"bytes,6,rep,name=domain_bindings,json=domainBindings,proto3" json:"domain_bindings,omitempty"`
+	UsageReports          []*UsageReport                           `protobuf:"bytes,7,rep,name=usage_reports,json=usageReports,proto3" json:"usage_reports,omitempty"`
+	Disputes              []*Dispute                               `protobuf:"bytes,8,rep,name=disputes,proto3" json:"disputes,omitempty"`
+	DisputeCount          uint64                                   `protobuf:"varint,9,opt,name=dispute_count,json=disputeCount,proto3" json:"dispute_count,omitempty"`
+	Bonds                 []*GatewayBond                           `protobuf:"bytes,10,rep,name=bonds,proto3" json:"bonds,omitempty"`
+	Reputations           []*GatewayReputation                     `protobuf:"bytes,11,rep,name=reputations,proto3" json:"reputations,omitempty"`
+	Offers                []*Offer                                 `protobuf:"bytes,12,rep,name=offers,proto3" json:"offers,omitempty"`
+	OfferCount            uint64                                   `protobuf:"varint,13,opt,name=offer_count,json=offerCount,proto3" json:"offer_count,omitempty"`
+	TreasuryBalance       github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,14,rep,name=treasury_balance,json=treasuryBalance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"treasury_balance"`
+	TreasuryFlows         TreasuryFlows                            `protobuf:"bytes,15,opt,name=treasury_flows,json=treasuryFlows,proto3" json:"treasury_flows"`
+	TreasuryDistributedAt uint64                                   `protobuf:"varint,16,opt,name=treasury_distributed_at,json=treasuryDistributedAt,proto3" json:"treasury_distributed_at,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -98,6 +112,83 @@ func (m *GenesisState) GetContractCount() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetDomainBindings() []*DomainBinding {
+	if m != nil {
+		return m.DomainBindings
+	}
+	return nil
+}
+
+func (m *GenesisState) GetUsageReports() []*UsageReport {
+	if m != nil {
+		return m.UsageReports
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDisputes() []*Dispute {
+	if m != nil {
+		return m.Disputes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDisputeCount() uint64 {
+	if m != nil {
+		return m.DisputeCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetBonds() []*GatewayBond {
+	if m != nil {
+		return m.Bonds
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReputations() []*GatewayReputation {
+	if m != nil {
+		return m.Reputations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOffers() []*Offer {
+	if m != nil {
+		return m.Offers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOfferCount() uint64 {
+	if m != nil {
+		return m.OfferCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetTreasuryBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TreasuryBalance
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTreasuryFlows() TreasuryFlows {
+	if m != nil {
+		return m.TreasuryFlows
+	}
+	return TreasuryFlows{}
+}
+
+func (m *GenesisState) GetTreasuryDistributedAt() uint64 {
+	if m != nil {
+		return m.TreasuryDistributedAt
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "lumen.gateway.v1.GenesisState")
 }
@@ -105,24 +196,44 @@ func init() {
 func init() { proto.RegisterFile("lumen/gateway/v1/genesis.proto", fileDescriptor_436e4948f9bc522f) }
 
 var fileDescriptor_436e4948f9bc522f = []byte{
-	// 258 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcb, 0x29, 0xcd, 0x4d,
-	0xcd, 0xd3, 0x4f, 0x4f, 0x2c, 0x49, 0x2d, 0x4f, 0xac, 0xd4, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd,
-	0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x00, 0xcb, 0xeb, 0x41,
-	0xe5, 0xf5, 0xca, 0x0c, 0xa5, 0x64, 0x30, 0x74, 0x94, 0x54, 0x16, 0xa4, 0x42, 0xd5, 0x4b, 0xc9,
-	0x62, 0xc8, 0x16, 0x24, 0x16, 0x25, 0xe6, 0x42, 0xa5, 0x95, 0xfe, 0x32, 0x72, 0xf1, 0xb8, 0x43,
-	0x2c, 0x08, 0x2e, 0x49, 0x2c, 0x49, 0x15, 0x32, 0xe0, 0x62, 0x83, 0x28, 0x90, 0x60, 0x54, 0x60,
-	0xd4, 0xe0, 0x36, 0x92, 0xd0, 0x43, 0xb7, 0x50, 0x2f, 0x00, 0x2c, 0x1f, 0x04, 0x55, 0x27, 0x64,
-	0xca, 0xc5, 0x01, 0x95, 0x2c, 0x96, 0x60, 0x52, 0x60, 0xd6, 0xe0, 0x36, 0x92, 0xc4, 0xd4, 0xe3,
-	0x0e, 0x61, 0x06, 0xc1, 0x95, 0x0a, 0x59, 0x70, 0x71, 0x26, 0xe7, 0xe7, 0x95, 0x14, 0x25, 0x26,
-	0x97, 0x14, 0x4b, 0x30, 0x83, 0xf5, 0x49, 0x61, 0xea, 0x73, 0x86, 0x2a, 0x09, 0x42, 0x28, 0x16,
-	0x52, 0xe6, 0xe2, 0x85, 0xaa, 0x88, 0x4f, 0xce, 0x2f, 0xcd, 0x2b, 0x91, 0x60, 0x51, 0x60, 0xd4,
-	0x60, 0x09, 0xe2, 0x81, 0x0a, 0x3a, 0x83, 0xc4, 0x84, 0x54, 0xb9, 0xf8, 0x60, 0x3a, 0xa0, 0xaa,
-	0x58, 0xc1, 0xaa, 0x78, 0x61, 0xa2, 0x60, 0x65, 0x4e, 0x06, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78,
-	0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc,
-	0x78, 0x2c, 0xc7, 0x10, 0x25, 0x06, 0x09, 0xb8, 0x0a, 0x58, 0xd0, 0x15, 0x43, 0x82, 0x35, 0x89,
-	0x0d, 0x1c, 0x70, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc2, 0xca, 0xaa, 0xf3, 0xa9, 0x01,
-	0x00, 0x00,
+	// 585 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xc7, 0x63, 0xda, 0xa6, 0xed, 0xe6, 0xa3, 0xd1, 0x0a, 0xe8, 0x12, 0x51, 0x27, 0xa2, 0x42,
+	0xca, 0x05, 0x3b, 0x69, 0x05, 0xe2, 0x8a, 0x13, 0x28, 0x07, 0x24, 0xd0, 0x02, 0x17, 0x2e, 0xd1,
+	0xda, 0xde, 0x18, 0x8b, 0x64, 0xd7, 0xf2, 0xae, 0x53, 0xf2, 0x16, 0x3c, 0x07, 0x57, 0x5e, 0xa2,
+	0xc7, 0x1e, 0x39, 0x01, 0x4a, 0x5e, 0x04, 0x79, 0x77, 0x9d, 0x44, 0x98, 0x9c, 0x3c, 0x9e, 0xff,
+	0x6f, 0x66, 0x67, 0xc6, 0xb3, 0x06, 0xf6, 0x34, 0x9b, 0x51, 0xe6, 0x46, 0x44, 0xd2, 0x6b, 0xb2,
+	0x70, 0xe7, 0x03, 0x37, 0xa2, 0x8c, 0x8a, 0x58, 0x38, 0x49, 0xca, 0x25, 0x87, 0x2d, 0xa5, 0x3b,
+	0x46, 0x77, 0xe6, 0x83, 0xf6, 0xc3, 0x52, 0x84, 0x5c, 0x24, 0xd4, 0xf0, 0xed, 0xb3, 0x92, 0x9a,
+	0x90, 0x94, 0xcc, 0x0a, 0xf9, 0x6e, 0xc4, 0x23, 0xae, 0x4c, 0x37, 0xb7, 0x8c, 0xd7, 0x0e, 0xb8,
+	0x98, 0x71, 0xe1, 0xfa, 0x44, 0x50, 0x77, 0x3e, 0xf0, 0xa9, 0x24, 0x03, 0x37, 0xe0, 0x31, 0xd3,
+	0xfa, 0xa3, 0x1f, 0x87, 0xa0, 0x7e, 0xa5, 0xcb, 0x7a, 0x2f, 0x89, 0xa4, 0xb0, 0x0f, 0xaa, 0x3a,
+	0x2d, 0xb2, 0xba, 0x56, 0xaf, 0x76, 0x81, 0x9c, 0x7f, 0xcb, 0x74, 0xde, 0x29, 0x1d, 0x1b, 0x0e,
+	0x3e, 0x05, 0x47, 0x46, 0x14, 0xe8, 0x4e, 0x77, 0xaf, 0x57, 0xbb, 0x78, 0x50, 0x8e, 0xb9, 0xd2,
+	0x26, 0x5e, 0xa3, 0xf0, 0x39, 0x38, 0x0e, 0x38, 0x93, 0x29, 0x09, 0xa4, 0x40, 0x7b, 0x2a, 0xae,
+	0x5d, 0x8e, 0x1b, 0x1a, 0x04, 0x6f, 0x60, 0x78, 0x0e, 0x1a, 0x86, 0x18, 0x07, 0x3c, 0x63, 0x12,
+	0xed, 0x77, 0xad, 0xde, 0x3e, 0xae, 0x1b, 0xe7, 0x30, 0xf7, 0xc1, 0xc7, 0xa0, 0x59, 0x44, 0x18,
+	0xea, 0x40, 0x51, 0x8d, 0xc2, 0xab, 0xb1, 0xd7, 0xe0, 0x24, 0xe4, 0x33, 0x12, 0xb3, 0xb1, 0x1f,
+	0xb3, 0x30, 0x66, 0x91, 0x40, 0x55, 0x55, 0x4b, 0xa7, 0x5c, 0xcb, 0x48, 0x81, 0x9e, 0xe6, 0x70,
+	0x33, 0xdc, 0x7e, 0x15, 0xd0, 0x03, 0x8d, 0x4c, 0x90, 0x88, 0x8e, 0x53, 0x9a, 0xf0, 0x54, 0x0a,
+	0x74, 0xa8, 0xf2, 0x9c, 0x95, 0xf3, 0x7c, 0xcc, 0x31, 0xac, 0x28, 0x5c, 0xcf, 0x36, 0x2f, 0x6a,
+	0x94, 0x61, 0x2c, 0x92, 0x4c, 0x52, 0x81, 0x8e, 0x76, 0x8d, 0x72, 0xa4, 0x09, 0xbc, 0x46, 0xf3,
+	0x81, 0x18, 0xdb, 0xb4, 0x7a, 0xac, 0x07, 0x62, 0x9c, 0xba, 0xd3, 0x4b, 0x70, 0xe0, 0x73, 0x16,
+	0x0a, 0x04, 0x76, 0xd5, 0x65, 0xbe, 0x91, 0xc7, 0x59, 0x88, 0x35, 0x0b, 0x5f, 0x82, 0x5a, 0x4a,
+	0x93, 0x4c, 0x12, 0x19, 0x73, 0x26, 0x50, 0x4d, 0x85, 0x9e, 0xef, 0xfe, 0xbc, 0x6b, 0x16, 0x6f,
+	0xc7, 0x41, 0x17, 0x54, 0xf9, 0x64, 0x42, 0x53, 0x81, 0xea, 0x2a, 0xc3, 0x69, 0x39, 0xc3, 0xdb,
+	0x5c, 0xc7, 0x06, 0x83, 0x1d, 0x50, 0x53, 0x96, 0xe9, 0xa7, 0xa1, 0xfa, 0x01, 0xca, 0xa5, 0xbb,
+	0x99, 0x83, 0x96, 0x4c, 0x29, 0x11, 0x59, 0xba, 0x18, 0xfb, 0x64, 0x4a, 0x58, 0x40, 0x51, 0xd3,
+	0x4c, 0x4c, 0xaf, 0xbc, 0x93, 0xaf, 0xbc, 0x63, 0x56, 0xde, 0x19, 0xf2, 0x98, 0x79, 0xfd, 0x9b,
+	0x5f, 0x9d, 0xca, 0xf7, 0xdf, 0x9d, 0x5e, 0x14, 0xcb, 0xcf, 0x99, 0xef, 0x04, 0x7c, 0xe6, 0x9a,
+	0xfb, 0xa1, 0x1f, 0x4f, 0x44, 0xf8, 0xc5, 0xdc, 0xb9, 0x3c, 0x40, 0xe0, 0x93, 0xe2, 0x10, 0x4f,
+	0x9f, 0x01, 0xdf, 0x80, 0xe6, 0xfa, 0xdc, 0xc9, 0x94, 0x5f, 0x0b, 0x74, 0xd2, 0xb5, 0xfe, 0xbf,
+	0x2e, 0x1f, 0x0c, 0xf7, 0x2a, 0xc7, 0xbc, 0xfd, 0xfc, 0x6c, 0xdc, 0x90, 0xdb, 0x4e, 0xf8, 0x0c,
+	0x9c, 0xae, 0xb3, 0x85, 0xb1, 0x90, 0x69, 0xec, 0x67, 0x92, 0x86, 0x63, 0x22, 0x51, 0x4b, 0xb5,
+	0x7c, 0xaf, 0x90, 0x47, 0x1b, 0xf5, 0x85, 0xf4, 0xfa, 0x37, 0x4b, 0xdb, 0xba, 0x5d, 0xda, 0xd6,
+	0x9f, 0xa5, 0x6d, 0x7d, 0x5b, 0xd9, 0x95, 0xdb, 0x95, 0x5d, 0xf9, 0xb9, 0xb2, 0x2b, 0x9f, 0xee,
+	0xeb, 0x9f, 0xc4, 0xd7, 0xe2, 0x37, 0x21, 0x74, 0x3b, 0x7e, 0x55, 0x5d, 0xf7, 0xcb, 0xbf, 0x03,
+	0x00, 0xa2, 0x5a, 0x57, 0x62, 0x95, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -145,6 +256,131 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TreasuryDistributedAt != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TreasuryDistributedAt))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	{
+		size, err := m.TreasuryFlows.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	if len(m.TreasuryBalance) > 0 {
+		for iNdEx := len(m.TreasuryBalance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TreasuryBalance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.OfferCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.OfferCount))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.Offers) > 0 {
+		for iNdEx := len(m.Offers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Offers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.Reputations) > 0 {
+		for iNdEx := len(m.Reputations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reputations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Bonds) > 0 {
+		for iNdEx := len(m.Bonds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bonds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.DisputeCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DisputeCount))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Disputes) > 0 {
+		for iNdEx := len(m.Disputes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Disputes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.UsageReports) > 0 {
+		for iNdEx := len(m.UsageReports) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UsageReports[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.DomainBindings) > 0 {
+		for iNdEx := len(m.DomainBindings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DomainBindings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if m.ContractCount != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.ContractCount))
 		i--
@@ -237,6 +473,59 @@ func (m *GenesisState) Size() (n int) {
 	if m.ContractCount != 0 {
 		n += 1 + sovGenesis(uint64(m.ContractCount))
 	}
+	if len(m.DomainBindings) > 0 {
+		for _, e := range m.DomainBindings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UsageReports) > 0 {
+		for _, e := range m.UsageReports {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Disputes) > 0 {
+		for _, e := range m.Disputes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.DisputeCount != 0 {
+		n += 1 + sovGenesis(uint64(m.DisputeCount))
+	}
+	if len(m.Bonds) > 0 {
+		for _, e := range m.Bonds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Reputations) > 0 {
+		for _, e := range m.Reputations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Offers) > 0 {
+		for _, e := range m.Offers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.OfferCount != 0 {
+		n += 1 + sovGenesis(uint64(m.OfferCount))
+	}
+	if len(m.TreasuryBalance) > 0 {
+		for _, e := range m.TreasuryBalance {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.TreasuryFlows.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.TreasuryDistributedAt != 0 {
+		n += 2 + sovGenesis(uint64(m.TreasuryDistributedAt))
+	}
 	return n
 }
 
@@ -417,6 +706,334 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainBindings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainBindings = append(m.DomainBindings, &DomainBinding{})
+			if err := m.DomainBindings[len(m.DomainBindings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsageReports", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UsageReports = append(m.UsageReports, &UsageReport{})
+			if err := m.UsageReports[len(m.UsageReports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disputes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Disputes = append(m.Disputes, &Dispute{})
+			if err := m.Disputes[len(m.Disputes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeCount", wireType)
+			}
+			m.DisputeCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisputeCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bonds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bonds = append(m.Bonds, &GatewayBond{})
+			if err := m.Bonds[len(m.Bonds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reputations = append(m.Reputations, &GatewayReputation{})
+			if err := m.Reputations[len(m.Reputations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Offers = append(m.Offers, &Offer{})
+			if err := m.Offers[len(m.Offers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferCount", wireType)
+			}
+			m.OfferCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OfferCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryBalance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TreasuryBalance = append(m.TreasuryBalance, types.Coin{})
+			if err := m.TreasuryBalance[len(m.TreasuryBalance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryFlows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TreasuryFlows.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryDistributedAt", wireType)
+			}
+			m.TreasuryDistributedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TreasuryDistributedAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -9,6 +9,7 @@ const (
 
 	ModuleAccountTreasury = "GatewaysTreasury"
 	ModuleAccountEscrow   = "GatewaysEscrow"
+	ModuleAccountBond     = "GatewaysBond"
 )
 
 var ParamsKey = collections.NewPrefix("gateways/params")
@@ -18,4 +19,39 @@ var (
 	GatewaySeqKey  = collections.NewPrefix("gateways/gateway_seq")
 	ContractKey    = collections.NewPrefix("gateways/contract/")
 	ContractSeqKey = collections.NewPrefix("gateways/contract_seq")
+
+	ContractClientKey  = collections.NewPrefix("gateways/contract_client/")
+	ContractGatewayKey = collections.NewPrefix("gateways/contract_gateway/")
+	ContractStatusKey  = collections.NewPrefix("gateways/contract_status/")
+	ContractPayoutKey  = collections.NewPrefix("gateways/contract_payout/")
+
+	DomainBindingKey = collections.NewPrefix("gateways/domain_binding/")
+	GatewayDomainKey = collections.NewPrefix("gateways/gateway_domain/")
+
+	UsageReportKey = collections.NewPrefix("gateways/usage_report/")
+
+	DisputeKey         = collections.NewPrefix("gateways/dispute/")
+	DisputeSeqKey      = collections.NewPrefix("gateways/dispute_seq")
+	DisputeDeadlineKey = collections.NewPrefix("gateways/dispute_deadline/")
+
+	GatewayBondKey = collections.NewPrefix("gateways/bond/")
+	ReputationKey  = collections.NewPrefix("gateways/reputation/")
+
+	OfferKey        = collections.NewPrefix("gateways/offer/")
+	OfferSeqKey     = collections.NewPrefix("gateways/offer_seq")
+	GatewayOfferKey = collections.NewPrefix("gateways/gateway_offer/")
+
+	GatewayPayoutKey = collections.NewPrefix("gateways/gateway_payout/")
+	AutoClaimKey     = collections.NewPrefix("gateways/auto_claim/")
+
+	PendingDeadlineKey = collections.NewPrefix("gateways/pending_deadline/")
+
+	DecommissionKey       = collections.NewPrefix("gateways/decommission/")
+	DecommissionCursorKey = collections.NewPrefix("gateways/decommission_cursor")
+	LivenessKey           = collections.NewPrefix("gateways/liveness/")
+	OpenContractKey       = collections.NewPrefix("gateways/open_contract/")
+
+	TreasuryBalanceKey     = collections.NewPrefix("gateways/treasury_balance/")
+	TreasuryFlowKey        = collections.NewPrefix("gateways/treasury_flow/")
+	TreasuryDistributedKey = collections.NewPrefix("gateways/treasury_distributed")
 )
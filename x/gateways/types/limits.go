@@ -4,10 +4,60 @@ const (
 	// GatewayMetadataMaxLen bounds free-form operator metadata blobs so they
 	// cannot be abused to stuff megabytes of JSON in a tx memo.
 	GatewayMetadataMaxLen = 1024
-	// GatewayEndpointMaxLen caps future endpoint strings; endpoints are human
+	// GatewayEndpointMaxLen caps endpoint hostnames; endpoints are human
 	// readable hostnames so 64 chars covers typical subdomains.
 	GatewayEndpointMaxLen = 64
+	// MaxGatewayEndpoints caps the endpoints listed in a gateway profile.
+	MaxGatewayEndpoints = 8
+	// MaxGatewayRegions caps the regions listed in a gateway profile.
+	MaxGatewayRegions = 16
+	// GatewayContactMaxLen bounds the contact line of a gateway profile.
+	GatewayContactMaxLen = 256
 	// ContractMetadataMaxLen clamps per-contract metadata (client supplied)
 	// to 1 KiB to avoid bloating events and state.
 	ContractMetadataMaxLen = 1024
+	// MaxDomainsPerGateway caps how many x/dns names a single gateway can bind.
+	MaxDomainsPerGateway = 16
+	// UsageDisputeReasonMaxLen bounds the free-form reason a client attaches
+	// to a usage dispute.
+	UsageDisputeReasonMaxLen = 512
+	// DisputeReasonMaxLen bounds the reason a client gives when opening a
+	// contract dispute and the ruling text an arbiter records.
+	DisputeReasonMaxLen = 512
+	// MaxDisputeEvidence caps the evidence hashes attached to one dispute.
+	MaxDisputeEvidence = 16
+	// MaxDisputeExpirationsPerBlock bounds how many timed-out disputes the
+	// EndBlocker releases per block.
+	MaxDisputeExpirationsPerBlock = 100
+	// MaxActiveOffersPerGateway caps the live price list of one gateway.
+	MaxActiveOffersPerGateway = 32
+	// MaxOfferRegions caps the regions advertised by one offer.
+	MaxOfferRegions = 16
+	// OfferRegionMaxLen bounds a single region label (e.g. "eu-west").
+	OfferRegionMaxLen = 32
+	// AutoClaimRetrySeconds is how long the EndBlocker waits before retrying
+	// a due contract it could not claim (open dispute, unsettled usage).
+	AutoClaimRetrySeconds = 60 * 60
+	// ContractRejectReasonMaxLen bounds the reason a gateway gives when it
+	// rejects a pending contract.
+	ContractRejectReasonMaxLen = 256
+	// MaxPendingExpirationsPerBlock bounds how many unaccepted contracts the
+	// EndBlocker refunds per block.
+	MaxPendingExpirationsPerBlock = 100
+	// MaxDecommissionClosuresPerBlock bounds how many open contracts of
+	// decommissioned gateways the EndBlocker visits per block.
+	MaxDecommissionClosuresPerBlock = 100
+	// MaxDecommissionGatewaysPerBlock bounds how many due decommission queue
+	// entries the EndBlocker visits per block.
+	MaxDecommissionGatewaysPerBlock = 20
+	// MaxLivenessDeactivationsPerBlock bounds how many silent gateways the
+	// EndBlocker deactivates per block.
+	MaxLivenessDeactivationsPerBlock = 100
+	// MinHeartbeatSpacingSeconds is the least time between two heartbeats of
+	// a gateway; with liveness tracking on, half the interval applies if
+	// longer.
+	MinHeartbeatSpacingSeconds = 60
+	// MaxAcceptedDenoms caps the payment denoms governance may whitelist and a
+	// gateway may accept besides ulmn.
+	MaxAcceptedDenoms = 16
 )
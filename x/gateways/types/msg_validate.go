@@ -1,9 +1,11 @@
 package types
 
 import (
+	"fmt"
 	"strings"
 	"unicode/utf8"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -16,6 +18,34 @@ var (
 	_ sdk.Msg = (*MsgCancelContract)(nil)
 	_ sdk.Msg = (*MsgFinalizeContract)(nil)
 	_ sdk.Msg = (*MsgUpdateParams)(nil)
+	_ sdk.Msg = (*MsgBindDomain)(nil)
+	_ sdk.Msg = (*MsgUnbindDomain)(nil)
+	_ sdk.Msg = (*MsgSubmitUsageReport)(nil)
+	_ sdk.Msg = (*MsgAcknowledgeUsageReport)(nil)
+	_ sdk.Msg = (*MsgDisputeUsageReport)(nil)
+	_ sdk.Msg = (*MsgOpenDispute)(nil)
+	_ sdk.Msg = (*MsgSubmitDisputeEvidence)(nil)
+	_ sdk.Msg = (*MsgResolveDispute)(nil)
+	_ sdk.Msg = (*MsgBondGateway)(nil)
+	_ sdk.Msg = (*MsgUnbondGateway)(nil)
+	_ sdk.Msg = (*MsgWithdrawBond)(nil)
+	_ sdk.Msg = (*MsgCreateOffer)(nil)
+	_ sdk.Msg = (*MsgRetireOffer)(nil)
+	_ sdk.Msg = (*MsgExtendContract)(nil)
+	_ sdk.Msg = (*MsgAmendContract)(nil)
+	_ sdk.Msg = (*MsgAcceptAmendment)(nil)
+	_ sdk.Msg = (*MsgClaimAll)(nil)
+	_ sdk.Msg = (*MsgAcceptContract)(nil)
+	_ sdk.Msg = (*MsgRejectContract)(nil)
+	_ sdk.Msg = (*MsgSetGatewayDenoms)(nil)
+	_ sdk.Msg = (*MsgTreasurySpend)(nil)
+	_ sdk.Msg = (*MsgTransferGateway)(nil)
+	_ sdk.Msg = (*MsgAcceptGatewayTransfer)(nil)
+	_ sdk.Msg = (*MsgDecommissionGateway)(nil)
+	_ sdk.Msg = (*MsgMigrateContract)(nil)
+	_ sdk.Msg = (*MsgGatewayHeartbeat)(nil)
+	_ sdk.Msg = (*MsgTransferContract)(nil)
+	_ sdk.Msg = (*MsgApproveContractTransfer)(nil)
 )
 
 func (m *MsgRegisterGateway) ValidateBasic() error {
@@ -28,6 +58,9 @@ func (m *MsgRegisterGateway) ValidateBasic() error {
 	if err := validateMetadata("metadata", m.Metadata, GatewayMetadataMaxLen); err != nil {
 		return err
 	}
+	if _, err := NormalizeGatewayProfile(m.Profile); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid profile: %s", err)
+	}
 	return nil
 }
 
@@ -59,6 +92,9 @@ func (m *MsgUpdateGateway) ValidateBasic() error {
 			return err
 		}
 	}
+	if _, err := NormalizeGatewayProfile(m.Profile); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid profile: %s", err)
+	}
 	return nil
 }
 
@@ -77,9 +113,14 @@ func (m *MsgCreateContract) ValidateBasic() error {
 	if m.GatewayId == 0 {
 		return sdkerrors.ErrInvalidRequest.Wrap("gateway_id required")
 	}
-	if m.PriceUlmn == 0 {
+	if m.PriceUlmn == 0 && m.OfferId == 0 {
 		return sdkerrors.ErrInvalidRequest.Wrap("price_ulmn must be > 0")
 	}
+	if m.Denom != "" {
+		if err := sdk.ValidateDenom(m.Denom); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("invalid denom: %s", err)
+		}
+	}
 	if m.MonthsTotal == 0 {
 		return sdkerrors.ErrInvalidRequest.Wrap("months_total must be > 0")
 	}
@@ -142,6 +183,243 @@ func (m *MsgFinalizeContract) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{addr}
 }
 
+func (m *MsgBindDomain) ValidateBasic() error {
+	return validateDomainBindingMsg(m.Operator, m.GatewayId, m.Domain)
+}
+
+func (m *MsgBindDomain) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (m *MsgUnbindDomain) ValidateBasic() error {
+	return validateDomainBindingMsg(m.Operator, m.GatewayId, m.Domain)
+}
+
+func (m *MsgUnbindDomain) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func validateDomainBindingMsg(operator string, gatewayID uint64, domain string) error {
+	if _, err := sdk.AccAddressFromBech32(operator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid operator address (%s)", err)
+	}
+	if gatewayID == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("gateway_id required")
+	}
+	if len(domain) > GatewayEndpointMaxLen {
+		return sdkerrors.ErrInvalidRequest.Wrapf("domain too long: %d > %d", len(domain), GatewayEndpointMaxLen)
+	}
+	ep, err := NormalizeGatewayEndpoint(domain)
+	if err != nil {
+		return err
+	}
+	if ep == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("domain required")
+	}
+	return nil
+}
+
+func (m *MsgSubmitUsageReport) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Operator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid operator address (%s)", err)
+	}
+	if m.ContractId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("contract_id required")
+	}
+	if m.Month == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("month must be >= 1")
+	}
+	return ValidateEvidenceHash(m.EvidenceHash)
+}
+
+func (m *MsgSubmitUsageReport) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (m *MsgAcknowledgeUsageReport) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Client); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid client address (%s)", err)
+	}
+	if m.ContractId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("contract_id required")
+	}
+	if m.Month == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("month must be >= 1")
+	}
+	return nil
+}
+
+func (m *MsgAcknowledgeUsageReport) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Client)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (m *MsgDisputeUsageReport) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Client); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid client address (%s)", err)
+	}
+	if m.ContractId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("contract_id required")
+	}
+	if m.Month == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("month must be >= 1")
+	}
+	return validateMetadata("reason", m.Reason, UsageDisputeReasonMaxLen)
+}
+
+func (m *MsgDisputeUsageReport) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Client)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (m *MsgOpenDispute) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Client); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid client address (%s)", err)
+	}
+	if m.ContractId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("contract_id required")
+	}
+	if m.Reason == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("reason required")
+	}
+	if err := validateMetadata("reason", m.Reason, DisputeReasonMaxLen); err != nil {
+		return err
+	}
+	return ValidateEvidenceHash(m.EvidenceHash)
+}
+
+func (m *MsgOpenDispute) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Client)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (m *MsgSubmitDisputeEvidence) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Submitter); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid submitter address (%s)", err)
+	}
+	if m.DisputeId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("dispute_id required")
+	}
+	if m.EvidenceHash == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("evidence_hash required")
+	}
+	return ValidateEvidenceHash(m.EvidenceHash)
+}
+
+func (m *MsgSubmitDisputeEvidence) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Submitter)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (m *MsgResolveDispute) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Arbiter); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid arbiter address (%s)", err)
+	}
+	if m.DisputeId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("dispute_id required")
+	}
+	if m.ClientRefundBps > 10_000 {
+		return sdkerrors.ErrInvalidRequest.Wrap("client_refund_bps must be <= 10000")
+	}
+	return validateMetadata("ruling", m.Ruling, DisputeReasonMaxLen)
+}
+
+func (m *MsgResolveDispute) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Arbiter)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (m *MsgBondGateway) ValidateBasic() error {
+	return validateBondMsg(m.Operator, m.GatewayId, m.AmountUlmn)
+}
+
+func (m *MsgBondGateway) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (m *MsgUnbondGateway) ValidateBasic() error {
+	return validateBondMsg(m.Operator, m.GatewayId, m.AmountUlmn)
+}
+
+func (m *MsgUnbondGateway) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (m *MsgWithdrawBond) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Operator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid operator address (%s)", err)
+	}
+	if m.GatewayId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("gateway_id required")
+	}
+	return nil
+}
+
+func (m *MsgWithdrawBond) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func validateBondMsg(operator string, gatewayID uint64, amount string) error {
+	if _, err := sdk.AccAddressFromBech32(operator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid operator address (%s)", err)
+	}
+	if gatewayID == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("gateway_id required")
+	}
+	if _, err := ParseBondAmount(amount); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	return nil
+}
+
+// ParseBondAmount parses a positive integer amount of the base denom.
+func ParseBondAmount(raw string) (sdkmath.Int, error) {
+	amt, ok := sdkmath.NewIntFromString(strings.TrimSpace(raw))
+	if !ok || !amt.IsPositive() {
+		return sdkmath.Int{}, fmt.Errorf("amount_ulmn must be a positive integer")
+	}
+	return amt, nil
+}
+
 func (m *MsgUpdateParams) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
 		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address (%s)", err)
@@ -157,6 +435,244 @@ func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{addr}
 }
 
+func (m *MsgCreateOffer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Operator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid operator address (%s)", err)
+	}
+	if m.GatewayId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("gateway_id required")
+	}
+	if err := ValidateOfferTerms(m.PriceUlmn, m.MinMonths, m.MaxMonths, m.Regions); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	if m.Denom != "" {
+		if err := sdk.ValidateDenom(m.Denom); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("invalid denom: %s", err)
+		}
+	}
+	if m.CancellationPolicy != nil {
+		if err := ValidateCancellationPolicy(*m.CancellationPolicy); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("invalid cancellation policy: %s", err)
+		}
+	}
+	return nil
+}
+
+func (m *MsgCreateOffer) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (m *MsgRetireOffer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Operator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid operator address (%s)", err)
+	}
+	if m.OfferId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("offer_id required")
+	}
+	return nil
+}
+
+func (m *MsgRetireOffer) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (m *MsgExtendContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Client); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid client address (%s)", err)
+	}
+	if m.AdditionalMonths == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("additional_months must be > 0")
+	}
+	return nil
+}
+
+func (m *MsgExtendContract) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Client)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (m *MsgAmendContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Client); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid client address (%s)", err)
+	}
+	if m.PriceUlmn == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("price_ulmn must be > 0")
+	}
+	return nil
+}
+
+func (m *MsgAmendContract) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Client)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (m *MsgAcceptAmendment) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Operator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid operator address (%s)", err)
+	}
+	return nil
+}
+
+func (m *MsgAcceptAmendment) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (m *MsgClaimAll) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Operator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid operator address (%s)", err)
+	}
+	if m.GatewayId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("gateway_id required")
+	}
+	return nil
+}
+
+func (m *MsgClaimAll) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (m *MsgAcceptContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Operator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid operator address (%s)", err)
+	}
+	return nil
+}
+
+func (m *MsgAcceptContract) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (m *MsgRejectContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Operator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid operator address (%s)", err)
+	}
+	return validateMetadata("reason", m.Reason, ContractRejectReasonMaxLen)
+}
+
+func (m *MsgRejectContract) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (m *MsgSetGatewayDenoms) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Operator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid operator address (%s)", err)
+	}
+	if m.GatewayId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("gateway_id required")
+	}
+	if err := ValidateGatewayDenoms(m.Denoms); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	return nil
+}
+
+func (m *MsgSetGatewayDenoms) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (m *MsgTreasurySpend) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Recipient); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address (%s)", err)
+	}
+	if err := m.Amount.Validate(); err != nil {
+		return sdkerrors.ErrInvalidCoins.Wrap(err.Error())
+	}
+	if m.Amount.IsZero() {
+		return sdkerrors.ErrInvalidCoins.Wrap("amount must be positive")
+	}
+	return nil
+}
+
+func (m *MsgTreasurySpend) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (m *MsgTransferGateway) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Operator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid operator address (%s)", err)
+	}
+	if m.GatewayId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("gateway_id required")
+	}
+	if m.NewOperator == "" {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(m.NewOperator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid new operator address (%s)", err)
+	}
+	if m.NewOperator == m.Operator {
+		return sdkerrors.ErrInvalidRequest.Wrap("new operator must differ from the operator")
+	}
+	return nil
+}
+
+func (m *MsgTransferGateway) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (m *MsgAcceptGatewayTransfer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.NewOperator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid new operator address (%s)", err)
+	}
+	if m.GatewayId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("gateway_id required")
+	}
+	return nil
+}
+
+func (m *MsgAcceptGatewayTransfer) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.NewOperator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
 func validateMetadata(field, value string, max int) error {
 	if value == "" {
 		return nil
@@ -174,3 +690,104 @@ func validateMetadata(field, value string, max int) error {
 	}
 	return nil
 }
+
+func (m *MsgDecommissionGateway) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Operator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid operator address (%s)", err)
+	}
+	if m.GatewayId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("gateway_id required")
+	}
+	return nil
+}
+
+func (m *MsgDecommissionGateway) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (m *MsgMigrateContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Client); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid client address (%s)", err)
+	}
+	if m.ContractId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("contract_id required")
+	}
+	if m.NewGatewayId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("new_gateway_id required")
+	}
+	return nil
+}
+
+func (m *MsgMigrateContract) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Client)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (m *MsgGatewayHeartbeat) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Operator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid operator address (%s)", err)
+	}
+	if m.GatewayId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("gateway_id required")
+	}
+	return nil
+}
+
+func (m *MsgGatewayHeartbeat) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (m *MsgTransferContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Client); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid client address (%s)", err)
+	}
+	if m.ContractId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("contract_id required")
+	}
+	if newClient := strings.TrimSpace(m.NewClient); newClient != "" {
+		if _, err := sdk.AccAddressFromBech32(newClient); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid new client address (%s)", err)
+		}
+		if newClient == m.Client {
+			return sdkerrors.ErrInvalidRequest.Wrap("new client must differ from the client")
+		}
+	}
+	return nil
+}
+
+func (m *MsgTransferContract) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Client)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (m *MsgApproveContractTransfer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Operator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid operator address (%s)", err)
+	}
+	if m.ContractId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("contract_id required")
+	}
+	return nil
+}
+
+func (m *MsgApproveContractTransfer) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
@@ -0,0 +1,56 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ValidateOfferTerms checks the client-facing terms of an offer: a positive
+// price, a sane months range (max_months 0 means no upper bound) and short,
+// distinct lowercase region labels.
+func ValidateOfferTerms(priceUlmn uint64, minMonths, maxMonths uint32, regions []string) error {
+	if priceUlmn == 0 {
+		return fmt.Errorf("price_ulmn must be > 0")
+	}
+	if minMonths == 0 {
+		return fmt.Errorf("min_months must be > 0")
+	}
+	if maxMonths != 0 && maxMonths < minMonths {
+		return fmt.Errorf("max_months must be >= min_months")
+	}
+	return validateRegions(regions, MaxOfferRegions)
+}
+
+// validateRegions checks a list of at most max short, distinct lowercase
+// region labels.
+func validateRegions(regions []string, max int) error {
+	if len(regions) > max {
+		return fmt.Errorf("too many regions: %d > %d", len(regions), max)
+	}
+	seen := make(map[string]struct{}, len(regions))
+	for _, region := range regions {
+		if region == "" || len(region) > OfferRegionMaxLen {
+			return fmt.Errorf("region must be 1-%d characters", OfferRegionMaxLen)
+		}
+		if strings.ContainsFunc(region, func(r rune) bool {
+			return !(r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || r == '-')
+		}) {
+			return fmt.Errorf("region %q must use lowercase letters, digits and '-'", region)
+		}
+		if _, ok := seen[region]; ok {
+			return fmt.Errorf("duplicate region %q", region)
+		}
+		seen[region] = struct{}{}
+	}
+	return nil
+}
+
+// AcceptsMonths reports whether a contract of the given length fits the offer.
+func (o Offer) AcceptsMonths(months uint32) bool {
+	return months >= o.MinMonths && (o.MaxMonths == 0 || months <= o.MaxMonths)
+}
+
+// HasCapacity reports whether the offer can take another contract.
+func (o Offer) HasCapacity() bool {
+	return o.CapacitySlots == 0 || o.UsedSlots < o.CapacitySlots
+}
@@ -1,11 +1,37 @@
 package types
 
-import "fmt"
+import (
+	"fmt"
+
+	"lumen/app/denom"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 const (
 	defaultActionFeeUlmn          uint64 = 1_000      // 0.001 LUMEN
 	defaultRegisterGatewayFeeUlmn uint64 = 50_000_000 // 50 LUMEN
 	defaultMinContractPriceUlmn   uint64 = 100_000    // 0.1 LUMEN / month
+	defaultUsageDisputeWindow     uint64 = 3 * 24 * 60 * 60
+	defaultDisputeTimeout         uint64 = 30 * 24 * 60 * 60
+	maxDisputeTimeout             uint64 = 365 * 24 * 60 * 60
+	defaultMinBondUlmn            uint64 = 100_000_000 // 100 LUMEN
+	defaultBondPerClientUlmn      uint64 = 10_000_000  // 10 LUMEN
+	defaultUnbondingDelay         uint64 = 21 * 24 * 60 * 60
+	defaultAutoClaimsPerBlock     uint32 = 100
+	maxAutoClaimsPerBlock         uint32 = 1_000
+	defaultAcceptanceTimeout      uint64 = 3 * 24 * 60 * 60
+	maxAcceptanceTimeout          uint64 = 30 * 24 * 60 * 60
+	defaultTreasuryInterval       uint64 = 7 * 24 * 60 * 60
+	maxTreasuryInterval           uint64 = 365 * 24 * 60 * 60
+	defaultTransferCooldown       uint64 = 30 * 24 * 60 * 60
+	maxTransferCooldown           uint64 = 365 * 24 * 60 * 60
+	maxCancellationNotice         uint64 = 365 * 24 * 60 * 60
+	maxHeartbeatInterval          uint64 = 7 * 24 * 60 * 60
+	defaultHeartbeatMissedWindows uint32 = 3
+	maxHeartbeatMissedWindows     uint32 = 100
+	defaultMaxCancellationRatio   uint32 = 2_000
 )
 
 func NewParams() Params {
@@ -23,7 +49,82 @@ func DefaultParams() Params {
 		MaxActiveContractsPerGateway: 100,
 		ActionFeeUlmn:                defaultActionFeeUlmn,
 		RegisterGatewayFeeUlmn:       defaultRegisterGatewayFeeUlmn,
+		UsageDisputeWindowSeconds:    defaultUsageDisputeWindow,
+		RequireUsageReports:          false,
+		Arbiters:                     []string{},
+		DisputeTimeoutSeconds:        defaultDisputeTimeout,
+		MinBondUlmn:                  defaultMinBondUlmn,
+		BondPerClientUlmn:            defaultBondPerClientUlmn,
+		UnbondingDelaySeconds:        defaultUnbondingDelay,
+		DisputeSlashBps:              1_000,
+		MaxCancellations:             20,
+		CancellationSlashBps:         100,
+		MaxCancellationRatioBps:      defaultMaxCancellationRatio,
+		AutoClaimsPerBlock:           defaultAutoClaimsPerBlock,
+		AcceptanceTimeoutSeconds:     defaultAcceptanceTimeout,
+		// Shares start at zero: the treasury keeps accruing until governance
+		// sets a policy.
+		TreasuryDistributionIntervalSeconds: defaultTreasuryInterval,
+		GatewayTransferCooldownSeconds:      defaultTransferCooldown,
+		// Liveness tracking stays off until governance sets an interval.
+		HeartbeatMissedWindows: defaultHeartbeatMissedWindows,
+	}
+}
+
+// RequiredBond is the bond a gateway must hold to serve activeClients
+// contracts.
+func (p Params) RequiredBond(activeClients uint32) sdkmath.Int {
+	perClient := sdkmath.NewIntFromUint64(p.BondPerClientUlmn).MulRaw(int64(activeClients))
+	return sdkmath.NewIntFromUint64(p.MinBondUlmn).Add(perClient)
+}
+
+// DisputeTimeout returns how long a dispute may stay open before its freeze
+// lifts, falling back to the default for params stored before it existed.
+func (p Params) DisputeTimeout() uint64 {
+	if p.DisputeTimeoutSeconds == 0 {
+		return defaultDisputeTimeout
+	}
+	return p.DisputeTimeoutSeconds
+}
+
+// CancellationsExcessive reports whether a gateway with reputation rep and
+// activeClients running contracts has cancelled enough to be slashed: more
+// than max_cancellations contracts, making up more than
+// max_cancellation_ratio_bps of all its contracts. A busy gateway with a long
+// record is not slashed for a handful of cancellations.
+func (p Params) CancellationsExcessive(rep GatewayReputation, activeClients uint32) bool {
+	if p.MaxCancellations == 0 || rep.ContractsCancelled <= uint64(p.MaxCancellations) {
+		return false
+	}
+	ratio := p.MaxCancellationRatioBps
+	if ratio == 0 {
+		ratio = defaultMaxCancellationRatio
 	}
+	total := sdkmath.NewIntFromUint64(rep.ContractsCompleted).
+		Add(sdkmath.NewIntFromUint64(rep.ContractsCancelled)).
+		Add(sdkmath.NewIntFromUint64(uint64(activeClients)))
+	cancelled := sdkmath.NewIntFromUint64(rep.ContractsCancelled).MulRaw(10_000)
+	return cancelled.GT(total.MulRaw(int64(ratio)))
+}
+
+// HeartbeatGrace returns how long an active gateway may go without a
+// heartbeat before it is deactivated, or 0 when liveness is not tracked.
+func (p Params) HeartbeatGrace() uint64 {
+	windows := uint64(p.HeartbeatMissedWindows)
+	if windows == 0 {
+		windows = 1
+	}
+	return p.HeartbeatIntervalSeconds * windows
+}
+
+// IsArbiter reports whether addr is in the governance-appointed arbiter set.
+func (p Params) IsArbiter(addr string) bool {
+	for _, a := range p.Arbiters {
+		if a == addr {
+			return true
+		}
+	}
+	return false
 }
 
 func ValidateParams(p Params) error {
@@ -48,5 +149,109 @@ func ValidateParams(p Params) error {
 	if p.RegisterGatewayFeeUlmn > 1_000_000_000_000_000 {
 		return fmt.Errorf("register_gateway_fee_ulmn is too large")
 	}
+	if p.UsageDisputeWindowSeconds > p.MonthSeconds {
+		return fmt.Errorf("usage_dispute_window_seconds must be <= month_seconds")
+	}
+	if p.DisputeSlashBps > 10_000 {
+		return fmt.Errorf("dispute_slash_bps must be <= 10000")
+	}
+	if p.CancellationSlashBps > 10_000 {
+		return fmt.Errorf("cancellation_slash_bps must be <= 10000")
+	}
+	if p.MaxCancellationRatioBps > 10_000 {
+		return fmt.Errorf("max_cancellation_ratio_bps must be <= 10000")
+	}
+	if p.MinBondUlmn > 1_000_000_000_000_000 || p.BondPerClientUlmn > 1_000_000_000_000_000 {
+		return fmt.Errorf("bond requirement is too large")
+	}
+	if p.AutoClaimsPerBlock > maxAutoClaimsPerBlock {
+		return fmt.Errorf("auto_claims_per_block must be <= %d", maxAutoClaimsPerBlock)
+	}
+	if p.AcceptanceTimeoutSeconds > maxAcceptanceTimeout {
+		return fmt.Errorf("acceptance_timeout_seconds must be <= %d", maxAcceptanceTimeout)
+	}
+	if uint64(p.TreasuryCommunityPoolBps)+uint64(p.TreasuryBurnBps)+uint64(p.TreasuryStakersBps) > 10_000 {
+		return fmt.Errorf("treasury community pool, burn and stakers shares must sum to <= 10000 bps")
+	}
+	if p.TreasuryDistributionIntervalSeconds > maxTreasuryInterval {
+		return fmt.Errorf("treasury_distribution_interval_seconds must be <= %d", maxTreasuryInterval)
+	}
+	if p.GatewayTransferCooldownSeconds > maxTransferCooldown {
+		return fmt.Errorf("gateway_transfer_cooldown_seconds must be <= %d", maxTransferCooldown)
+	}
+	if err := validateAcceptedDenoms(p.AcceptedDenoms); err != nil {
+		return err
+	}
+	if p.HeartbeatIntervalSeconds > maxHeartbeatInterval {
+		return fmt.Errorf("heartbeat_interval_seconds must be <= %d", maxHeartbeatInterval)
+	}
+	if p.HeartbeatMissedWindows > maxHeartbeatMissedWindows {
+		return fmt.Errorf("heartbeat_missed_windows must be <= %d", maxHeartbeatMissedWindows)
+	}
+	if err := ValidateCancellationPolicy(p.CancellationPolicy); err != nil {
+		return fmt.Errorf("cancellation_policy: %w", err)
+	}
+	if p.DisputeTimeoutSeconds > maxDisputeTimeout {
+		return fmt.Errorf("dispute_timeout_seconds must be <= %d", maxDisputeTimeout)
+	}
+	seen := make(map[string]struct{}, len(p.Arbiters))
+	for _, a := range p.Arbiters {
+		if _, err := sdk.AccAddressFromBech32(a); err != nil {
+			return fmt.Errorf("invalid arbiter %q: %w", a, err)
+		}
+		if _, ok := seen[a]; ok {
+			return fmt.Errorf("duplicate arbiter %s", a)
+		}
+		seen[a] = struct{}{}
+	}
+	return nil
+}
+
+func validateAcceptedDenoms(list []AcceptedDenom) error {
+	if len(list) > MaxAcceptedDenoms {
+		return fmt.Errorf("too many accepted_denoms: %d > %d", len(list), MaxAcceptedDenoms)
+	}
+	seen := make(map[string]struct{}, len(list))
+	for _, d := range list {
+		if err := sdk.ValidateDenom(d.Denom); err != nil {
+			return fmt.Errorf("invalid accepted denom %q: %w", d.Denom, err)
+		}
+		if d.Denom == denom.BaseDenom {
+			return fmt.Errorf("accepted_denoms must not list %s", denom.BaseDenom)
+		}
+		if d.MinPricePerMonth == 0 {
+			return fmt.Errorf("accepted denom %s: min_price_per_month must be > 0", d.Denom)
+		}
+		if _, ok := seen[d.Denom]; ok {
+			return fmt.Errorf("duplicate accepted denom %s", d.Denom)
+		}
+		seen[d.Denom] = struct{}{}
+	}
+	return nil
+}
+
+// ValidateCancellationPolicy checks the bounds of a params or offer
+// cancellation policy.
+func ValidateCancellationPolicy(policy CancellationPolicy) error {
+	if policy.NoticeSeconds > maxCancellationNotice {
+		return fmt.Errorf("notice_seconds must be <= %d", maxCancellationNotice)
+	}
+	if policy.PenaltyBps > 10_000 {
+		return fmt.Errorf("penalty_bps must be <= 10000")
+	}
 	return nil
 }
+
+// MinPricePerMonth returns the monthly price floor for a payment denom and
+// whether contracts may be paid in it at all.
+func (p Params) MinPricePerMonth(payDenom string) (uint64, bool) {
+	if payDenom == denom.BaseDenom {
+		return p.MinPriceUlmnPerMonth, true
+	}
+	for _, d := range p.AcceptedDenoms {
+		if d.Denom == payDenom {
+			return d.MinPricePerMonth, true
+		}
+	}
+	return 0, false
+}
@@ -25,14 +25,51 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type Params struct {
-	PlatformCommissionBps        uint32 `protobuf:"varint,1,opt,name=platform_commission_bps,json=platformCommissionBps,proto3" json:"platform_commission_bps,omitempty"`
-	MonthSeconds                 uint64 `protobuf:"varint,2,opt,name=month_seconds,json=monthSeconds,proto3" json:"month_seconds,omitempty"`
-	FinalizeDelayMonths          uint32 `protobuf:"varint,3,opt,name=finalize_delay_months,json=finalizeDelayMonths,proto3" json:"finalize_delay_months,omitempty"`
-	FinalizerRewardBps           uint32 `protobuf:"varint,4,opt,name=finalizer_reward_bps,json=finalizerRewardBps,proto3" json:"finalizer_reward_bps,omitempty"`
-	MinPriceUlmnPerMonth         uint64 `protobuf:"varint,5,opt,name=min_price_ulmn_per_month,json=minPriceUlmnPerMonth,proto3" json:"min_price_ulmn_per_month,omitempty"`
-	MaxActiveContractsPerGateway uint32 `protobuf:"varint,6,opt,name=max_active_contracts_per_gateway,json=maxActiveContractsPerGateway,proto3" json:"max_active_contracts_per_gateway,omitempty"`
-	ActionFeeUlmn                uint64 `protobuf:"varint,7,opt,name=action_fee_ulmn,json=actionFeeUlmn,proto3" json:"action_fee_ulmn,omitempty"`
-	RegisterGatewayFeeUlmn       uint64 `protobuf:"varint,8,opt,name=register_gateway_fee_ulmn,json=registerGatewayFeeUlmn,proto3" json:"register_gateway_fee_ulmn,omitempty"`
+	PlatformCommissionBps        uint32   `protobuf:"varint,1,opt,name=platform_commission_bps,json=platformCommissionBps,proto3" json:"platform_commission_bps,omitempty"`
+	MonthSeconds                 uint64   `protobuf:"varint,2,opt,name=month_seconds,json=monthSeconds,proto3" json:"month_seconds,omitempty"`
+	FinalizeDelayMonths          uint32   `protobuf:"varint,3,opt,name=finalize_delay_months,json=finalizeDelayMonths,proto3" json:"finalize_delay_months,omitempty"`
+	FinalizerRewardBps           uint32   `protobuf:"varint,4,opt,name=finalizer_reward_bps,json=finalizerRewardBps,proto3" json:"finalizer_reward_bps,omitempty"`
+	MinPriceUlmnPerMonth         uint64   `protobuf:"varint,5,opt,name=min_price_ulmn_per_month,json=minPriceUlmnPerMonth,proto3" json:"min_price_ulmn_per_month,omitempty"`
+	MaxActiveContractsPerGateway uint32   `protobuf:"varint,6,opt,name=max_active_contracts_per_gateway,json=maxActiveContractsPerGateway,proto3" json:"max_active_contracts_per_gateway,omitempty"`
+	ActionFeeUlmn                uint64   `protobuf:"varint,7,opt,name=action_fee_ulmn,json=actionFeeUlmn,proto3" json:"action_fee_ulmn,omitempty"`
+	RegisterGatewayFeeUlmn       uint64   `protobuf:"varint,8,opt,name=register_gateway_fee_ulmn,json=registerGatewayFeeUlmn,proto3" json:"register_gateway_fee_ulmn,omitempty"`
+	UsageDisputeWindowSeconds    uint64   `protobuf:"varint,9,opt,name=usage_dispute_window_seconds,json=usageDisputeWindowSeconds,proto3" json:"usage_dispute_window_seconds,omitempty"`
+	RequireUsageReports          bool     `protobuf:"varint,10,opt,name=require_usage_reports,json=requireUsageReports,proto3" json:"require_usage_reports,omitempty"`
+	Arbiters                     []string `protobuf:"bytes,11,rep,name=arbiters,proto3" json:"arbiters,omitempty"`
+	DisputeTimeoutSeconds        uint64   `protobuf:"varint,12,opt,name=dispute_timeout_seconds,json=disputeTimeoutSeconds,proto3" json:"dispute_timeout_seconds,omitempty"`
+	MinBondUlmn                  uint64   `protobuf:"varint,13,opt,name=min_bond_ulmn,json=minBondUlmn,proto3" json:"min_bond_ulmn,omitempty"`
+	BondPerClientUlmn            uint64   `protobuf:"varint,14,opt,name=bond_per_client_ulmn,json=bondPerClientUlmn,proto3" json:"bond_per_client_ulmn,omitempty"`
+	UnbondingDelaySeconds        uint64   `protobuf:"varint,15,opt,name=unbonding_delay_seconds,json=unbondingDelaySeconds,proto3" json:"unbonding_delay_seconds,omitempty"`
+	DisputeSlashBps              uint32   `protobuf:"varint,16,opt,name=dispute_slash_bps,json=disputeSlashBps,proto3" json:"dispute_slash_bps,omitempty"`
+	MaxCancellations             uint32   `protobuf:"varint,17,opt,name=max_cancellations,json=maxCancellations,proto3" json:"max_cancellations,omitempty"`
+	CancellationSlashBps         uint32   `protobuf:"varint,18,opt,name=cancellation_slash_bps,json=cancellationSlashBps,proto3" json:"cancellation_slash_bps,omitempty"`
+	AutoClaimsPerBlock           uint32   `protobuf:"varint,19,opt,name=auto_claims_per_block,json=autoClaimsPerBlock,proto3" json:"auto_claims_per_block,omitempty"`
+	AcceptanceTimeoutSeconds     uint64   `protobuf:"varint,20,opt,name=acceptance_timeout_seconds,json=acceptanceTimeoutSeconds,proto3" json:"acceptance_timeout_seconds,omitempty"`
+	// accepted_denoms whitelists payment denoms besides ulmn (e.g. ibc/...
+	// stablecoins) that gateways may opt into.
+	AcceptedDenoms []AcceptedDenom `protobuf:"bytes,21,rep,name=accepted_denoms,json=acceptedDenoms,proto3" json:"accepted_denoms"`
+	// Treasury policy: every treasury_distribution_interval_seconds the EndBlocker
+	// splits the treasury balance by these shares (the remainder stays). A burn
+	// share of a non-native denom goes to the community pool instead.
+	TreasuryCommunityPoolBps            uint32 `protobuf:"varint,22,opt,name=treasury_community_pool_bps,json=treasuryCommunityPoolBps,proto3" json:"treasury_community_pool_bps,omitempty"`
+	TreasuryBurnBps                     uint32 `protobuf:"varint,23,opt,name=treasury_burn_bps,json=treasuryBurnBps,proto3" json:"treasury_burn_bps,omitempty"`
+	TreasuryStakersBps                  uint32 `protobuf:"varint,24,opt,name=treasury_stakers_bps,json=treasuryStakersBps,proto3" json:"treasury_stakers_bps,omitempty"`
+	TreasuryDistributionIntervalSeconds uint64 `protobuf:"varint,25,opt,name=treasury_distribution_interval_seconds,json=treasuryDistributionIntervalSeconds,proto3" json:"treasury_distribution_interval_seconds,omitempty"`
+	// gateway_transfer_cooldown_seconds is how long after an ownership transfer
+	// before the gateway can be transferred again, 0 = no cooldown.
+	GatewayTransferCooldownSeconds uint64 `protobuf:"varint,26,opt,name=gateway_transfer_cooldown_seconds,json=gatewayTransferCooldownSeconds,proto3" json:"gateway_transfer_cooldown_seconds,omitempty"`
+	// cancellation_policy sets the terms of MsgCancelContract for contracts
+	// whose offer does not override them.
+	CancellationPolicy CancellationPolicy `protobuf:"bytes,27,opt,name=cancellation_policy,json=cancellationPolicy,proto3" json:"cancellation_policy"`
+	// Liveness: an active gateway that posts no heartbeat for
+	// heartbeat_missed_windows consecutive windows of heartbeat_interval_seconds
+	// is deactivated. An interval of 0 disables liveness tracking.
+	HeartbeatIntervalSeconds uint64 `protobuf:"varint,28,opt,name=heartbeat_interval_seconds,json=heartbeatIntervalSeconds,proto3" json:"heartbeat_interval_seconds,omitempty"`
+	HeartbeatMissedWindows   uint32 `protobuf:"varint,29,opt,name=heartbeat_missed_windows,json=heartbeatMissedWindows,proto3" json:"heartbeat_missed_windows,omitempty"`
+	// max_cancellation_ratio_bps is the share of a gateway's contracts (completed,
+	// cancelled or running) that may end in cancellation before the cancellations
+	// beyond max_cancellations are slashed. 0 = the 20% default.
+	MaxCancellationRatioBps uint32 `protobuf:"varint,30,opt,name=max_cancellation_ratio_bps,json=maxCancellationRatioBps,proto3" json:"max_cancellation_ratio_bps,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -124,40 +161,356 @@ func (m *Params) GetRegisterGatewayFeeUlmn() uint64 {
 	return 0
 }
 
+func (m *Params) GetUsageDisputeWindowSeconds() uint64 {
+	if m != nil {
+		return m.UsageDisputeWindowSeconds
+	}
+	return 0
+}
+
+func (m *Params) GetRequireUsageReports() bool {
+	if m != nil {
+		return m.RequireUsageReports
+	}
+	return false
+}
+
+func (m *Params) GetArbiters() []string {
+	if m != nil {
+		return m.Arbiters
+	}
+	return nil
+}
+
+func (m *Params) GetDisputeTimeoutSeconds() uint64 {
+	if m != nil {
+		return m.DisputeTimeoutSeconds
+	}
+	return 0
+}
+
+func (m *Params) GetMinBondUlmn() uint64 {
+	if m != nil {
+		return m.MinBondUlmn
+	}
+	return 0
+}
+
+func (m *Params) GetBondPerClientUlmn() uint64 {
+	if m != nil {
+		return m.BondPerClientUlmn
+	}
+	return 0
+}
+
+func (m *Params) GetUnbondingDelaySeconds() uint64 {
+	if m != nil {
+		return m.UnbondingDelaySeconds
+	}
+	return 0
+}
+
+func (m *Params) GetDisputeSlashBps() uint32 {
+	if m != nil {
+		return m.DisputeSlashBps
+	}
+	return 0
+}
+
+func (m *Params) GetMaxCancellations() uint32 {
+	if m != nil {
+		return m.MaxCancellations
+	}
+	return 0
+}
+
+func (m *Params) GetCancellationSlashBps() uint32 {
+	if m != nil {
+		return m.CancellationSlashBps
+	}
+	return 0
+}
+
+func (m *Params) GetAutoClaimsPerBlock() uint32 {
+	if m != nil {
+		return m.AutoClaimsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetAcceptanceTimeoutSeconds() uint64 {
+	if m != nil {
+		return m.AcceptanceTimeoutSeconds
+	}
+	return 0
+}
+
+func (m *Params) GetAcceptedDenoms() []AcceptedDenom {
+	if m != nil {
+		return m.AcceptedDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetTreasuryCommunityPoolBps() uint32 {
+	if m != nil {
+		return m.TreasuryCommunityPoolBps
+	}
+	return 0
+}
+
+func (m *Params) GetTreasuryBurnBps() uint32 {
+	if m != nil {
+		return m.TreasuryBurnBps
+	}
+	return 0
+}
+
+func (m *Params) GetTreasuryStakersBps() uint32 {
+	if m != nil {
+		return m.TreasuryStakersBps
+	}
+	return 0
+}
+
+func (m *Params) GetTreasuryDistributionIntervalSeconds() uint64 {
+	if m != nil {
+		return m.TreasuryDistributionIntervalSeconds
+	}
+	return 0
+}
+
+func (m *Params) GetGatewayTransferCooldownSeconds() uint64 {
+	if m != nil {
+		return m.GatewayTransferCooldownSeconds
+	}
+	return 0
+}
+
+func (m *Params) GetCancellationPolicy() CancellationPolicy {
+	if m != nil {
+		return m.CancellationPolicy
+	}
+	return CancellationPolicy{}
+}
+
+func (m *Params) GetHeartbeatIntervalSeconds() uint64 {
+	if m != nil {
+		return m.HeartbeatIntervalSeconds
+	}
+	return 0
+}
+
+func (m *Params) GetHeartbeatMissedWindows() uint32 {
+	if m != nil {
+		return m.HeartbeatMissedWindows
+	}
+	return 0
+}
+
+func (m *Params) GetMaxCancellationRatioBps() uint32 {
+	if m != nil {
+		return m.MaxCancellationRatioBps
+	}
+	return 0
+}
+
+// AcceptedDenom is a governance-whitelisted payment denom with its own
+// monthly price floor.
+type AcceptedDenom struct {
+	Denom            string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	MinPricePerMonth uint64 `protobuf:"varint,2,opt,name=min_price_per_month,json=minPricePerMonth,proto3" json:"min_price_per_month,omitempty"`
+}
+
+func (m *AcceptedDenom) Reset()         { *m = AcceptedDenom{} }
+func (m *AcceptedDenom) String() string { return proto.CompactTextString(m) }
+func (*AcceptedDenom) ProtoMessage()    {}
+func (*AcceptedDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f12ee76b2aefcba9, []int{1}
+}
+func (m *AcceptedDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AcceptedDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AcceptedDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AcceptedDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AcceptedDenom.Merge(m, src)
+}
+func (m *AcceptedDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *AcceptedDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_AcceptedDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AcceptedDenom proto.InternalMessageInfo
+
+func (m *AcceptedDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *AcceptedDenom) GetMinPricePerMonth() uint64 {
+	if m != nil {
+		return m.MinPricePerMonth
+	}
+	return 0
+}
+
+// CancellationPolicy sets what a gateway keeps when a client cancels an
+// active contract. The gateway is paid for the service up to the end of the
+// notice period, by the second with pro_rata or else for every month begun;
+// penalty_bps of the escrow left after that is paid to it on top. The client
+// gets the rest back.
+type CancellationPolicy struct {
+	NoticeSeconds uint64 `protobuf:"varint,1,opt,name=notice_seconds,json=noticeSeconds,proto3" json:"notice_seconds,omitempty"`
+	PenaltyBps    uint32 `protobuf:"varint,2,opt,name=penalty_bps,json=penaltyBps,proto3" json:"penalty_bps,omitempty"`
+	ProRata       bool   `protobuf:"varint,3,opt,name=pro_rata,json=proRata,proto3" json:"pro_rata,omitempty"`
+}
+
+func (m *CancellationPolicy) Reset()         { *m = CancellationPolicy{} }
+func (m *CancellationPolicy) String() string { return proto.CompactTextString(m) }
+func (*CancellationPolicy) ProtoMessage()    {}
+func (*CancellationPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f12ee76b2aefcba9, []int{2}
+}
+func (m *CancellationPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CancellationPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancellationPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CancellationPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancellationPolicy.Merge(m, src)
+}
+func (m *CancellationPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *CancellationPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancellationPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancellationPolicy proto.InternalMessageInfo
+
+func (m *CancellationPolicy) GetNoticeSeconds() uint64 {
+	if m != nil {
+		return m.NoticeSeconds
+	}
+	return 0
+}
+
+func (m *CancellationPolicy) GetPenaltyBps() uint32 {
+	if m != nil {
+		return m.PenaltyBps
+	}
+	return 0
+}
+
+func (m *CancellationPolicy) GetProRata() bool {
+	if m != nil {
+		return m.ProRata
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "lumen.gateway.v1.Params")
+	proto.RegisterType((*AcceptedDenom)(nil), "lumen.gateway.v1.AcceptedDenom")
+	proto.RegisterType((*CancellationPolicy)(nil), "lumen.gateway.v1.CancellationPolicy")
 }
 
 func init() { proto.RegisterFile("lumen/gateway/v1/params.proto", fileDescriptor_f12ee76b2aefcba9) }
 
 var fileDescriptor_f12ee76b2aefcba9 = []byte{
-	// 415 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0xcd, 0xaa, 0xd3, 0x40,
-	0x14, 0xc7, 0x1b, 0xef, 0xb5, 0xca, 0x60, 0x51, 0xc7, 0xde, 0x7b, 0x63, 0xd1, 0x18, 0x14, 0xa4,
-	0xb8, 0x68, 0x5a, 0x85, 0x82, 0xee, 0x6c, 0xa5, 0xae, 0x84, 0x50, 0x71, 0xe3, 0x66, 0x98, 0xa6,
-	0xd3, 0x38, 0x90, 0xf9, 0x60, 0x66, 0xfa, 0xe5, 0x23, 0xb8, 0xf2, 0x11, 0x7c, 0x04, 0x1f, 0xc3,
-	0x65, 0x97, 0x2e, 0xa5, 0x5d, 0xe8, 0x63, 0x48, 0xce, 0x24, 0xed, 0xe2, 0x6e, 0xca, 0x70, 0x7e,
-	0xff, 0x8f, 0x43, 0x73, 0xd0, 0xe3, 0x62, 0x29, 0x98, 0x4c, 0x72, 0xea, 0xd8, 0x9a, 0x6e, 0x93,
-	0xd5, 0x20, 0xd1, 0xd4, 0x50, 0x61, 0x7b, 0xda, 0x28, 0xa7, 0xf0, 0x3d, 0xc0, 0xbd, 0x0a, 0xf7,
-	0x56, 0x83, 0xce, 0x7d, 0x2a, 0xb8, 0x54, 0x09, 0xfc, 0x7a, 0x51, 0xa7, 0x9d, 0xab, 0x5c, 0xc1,
-	0x33, 0x29, 0x5f, 0x7e, 0xfa, 0x74, 0x77, 0x86, 0x9a, 0x29, 0x64, 0xe1, 0x21, 0xba, 0xd2, 0x05,
-	0x75, 0x0b, 0x65, 0x04, 0xc9, 0x94, 0x10, 0xdc, 0x5a, 0xae, 0x24, 0x99, 0x69, 0x1b, 0x06, 0x71,
-	0xd0, 0x6d, 0x4d, 0x2f, 0x6a, 0x3c, 0x3e, 0xd2, 0x91, 0xb6, 0xf8, 0x19, 0x6a, 0x09, 0x25, 0xdd,
-	0x17, 0x62, 0x59, 0xa6, 0xe4, 0xdc, 0x86, 0x37, 0xe2, 0xa0, 0x7b, 0x3e, 0xbd, 0x03, 0xc3, 0x8f,
-	0x7e, 0x86, 0x5f, 0xa2, 0x8b, 0x05, 0x97, 0xb4, 0xe0, 0x5f, 0x19, 0x99, 0xb3, 0x82, 0x6e, 0x09,
-	0x60, 0x1b, 0x9e, 0x41, 0xf4, 0x83, 0x1a, 0xbe, 0x2b, 0xd9, 0x07, 0x40, 0xb8, 0x8f, 0xda, 0xf5,
-	0xd8, 0x10, 0xc3, 0xd6, 0xd4, 0xcc, 0x61, 0x9b, 0x73, 0xb0, 0xe0, 0x23, 0x9b, 0x02, 0x2a, 0x57,
-	0x19, 0xa2, 0x50, 0x70, 0x49, 0xb4, 0xe1, 0x19, 0x23, 0xcb, 0x42, 0x48, 0xa2, 0x99, 0xf1, 0x4d,
-	0xe1, 0x4d, 0xd8, 0xaa, 0x2d, 0xb8, 0x4c, 0x4b, 0xfc, 0xa9, 0x10, 0x32, 0x65, 0x06, 0xaa, 0xf0,
-	0x04, 0xc5, 0x82, 0x6e, 0x08, 0xcd, 0x1c, 0x5f, 0x31, 0x92, 0x29, 0xe9, 0x0c, 0xcd, 0x9c, 0x05,
-	0x77, 0xf5, 0xaf, 0x86, 0x4d, 0x68, 0x7d, 0x24, 0xe8, 0xe6, 0x2d, 0xc8, 0xc6, 0xb5, 0x2a, 0x65,
-	0xe6, 0xbd, 0xd7, 0xe0, 0xe7, 0xe8, 0x6e, 0x99, 0xa1, 0x24, 0x59, 0x30, 0xbf, 0x40, 0x78, 0x0b,
-	0x6a, 0x5b, 0x7e, 0x3c, 0x61, 0xd0, 0x8b, 0x5f, 0xa3, 0x87, 0x86, 0xe5, 0xdc, 0xba, 0x53, 0xfe,
-	0xc9, 0x71, 0x1b, 0x1c, 0x97, 0xb5, 0xa0, 0xca, 0xae, 0xac, 0x6f, 0xe2, 0x7f, 0x3f, 0x9e, 0x04,
-	0xdf, 0xfe, 0xfe, 0x7c, 0x71, 0xe5, 0x6f, 0x62, 0x53, 0x5f, 0x85, 0x4d, 0xfc, 0x77, 0x1c, 0xf5,
-	0x7f, 0xed, 0xa3, 0x60, 0xb7, 0x8f, 0x82, 0x3f, 0xfb, 0x28, 0xf8, 0x7e, 0x88, 0x1a, 0xbb, 0x43,
-	0xd4, 0xf8, 0x7d, 0x88, 0x1a, 0x9f, 0x2f, 0xaf, 0x59, 0xdc, 0x56, 0x33, 0x3b, 0x6b, 0xc2, 0x2d,
-	0xbc, 0xfa, 0x1f, 0x00, 0x00, 0xff, 0xff, 0x28, 0x37, 0x36, 0x4d, 0x67, 0x02, 0x00, 0x00,
+	// 1058 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x55, 0x4f, 0x4f, 0x1b, 0xc7,
+	0x1b, 0x66, 0x03, 0x21, 0x30, 0xc4, 0x80, 0x17, 0x03, 0x8b, 0x43, 0x8c, 0x7f, 0xe4, 0xd7, 0xc8,
+	0xa2, 0x2a, 0x06, 0x5a, 0xa1, 0x36, 0x6d, 0x55, 0xc5, 0x46, 0xa9, 0x72, 0x88, 0x64, 0x2d, 0x89,
+	0x2a, 0xe5, 0x32, 0x1d, 0xef, 0x0e, 0x66, 0x94, 0xdd, 0x99, 0xed, 0xcc, 0x2c, 0xc6, 0x95, 0xfa,
+	0x05, 0x7a, 0xea, 0x47, 0xe8, 0xb1, 0xc7, 0x7c, 0x8c, 0xdc, 0x9a, 0x63, 0x4f, 0x55, 0x05, 0x87,
+	0xf4, 0x63, 0x54, 0xf3, 0xce, 0xce, 0xda, 0xb1, 0x2f, 0xf6, 0xee, 0xfb, 0x3c, 0xef, 0x3b, 0xef,
+	0x9f, 0x67, 0xe7, 0x45, 0x0f, 0x93, 0x3c, 0xa5, 0xbc, 0x3d, 0x20, 0x9a, 0x0e, 0xc9, 0xa8, 0x7d,
+	0x75, 0xdc, 0xce, 0x88, 0x24, 0xa9, 0x3a, 0xcc, 0xa4, 0xd0, 0xc2, 0x5f, 0x07, 0xf8, 0xb0, 0x80,
+	0x0f, 0xaf, 0x8e, 0xeb, 0x55, 0x92, 0x32, 0x2e, 0xda, 0xf0, 0x6b, 0x49, 0xf5, 0xda, 0x40, 0x0c,
+	0x04, 0x3c, 0xb6, 0xcd, 0x93, 0xb5, 0xee, 0xff, 0x59, 0x41, 0x8b, 0x3d, 0x88, 0xe5, 0x9f, 0xa2,
+	0xed, 0x2c, 0x21, 0xfa, 0x42, 0xc8, 0x14, 0x47, 0x22, 0x4d, 0x99, 0x52, 0x4c, 0x70, 0xdc, 0xcf,
+	0x54, 0xe0, 0x35, 0xbd, 0x56, 0x25, 0xdc, 0x74, 0x70, 0xb7, 0x44, 0x3b, 0x99, 0xf2, 0x1f, 0xa1,
+	0x4a, 0x2a, 0xb8, 0xbe, 0xc4, 0x8a, 0x46, 0x82, 0xc7, 0x2a, 0xb8, 0xd3, 0xf4, 0x5a, 0x0b, 0xe1,
+	0x7d, 0x30, 0x9e, 0x5b, 0x9b, 0x7f, 0x82, 0x36, 0x2f, 0x18, 0x27, 0x09, 0xfb, 0x99, 0xe2, 0x98,
+	0x26, 0x64, 0x84, 0x01, 0x56, 0xc1, 0x3c, 0x84, 0xde, 0x70, 0xe0, 0x99, 0xc1, 0x5e, 0x00, 0xe4,
+	0x1f, 0xa1, 0x9a, 0x33, 0x4b, 0x2c, 0xe9, 0x90, 0xc8, 0x18, 0xb2, 0x59, 0x00, 0x17, 0xbf, 0xc4,
+	0x42, 0x80, 0x4c, 0x2a, 0xa7, 0x28, 0x48, 0x19, 0xc7, 0x99, 0x64, 0x11, 0xc5, 0x79, 0x92, 0x72,
+	0x9c, 0x51, 0x69, 0x4f, 0x0a, 0xee, 0x42, 0x56, 0xb5, 0x94, 0xf1, 0x9e, 0x81, 0x5f, 0x25, 0x29,
+	0xef, 0x51, 0x09, 0x47, 0xf9, 0xcf, 0x50, 0x33, 0x25, 0xd7, 0x98, 0x44, 0x9a, 0x5d, 0x51, 0x1c,
+	0x09, 0xae, 0x25, 0x89, 0xb4, 0x02, 0xef, 0xa2, 0xab, 0xc1, 0x22, 0x9c, 0xba, 0x9b, 0x92, 0xeb,
+	0xa7, 0x40, 0xeb, 0x3a, 0x56, 0x8f, 0xca, 0xef, 0x2d, 0xc7, 0x7f, 0x8c, 0xd6, 0x4c, 0x0c, 0xc1,
+	0xf1, 0x05, 0xb5, 0x09, 0x04, 0xf7, 0xe0, 0xd8, 0x8a, 0x35, 0x3f, 0xa3, 0x70, 0xae, 0xff, 0x15,
+	0xda, 0x91, 0x74, 0xc0, 0x94, 0x1e, 0xc7, 0x1f, 0x7b, 0x2c, 0x81, 0xc7, 0x96, 0x23, 0x14, 0xb1,
+	0x9d, 0xeb, 0x77, 0x68, 0x37, 0x57, 0x64, 0x40, 0x71, 0xcc, 0x54, 0x96, 0x6b, 0x8a, 0x87, 0x8c,
+	0xc7, 0x62, 0x58, 0x36, 0x7f, 0x19, 0xbc, 0x77, 0x80, 0x73, 0x66, 0x29, 0x3f, 0x00, 0x63, 0x62,
+	0x12, 0x92, 0xfe, 0x94, 0x33, 0x49, 0xb1, 0x0d, 0x24, 0x69, 0x26, 0xa4, 0x56, 0x01, 0x6a, 0x7a,
+	0xad, 0xa5, 0x70, 0xa3, 0x00, 0x5f, 0x19, 0x2c, 0xb4, 0x90, 0x5f, 0x47, 0x4b, 0x44, 0xf6, 0x99,
+	0xa6, 0x52, 0x05, 0x2b, 0xcd, 0xf9, 0xd6, 0x72, 0x58, 0xbe, 0x1b, 0xd9, 0xb8, 0x54, 0x34, 0x4b,
+	0xa9, 0xc8, 0x75, 0x99, 0xcb, 0x7d, 0xc8, 0x65, 0xb3, 0x80, 0x5f, 0x5a, 0xd4, 0xe5, 0xb1, 0x8f,
+	0x2a, 0x66, 0x56, 0x7d, 0xc1, 0x63, 0x5b, 0x77, 0x05, 0xd8, 0x2b, 0x29, 0xe3, 0x1d, 0xc1, 0x63,
+	0x28, 0xb6, 0x8d, 0x6a, 0x80, 0x9b, 0x39, 0x44, 0x09, 0xa3, 0x5c, 0x5b, 0xea, 0x2a, 0x50, 0xab,
+	0x06, 0xeb, 0x51, 0xd9, 0x05, 0x04, 0x1c, 0x4e, 0xd1, 0x76, 0xce, 0x8d, 0x99, 0xf1, 0x41, 0xa1,
+	0x33, 0x97, 0xcc, 0x9a, 0x4d, 0xa6, 0x84, 0x41, 0x69, 0x2e, 0x99, 0x03, 0x54, 0x75, 0x45, 0xa8,
+	0x84, 0xa8, 0x4b, 0xd0, 0xd9, 0x3a, 0x4c, 0x7c, 0xad, 0x00, 0xce, 0x8d, 0xdd, 0x88, 0xec, 0x53,
+	0x54, 0x35, 0x62, 0x89, 0x08, 0x8f, 0x68, 0x92, 0x10, 0x33, 0x57, 0x15, 0x54, 0x81, 0xbb, 0x9e,
+	0x92, 0xeb, 0xee, 0xa4, 0xdd, 0xff, 0x02, 0x6d, 0x4d, 0x12, 0x27, 0xa2, 0xfb, 0xe0, 0x51, 0x9b,
+	0x44, 0xcb, 0x23, 0x8e, 0xd1, 0x26, 0xc9, 0xb5, 0xc0, 0x51, 0x42, 0x58, 0x6a, 0x65, 0xd8, 0x4f,
+	0x44, 0xf4, 0x26, 0xd8, 0xb0, 0xd2, 0x37, 0x60, 0x17, 0xb0, 0x1e, 0x95, 0x1d, 0x83, 0xf8, 0xdf,
+	0xa0, 0x3a, 0x89, 0x22, 0x9a, 0x69, 0x13, 0x6f, 0x66, 0x12, 0x35, 0x28, 0x3e, 0x18, 0x33, 0xa6,
+	0x86, 0x71, 0x8e, 0xd6, 0x2c, 0x46, 0x63, 0x1c, 0x53, 0x2e, 0x52, 0x15, 0x6c, 0x36, 0xe7, 0x5b,
+	0x2b, 0x27, 0x7b, 0x87, 0xd3, 0x77, 0xcb, 0xe1, 0xd3, 0x82, 0x78, 0x66, 0x78, 0x9d, 0xe5, 0x77,
+	0x7f, 0xef, 0xcd, 0xfd, 0xf1, 0xe1, 0xed, 0x81, 0x17, 0xae, 0x92, 0x49, 0x44, 0xf9, 0xdf, 0xa2,
+	0x07, 0x5a, 0x52, 0xa2, 0x72, 0x39, 0x82, 0x0b, 0x25, 0xe7, 0x4c, 0x8f, 0x70, 0x26, 0x44, 0x02,
+	0x0d, 0xd8, 0x82, 0x5a, 0x02, 0x47, 0xe9, 0x3a, 0x46, 0x4f, 0x88, 0xc4, 0x34, 0xe1, 0x00, 0x55,
+	0x4b, 0xf7, 0x7e, 0x2e, 0xed, 0x4d, 0xb4, 0x6d, 0x67, 0xe2, 0x80, 0x4e, 0x2e, 0xe1, 0x0e, 0x3a,
+	0x42, 0xb5, 0x92, 0xab, 0x34, 0x79, 0x43, 0xa5, 0x02, 0x7a, 0x60, 0xfb, 0xe5, 0xb0, 0x73, 0x0b,
+	0x19, 0x8f, 0x73, 0xf4, 0xb8, 0xf4, 0x88, 0x99, 0xd2, 0x92, 0xf5, 0x73, 0x98, 0x10, 0xe3, 0x9a,
+	0xca, 0x2b, 0x92, 0x94, 0xbd, 0xdb, 0x81, 0xde, 0x3d, 0x72, 0xec, 0xb3, 0x09, 0xf2, 0xf3, 0x82,
+	0xeb, 0xda, 0xf8, 0x1c, 0xfd, 0xcf, 0x7d, 0xce, 0x5a, 0x12, 0xae, 0x2e, 0x8c, 0x6e, 0x85, 0x48,
+	0x62, 0x31, 0xe4, 0x65, 0xbc, 0x3a, 0xc4, 0x6b, 0x14, 0xc4, 0x97, 0x05, 0xaf, 0x5b, 0xd0, 0x5c,
+	0xa8, 0x1f, 0xd1, 0xc6, 0x47, 0xc2, 0xc9, 0x44, 0xc2, 0xa2, 0x51, 0xf0, 0xa0, 0xe9, 0xb5, 0x56,
+	0x4e, 0xfe, 0x3f, 0x3b, 0x95, 0x49, 0xd9, 0xf5, 0x80, 0x3b, 0x39, 0x1a, 0x3f, 0x9a, 0x81, 0x8d,
+	0x62, 0x2e, 0x29, 0x91, 0xba, 0x4f, 0x89, 0x9e, 0xad, 0x7a, 0xd7, 0x2a, 0xa6, 0x64, 0x4c, 0x97,
+	0xfa, 0x25, 0x1a, 0x63, 0xd8, 0x6c, 0x03, 0x1a, 0x17, 0x57, 0x91, 0x0a, 0x1e, 0x42, 0xd7, 0xb7,
+	0x4a, 0xfc, 0x05, 0xc0, 0xf6, 0x1a, 0x52, 0xfe, 0xd7, 0xa8, 0x3e, 0xfd, 0xfd, 0x60, 0x69, 0xfe,
+	0x60, 0x62, 0x0d, 0xf0, 0xdd, 0x9e, 0xfa, 0x90, 0x42, 0xf3, 0xdb, 0xc9, 0xd4, 0x93, 0xe6, 0xbf,
+	0xbf, 0xef, 0x79, 0xbf, 0x7e, 0x78, 0x7b, 0xb0, 0x6d, 0x57, 0xe2, 0xb5, 0x5b, 0x8a, 0xaa, 0x6d,
+	0xd7, 0xd8, 0xfe, 0x6b, 0x54, 0xf9, 0x48, 0xa1, 0x7e, 0x0d, 0xdd, 0x05, 0x49, 0xc3, 0x16, 0x5b,
+	0x0e, 0xed, 0x8b, 0xff, 0x19, 0xda, 0x18, 0xaf, 0x8a, 0xf1, 0x96, 0xb0, 0xbb, 0x6b, 0xdd, 0x6d,
+	0x09, 0xb7, 0x21, 0x9e, 0x2c, 0x98, 0x73, 0xf7, 0x7f, 0x41, 0xfe, 0x6c, 0x9f, 0xfd, 0x4f, 0xd0,
+	0x2a, 0x17, 0xda, 0xc4, 0x71, 0xcd, 0xf3, 0xec, 0xa5, 0x6f, 0xad, 0xae, 0x63, 0x7b, 0x68, 0x25,
+	0xa3, 0x9c, 0x24, 0x7a, 0x04, 0x85, 0xde, 0x81, 0x42, 0x51, 0x61, 0x32, 0x92, 0xdc, 0x41, 0x4b,
+	0x99, 0x14, 0xa6, 0x17, 0x04, 0xd6, 0xe2, 0x52, 0x78, 0x2f, 0x93, 0x22, 0x24, 0x9a, 0xd8, 0xe3,
+	0x3b, 0x47, 0xef, 0x6e, 0x1a, 0xde, 0xfb, 0x9b, 0x86, 0xf7, 0xcf, 0x4d, 0xc3, 0xfb, 0xed, 0xb6,
+	0x31, 0xf7, 0xfe, 0xb6, 0x31, 0xf7, 0xd7, 0x6d, 0x63, 0xee, 0xf5, 0xd6, 0x4c, 0x37, 0xf4, 0x28,
+	0xa3, 0xaa, 0xbf, 0x08, 0x5b, 0xfe, 0xf3, 0xff, 0x06, 0x00, 0x1e, 0xfd, 0x7c, 0x53, 0x41, 0x08,
+	0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -203,155 +556,1062 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.RegisterGatewayFeeUlmn != that1.RegisterGatewayFeeUlmn {
 		return false
 	}
-	return true
-}
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.UsageDisputeWindowSeconds != that1.UsageDisputeWindowSeconds {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RegisterGatewayFeeUlmn != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.RegisterGatewayFeeUlmn))
-		i--
-		dAtA[i] = 0x40
+	if this.RequireUsageReports != that1.RequireUsageReports {
+		return false
 	}
-	if m.ActionFeeUlmn != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.ActionFeeUlmn))
-		i--
-		dAtA[i] = 0x38
+	if len(this.Arbiters) != len(that1.Arbiters) {
+		return false
 	}
-	if m.MaxActiveContractsPerGateway != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.MaxActiveContractsPerGateway))
-		i--
-		dAtA[i] = 0x30
+	for i := range this.Arbiters {
+		if this.Arbiters[i] != that1.Arbiters[i] {
+			return false
+		}
 	}
-	if m.MinPriceUlmnPerMonth != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.MinPriceUlmnPerMonth))
-		i--
-		dAtA[i] = 0x28
+	if this.DisputeTimeoutSeconds != that1.DisputeTimeoutSeconds {
+		return false
 	}
-	if m.FinalizerRewardBps != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.FinalizerRewardBps))
-		i--
-		dAtA[i] = 0x20
+	if this.MinBondUlmn != that1.MinBondUlmn {
+		return false
 	}
-	if m.FinalizeDelayMonths != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.FinalizeDelayMonths))
-		i--
-		dAtA[i] = 0x18
+	if this.BondPerClientUlmn != that1.BondPerClientUlmn {
+		return false
 	}
-	if m.MonthSeconds != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.MonthSeconds))
-		i--
-		dAtA[i] = 0x10
+	if this.UnbondingDelaySeconds != that1.UnbondingDelaySeconds {
+		return false
 	}
-	if m.PlatformCommissionBps != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.PlatformCommissionBps))
-		i--
-		dAtA[i] = 0x8
+	if this.DisputeSlashBps != that1.DisputeSlashBps {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
-	offset -= sovParams(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if this.MaxCancellations != that1.MaxCancellations {
+		return false
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.CancellationSlashBps != that1.CancellationSlashBps {
+		return false
 	}
-	var l int
-	_ = l
-	if m.PlatformCommissionBps != 0 {
-		n += 1 + sovParams(uint64(m.PlatformCommissionBps))
+	if this.AutoClaimsPerBlock != that1.AutoClaimsPerBlock {
+		return false
 	}
-	if m.MonthSeconds != 0 {
-		n += 1 + sovParams(uint64(m.MonthSeconds))
+	if this.AcceptanceTimeoutSeconds != that1.AcceptanceTimeoutSeconds {
+		return false
 	}
-	if m.FinalizeDelayMonths != 0 {
-		n += 1 + sovParams(uint64(m.FinalizeDelayMonths))
+	if len(this.AcceptedDenoms) != len(that1.AcceptedDenoms) {
+		return false
 	}
-	if m.FinalizerRewardBps != 0 {
-		n += 1 + sovParams(uint64(m.FinalizerRewardBps))
+	for i := range this.AcceptedDenoms {
+		if !this.AcceptedDenoms[i].Equal(&that1.AcceptedDenoms[i]) {
+			return false
+		}
 	}
-	if m.MinPriceUlmnPerMonth != 0 {
-		n += 1 + sovParams(uint64(m.MinPriceUlmnPerMonth))
+	if this.TreasuryCommunityPoolBps != that1.TreasuryCommunityPoolBps {
+		return false
 	}
-	if m.MaxActiveContractsPerGateway != 0 {
-		n += 1 + sovParams(uint64(m.MaxActiveContractsPerGateway))
+	if this.TreasuryBurnBps != that1.TreasuryBurnBps {
+		return false
 	}
-	if m.ActionFeeUlmn != 0 {
-		n += 1 + sovParams(uint64(m.ActionFeeUlmn))
+	if this.TreasuryStakersBps != that1.TreasuryStakersBps {
+		return false
+	}
+	if this.TreasuryDistributionIntervalSeconds != that1.TreasuryDistributionIntervalSeconds {
+		return false
+	}
+	if this.GatewayTransferCooldownSeconds != that1.GatewayTransferCooldownSeconds {
+		return false
+	}
+	if !this.CancellationPolicy.Equal(&that1.CancellationPolicy) {
+		return false
+	}
+	if this.HeartbeatIntervalSeconds != that1.HeartbeatIntervalSeconds {
+		return false
+	}
+	if this.HeartbeatMissedWindows != that1.HeartbeatMissedWindows {
+		return false
+	}
+	if this.MaxCancellationRatioBps != that1.MaxCancellationRatioBps {
+		return false
+	}
+	return true
+}
+func (this *AcceptedDenom) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AcceptedDenom)
+	if !ok {
+		that2, ok := that.(AcceptedDenom)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if this.MinPricePerMonth != that1.MinPricePerMonth {
+		return false
+	}
+	return true
+}
+func (this *CancellationPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CancellationPolicy)
+	if !ok {
+		that2, ok := that.(CancellationPolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NoticeSeconds != that1.NoticeSeconds {
+		return false
+	}
+	if this.PenaltyBps != that1.PenaltyBps {
+		return false
+	}
+	if this.ProRata != that1.ProRata {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxCancellationRatioBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxCancellationRatioBps))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf0
+	}
+	if m.HeartbeatMissedWindows != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.HeartbeatMissedWindows))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe8
+	}
+	if m.HeartbeatIntervalSeconds != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.HeartbeatIntervalSeconds))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
+	}
+	{
+		size, err := m.CancellationPolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xda
+	if m.GatewayTransferCooldownSeconds != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.GatewayTransferCooldownSeconds))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
+	}
+	if m.TreasuryDistributionIntervalSeconds != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.TreasuryDistributionIntervalSeconds))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.TreasuryStakersBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.TreasuryStakersBps))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.TreasuryBurnBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.TreasuryBurnBps))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.TreasuryCommunityPoolBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.TreasuryCommunityPoolBps))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if len(m.AcceptedDenoms) > 0 {
+		for iNdEx := len(m.AcceptedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AcceptedDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if m.AcceptanceTimeoutSeconds != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AcceptanceTimeoutSeconds))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.AutoClaimsPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AutoClaimsPerBlock))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.CancellationSlashBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CancellationSlashBps))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.MaxCancellations != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxCancellations))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.DisputeSlashBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DisputeSlashBps))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.UnbondingDelaySeconds != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.UnbondingDelaySeconds))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.BondPerClientUlmn != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BondPerClientUlmn))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.MinBondUlmn != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinBondUlmn))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.DisputeTimeoutSeconds != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DisputeTimeoutSeconds))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.Arbiters) > 0 {
+		for iNdEx := len(m.Arbiters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Arbiters[iNdEx])
+			copy(dAtA[i:], m.Arbiters[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.Arbiters[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.RequireUsageReports {
+		i--
+		if m.RequireUsageReports {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.UsageDisputeWindowSeconds != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.UsageDisputeWindowSeconds))
+		i--
+		dAtA[i] = 0x48
 	}
 	if m.RegisterGatewayFeeUlmn != 0 {
-		n += 1 + sovParams(uint64(m.RegisterGatewayFeeUlmn))
+		i = encodeVarintParams(dAtA, i, uint64(m.RegisterGatewayFeeUlmn))
+		i--
+		dAtA[i] = 0x40
 	}
-	return n
+	if m.ActionFeeUlmn != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ActionFeeUlmn))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxActiveContractsPerGateway != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxActiveContractsPerGateway))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MinPriceUlmnPerMonth != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinPriceUlmnPerMonth))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.FinalizerRewardBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FinalizerRewardBps))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FinalizeDelayMonths != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FinalizeDelayMonths))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MonthSeconds != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MonthSeconds))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PlatformCommissionBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PlatformCommissionBps))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovParams(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *AcceptedDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozParams(x uint64) (n int) {
-	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *AcceptedDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowParams
+
+func (m *AcceptedDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinPricePerMonth != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinPricePerMonth))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CancellationPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancellationPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancellationPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProRata {
+		i--
+		if m.ProRata {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PenaltyBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PenaltyBps))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.NoticeSeconds != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.NoticeSeconds))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PlatformCommissionBps != 0 {
+		n += 1 + sovParams(uint64(m.PlatformCommissionBps))
+	}
+	if m.MonthSeconds != 0 {
+		n += 1 + sovParams(uint64(m.MonthSeconds))
+	}
+	if m.FinalizeDelayMonths != 0 {
+		n += 1 + sovParams(uint64(m.FinalizeDelayMonths))
+	}
+	if m.FinalizerRewardBps != 0 {
+		n += 1 + sovParams(uint64(m.FinalizerRewardBps))
+	}
+	if m.MinPriceUlmnPerMonth != 0 {
+		n += 1 + sovParams(uint64(m.MinPriceUlmnPerMonth))
+	}
+	if m.MaxActiveContractsPerGateway != 0 {
+		n += 1 + sovParams(uint64(m.MaxActiveContractsPerGateway))
+	}
+	if m.ActionFeeUlmn != 0 {
+		n += 1 + sovParams(uint64(m.ActionFeeUlmn))
+	}
+	if m.RegisterGatewayFeeUlmn != 0 {
+		n += 1 + sovParams(uint64(m.RegisterGatewayFeeUlmn))
+	}
+	if m.UsageDisputeWindowSeconds != 0 {
+		n += 1 + sovParams(uint64(m.UsageDisputeWindowSeconds))
+	}
+	if m.RequireUsageReports {
+		n += 2
+	}
+	if len(m.Arbiters) > 0 {
+		for _, s := range m.Arbiters {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.DisputeTimeoutSeconds != 0 {
+		n += 1 + sovParams(uint64(m.DisputeTimeoutSeconds))
+	}
+	if m.MinBondUlmn != 0 {
+		n += 1 + sovParams(uint64(m.MinBondUlmn))
+	}
+	if m.BondPerClientUlmn != 0 {
+		n += 1 + sovParams(uint64(m.BondPerClientUlmn))
+	}
+	if m.UnbondingDelaySeconds != 0 {
+		n += 1 + sovParams(uint64(m.UnbondingDelaySeconds))
+	}
+	if m.DisputeSlashBps != 0 {
+		n += 2 + sovParams(uint64(m.DisputeSlashBps))
+	}
+	if m.MaxCancellations != 0 {
+		n += 2 + sovParams(uint64(m.MaxCancellations))
+	}
+	if m.CancellationSlashBps != 0 {
+		n += 2 + sovParams(uint64(m.CancellationSlashBps))
+	}
+	if m.AutoClaimsPerBlock != 0 {
+		n += 2 + sovParams(uint64(m.AutoClaimsPerBlock))
+	}
+	if m.AcceptanceTimeoutSeconds != 0 {
+		n += 2 + sovParams(uint64(m.AcceptanceTimeoutSeconds))
+	}
+	if len(m.AcceptedDenoms) > 0 {
+		for _, e := range m.AcceptedDenoms {
+			l = e.Size()
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	if m.TreasuryCommunityPoolBps != 0 {
+		n += 2 + sovParams(uint64(m.TreasuryCommunityPoolBps))
+	}
+	if m.TreasuryBurnBps != 0 {
+		n += 2 + sovParams(uint64(m.TreasuryBurnBps))
+	}
+	if m.TreasuryStakersBps != 0 {
+		n += 2 + sovParams(uint64(m.TreasuryStakersBps))
+	}
+	if m.TreasuryDistributionIntervalSeconds != 0 {
+		n += 2 + sovParams(uint64(m.TreasuryDistributionIntervalSeconds))
+	}
+	if m.GatewayTransferCooldownSeconds != 0 {
+		n += 2 + sovParams(uint64(m.GatewayTransferCooldownSeconds))
+	}
+	l = m.CancellationPolicy.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.HeartbeatIntervalSeconds != 0 {
+		n += 2 + sovParams(uint64(m.HeartbeatIntervalSeconds))
+	}
+	if m.HeartbeatMissedWindows != 0 {
+		n += 2 + sovParams(uint64(m.HeartbeatMissedWindows))
+	}
+	if m.MaxCancellationRatioBps != 0 {
+		n += 2 + sovParams(uint64(m.MaxCancellationRatioBps))
+	}
+	return n
+}
+
+func (m *AcceptedDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.MinPricePerMonth != 0 {
+		n += 1 + sovParams(uint64(m.MinPricePerMonth))
+	}
+	return n
+}
+
+func (m *CancellationPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NoticeSeconds != 0 {
+		n += 1 + sovParams(uint64(m.NoticeSeconds))
+	}
+	if m.PenaltyBps != 0 {
+		n += 1 + sovParams(uint64(m.PenaltyBps))
+	}
+	if m.ProRata {
+		n += 2
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlatformCommissionBps", wireType)
+			}
+			m.PlatformCommissionBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PlatformCommissionBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonthSeconds", wireType)
+			}
+			m.MonthSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MonthSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizeDelayMonths", wireType)
+			}
+			m.FinalizeDelayMonths = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalizeDelayMonths |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizerRewardBps", wireType)
+			}
+			m.FinalizerRewardBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalizerRewardBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPriceUlmnPerMonth", wireType)
+			}
+			m.MinPriceUlmnPerMonth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinPriceUlmnPerMonth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxActiveContractsPerGateway", wireType)
+			}
+			m.MaxActiveContractsPerGateway = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxActiveContractsPerGateway |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionFeeUlmn", wireType)
+			}
+			m.ActionFeeUlmn = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActionFeeUlmn |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisterGatewayFeeUlmn", wireType)
+			}
+			m.RegisterGatewayFeeUlmn = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegisterGatewayFeeUlmn |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsageDisputeWindowSeconds", wireType)
+			}
+			m.UsageDisputeWindowSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UsageDisputeWindowSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireUsageReports", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequireUsageReports = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Arbiters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Arbiters = append(m.Arbiters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeTimeoutSeconds", wireType)
+			}
+			m.DisputeTimeoutSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisputeTimeoutSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBondUlmn", wireType)
+			}
+			m.MinBondUlmn = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinBondUlmn |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondPerClientUlmn", wireType)
+			}
+			m.BondPerClientUlmn = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BondPerClientUlmn |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingDelaySeconds", wireType)
+			}
+			m.UnbondingDelaySeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingDelaySeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeSlashBps", wireType)
+			}
+			m.DisputeSlashBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisputeSlashBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCancellations", wireType)
+			}
+			m.MaxCancellations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCancellations |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancellationSlashBps", wireType)
+			}
+			m.CancellationSlashBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CancellationSlashBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoClaimsPerBlock", wireType)
+			}
+			m.AutoClaimsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoClaimsPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptanceTimeoutSeconds", wireType)
+			}
+			m.AcceptanceTimeoutSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AcceptanceTimeoutSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.AcceptedDenoms = append(m.AcceptedDenoms, AcceptedDenom{})
+			if err := m.AcceptedDenoms[len(m.AcceptedDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 22:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PlatformCommissionBps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryCommunityPoolBps", wireType)
 			}
-			m.PlatformCommissionBps = 0
+			m.TreasuryCommunityPoolBps = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -361,16 +1621,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PlatformCommissionBps |= uint32(b&0x7F) << shift
+				m.TreasuryCommunityPoolBps |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 23:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MonthSeconds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryBurnBps", wireType)
 			}
-			m.MonthSeconds = 0
+			m.TreasuryBurnBps = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -380,16 +1640,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MonthSeconds |= uint64(b&0x7F) << shift
+				m.TreasuryBurnBps |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 24:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalizeDelayMonths", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryStakersBps", wireType)
 			}
-			m.FinalizeDelayMonths = 0
+			m.TreasuryStakersBps = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -399,16 +1659,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FinalizeDelayMonths |= uint32(b&0x7F) << shift
+				m.TreasuryStakersBps |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 25:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalizerRewardBps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryDistributionIntervalSeconds", wireType)
 			}
-			m.FinalizerRewardBps = 0
+			m.TreasuryDistributionIntervalSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -418,16 +1678,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FinalizerRewardBps |= uint32(b&0x7F) << shift
+				m.TreasuryDistributionIntervalSeconds |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 26:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinPriceUlmnPerMonth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayTransferCooldownSeconds", wireType)
 			}
-			m.MinPriceUlmnPerMonth = 0
+			m.GatewayTransferCooldownSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -437,16 +1697,49 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinPriceUlmnPerMonth |= uint64(b&0x7F) << shift
+				m.GatewayTransferCooldownSeconds |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancellationPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CancellationPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxActiveContractsPerGateway", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatIntervalSeconds", wireType)
 			}
-			m.MaxActiveContractsPerGateway = 0
+			m.HeartbeatIntervalSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -456,16 +1749,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxActiveContractsPerGateway |= uint32(b&0x7F) << shift
+				m.HeartbeatIntervalSeconds |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 29:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActionFeeUlmn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatMissedWindows", wireType)
 			}
-			m.ActionFeeUlmn = 0
+			m.HeartbeatMissedWindows = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -475,16 +1768,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ActionFeeUlmn |= uint64(b&0x7F) << shift
+				m.HeartbeatMissedWindows |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+		case 30:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegisterGatewayFeeUlmn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCancellationRatioBps", wireType)
 			}
-			m.RegisterGatewayFeeUlmn = 0
+			m.MaxCancellationRatioBps = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -494,11 +1787,220 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RegisterGatewayFeeUlmn |= uint64(b&0x7F) << shift
+				m.MaxCancellationRatioBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AcceptedDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AcceptedDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AcceptedDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPricePerMonth", wireType)
+			}
+			m.MinPricePerMonth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinPricePerMonth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CancellationPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancellationPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancellationPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoticeSeconds", wireType)
+			}
+			m.NoticeSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NoticeSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PenaltyBps", wireType)
+			}
+			m.PenaltyBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PenaltyBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProRata", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.ProRata = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,82 @@
+package types
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// NormalizeGatewayProfile validates an operator-supplied profile and returns
+// the copy to store, with hostnames lowercased and the contact trimmed. A nil
+// profile stays nil.
+func NormalizeGatewayProfile(p *GatewayProfile) (*GatewayProfile, error) {
+	if p == nil {
+		return nil, nil
+	}
+	out := &GatewayProfile{
+		Endpoints: make([]GatewayEndpoint, 0, len(p.Endpoints)),
+		Regions:   p.Regions,
+		Protocols: p.Protocols,
+		Capacity:  p.Capacity,
+		Contact:   strings.TrimSpace(p.Contact),
+	}
+
+	if len(p.Protocols) > len(GatewayProtocol_name)-1 {
+		return nil, fmt.Errorf("too many protocols")
+	}
+	for i, proto := range p.Protocols {
+		if _, ok := GatewayProtocol_name[int32(proto)]; !ok || proto == GatewayProtocol_GATEWAY_PROTOCOL_UNSPECIFIED {
+			return nil, fmt.Errorf("invalid protocol %d", proto)
+		}
+		if slices.Contains(p.Protocols[:i], proto) {
+			return nil, fmt.Errorf("duplicate protocol %s", proto)
+		}
+	}
+
+	if len(p.Endpoints) > MaxGatewayEndpoints {
+		return nil, fmt.Errorf("too many endpoints: %d > %d", len(p.Endpoints), MaxGatewayEndpoints)
+	}
+	for _, ep := range p.Endpoints {
+		host, err := NormalizeGatewayEndpoint(ep.Host)
+		if err != nil {
+			return nil, fmt.Errorf("endpoint %q: %w", ep.Host, err)
+		}
+		if host == "" || len(host) > GatewayEndpointMaxLen {
+			return nil, fmt.Errorf("endpoint host must be 1-%d characters", GatewayEndpointMaxLen)
+		}
+		if ep.Port > 65535 {
+			return nil, fmt.Errorf("endpoint %s: invalid port %d", host, ep.Port)
+		}
+		if !slices.Contains(p.Protocols, ep.Protocol) {
+			return nil, fmt.Errorf("endpoint %s: protocol %s not listed in protocols", host, ep.Protocol)
+		}
+		normalized := GatewayEndpoint{Host: host, Protocol: ep.Protocol, Port: ep.Port}
+		if slices.Contains(out.Endpoints, normalized) {
+			return nil, fmt.Errorf("duplicate endpoint %s", host)
+		}
+		out.Endpoints = append(out.Endpoints, normalized)
+	}
+
+	if err := validateRegions(p.Regions, MaxGatewayRegions); err != nil {
+		return nil, err
+	}
+
+	if len(out.Contact) > GatewayContactMaxLen {
+		return nil, fmt.Errorf("contact too long: %d > %d", len(out.Contact), GatewayContactMaxLen)
+	}
+	if !utf8.ValidString(out.Contact) || strings.ContainsFunc(out.Contact, func(r rune) bool { return r < 0x20 }) {
+		return nil, fmt.Errorf("contact must be printable UTF-8")
+	}
+	return out, nil
+}
+
+// ServesRegion reports whether the gateway's profile lists the region.
+func (g Gateway) ServesRegion(region string) bool {
+	return g.Profile != nil && slices.Contains(g.Profile.Regions, region)
+}
+
+// SupportsProtocol reports whether the gateway's profile lists the protocol.
+func (g Gateway) SupportsProtocol(proto GatewayProtocol) bool {
+	return g.Profile != nil && slices.Contains(g.Profile.Protocols, proto)
+}
@@ -0,0 +1,51 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func sampleProfile() *GatewayProfile {
+	return &GatewayProfile{
+		Endpoints: []GatewayEndpoint{{Host: "Gateway.Lumen", Protocol: GatewayProtocol_GATEWAY_PROTOCOL_IPFS}},
+		Regions:   []string{"eu-west"},
+		Protocols: []GatewayProtocol{GatewayProtocol_GATEWAY_PROTOCOL_IPFS, GatewayProtocol_GATEWAY_PROTOCOL_S3},
+		Capacity:  &GatewayCapacity{StorageGb: 1_000},
+		Contact:   " ops@gateway.lumen ",
+	}
+}
+
+func TestNormalizeGatewayProfile(t *testing.T) {
+	profile, err := NormalizeGatewayProfile(sampleProfile())
+	require.NoError(t, err)
+	require.Equal(t, "gateway.lumen", profile.Endpoints[0].Host)
+	require.Equal(t, "ops@gateway.lumen", profile.Contact)
+
+	profile, err = NormalizeGatewayProfile(nil)
+	require.NoError(t, err)
+	require.Nil(t, profile)
+
+	for name, mutate := range map[string]func(p *GatewayProfile){
+		"invalid host":       func(p *GatewayProfile) { p.Endpoints[0].Host = "not a host" },
+		"empty host":         func(p *GatewayProfile) { p.Endpoints[0].Host = "" },
+		"invalid port":       func(p *GatewayProfile) { p.Endpoints[0].Port = 70_000 },
+		"unlisted protocol":  func(p *GatewayProfile) { p.Endpoints[0].Protocol = GatewayProtocol_GATEWAY_PROTOCOL_HTTP },
+		"duplicate endpoint": func(p *GatewayProfile) { p.Endpoints = append(p.Endpoints, p.Endpoints[0]) },
+		"unspecified proto": func(p *GatewayProfile) {
+			p.Protocols = append(p.Protocols, GatewayProtocol_GATEWAY_PROTOCOL_UNSPECIFIED)
+		},
+		"unknown proto":       func(p *GatewayProfile) { p.Protocols = append(p.Protocols, GatewayProtocol(42)) },
+		"duplicate protocol":  func(p *GatewayProfile) { p.Protocols = append(p.Protocols, GatewayProtocol_GATEWAY_PROTOCOL_S3) },
+		"uppercase region":    func(p *GatewayProfile) { p.Regions = []string{"EU"} },
+		"duplicate region":    func(p *GatewayProfile) { p.Regions = []string{"eu", "eu"} },
+		"contact too long":    func(p *GatewayProfile) { p.Contact = strings.Repeat("a", GatewayContactMaxLen+1) },
+		"contact control chr": func(p *GatewayProfile) { p.Contact = "ops\tteam" },
+	} {
+		p := sampleProfile()
+		mutate(p)
+		_, err := NormalizeGatewayProfile(p)
+		require.Error(t, err, name)
+	}
+}
@@ -0,0 +1,40 @@
+package types
+
+const (
+	// reputationNeutralBps is the reliability assumed before any contract ends.
+	reputationNeutralBps = 5_000
+	// reputationMaturityMonths is how many delivered months earn the full
+	// experience component.
+	reputationMaturityMonths = 24
+	// reputationMaturitySeconds is the gateway age that earns the full age
+	// component.
+	reputationMaturitySeconds = 365 * 24 * 60 * 60
+
+	reputationReliabilityWeight = 60
+	reputationExperienceWeight  = 25
+	reputationAgeWeight         = 15
+)
+
+// ReputationScore rates a gateway in basis points from its contract
+// outcomes. Reliability (60%) is completed contracts over all ended ones,
+// with lost disputes counting twice; experience (25%) grows with months
+// delivered; age (15%) grows with time since registration. Both saturate
+// after reputationMaturityMonths / one year.
+func ReputationScore(rep GatewayReputation, createdAt, now uint64) uint32 {
+	reliability := uint64(reputationNeutralBps)
+	if ended := rep.ContractsCompleted + rep.ContractsCancelled + 2*rep.DisputesLost; ended > 0 {
+		reliability = rep.ContractsCompleted * 10_000 / ended
+	}
+
+	experience := min(rep.MonthsDelivered, reputationMaturityMonths) * 10_000 / reputationMaturityMonths
+
+	var age uint64
+	if now > createdAt {
+		age = min(now-createdAt, reputationMaturitySeconds) * 10_000 / reputationMaturitySeconds
+	}
+
+	score := (reliability*reputationReliabilityWeight +
+		experience*reputationExperienceWeight +
+		age*reputationAgeWeight) / 100
+	return uint32(min(score, 10_000))
+}
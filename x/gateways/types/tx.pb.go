@@ -7,6 +7,8 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -33,9 +35,10 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type MsgRegisterGateway struct {
-	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
-	Payout   string `protobuf:"bytes,2,opt,name=payout,proto3" json:"payout,omitempty"`
-	Metadata string `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Operator string          `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	Payout   string          `protobuf:"bytes,2,opt,name=payout,proto3" json:"payout,omitempty"`
+	Metadata string          `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Profile  *GatewayProfile `protobuf:"bytes,4,opt,name=profile,proto3" json:"profile,omitempty"`
 }
 
 func (m *MsgRegisterGateway) Reset()         { *m = MsgRegisterGateway{} }
@@ -92,6 +95,13 @@ func (m *MsgRegisterGateway) GetMetadata() string {
 	return ""
 }
 
+func (m *MsgRegisterGateway) GetProfile() *GatewayProfile {
+	if m != nil {
+		return m.Profile
+	}
+	return nil
+}
+
 type MsgRegisterGatewayResponse struct {
 	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
@@ -137,11 +147,14 @@ func (m *MsgRegisterGatewayResponse) GetId() uint64 {
 }
 
 type MsgUpdateGateway struct {
-	Operator  string             `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
-	GatewayId uint64             `protobuf:"varint,2,opt,name=gateway_id,json=gatewayId,proto3" json:"gateway_id,omitempty"`
-	Payout    *types.StringValue `protobuf:"bytes,3,opt,name=payout,proto3" json:"payout,omitempty"`
-	Metadata  *types.StringValue `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Active    *types.BoolValue   `protobuf:"bytes,5,opt,name=active,proto3" json:"active,omitempty"`
+	Operator                string             `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	GatewayId               uint64             `protobuf:"varint,2,opt,name=gateway_id,json=gatewayId,proto3" json:"gateway_id,omitempty"`
+	Payout                  *types.StringValue `protobuf:"bytes,3,opt,name=payout,proto3" json:"payout,omitempty"`
+	Metadata                *types.StringValue `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Active                  *types.BoolValue   `protobuf:"bytes,5,opt,name=active,proto3" json:"active,omitempty"`
+	AutoClaim               *types.BoolValue   `protobuf:"bytes,6,opt,name=auto_claim,json=autoClaim,proto3" json:"auto_claim,omitempty"`
+	Profile                 *GatewayProfile    `protobuf:"bytes,7,opt,name=profile,proto3" json:"profile,omitempty"`
+	ContractTransferConsent *types.BoolValue   `protobuf:"bytes,8,opt,name=contract_transfer_consent,json=contractTransferConsent,proto3" json:"contract_transfer_consent,omitempty"`
 }
 
 func (m *MsgUpdateGateway) Reset()         { *m = MsgUpdateGateway{} }
@@ -212,6 +225,27 @@ func (m *MsgUpdateGateway) GetActive() *types.BoolValue {
 	return nil
 }
 
+func (m *MsgUpdateGateway) GetAutoClaim() *types.BoolValue {
+	if m != nil {
+		return m.AutoClaim
+	}
+	return nil
+}
+
+func (m *MsgUpdateGateway) GetProfile() *GatewayProfile {
+	if m != nil {
+		return m.Profile
+	}
+	return nil
+}
+
+func (m *MsgUpdateGateway) GetContractTransferConsent() *types.BoolValue {
+	if m != nil {
+		return m.ContractTransferConsent
+	}
+	return nil
+}
+
 type MsgUpdateGatewayResponse struct {
 }
 
@@ -344,6 +378,12 @@ type MsgCreateContract struct {
 	NetworkGbPerMonth uint64 `protobuf:"varint,5,opt,name=network_gb_per_month,json=networkGbPerMonth,proto3" json:"network_gb_per_month,omitempty"`
 	MonthsTotal       uint32 `protobuf:"varint,6,opt,name=months_total,json=monthsTotal,proto3" json:"months_total,omitempty"`
 	Metadata          string `protobuf:"bytes,7,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// offer_id creates the contract from a gateway offer. price and quotas may
+	// then be left zero; if set they must match the offer.
+	OfferId uint64 `protobuf:"varint,8,opt,name=offer_id,json=offerId,proto3" json:"offer_id,omitempty"`
+	// denom pays the contract in a whitelisted denom the gateway accepts;
+	// empty means ulmn. Offer contracts use the offer's denom.
+	Denom string `protobuf:"bytes,9,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *MsgCreateContract) Reset()         { *m = MsgCreateContract{} }
@@ -428,6 +468,20 @@ func (m *MsgCreateContract) GetMetadata() string {
 	return ""
 }
 
+func (m *MsgCreateContract) GetOfferId() uint64 {
+	if m != nil {
+		return m.OfferId
+	}
+	return 0
+}
+
+func (m *MsgCreateContract) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 type MsgCreateContractResponse struct {
 	ContractId uint64 `protobuf:"varint,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
 }
@@ -760,1141 +814,14329 @@ func (m *MsgFinalizeContractResponse) GetRewardUlmn() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*MsgRegisterGateway)(nil), "lumen.gateway.v1.MsgRegisterGateway")
-	proto.RegisterType((*MsgRegisterGatewayResponse)(nil), "lumen.gateway.v1.MsgRegisterGatewayResponse")
-	proto.RegisterType((*MsgUpdateGateway)(nil), "lumen.gateway.v1.MsgUpdateGateway")
-	proto.RegisterType((*MsgUpdateGatewayResponse)(nil), "lumen.gateway.v1.MsgUpdateGatewayResponse")
-	proto.RegisterType((*MsgUpdateParams)(nil), "lumen.gateway.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "lumen.gateway.v1.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgCreateContract)(nil), "lumen.gateway.v1.MsgCreateContract")
-	proto.RegisterType((*MsgCreateContractResponse)(nil), "lumen.gateway.v1.MsgCreateContractResponse")
-	proto.RegisterType((*MsgClaimPayment)(nil), "lumen.gateway.v1.MsgClaimPayment")
-	proto.RegisterType((*MsgClaimPaymentResponse)(nil), "lumen.gateway.v1.MsgClaimPaymentResponse")
-	proto.RegisterType((*MsgCancelContract)(nil), "lumen.gateway.v1.MsgCancelContract")
-	proto.RegisterType((*MsgCancelContractResponse)(nil), "lumen.gateway.v1.MsgCancelContractResponse")
-	proto.RegisterType((*MsgFinalizeContract)(nil), "lumen.gateway.v1.MsgFinalizeContract")
-	proto.RegisterType((*MsgFinalizeContractResponse)(nil), "lumen.gateway.v1.MsgFinalizeContractResponse")
+type MsgBindDomain struct {
+	Operator  string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	GatewayId uint64 `protobuf:"varint,2,opt,name=gateway_id,json=gatewayId,proto3" json:"gateway_id,omitempty"`
+	Domain    string `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`
 }
 
-func init() { proto.RegisterFile("lumen/gateway/v1/tx.proto", fileDescriptor_586f618667a3f0d9) }
-
-var fileDescriptor_586f618667a3f0d9 = []byte{
-	// 926 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcf, 0x6f, 0xdc, 0x44,
-	0x14, 0x8e, 0xb7, 0x9b, 0xd0, 0x7d, 0x9b, 0xa4, 0x89, 0x89, 0xe8, 0xc6, 0x6d, 0xb6, 0xa9, 0x0b,
-	0x52, 0x09, 0xed, 0xba, 0x4d, 0xab, 0x08, 0x15, 0x84, 0x20, 0x91, 0xa8, 0x7a, 0x58, 0x29, 0x32,
-	0x94, 0x03, 0xaa, 0x64, 0xcd, 0xae, 0x27, 0x8e, 0x85, 0xed, 0xb1, 0x66, 0x66, 0xb3, 0x5d, 0x0e,
-	0x08, 0x71, 0xe4, 0xc4, 0x99, 0x7f, 0x00, 0x8e, 0x41, 0xe2, 0xc6, 0x8d, 0x53, 0x8f, 0x15, 0x27,
-	0x4e, 0x08, 0x25, 0x87, 0xfc, 0x1b, 0x68, 0xc6, 0xe3, 0xc9, 0xda, 0xbb, 0xc1, 0xab, 0xe6, 0x12,
-	0x65, 0xde, 0xfb, 0xbe, 0x79, 0xdf, 0xfb, 0xe5, 0x59, 0x58, 0x8f, 0x06, 0x31, 0x4e, 0x9c, 0x00,
-	0x71, 0x3c, 0x44, 0x23, 0xe7, 0xe8, 0xa1, 0xc3, 0x5f, 0x76, 0x52, 0x4a, 0x38, 0x31, 0x57, 0xa4,
-	0xab, 0xa3, 0x5c, 0x9d, 0xa3, 0x87, 0xd6, 0x2a, 0x8a, 0xc3, 0x84, 0x38, 0xf2, 0x6f, 0x06, 0xb2,
-	0xae, 0xf7, 0x09, 0x8b, 0x09, 0x73, 0x62, 0x16, 0x08, 0x72, 0xcc, 0x02, 0xe5, 0x58, 0xcf, 0x1c,
-	0x9e, 0x3c, 0x39, 0xd9, 0x41, 0xb9, 0xd6, 0x02, 0x12, 0x90, 0xcc, 0x2e, 0xfe, 0x53, 0xd6, 0x76,
-	0x40, 0x48, 0x10, 0x61, 0x47, 0x9e, 0x7a, 0x83, 0x03, 0x67, 0x48, 0x51, 0x9a, 0x62, 0x9a, 0xb3,
-	0x6e, 0x4e, 0x2a, 0x1d, 0xa5, 0x38, 0xf7, 0x6e, 0x4c, 0x78, 0x53, 0x44, 0x51, 0xac, 0xdc, 0xf6,
-	0x2f, 0x06, 0x98, 0x5d, 0x16, 0xb8, 0x38, 0x08, 0x19, 0xc7, 0xf4, 0x69, 0x06, 0x33, 0x1f, 0xc3,
-	0x55, 0x92, 0x62, 0x8a, 0x38, 0xa1, 0x2d, 0x63, 0xd3, 0xb8, 0xdb, 0xd8, 0x6d, 0xfd, 0xf5, 0xfb,
-	0xfd, 0x35, 0xa5, 0xf6, 0x33, 0xdf, 0xa7, 0x98, 0xb1, 0x2f, 0x38, 0x0d, 0x93, 0xc0, 0xd5, 0x48,
-	0xf3, 0x01, 0x2c, 0xa4, 0x68, 0x44, 0x06, 0xbc, 0x55, 0xab, 0xe0, 0x28, 0x9c, 0x69, 0xc1, 0xd5,
-	0x18, 0x73, 0xe4, 0x23, 0x8e, 0x5a, 0x57, 0x04, 0xc7, 0xd5, 0xe7, 0x27, 0x4b, 0x3f, 0x9c, 0x1d,
-	0x6f, 0xe9, 0xcb, 0xed, 0x7b, 0x60, 0x4d, 0x0a, 0x75, 0x31, 0x4b, 0x49, 0xc2, 0xb0, 0xb9, 0x0c,
-	0xb5, 0xd0, 0x97, 0x52, 0xeb, 0x6e, 0x2d, 0xf4, 0xed, 0x9f, 0x6b, 0xb0, 0xd2, 0x65, 0xc1, 0xf3,
-	0xd4, 0x47, 0x1c, 0x5f, 0x2e, 0xab, 0x0d, 0x00, 0x55, 0x3d, 0x2f, 0xf4, 0x65, 0x66, 0x75, 0xb7,
-	0xa1, 0x2c, 0xcf, 0x7c, 0xf3, 0xb1, 0x4e, 0x5a, 0x24, 0xd0, 0xdc, 0xbe, 0xd9, 0xc9, 0xfa, 0xd5,
-	0xc9, 0xfb, 0xd5, 0xc9, 0x6e, 0xfc, 0x0a, 0x45, 0x03, 0xac, 0x13, 0xff, 0x70, 0x2c, 0xf1, 0xfa,
-	0x0c, 0x3c, 0x8d, 0x36, 0xb7, 0x61, 0x01, 0xf5, 0x79, 0x78, 0x84, 0x5b, 0xf3, 0x92, 0x67, 0x4d,
-	0xf0, 0x76, 0x09, 0x89, 0x54, 0xb4, 0x0c, 0x59, 0x2e, 0xa5, 0x05, 0xad, 0x72, 0x6d, 0xf2, 0x42,
-	0xda, 0x7f, 0x18, 0x70, 0x4d, 0x3b, 0xf7, 0xe5, 0xa8, 0x98, 0x3b, 0xd0, 0x40, 0x03, 0x7e, 0x48,
-	0x68, 0xc8, 0x47, 0x95, 0x85, 0x3b, 0x87, 0x9a, 0x1f, 0x89, 0xd2, 0x88, 0x1b, 0x64, 0xd5, 0x9a,
-	0xdb, 0xad, 0x4e, 0x79, 0x73, 0x3a, 0x59, 0x84, 0xdd, 0xc6, 0xab, 0x7f, 0x6e, 0xcd, 0xfd, 0x7a,
-	0x76, 0xbc, 0x65, 0xb8, 0x8a, 0xf2, 0xe4, 0x91, 0xd0, 0x7c, 0x7e, 0xd9, 0x8f, 0x67, 0xc7, 0x5b,
-	0x9b, 0xd9, 0x2c, 0xbf, 0xcc, 0xa7, 0x99, 0x39, 0x25, 0xa5, 0xf6, 0x3a, 0x5c, 0x2f, 0x99, 0x74,
-	0x62, 0xbf, 0xd5, 0x60, 0xb5, 0xcb, 0x82, 0x3d, 0x8a, 0x11, 0xc7, 0x7b, 0x24, 0xe1, 0x14, 0xf5,
-	0xb9, 0x18, 0xd9, 0x7e, 0x14, 0xe2, 0x84, 0x57, 0xe6, 0xa5, 0x70, 0x55, 0xe3, 0xb0, 0x01, 0x90,
-	0xd2, 0xb0, 0x8f, 0xbd, 0x41, 0x14, 0x27, 0x72, 0x24, 0xea, 0x6e, 0x43, 0x5a, 0x9e, 0x47, 0x71,
-	0x62, 0x3a, 0xb0, 0xc6, 0x38, 0xa1, 0x28, 0xc0, 0x5e, 0xd0, 0xf3, 0x52, 0x4c, 0xbd, 0x98, 0x24,
-	0xfc, 0x50, 0xce, 0x40, 0xdd, 0x5d, 0x55, 0xbe, 0xa7, 0xbd, 0x7d, 0x4c, 0xbb, 0xc2, 0x21, 0x08,
-	0x09, 0xe6, 0x43, 0x42, 0xbf, 0x29, 0x12, 0xe6, 0x33, 0x82, 0xf2, 0x8d, 0x11, 0x6e, 0xc3, 0xa2,
-	0x44, 0x30, 0x8f, 0x13, 0x8e, 0xa2, 0xd6, 0xc2, 0xa6, 0x71, 0x77, 0xc9, 0x6d, 0x66, 0xb6, 0x2f,
-	0x85, 0xa9, 0xb0, 0x75, 0x6f, 0x95, 0xb6, 0xae, 0x29, 0xca, 0xae, 0x72, 0xb5, 0x3f, 0x86, 0xf5,
-	0x89, 0x92, 0xe9, 0x95, 0xbb, 0x05, 0xcd, 0xbe, 0xb2, 0x79, 0x7a, 0xf7, 0x20, 0x37, 0x3d, 0xf3,
-	0xed, 0xa1, 0x9c, 0xa4, 0xbd, 0x08, 0x85, 0xf1, 0x3e, 0x1a, 0xc5, 0xa2, 0x78, 0x6f, 0xb6, 0x81,
-	0xa5, 0x48, 0xb5, 0x72, 0xa4, 0xf2, 0x7c, 0xef, 0xc8, 0x29, 0x18, 0x0f, 0xac, 0x45, 0xdf, 0x80,
-	0x46, 0x8a, 0x42, 0x3f, 0xeb, 0x8e, 0x91, 0xe5, 0x2e, 0x0c, 0xa2, 0x39, 0x36, 0xcb, 0x26, 0x04,
-	0x25, 0x7d, 0x1c, 0x5d, 0x62, 0x42, 0x2a, 0xe5, 0x16, 0x6a, 0xfc, 0x69, 0x56, 0xe3, 0x42, 0x50,
-	0x2d, 0xf7, 0x0e, 0x2c, 0x51, 0x7c, 0x30, 0x48, 0x7c, 0x5c, 0x90, 0xbc, 0x98, 0x1b, 0xa5, 0xec,
-	0xef, 0xe0, 0xed, 0x2e, 0x0b, 0x3e, 0x0f, 0x13, 0x14, 0x85, 0xdf, 0x9e, 0x8f, 0xf6, 0x0e, 0x34,
-	0x0e, 0x94, 0xad, 0xba, 0xd8, 0xe7, 0xd0, 0x6a, 0xf9, 0xcb, 0x72, 0x33, 0x35, 0xc1, 0xfe, 0x04,
-	0x6e, 0x4c, 0x89, 0x3f, 0x3e, 0x27, 0x14, 0x0f, 0x11, 0x2d, 0x64, 0x00, 0x99, 0x49, 0xe8, 0xdf,
-	0xfe, 0x73, 0x1e, 0xae, 0x74, 0x59, 0x60, 0xbe, 0x80, 0xc5, 0xc2, 0x67, 0xe7, 0xf6, 0xe4, 0xe7,
-	0xa2, 0xb4, 0xdc, 0xd6, 0xfb, 0x95, 0x10, 0x2d, 0x03, 0xc3, 0xb5, 0xf2, 0x2b, 0xf7, 0xee, 0x54,
-	0x76, 0x09, 0x65, 0xdd, 0x9b, 0x05, 0xa5, 0xc3, 0x78, 0xb0, 0x54, 0x7c, 0x74, 0xec, 0xff, 0x91,
-	0x98, 0x87, 0xd8, 0xaa, 0xc6, 0xe8, 0x00, 0x3d, 0x58, 0x2e, 0x7d, 0xc3, 0xee, 0x4c, 0x65, 0x17,
-	0x41, 0xd6, 0x07, 0x33, 0x80, 0x74, 0x8c, 0x17, 0xb0, 0x58, 0x58, 0xdb, 0xe9, 0x9d, 0x18, 0x87,
-	0x5c, 0xd0, 0x89, 0xa9, 0x3b, 0x28, 0x32, 0x28, 0xee, 0xd8, 0x05, 0x19, 0x14, 0x40, 0x17, 0x65,
-	0x30, 0x7d, 0x71, 0x0e, 0x61, 0x65, 0x62, 0x21, 0xde, 0x9b, 0x7a, 0x41, 0x19, 0x66, 0xdd, 0x9f,
-	0x09, 0x96, 0x47, 0xb2, 0xe6, 0xbf, 0x17, 0xcf, 0xd6, 0xee, 0x83, 0x57, 0x27, 0x6d, 0xe3, 0xf5,
-	0x49, 0xdb, 0xf8, 0xf7, 0xa4, 0x6d, 0xfc, 0x74, 0xda, 0x9e, 0x7b, 0x7d, 0xda, 0x9e, 0xfb, 0xfb,
-	0xb4, 0x3d, 0xf7, 0xf5, 0x3b, 0x13, 0xaf, 0x96, 0xfc, 0x7d, 0xd6, 0x5b, 0x90, 0x0f, 0xf6, 0xa3,
-	0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xbb, 0xa7, 0x96, 0x3e, 0x6a, 0x0a, 0x00, 0x00,
+func (m *MsgBindDomain) Reset()         { *m = MsgBindDomain{} }
+func (m *MsgBindDomain) String() string { return proto.CompactTextString(m) }
+func (*MsgBindDomain) ProtoMessage()    {}
+func (*MsgBindDomain) Descriptor() ([]byte, []int) {
+	return fileDescriptor_586f618667a3f0d9, []int{14}
+}
+func (m *MsgBindDomain) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBindDomain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBindDomain.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBindDomain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBindDomain.Merge(m, src)
+}
+func (m *MsgBindDomain) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBindDomain) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBindDomain.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgBindDomain proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	RegisterGateway(ctx context.Context, in *MsgRegisterGateway, opts ...grpc.CallOption) (*MsgRegisterGatewayResponse, error)
-	UpdateGateway(ctx context.Context, in *MsgUpdateGateway, opts ...grpc.CallOption) (*MsgUpdateGatewayResponse, error)
-	CreateContract(ctx context.Context, in *MsgCreateContract, opts ...grpc.CallOption) (*MsgCreateContractResponse, error)
-	ClaimPayment(ctx context.Context, in *MsgClaimPayment, opts ...grpc.CallOption) (*MsgClaimPaymentResponse, error)
-	CancelContract(ctx context.Context, in *MsgCancelContract, opts ...grpc.CallOption) (*MsgCancelContractResponse, error)
-	FinalizeContract(ctx context.Context, in *MsgFinalizeContract, opts ...grpc.CallOption) (*MsgFinalizeContractResponse, error)
+func (m *MsgBindDomain) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgBindDomain) GetGatewayId() uint64 {
+	if m != nil {
+		return m.GatewayId
+	}
+	return 0
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgBindDomain) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/lumen.gateway.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgBindDomainResponse struct {
 }
 
-func (c *msgClient) RegisterGateway(ctx context.Context, in *MsgRegisterGateway, opts ...grpc.CallOption) (*MsgRegisterGatewayResponse, error) {
-	out := new(MsgRegisterGatewayResponse)
-	err := c.cc.Invoke(ctx, "/lumen.gateway.v1.Msg/RegisterGateway", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgBindDomainResponse) Reset()         { *m = MsgBindDomainResponse{} }
+func (m *MsgBindDomainResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBindDomainResponse) ProtoMessage()    {}
+func (*MsgBindDomainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_586f618667a3f0d9, []int{15}
+}
+func (m *MsgBindDomainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBindDomainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBindDomainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgBindDomainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBindDomainResponse.Merge(m, src)
+}
+func (m *MsgBindDomainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBindDomainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBindDomainResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateGateway(ctx context.Context, in *MsgUpdateGateway, opts ...grpc.CallOption) (*MsgUpdateGatewayResponse, error) {
-	out := new(MsgUpdateGatewayResponse)
-	err := c.cc.Invoke(ctx, "/lumen.gateway.v1.Msg/UpdateGateway", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgBindDomainResponse proto.InternalMessageInfo
+
+type MsgUnbindDomain struct {
+	Operator  string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	GatewayId uint64 `protobuf:"varint,2,opt,name=gateway_id,json=gatewayId,proto3" json:"gateway_id,omitempty"`
+	Domain    string `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`
 }
 
-func (c *msgClient) CreateContract(ctx context.Context, in *MsgCreateContract, opts ...grpc.CallOption) (*MsgCreateContractResponse, error) {
-	out := new(MsgCreateContractResponse)
-	err := c.cc.Invoke(ctx, "/lumen.gateway.v1.Msg/CreateContract", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUnbindDomain) Reset()         { *m = MsgUnbindDomain{} }
+func (m *MsgUnbindDomain) String() string { return proto.CompactTextString(m) }
+func (*MsgUnbindDomain) ProtoMessage()    {}
+func (*MsgUnbindDomain) Descriptor() ([]byte, []int) {
+	return fileDescriptor_586f618667a3f0d9, []int{16}
+}
+func (m *MsgUnbindDomain) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnbindDomain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnbindDomain.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgUnbindDomain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnbindDomain.Merge(m, src)
+}
+func (m *MsgUnbindDomain) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnbindDomain) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnbindDomain.DiscardUnknown(m)
 }
 
-func (c *msgClient) ClaimPayment(ctx context.Context, in *MsgClaimPayment, opts ...grpc.CallOption) (*MsgClaimPaymentResponse, error) {
-	out := new(MsgClaimPaymentResponse)
-	err := c.cc.Invoke(ctx, "/lumen.gateway.v1.Msg/ClaimPayment", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUnbindDomain proto.InternalMessageInfo
+
+func (m *MsgUnbindDomain) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) CancelContract(ctx context.Context, in *MsgCancelContract, opts ...grpc.CallOption) (*MsgCancelContractResponse, error) {
-	out := new(MsgCancelContractResponse)
-	err := c.cc.Invoke(ctx, "/lumen.gateway.v1.Msg/CancelContract", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUnbindDomain) GetGatewayId() uint64 {
+	if m != nil {
+		return m.GatewayId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) FinalizeContract(ctx context.Context, in *MsgFinalizeContract, opts ...grpc.CallOption) (*MsgFinalizeContractResponse, error) {
-	out := new(MsgFinalizeContractResponse)
-	err := c.cc.Invoke(ctx, "/lumen.gateway.v1.Msg/FinalizeContract", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUnbindDomain) GetDomain() string {
+	if m != nil {
+		return m.Domain
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	RegisterGateway(context.Context, *MsgRegisterGateway) (*MsgRegisterGatewayResponse, error)
-	UpdateGateway(context.Context, *MsgUpdateGateway) (*MsgUpdateGatewayResponse, error)
-	CreateContract(context.Context, *MsgCreateContract) (*MsgCreateContractResponse, error)
-	ClaimPayment(context.Context, *MsgClaimPayment) (*MsgClaimPaymentResponse, error)
-	CancelContract(context.Context, *MsgCancelContract) (*MsgCancelContractResponse, error)
-	FinalizeContract(context.Context, *MsgFinalizeContract) (*MsgFinalizeContractResponse, error)
+type MsgUnbindDomainResponse struct {
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgUnbindDomainResponse) Reset()         { *m = MsgUnbindDomainResponse{} }
+func (m *MsgUnbindDomainResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnbindDomainResponse) ProtoMessage()    {}
+func (*MsgUnbindDomainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_586f618667a3f0d9, []int{17}
+}
+func (m *MsgUnbindDomainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnbindDomainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnbindDomainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnbindDomainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnbindDomainResponse.Merge(m, src)
+}
+func (m *MsgUnbindDomainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnbindDomainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnbindDomainResponse.DiscardUnknown(m)
 }
 
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+var xxx_messageInfo_MsgUnbindDomainResponse proto.InternalMessageInfo
+
+type MsgSubmitUsageReport struct {
+	Operator     string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	ContractId   uint64 `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	Month        uint32 `protobuf:"varint,3,opt,name=month,proto3" json:"month,omitempty"`
+	StorageGb    uint64 `protobuf:"varint,4,opt,name=storage_gb,json=storageGb,proto3" json:"storage_gb,omitempty"`
+	NetworkGb    uint64 `protobuf:"varint,5,opt,name=network_gb,json=networkGb,proto3" json:"network_gb,omitempty"`
+	EvidenceHash string `protobuf:"bytes,6,opt,name=evidence_hash,json=evidenceHash,proto3" json:"evidence_hash,omitempty"`
 }
-func (*UnimplementedMsgServer) RegisterGateway(ctx context.Context, req *MsgRegisterGateway) (*MsgRegisterGatewayResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterGateway not implemented")
+
+func (m *MsgSubmitUsageReport) Reset()         { *m = MsgSubmitUsageReport{} }
+func (m *MsgSubmitUsageReport) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitUsageReport) ProtoMessage()    {}
+func (*MsgSubmitUsageReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_586f618667a3f0d9, []int{18}
 }
-func (*UnimplementedMsgServer) UpdateGateway(ctx context.Context, req *MsgUpdateGateway) (*MsgUpdateGatewayResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateGateway not implemented")
+func (m *MsgSubmitUsageReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) CreateContract(ctx context.Context, req *MsgCreateContract) (*MsgCreateContractResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateContract not implemented")
+func (m *MsgSubmitUsageReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitUsageReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) ClaimPayment(ctx context.Context, req *MsgClaimPayment) (*MsgClaimPaymentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClaimPayment not implemented")
+func (m *MsgSubmitUsageReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitUsageReport.Merge(m, src)
 }
-func (*UnimplementedMsgServer) CancelContract(ctx context.Context, req *MsgCancelContract) (*MsgCancelContractResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelContract not implemented")
+func (m *MsgSubmitUsageReport) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) FinalizeContract(ctx context.Context, req *MsgFinalizeContract) (*MsgFinalizeContractResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FinalizeContract not implemented")
+func (m *MsgSubmitUsageReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitUsageReport.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgSubmitUsageReport proto.InternalMessageInfo
+
+func (m *MsgSubmitUsageReport) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgSubmitUsageReport) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
+	return 0
+}
+
+func (m *MsgSubmitUsageReport) GetMonth() uint32 {
+	if m != nil {
+		return m.Month
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lumen.gateway.v1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
-	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_RegisterGateway_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRegisterGateway)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RegisterGateway(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lumen.gateway.v1.Msg/RegisterGateway",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RegisterGateway(ctx, req.(*MsgRegisterGateway))
+func (m *MsgSubmitUsageReport) GetStorageGb() uint64 {
+	if m != nil {
+		return m.StorageGb
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_UpdateGateway_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateGateway)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateGateway(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lumen.gateway.v1.Msg/UpdateGateway",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateGateway(ctx, req.(*MsgUpdateGateway))
+func (m *MsgSubmitUsageReport) GetNetworkGb() uint64 {
+	if m != nil {
+		return m.NetworkGb
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_CreateContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateContract)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateContract(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lumen.gateway.v1.Msg/CreateContract",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateContract(ctx, req.(*MsgCreateContract))
+func (m *MsgSubmitUsageReport) GetEvidenceHash() string {
+	if m != nil {
+		return m.EvidenceHash
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_ClaimPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgClaimPayment)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ClaimPayment(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lumen.gateway.v1.Msg/ClaimPayment",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ClaimPayment(ctx, req.(*MsgClaimPayment))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgSubmitUsageReportResponse struct {
+	DisputeDeadline uint64 `protobuf:"varint,1,opt,name=dispute_deadline,json=disputeDeadline,proto3" json:"dispute_deadline,omitempty"`
 }
 
-func _Msg_CancelContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCancelContract)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CancelContract(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lumen.gateway.v1.Msg/CancelContract",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CancelContract(ctx, req.(*MsgCancelContract))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgSubmitUsageReportResponse) Reset()         { *m = MsgSubmitUsageReportResponse{} }
+func (m *MsgSubmitUsageReportResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitUsageReportResponse) ProtoMessage()    {}
+func (*MsgSubmitUsageReportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_586f618667a3f0d9, []int{19}
 }
-
-func _Msg_FinalizeContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgFinalizeContract)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).FinalizeContract(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lumen.gateway.v1.Msg/FinalizeContract",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).FinalizeContract(ctx, req.(*MsgFinalizeContract))
+func (m *MsgSubmitUsageReportResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitUsageReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitUsageReportResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "lumen.gateway.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "RegisterGateway",
-			Handler:    _Msg_RegisterGateway_Handler,
-		},
-		{
-			MethodName: "UpdateGateway",
-			Handler:    _Msg_UpdateGateway_Handler,
-		},
-		{
-			MethodName: "CreateContract",
-			Handler:    _Msg_CreateContract_Handler,
-		},
-		{
-			MethodName: "ClaimPayment",
-			Handler:    _Msg_ClaimPayment_Handler,
-		},
-		{
-			MethodName: "CancelContract",
-			Handler:    _Msg_CancelContract_Handler,
-		},
-		{
-			MethodName: "FinalizeContract",
-			Handler:    _Msg_FinalizeContract_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "lumen/gateway/v1/tx.proto",
+func (m *MsgSubmitUsageReportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitUsageReportResponse.Merge(m, src)
+}
+func (m *MsgSubmitUsageReportResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitUsageReportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitUsageReportResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRegisterGateway) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSubmitUsageReportResponse proto.InternalMessageInfo
+
+func (m *MsgSubmitUsageReportResponse) GetDisputeDeadline() uint64 {
+	if m != nil {
+		return m.DisputeDeadline
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgRegisterGateway) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgAcknowledgeUsageReport struct {
+	Client     string `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
+	ContractId uint64 `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	Month      uint32 `protobuf:"varint,3,opt,name=month,proto3" json:"month,omitempty"`
 }
 
-func (m *MsgRegisterGateway) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Metadata) > 0 {
-		i -= len(m.Metadata)
-		copy(dAtA[i:], m.Metadata)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Metadata)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Payout) > 0 {
-		i -= len(m.Payout)
-		copy(dAtA[i:], m.Payout)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Payout)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgAcknowledgeUsageReport) Reset()         { *m = MsgAcknowledgeUsageReport{} }
+func (m *MsgAcknowledgeUsageReport) String() string { return proto.CompactTextString(m) }
+func (*MsgAcknowledgeUsageReport) ProtoMessage()    {}
+func (*MsgAcknowledgeUsageReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_586f618667a3f0d9, []int{20}
 }
-
-func (m *MsgRegisterGatewayResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgAcknowledgeUsageReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcknowledgeUsageReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcknowledgeUsageReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgAcknowledgeUsageReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcknowledgeUsageReport.Merge(m, src)
+}
+func (m *MsgAcknowledgeUsageReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcknowledgeUsageReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcknowledgeUsageReport.DiscardUnknown(m)
 }
 
-func (m *MsgRegisterGatewayResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgAcknowledgeUsageReport proto.InternalMessageInfo
+
+func (m *MsgAcknowledgeUsageReport) GetClient() string {
+	if m != nil {
+		return m.Client
+	}
+	return ""
 }
 
-func (m *MsgRegisterGatewayResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (m *MsgAcknowledgeUsageReport) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgUpdateGateway) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgAcknowledgeUsageReport) GetMonth() uint32 {
+	if m != nil {
+		return m.Month
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgUpdateGateway) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgAcknowledgeUsageReportResponse struct {
 }
 
-func (m *MsgUpdateGateway) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Active != nil {
-		{
-			size, err := m.Active.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgAcknowledgeUsageReportResponse) Reset()         { *m = MsgAcknowledgeUsageReportResponse{} }
+func (m *MsgAcknowledgeUsageReportResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcknowledgeUsageReportResponse) ProtoMessage()    {}
+func (*MsgAcknowledgeUsageReportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_586f618667a3f0d9, []int{21}
+}
+func (m *MsgAcknowledgeUsageReportResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcknowledgeUsageReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcknowledgeUsageReportResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x2a
+		return b[:n], nil
 	}
-	if m.Metadata != nil {
-		{
-			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+}
+func (m *MsgAcknowledgeUsageReportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcknowledgeUsageReportResponse.Merge(m, src)
+}
+func (m *MsgAcknowledgeUsageReportResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcknowledgeUsageReportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcknowledgeUsageReportResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcknowledgeUsageReportResponse proto.InternalMessageInfo
+
+type MsgDisputeUsageReport struct {
+	Client     string `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
+	ContractId uint64 `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	Month      uint32 `protobuf:"varint,3,opt,name=month,proto3" json:"month,omitempty"`
+	Reason     string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *MsgDisputeUsageReport) Reset()         { *m = MsgDisputeUsageReport{} }
+func (m *MsgDisputeUsageReport) String() string { return proto.CompactTextString(m) }
+func (*MsgDisputeUsageReport) ProtoMessage()    {}
+func (*MsgDisputeUsageReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_586f618667a3f0d9, []int{22}
+}
+func (m *MsgDisputeUsageReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDisputeUsageReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDisputeUsageReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x22
+		return b[:n], nil
 	}
-	if m.Payout != nil {
-		{
-			size, err := m.Payout.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *MsgDisputeUsageReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDisputeUsageReport.Merge(m, src)
+}
+func (m *MsgDisputeUsageReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDisputeUsageReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDisputeUsageReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDisputeUsageReport proto.InternalMessageInfo
+
+func (m *MsgDisputeUsageReport) GetClient() string {
+	if m != nil {
+		return m.Client
 	}
-	if m.GatewayId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.GatewayId))
-		i--
-		dAtA[i] = 0x10
+	return ""
+}
+
+func (m *MsgDisputeUsageReport) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
 	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *MsgDisputeUsageReport) GetMonth() uint32 {
+	if m != nil {
+		return m.Month
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgUpdateGatewayResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgDisputeUsageReport) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateGatewayResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgDisputeUsageReportResponse names the contract dispute the arbiters rule
+// on; an already open one is reused.
+type MsgDisputeUsageReportResponse struct {
+	DisputeId uint64 `protobuf:"varint,1,opt,name=dispute_id,json=disputeId,proto3" json:"dispute_id,omitempty"`
 }
 
-func (m *MsgUpdateGatewayResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgDisputeUsageReportResponse) Reset()         { *m = MsgDisputeUsageReportResponse{} }
+func (m *MsgDisputeUsageReportResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDisputeUsageReportResponse) ProtoMessage()    {}
+func (*MsgDisputeUsageReportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_586f618667a3f0d9, []int{23}
+}
+func (m *MsgDisputeUsageReportResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDisputeUsageReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDisputeUsageReportResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDisputeUsageReportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDisputeUsageReportResponse.Merge(m, src)
+}
+func (m *MsgDisputeUsageReportResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDisputeUsageReportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDisputeUsageReportResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgDisputeUsageReportResponse proto.InternalMessageInfo
+
+func (m *MsgDisputeUsageReportResponse) GetDisputeId() uint64 {
+	if m != nil {
+		return m.DisputeId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgOpenDispute struct {
+	Client       string `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
+	ContractId   uint64 `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	Reason       string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	EvidenceHash string `protobuf:"bytes,4,opt,name=evidence_hash,json=evidenceHash,proto3" json:"evidence_hash,omitempty"`
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgOpenDispute) Reset()         { *m = MsgOpenDispute{} }
+func (m *MsgOpenDispute) String() string { return proto.CompactTextString(m) }
+func (*MsgOpenDispute) ProtoMessage()    {}
+func (*MsgOpenDispute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_586f618667a3f0d9, []int{24}
+}
+func (m *MsgOpenDispute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgOpenDispute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgOpenDispute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgOpenDispute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOpenDispute.Merge(m, src)
+}
+func (m *MsgOpenDispute) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgOpenDispute) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOpenDispute.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgOpenDispute proto.InternalMessageInfo
+
+func (m *MsgOpenDispute) GetClient() string {
+	if m != nil {
+		return m.Client
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgOpenDispute) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
+	}
+	return 0
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgOpenDispute) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
 
-func (m *MsgCreateContract) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgOpenDispute) GetEvidenceHash() string {
+	if m != nil {
+		return m.EvidenceHash
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCreateContract) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgOpenDisputeResponse struct {
+	DisputeId uint64 `protobuf:"varint,1,opt,name=dispute_id,json=disputeId,proto3" json:"dispute_id,omitempty"`
+	Deadline  uint64 `protobuf:"varint,2,opt,name=deadline,proto3" json:"deadline,omitempty"`
 }
 
-func (m *MsgCreateContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Metadata) > 0 {
-		i -= len(m.Metadata)
-		copy(dAtA[i:], m.Metadata)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Metadata)))
-		i--
-		dAtA[i] = 0x3a
+func (m *MsgOpenDisputeResponse) Reset()         { *m = MsgOpenDisputeResponse{} }
+func (m *MsgOpenDisputeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgOpenDisputeResponse) ProtoMessage()    {}
+func (*MsgOpenDisputeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_586f618667a3f0d9, []int{25}
+}
+func (m *MsgOpenDisputeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgOpenDisputeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgOpenDisputeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.MonthsTotal != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MonthsTotal))
-		i--
-		dAtA[i] = 0x30
+}
+func (m *MsgOpenDisputeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOpenDisputeResponse.Merge(m, src)
+}
+func (m *MsgOpenDisputeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgOpenDisputeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOpenDisputeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgOpenDisputeResponse proto.InternalMessageInfo
+
+func (m *MsgOpenDisputeResponse) GetDisputeId() uint64 {
+	if m != nil {
+		return m.DisputeId
 	}
-	if m.NetworkGbPerMonth != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.NetworkGbPerMonth))
-		i--
-		dAtA[i] = 0x28
+	return 0
+}
+
+func (m *MsgOpenDisputeResponse) GetDeadline() uint64 {
+	if m != nil {
+		return m.Deadline
 	}
-	if m.StorageGbPerMonth != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.StorageGbPerMonth))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.PriceUlmn != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PriceUlmn))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.GatewayId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.GatewayId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Client) > 0 {
-		i -= len(m.Client)
-		copy(dAtA[i:], m.Client)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Client)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgCreateContractResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// MsgSubmitDisputeEvidence may be sent by the client or the gateway operator.
+type MsgSubmitDisputeEvidence struct {
+	Submitter    string `protobuf:"bytes,1,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	DisputeId    uint64 `protobuf:"varint,2,opt,name=dispute_id,json=disputeId,proto3" json:"dispute_id,omitempty"`
+	EvidenceHash string `protobuf:"bytes,3,opt,name=evidence_hash,json=evidenceHash,proto3" json:"evidence_hash,omitempty"`
 }
 
-func (m *MsgCreateContractResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSubmitDisputeEvidence) Reset()         { *m = MsgSubmitDisputeEvidence{} }
+func (m *MsgSubmitDisputeEvidence) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitDisputeEvidence) ProtoMessage()    {}
+func (*MsgSubmitDisputeEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_586f618667a3f0d9, []int{26}
 }
-
-func (m *MsgCreateContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ContractId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *MsgSubmitDisputeEvidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgClaimPayment) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitDisputeEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitDisputeEvidence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgClaimPayment) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSubmitDisputeEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitDisputeEvidence.Merge(m, src)
+}
+func (m *MsgSubmitDisputeEvidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitDisputeEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitDisputeEvidence.DiscardUnknown(m)
 }
 
-func (m *MsgClaimPayment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ContractId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgSubmitDisputeEvidence proto.InternalMessageInfo
+
+func (m *MsgSubmitDisputeEvidence) GetSubmitter() string {
+	if m != nil {
+		return m.Submitter
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgClaimPaymentResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitDisputeEvidence) GetDisputeId() uint64 {
+	if m != nil {
+		return m.DisputeId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgClaimPaymentResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSubmitDisputeEvidence) GetEvidenceHash() string {
+	if m != nil {
+		return m.EvidenceHash
+	}
+	return ""
 }
 
-func (m *MsgClaimPaymentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PaidUlmn) > 0 {
-		i -= len(m.PaidUlmn)
-		copy(dAtA[i:], m.PaidUlmn)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PaidUlmn)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type MsgSubmitDisputeEvidenceResponse struct {
 }
 
-func (m *MsgCancelContract) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitDisputeEvidenceResponse) Reset()         { *m = MsgSubmitDisputeEvidenceResponse{} }
+func (m *MsgSubmitDisputeEvidenceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitDisputeEvidenceResponse) ProtoMessage()    {}
+func (*MsgSubmitDisputeEvidenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_586f618667a3f0d9, []int{27}
+}
+func (m *MsgSubmitDisputeEvidenceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitDisputeEvidenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitDisputeEvidenceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSubmitDisputeEvidenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitDisputeEvidenceResponse.Merge(m, src)
+}
+func (m *MsgSubmitDisputeEvidenceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitDisputeEvidenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitDisputeEvidenceResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCancelContract) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSubmitDisputeEvidenceResponse proto.InternalMessageInfo
+
+// MsgResolveDispute is signed by an arbiter from params.arbiters or by the
+// governance authority through a proposal.
+type MsgResolveDispute struct {
+	Arbiter         string `protobuf:"bytes,1,opt,name=arbiter,proto3" json:"arbiter,omitempty"`
+	DisputeId       uint64 `protobuf:"varint,2,opt,name=dispute_id,json=disputeId,proto3" json:"dispute_id,omitempty"`
+	ClientRefundBps uint32 `protobuf:"varint,3,opt,name=client_refund_bps,json=clientRefundBps,proto3" json:"client_refund_bps,omitempty"`
+	Ruling          string `protobuf:"bytes,4,opt,name=ruling,proto3" json:"ruling,omitempty"`
 }
 
-func (m *MsgCancelContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ContractId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Client) > 0 {
-		i -= len(m.Client)
-		copy(dAtA[i:], m.Client)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Client)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgResolveDispute) Reset()         { *m = MsgResolveDispute{} }
+func (m *MsgResolveDispute) String() string { return proto.CompactTextString(m) }
+func (*MsgResolveDispute) ProtoMessage()    {}
+func (*MsgResolveDispute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_586f618667a3f0d9, []int{28}
+}
+func (m *MsgResolveDispute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResolveDispute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResolveDispute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgResolveDispute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResolveDispute.Merge(m, src)
+}
+func (m *MsgResolveDispute) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResolveDispute) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResolveDispute.DiscardUnknown(m)
 }
 
-func (m *MsgCancelContractResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgResolveDispute proto.InternalMessageInfo
+
+func (m *MsgResolveDispute) GetArbiter() string {
+	if m != nil {
+		return m.Arbiter
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCancelContractResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgResolveDispute) GetDisputeId() uint64 {
+	if m != nil {
+		return m.DisputeId
+	}
+	return 0
 }
 
-func (m *MsgCancelContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RefundedUlmn) > 0 {
-		i -= len(m.RefundedUlmn)
-		copy(dAtA[i:], m.RefundedUlmn)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RefundedUlmn)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgResolveDispute) GetClientRefundBps() uint32 {
+	if m != nil {
+		return m.ClientRefundBps
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgFinalizeContract) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgResolveDispute) GetRuling() string {
+	if m != nil {
+		return m.Ruling
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgFinalizeContract) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgResolveDisputeResponse struct {
+	ClientRefundUlmn  string `protobuf:"bytes,1,opt,name=client_refund_ulmn,json=clientRefundUlmn,proto3" json:"client_refund_ulmn,omitempty"`
+	GatewayPayoutUlmn string `protobuf:"bytes,2,opt,name=gateway_payout_ulmn,json=gatewayPayoutUlmn,proto3" json:"gateway_payout_ulmn,omitempty"`
 }
 
-func (m *MsgFinalizeContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ContractId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
-		i--
-		dAtA[i] = 0x10
+func (m *MsgResolveDisputeResponse) Reset()         { *m = MsgResolveDisputeResponse{} }
+func (m *MsgResolveDisputeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResolveDisputeResponse) ProtoMessage()    {}
+func (*MsgResolveDisputeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_586f618667a3f0d9, []int{29}
+}
+func (m *MsgResolveDisputeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResolveDisputeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResolveDisputeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Finalizer) > 0 {
-		i -= len(m.Finalizer)
-		copy(dAtA[i:], m.Finalizer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Finalizer)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgResolveDisputeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResolveDisputeResponse.Merge(m, src)
+}
+func (m *MsgResolveDisputeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResolveDisputeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResolveDisputeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResolveDisputeResponse proto.InternalMessageInfo
+
+func (m *MsgResolveDisputeResponse) GetClientRefundUlmn() string {
+	if m != nil {
+		return m.ClientRefundUlmn
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgFinalizeContractResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgResolveDisputeResponse) GetGatewayPayoutUlmn() string {
+	if m != nil {
+		return m.GatewayPayoutUlmn
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgFinalizeContractResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgBondGateway struct {
+	Operator   string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	GatewayId  uint64 `protobuf:"varint,2,opt,name=gateway_id,json=gatewayId,proto3" json:"gateway_id,omitempty"`
+	AmountUlmn string `protobuf:"bytes,3,opt,name=amount_ulmn,json=amountUlmn,proto3" json:"amount_ulmn,omitempty"`
 }
 
-func (m *MsgFinalizeContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RewardUlmn) > 0 {
-		i -= len(m.RewardUlmn)
-		copy(dAtA[i:], m.RewardUlmn)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RewardUlmn)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgBondGateway) Reset()         { *m = MsgBondGateway{} }
+func (m *MsgBondGateway) String() string { return proto.CompactTextString(m) }
+func (*MsgBondGateway) ProtoMessage()    {}
+func (*MsgBondGateway) Descriptor() ([]byte, []int) {
+	return fileDescriptor_586f618667a3f0d9, []int{30}
+}
+func (m *MsgBondGateway) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBondGateway) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBondGateway.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgBondGateway) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBondGateway.Merge(m, src)
+}
+func (m *MsgBondGateway) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBondGateway) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBondGateway.DiscardUnknown(m)
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_MsgBondGateway proto.InternalMessageInfo
+
+func (m *MsgBondGateway) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *MsgRegisterGateway) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Payout)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+
+func (m *MsgBondGateway) GetGatewayId() uint64 {
+	if m != nil {
+		return m.GatewayId
 	}
-	return n
+	return 0
 }
 
-func (m *MsgRegisterGatewayResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+func (m *MsgBondGateway) GetAmountUlmn() string {
+	if m != nil {
+		return m.AmountUlmn
 	}
-	return n
+	return ""
 }
 
-func (m *MsgUpdateGateway) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.GatewayId != 0 {
-		n += 1 + sovTx(uint64(m.GatewayId))
-	}
-	if m.Payout != nil {
-		l = m.Payout.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Active != nil {
-		l = m.Active.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+type MsgBondGatewayResponse struct {
+	BondedUlmn string `protobuf:"bytes,1,opt,name=bonded_ulmn,json=bondedUlmn,proto3" json:"bonded_ulmn,omitempty"`
 }
 
-func (m *MsgUpdateGatewayResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgBondGatewayResponse) Reset()         { *m = MsgBondGatewayResponse{} }
+func (m *MsgBondGatewayResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBondGatewayResponse) ProtoMessage()    {}
+func (*MsgBondGatewayResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_586f618667a3f0d9, []int{31}
+}
+func (m *MsgBondGatewayResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBondGatewayResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBondGatewayResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *MsgBondGatewayResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBondGatewayResponse.Merge(m, src)
+}
+func (m *MsgBondGatewayResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBondGatewayResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBondGatewayResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+var xxx_messageInfo_MsgBondGatewayResponse proto.InternalMessageInfo
+
+func (m *MsgBondGatewayResponse) GetBondedUlmn() string {
+	if m != nil {
+		return m.BondedUlmn
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+// MsgUnbondGateway starts unbonding part of the bond. Only the amount above
+// the gateway's required bond can be unbonded; an inactive gateway without
+// clients needs no bond.
+type MsgUnbondGateway struct {
+	Operator   string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	GatewayId  uint64 `protobuf:"varint,2,opt,name=gateway_id,json=gatewayId,proto3" json:"gateway_id,omitempty"`
+	AmountUlmn string `protobuf:"bytes,3,opt,name=amount_ulmn,json=amountUlmn,proto3" json:"amount_ulmn,omitempty"`
 }
 
-func (m *MsgCreateContract) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Client)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.GatewayId != 0 {
-		n += 1 + sovTx(uint64(m.GatewayId))
-	}
-	if m.PriceUlmn != 0 {
-		n += 1 + sovTx(uint64(m.PriceUlmn))
-	}
-	if m.StorageGbPerMonth != 0 {
-		n += 1 + sovTx(uint64(m.StorageGbPerMonth))
-	}
-	if m.NetworkGbPerMonth != 0 {
-		n += 1 + sovTx(uint64(m.NetworkGbPerMonth))
-	}
-	if m.MonthsTotal != 0 {
-		n += 1 + sovTx(uint64(m.MonthsTotal))
-	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgUnbondGateway) Reset()         { *m = MsgUnbondGateway{} }
+func (m *MsgUnbondGateway) String() string { return proto.CompactTextString(m) }
+func (*MsgUnbondGateway) ProtoMessage()    {}
+func (*MsgUnbondGateway) Descriptor() ([]byte, []int) {
+	return fileDescriptor_586f618667a3f0d9, []int{32}
+}
+func (m *MsgUnbondGateway) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnbondGateway) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnbondGateway.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgUnbondGateway) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnbondGateway.Merge(m, src)
+}
+func (m *MsgUnbondGateway) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnbondGateway) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnbondGateway.DiscardUnknown(m)
 }
 
-func (m *MsgCreateContractResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ContractId != 0 {
-		n += 1 + sovTx(uint64(m.ContractId))
+var xxx_messageInfo_MsgUnbondGateway proto.InternalMessageInfo
+
+func (m *MsgUnbondGateway) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	return n
+	return ""
 }
 
-func (m *MsgClaimPayment) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ContractId != 0 {
-		n += 1 + sovTx(uint64(m.ContractId))
+func (m *MsgUnbondGateway) GetGatewayId() uint64 {
+	if m != nil {
+		return m.GatewayId
 	}
-	return n
+	return 0
 }
 
-func (m *MsgClaimPaymentResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PaidUlmn)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgUnbondGateway) GetAmountUlmn() string {
+	if m != nil {
+		return m.AmountUlmn
 	}
-	return n
+	return ""
 }
 
-func (m *MsgCancelContract) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Client)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ContractId != 0 {
-		n += 1 + sovTx(uint64(m.ContractId))
-	}
-	return n
+type MsgUnbondGatewayResponse struct {
+	CompleteAt uint64 `protobuf:"varint,1,opt,name=complete_at,json=completeAt,proto3" json:"complete_at,omitempty"`
 }
 
-func (m *MsgCancelContractResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUnbondGatewayResponse) Reset()         { *m = MsgUnbondGatewayResponse{} }
+func (m *MsgUnbondGatewayResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnbondGatewayResponse) ProtoMessage()    {}
+func (*MsgUnbondGatewayResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_586f618667a3f0d9, []int{33}
+}
+func (m *MsgUnbondGatewayResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnbondGatewayResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnbondGatewayResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.RefundedUlmn)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgUnbondGatewayResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnbondGatewayResponse.Merge(m, src)
+}
+func (m *MsgUnbondGatewayResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnbondGatewayResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnbondGatewayResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnbondGatewayResponse proto.InternalMessageInfo
+
+func (m *MsgUnbondGatewayResponse) GetCompleteAt() uint64 {
+	if m != nil {
+		return m.CompleteAt
 	}
-	return n
+	return 0
 }
 
-func (m *MsgFinalizeContract) Size() (n int) {
-	if m == nil {
-		return 0
+type MsgWithdrawBond struct {
+	Operator  string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	GatewayId uint64 `protobuf:"varint,2,opt,name=gateway_id,json=gatewayId,proto3" json:"gateway_id,omitempty"`
+}
+
+func (m *MsgWithdrawBond) Reset()         { *m = MsgWithdrawBond{} }
+func (m *MsgWithdrawBond) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawBond) ProtoMessage()    {}
+func (*MsgWithdrawBond) Descriptor() ([]byte, []int) {
+	return fileDescriptor_586f618667a3f0d9, []int{34}
+}
+func (m *MsgWithdrawBond) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawBond) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawBond.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Finalizer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgWithdrawBond) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawBond.Merge(m, src)
+}
+func (m *MsgWithdrawBond) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawBond) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawBond.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawBond proto.InternalMessageInfo
+
+func (m *MsgWithdrawBond) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	if m.ContractId != 0 {
-		n += 1 + sovTx(uint64(m.ContractId))
+	return ""
+}
+
+func (m *MsgWithdrawBond) GetGatewayId() uint64 {
+	if m != nil {
+		return m.GatewayId
 	}
-	return n
+	return 0
 }
 
-func (m *MsgFinalizeContractResponse) Size() (n int) {
-	if m == nil {
-		return 0
+type MsgWithdrawBondResponse struct {
+	AmountUlmn string `protobuf:"bytes,1,opt,name=amount_ulmn,json=amountUlmn,proto3" json:"amount_ulmn,omitempty"`
+}
+
+func (m *MsgWithdrawBondResponse) Reset()         { *m = MsgWithdrawBondResponse{} }
+func (m *MsgWithdrawBondResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawBondResponse) ProtoMessage()    {}
+func (*MsgWithdrawBondResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_586f618667a3f0d9, []int{35}
+}
+func (m *MsgWithdrawBondResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawBondResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawBondResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.RewardUlmn)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgWithdrawBondResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawBondResponse.Merge(m, src)
+}
+func (m *MsgWithdrawBondResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawBondResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawBondResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawBondResponse proto.InternalMessageInfo
+
+func (m *MsgWithdrawBondResponse) GetAmountUlmn() string {
+	if m != nil {
+		return m.AmountUlmn
+	}
+	return ""
+}
+
+// MsgCreateOffer publishes a price list entry for a gateway. Once a gateway
+// has an active offer, clients can only contract with it through one.
+type MsgCreateOffer struct {
+	Operator           string              `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	GatewayId          uint64              `protobuf:"varint,2,opt,name=gateway_id,json=gatewayId,proto3" json:"gateway_id,omitempty"`
+	PriceUlmn          uint64              `protobuf:"varint,3,opt,name=price_ulmn,json=priceUlmn,proto3" json:"price_ulmn,omitempty"`
+	StorageGbPerMonth  uint64              `protobuf:"varint,4,opt,name=storage_gb_per_month,json=storageGbPerMonth,proto3" json:"storage_gb_per_month,omitempty"`
+	NetworkGbPerMonth  uint64              `protobuf:"varint,5,opt,name=network_gb_per_month,json=networkGbPerMonth,proto3" json:"network_gb_per_month,omitempty"`
+	MinMonths          uint32              `protobuf:"varint,6,opt,name=min_months,json=minMonths,proto3" json:"min_months,omitempty"`
+	MaxMonths          uint32              `protobuf:"varint,7,opt,name=max_months,json=maxMonths,proto3" json:"max_months,omitempty"`
+	Regions            []string            `protobuf:"bytes,8,rep,name=regions,proto3" json:"regions,omitempty"`
+	CapacitySlots      uint32              `protobuf:"varint,9,opt,name=capacity_slots,json=capacitySlots,proto3" json:"capacity_slots,omitempty"`
+	Denom              string              `protobuf:"bytes,10,opt,name=denom,proto3" json:"denom,omitempty"`
+	CancellationPolicy *CancellationPolicy `protobuf:"bytes,11,opt,name=cancellation_policy,json=cancellationPolicy,proto3" json:"cancellation_policy,omitempty"`
+}
+
+func (m *MsgCreateOffer) Reset()         { *m = MsgCreateOffer{} }
+func (m *MsgCreateOffer) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateOffer) ProtoMessage()    {}
+func (*MsgCreateOffer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_586f618667a3f0d9, []int{36}
+}
+func (m *MsgCreateOffer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateOffer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateOffer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateOffer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateOffer.Merge(m, src)
+}
+func (m *MsgCreateOffer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateOffer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateOffer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateOffer proto.InternalMessageInfo
+
+func (m *MsgCreateOffer) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *MsgCreateOffer) GetGatewayId() uint64 {
+	if m != nil {
+		return m.GatewayId
+	}
+	return 0
+}
+
+func (m *MsgCreateOffer) GetPriceUlmn() uint64 {
+	if m != nil {
+		return m.PriceUlmn
+	}
+	return 0
+}
+
+func (m *MsgCreateOffer) GetStorageGbPerMonth() uint64 {
+	if m != nil {
+		return m.StorageGbPerMonth
+	}
+	return 0
+}
+
+func (m *MsgCreateOffer) GetNetworkGbPerMonth() uint64 {
+	if m != nil {
+		return m.NetworkGbPerMonth
+	}
+	return 0
+}
+
+func (m *MsgCreateOffer) GetMinMonths() uint32 {
+	if m != nil {
+		return m.MinMonths
+	}
+	return 0
+}
+
+func (m *MsgCreateOffer) GetMaxMonths() uint32 {
+	if m != nil {
+		return m.MaxMonths
+	}
+	return 0
+}
+
+func (m *MsgCreateOffer) GetRegions() []string {
+	if m != nil {
+		return m.Regions
+	}
+	return nil
+}
+
+func (m *MsgCreateOffer) GetCapacitySlots() uint32 {
+	if m != nil {
+		return m.CapacitySlots
+	}
+	return 0
+}
+
+func (m *MsgCreateOffer) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MsgCreateOffer) GetCancellationPolicy() *CancellationPolicy {
+	if m != nil {
+		return m.CancellationPolicy
+	}
+	return nil
+}
+
+type MsgCreateOfferResponse struct {
+	OfferId uint64 `protobuf:"varint,1,opt,name=offer_id,json=offerId,proto3" json:"offer_id,omitempty"`
+}
+
+func (m *MsgCreateOfferResponse) Reset()         { *m = MsgCreateOfferResponse{} }
+func (m *MsgCreateOfferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateOfferResponse) ProtoMessage()    {}
+func (*MsgCreateOfferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_586f618667a3f0d9, []int{37}
+}
+func (m *MsgCreateOfferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateOfferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateOfferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateOfferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateOfferResponse.Merge(m, src)
+}
+func (m *MsgCreateOfferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateOfferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateOfferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateOfferResponse proto.InternalMessageInfo
+
+func (m *MsgCreateOfferResponse) GetOfferId() uint64 {
+	if m != nil {
+		return m.OfferId
+	}
+	return 0
+}
+
+// MsgRetireOffer stops new contracts on an offer. Running contracts are not
+// affected.
+type MsgRetireOffer struct {
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	OfferId  uint64 `protobuf:"varint,2,opt,name=offer_id,json=offerId,proto3" json:"offer_id,omitempty"`
+}
+
+func (m *MsgRetireOffer) Reset()         { *m = MsgRetireOffer{} }
+func (m *MsgRetireOffer) String() string { return proto.CompactTextString(m) }
+func (*MsgRetireOffer) ProtoMessage()    {}
+func (*MsgRetireOffer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_586f618667a3f0d9, []int{38}
+}
+func (m *MsgRetireOffer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRetireOffer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRetireOffer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRetireOffer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRetireOffer.Merge(m, src)
+}
+func (m *MsgRetireOffer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRetireOffer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRetireOffer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRetireOffer proto.InternalMessageInfo
+
+func (m *MsgRetireOffer) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *MsgRetireOffer) GetOfferId() uint64 {
+	if m != nil {
+		return m.OfferId
+	}
+	return 0
+}
+
+type MsgRetireOfferResponse struct {
+}
+
+func (m *MsgRetireOfferResponse) Reset()         { *m = MsgRetireOfferResponse{} }
+func (m *MsgRetireOfferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRetireOfferResponse) ProtoMessage()    {}
+func (*MsgRetireOfferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_586f618667a3f0d9, []int{39}
+}
+func (m *MsgRetireOfferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRetireOfferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRetireOfferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRetireOfferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRetireOfferResponse.Merge(m, src)
+}
+func (m *MsgRetireOfferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRetireOfferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRetireOfferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRetireOfferResponse proto.InternalMessageInfo
+
+// MsgExtendContract adds months to a running contract at its current price.
+// The client escrows price_ulmn per added month plus the send tax on top.
+type MsgExtendContract struct {
+	Client           string `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
+	ContractId       uint64 `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	AdditionalMonths uint32 `protobuf:"varint,3,opt,name=additional_months,json=additionalMonths,proto3" json:"additional_months,omitempty"`
+}
+
+func (m *MsgExtendContract) Reset()         { *m = MsgExtendContract{} }
+func (m *MsgExtendContract) String() string { return proto.CompactTextString(m) }
+func (*MsgExtendContract) ProtoMessage()    {}
+func (*MsgExtendContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_586f618667a3f0d9, []int{40}
+}
+func (m *MsgExtendContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExtendContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExtendContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgExtendContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExtendContract.Merge(m, src)
+}
+func (m *MsgExtendContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExtendContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExtendContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgExtendContract proto.InternalMessageInfo
+
+func (m *MsgExtendContract) GetClient() string {
+	if m != nil {
+		return m.Client
+	}
+	return ""
+}
+
+func (m *MsgExtendContract) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
+	}
+	return 0
+}
+
+func (m *MsgExtendContract) GetAdditionalMonths() uint32 {
+	if m != nil {
+		return m.AdditionalMonths
+	}
+	return 0
+}
+
+type MsgExtendContractResponse struct {
+	MonthsTotal uint32 `protobuf:"varint,1,opt,name=months_total,json=monthsTotal,proto3" json:"months_total,omitempty"`
+	EscrowUlmn  string `protobuf:"bytes,2,opt,name=escrow_ulmn,json=escrowUlmn,proto3" json:"escrow_ulmn,omitempty"`
+}
+
+func (m *MsgExtendContractResponse) Reset()         { *m = MsgExtendContractResponse{} }
+func (m *MsgExtendContractResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgExtendContractResponse) ProtoMessage()    {}
+func (*MsgExtendContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_586f618667a3f0d9, []int{41}
+}
+func (m *MsgExtendContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExtendContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExtendContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgExtendContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExtendContractResponse.Merge(m, src)
+}
+func (m *MsgExtendContractResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExtendContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExtendContractResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgExtendContractResponse proto.InternalMessageInfo
+
+func (m *MsgExtendContractResponse) GetMonthsTotal() uint32 {
+	if m != nil {
+		return m.MonthsTotal
+	}
+	return 0
+}
+
+func (m *MsgExtendContractResponse) GetEscrowUlmn() string {
+	if m != nil {
+		return m.EscrowUlmn
+	}
+	return ""
+}
+
+// MsgAmendContract proposes new quotas and a new gross monthly price for the
+// rest of a contract. A later proposal replaces a pending one.
+type MsgAmendContract struct {
+	Client            string `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
+	ContractId        uint64 `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	PriceUlmn         uint64 `protobuf:"varint,3,opt,name=price_ulmn,json=priceUlmn,proto3" json:"price_ulmn,omitempty"`
+	StorageGbPerMonth uint64 `protobuf:"varint,4,opt,name=storage_gb_per_month,json=storageGbPerMonth,proto3" json:"storage_gb_per_month,omitempty"`
+	NetworkGbPerMonth uint64 `protobuf:"varint,5,opt,name=network_gb_per_month,json=networkGbPerMonth,proto3" json:"network_gb_per_month,omitempty"`
+}
+
+func (m *MsgAmendContract) Reset()         { *m = MsgAmendContract{} }
+func (m *MsgAmendContract) String() string { return proto.CompactTextString(m) }
+func (*MsgAmendContract) ProtoMessage()    {}
+func (*MsgAmendContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_586f618667a3f0d9, []int{42}
+}
+func (m *MsgAmendContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAmendContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAmendContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAmendContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAmendContract.Merge(m, src)
+}
+func (m *MsgAmendContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAmendContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAmendContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAmendContract proto.InternalMessageInfo
+
+func (m *MsgAmendContract) GetClient() string {
+	if m != nil {
+		return m.Client
+	}
+	return ""
+}
+
+func (m *MsgAmendContract) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
+	}
+	return 0
+}
+
+func (m *MsgAmendContract) GetPriceUlmn() uint64 {
+	if m != nil {
+		return m.PriceUlmn
+	}
+	return 0
+}
+
+func (m *MsgAmendContract) GetStorageGbPerMonth() uint64 {
+	if m != nil {
+		return m.StorageGbPerMonth
+	}
+	return 0
+}
+
+func (m *MsgAmendContract) GetNetworkGbPerMonth() uint64 {
+	if m != nil {
+		return m.NetworkGbPerMonth
+	}
+	return 0
+}
+
+type MsgAmendContractResponse struct {
+}
+
+func (m *MsgAmendContractResponse) Reset()         { *m = MsgAmendContractResponse{} }
+func (m *MsgAmendContractResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAmendContractResponse) ProtoMessage()    {}
+func (*MsgAmendContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_586f618667a3f0d9, []int{43}
+}
+func (m *MsgAmendContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAmendContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAmendContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAmendContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAmendContractResponse.Merge(m, src)
+}
+func (m *MsgAmendContractResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAmendContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAmendContractResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAmendContractResponse proto.InternalMessageInfo
+
+// MsgAcceptAmendment applies the pending amendment. The client is charged
+// (or refunded) the escrow difference for the remaining months.
+type MsgAcceptAmendment struct {
+	Operator   string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	ContractId uint64 `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+}
+
+func (m *MsgAcceptAmendment) Reset()         { *m = MsgAcceptAmendment{} }
+func (m *MsgAcceptAmendment) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptAmendment) ProtoMessage()    {}
+func (*MsgAcceptAmendment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_586f618667a3f0d9, []int{44}
+}
+func (m *MsgAcceptAmendment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptAmendment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptAmendment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptAmendment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptAmendment.Merge(m, src)
+}
+func (m *MsgAcceptAmendment) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptAmendment) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptAmendment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptAmendment proto.InternalMessageInfo
+
+func (m *MsgAcceptAmendment) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *MsgAcceptAmendment) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
+	}
+	return 0
+}
+
+type MsgAcceptAmendmentResponse struct {
+	ChargedUlmn  string `protobuf:"bytes,1,opt,name=charged_ulmn,json=chargedUlmn,proto3" json:"charged_ulmn,omitempty"`
+	RefundedUlmn string `protobuf:"bytes,2,opt,name=refunded_ulmn,json=refundedUlmn,proto3" json:"refunded_ulmn,omitempty"`
+}
+
+func (m *MsgAcceptAmendmentResponse) Reset()         { *m = MsgAcceptAmendmentResponse{} }
+func (m *MsgAcceptAmendmentResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptAmendmentResponse) ProtoMessage()    {}
+func (*MsgAcceptAmendmentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_586f618667a3f0d9, []int{45}
+}
+func (m *MsgAcceptAmendmentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptAmendmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptAmendmentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptAmendmentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptAmendmentResponse.Merge(m, src)
+}
+func (m *MsgAcceptAmendmentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptAmendmentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptAmendmentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptAmendmentResponse proto.InternalMessageInfo
+
+func (m *MsgAcceptAmendmentResponse) GetChargedUlmn() string {
+	if m != nil {
+		return m.ChargedUlmn
+	}
+	return ""
+}
+
+func (m *MsgAcceptAmendmentResponse) GetRefundedUlmn() string {
+	if m != nil {
+		return m.RefundedUlmn
+	}
+	return ""
+}
+
+// MsgClaimAll claims every due contract of a gateway, oldest payout first.
+// Contracts that cannot be claimed yet (disputed, unsettled usage) are
+// skipped.
+type MsgClaimAll struct {
+	Operator  string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	GatewayId uint64 `protobuf:"varint,2,opt,name=gateway_id,json=gatewayId,proto3" json:"gateway_id,omitempty"`
+	Limit     uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *MsgClaimAll) Reset()         { *m = MsgClaimAll{} }
+func (m *MsgClaimAll) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimAll) ProtoMessage()    {}
+func (*MsgClaimAll) Descriptor() ([]byte, []int) {
+	return fileDescriptor_586f618667a3f0d9, []int{46}
+}
+func (m *MsgClaimAll) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimAll) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimAll.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimAll) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimAll.Merge(m, src)
+}
+func (m *MsgClaimAll) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimAll) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimAll.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimAll proto.InternalMessageInfo
+
+func (m *MsgClaimAll) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *MsgClaimAll) GetGatewayId() uint64 {
+	if m != nil {
+		return m.GatewayId
+	}
+	return 0
+}
+
+func (m *MsgClaimAll) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type MsgClaimAllResponse struct {
+	Claimed  uint32 `protobuf:"varint,1,opt,name=claimed,proto3" json:"claimed,omitempty"`
+	Skipped  uint32 `protobuf:"varint,2,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	PaidUlmn string `protobuf:"bytes,3,opt,name=paid_ulmn,json=paidUlmn,proto3" json:"paid_ulmn,omitempty"`
+}
+
+func (m *MsgClaimAllResponse) Reset()         { *m = MsgClaimAllResponse{} }
+func (m *MsgClaimAllResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimAllResponse) ProtoMessage()    {}
+func (*MsgClaimAllResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_586f618667a3f0d9, []int{47}
+}
+func (m *MsgClaimAllResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimAllResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimAllResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimAllResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimAllResponse.Merge(m, src)
+}
+func (m *MsgClaimAllResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimAllResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimAllResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimAllResponse proto.InternalMessageInfo
+
+func (m *MsgClaimAllResponse) GetClaimed() uint32 {
+	if m != nil {
+		return m.Claimed
+	}
+	return 0
+}
+
+func (m *MsgClaimAllResponse) GetSkipped() uint32 {
+	if m != nil {
+		return m.Skipped
+	}
+	return 0
+}
+
+func (m *MsgClaimAllResponse) GetPaidUlmn() string {
+	if m != nil {
+		return m.PaidUlmn
+	}
+	return ""
+}
+
+// MsgAcceptContract starts a PENDING contract: its term begins at the
+// accepting block and the held send tax is paid out.
+type MsgAcceptContract struct {
+	Operator   string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	ContractId uint64 `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+}
+
+func (m *MsgAcceptContract) Reset()         { *m = MsgAcceptContract{} }
+func (m *MsgAcceptContract) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptContract) ProtoMessage()    {}
+func (*MsgAcceptContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_586f618667a3f0d9, []int{48}
+}
+func (m *MsgAcceptContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptContract.Merge(m, src)
+}
+func (m *MsgAcceptContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptContract proto.InternalMessageInfo
+
+func (m *MsgAcceptContract) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *MsgAcceptContract) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
+	}
+	return 0
+}
+
+type MsgAcceptContractResponse struct {
+	StartTime uint64 `protobuf:"varint,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+}
+
+func (m *MsgAcceptContractResponse) Reset()         { *m = MsgAcceptContractResponse{} }
+func (m *MsgAcceptContractResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptContractResponse) ProtoMessage()    {}
+func (*MsgAcceptContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_586f618667a3f0d9, []int{49}
+}
+func (m *MsgAcceptContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptContractResponse.Merge(m, src)
+}
+func (m *MsgAcceptContractResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptContractResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptContractResponse proto.InternalMessageInfo
+
+func (m *MsgAcceptContractResponse) GetStartTime() uint64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+// MsgRejectContract declines a PENDING contract and refunds the client in
+// full, send tax included.
+type MsgRejectContract struct {
+	Operator   string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	ContractId uint64 `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	Reason     string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *MsgRejectContract) Reset()         { *m = MsgRejectContract{} }
+func (m *MsgRejectContract) String() string { return proto.CompactTextString(m) }
+func (*MsgRejectContract) ProtoMessage()    {}
+func (*MsgRejectContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_586f618667a3f0d9, []int{50}
+}
+func (m *MsgRejectContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRejectContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRejectContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRejectContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRejectContract.Merge(m, src)
+}
+func (m *MsgRejectContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRejectContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRejectContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRejectContract proto.InternalMessageInfo
+
+func (m *MsgRejectContract) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *MsgRejectContract) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
+	}
+	return 0
+}
+
+func (m *MsgRejectContract) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type MsgRejectContractResponse struct {
+	RefundedUlmn string `protobuf:"bytes,1,opt,name=refunded_ulmn,json=refundedUlmn,proto3" json:"refunded_ulmn,omitempty"`
+}
+
+func (m *MsgRejectContractResponse) Reset()         { *m = MsgRejectContractResponse{} }
+func (m *MsgRejectContractResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRejectContractResponse) ProtoMessage()    {}
+func (*MsgRejectContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_586f618667a3f0d9, []int{51}
+}
+func (m *MsgRejectContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRejectContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRejectContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRejectContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRejectContractResponse.Merge(m, src)
+}
+func (m *MsgRejectContractResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRejectContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRejectContractResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRejectContractResponse proto.InternalMessageInfo
+
+func (m *MsgRejectContractResponse) GetRefundedUlmn() string {
+	if m != nil {
+		return m.RefundedUlmn
+	}
+	return ""
+}
+
+// MsgSetGatewayDenoms replaces the whitelisted denoms a gateway accepts
+// besides ulmn. Running contracts keep their denom.
+type MsgSetGatewayDenoms struct {
+	Operator  string   `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	GatewayId uint64   `protobuf:"varint,2,opt,name=gateway_id,json=gatewayId,proto3" json:"gateway_id,omitempty"`
+	Denoms    []string `protobuf:"bytes,3,rep,name=denoms,proto3" json:"denoms,omitempty"`
+}
+
+func (m *MsgSetGatewayDenoms) Reset()         { *m = MsgSetGatewayDenoms{} }
+func (m *MsgSetGatewayDenoms) String() string { return proto.CompactTextString(m) }
+func (*MsgSetGatewayDenoms) ProtoMessage()    {}
+func (*MsgSetGatewayDenoms) Descriptor() ([]byte, []int) {
+	return fileDescriptor_586f618667a3f0d9, []int{52}
+}
+func (m *MsgSetGatewayDenoms) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetGatewayDenoms) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetGatewayDenoms.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetGatewayDenoms) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetGatewayDenoms.Merge(m, src)
+}
+func (m *MsgSetGatewayDenoms) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetGatewayDenoms) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetGatewayDenoms.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetGatewayDenoms proto.InternalMessageInfo
+
+func (m *MsgSetGatewayDenoms) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *MsgSetGatewayDenoms) GetGatewayId() uint64 {
+	if m != nil {
+		return m.GatewayId
+	}
+	return 0
+}
+
+func (m *MsgSetGatewayDenoms) GetDenoms() []string {
+	if m != nil {
+		return m.Denoms
+	}
+	return nil
+}
+
+type MsgSetGatewayDenomsResponse struct {
+}
+
+func (m *MsgSetGatewayDenomsResponse) Reset()         { *m = MsgSetGatewayDenomsResponse{} }
+func (m *MsgSetGatewayDenomsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetGatewayDenomsResponse) ProtoMessage()    {}
+func (*MsgSetGatewayDenomsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_586f618667a3f0d9, []int{53}
+}
+func (m *MsgSetGatewayDenomsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetGatewayDenomsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetGatewayDenomsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetGatewayDenomsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetGatewayDenomsResponse.Merge(m, src)
+}
+func (m *MsgSetGatewayDenomsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetGatewayDenomsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetGatewayDenomsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetGatewayDenomsResponse proto.InternalMessageInfo
+
+// MsgTreasurySpend pays out of the gateways treasury. Governance only.
+type MsgTreasurySpend struct {
+	Authority string                                   `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Recipient string                                   `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Amount    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgTreasurySpend) Reset()         { *m = MsgTreasurySpend{} }
+func (m *MsgTreasurySpend) String() string { return proto.CompactTextString(m) }
+func (*MsgTreasurySpend) ProtoMessage()    {}
+func (*MsgTreasurySpend) Descriptor() ([]byte, []int) {
+	return fileDescriptor_586f618667a3f0d9, []int{54}
+}
+func (m *MsgTreasurySpend) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTreasurySpend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTreasurySpend.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTreasurySpend) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTreasurySpend.Merge(m, src)
+}
+func (m *MsgTreasurySpend) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTreasurySpend) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTreasurySpend.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTreasurySpend proto.InternalMessageInfo
+
+func (m *MsgTreasurySpend) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgTreasurySpend) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *MsgTreasurySpend) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+type MsgTreasurySpendResponse struct {
+}
+
+func (m *MsgTreasurySpendResponse) Reset()         { *m = MsgTreasurySpendResponse{} }
+func (m *MsgTreasurySpendResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTreasurySpendResponse) ProtoMessage()    {}
+func (*MsgTreasurySpendResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_586f618667a3f0d9, []int{55}
+}
+func (m *MsgTreasurySpendResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTreasurySpendResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTreasurySpendResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTreasurySpendResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTreasurySpendResponse.Merge(m, src)
+}
+func (m *MsgTreasurySpendResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTreasurySpendResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTreasurySpendResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTreasurySpendResponse proto.InternalMessageInfo
+
+// MsgTransferGateway proposes new_operator as the gateway's operator. An
+// empty new_operator withdraws a pending proposal.
+type MsgTransferGateway struct {
+	Operator    string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	GatewayId   uint64 `protobuf:"varint,2,opt,name=gateway_id,json=gatewayId,proto3" json:"gateway_id,omitempty"`
+	NewOperator string `protobuf:"bytes,3,opt,name=new_operator,json=newOperator,proto3" json:"new_operator,omitempty"`
+}
+
+func (m *MsgTransferGateway) Reset()         { *m = MsgTransferGateway{} }
+func (m *MsgTransferGateway) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferGateway) ProtoMessage()    {}
+func (*MsgTransferGateway) Descriptor() ([]byte, []int) {
+	return fileDescriptor_586f618667a3f0d9, []int{56}
+}
+func (m *MsgTransferGateway) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferGateway) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferGateway.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferGateway) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferGateway.Merge(m, src)
+}
+func (m *MsgTransferGateway) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferGateway) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferGateway.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferGateway proto.InternalMessageInfo
+
+func (m *MsgTransferGateway) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *MsgTransferGateway) GetGatewayId() uint64 {
+	if m != nil {
+		return m.GatewayId
+	}
+	return 0
+}
+
+func (m *MsgTransferGateway) GetNewOperator() string {
+	if m != nil {
+		return m.NewOperator
+	}
+	return ""
+}
+
+type MsgTransferGatewayResponse struct {
+}
+
+func (m *MsgTransferGatewayResponse) Reset()         { *m = MsgTransferGatewayResponse{} }
+func (m *MsgTransferGatewayResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferGatewayResponse) ProtoMessage()    {}
+func (*MsgTransferGatewayResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_586f618667a3f0d9, []int{57}
+}
+func (m *MsgTransferGatewayResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferGatewayResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferGatewayResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferGatewayResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferGatewayResponse.Merge(m, src)
+}
+func (m *MsgTransferGatewayResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferGatewayResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferGatewayResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferGatewayResponse proto.InternalMessageInfo
+
+// MsgAcceptGatewayTransfer completes a transfer; the signer must be the
+// proposed operator.
+type MsgAcceptGatewayTransfer struct {
+	NewOperator string `protobuf:"bytes,1,opt,name=new_operator,json=newOperator,proto3" json:"new_operator,omitempty"`
+	GatewayId   uint64 `protobuf:"varint,2,opt,name=gateway_id,json=gatewayId,proto3" json:"gateway_id,omitempty"`
+}
+
+func (m *MsgAcceptGatewayTransfer) Reset()         { *m = MsgAcceptGatewayTransfer{} }
+func (m *MsgAcceptGatewayTransfer) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptGatewayTransfer) ProtoMessage()    {}
+func (*MsgAcceptGatewayTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_586f618667a3f0d9, []int{58}
+}
+func (m *MsgAcceptGatewayTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptGatewayTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptGatewayTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptGatewayTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptGatewayTransfer.Merge(m, src)
+}
+func (m *MsgAcceptGatewayTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptGatewayTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptGatewayTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptGatewayTransfer proto.InternalMessageInfo
+
+func (m *MsgAcceptGatewayTransfer) GetNewOperator() string {
+	if m != nil {
+		return m.NewOperator
+	}
+	return ""
+}
+
+func (m *MsgAcceptGatewayTransfer) GetGatewayId() uint64 {
+	if m != nil {
+		return m.GatewayId
+	}
+	return 0
+}
+
+type MsgAcceptGatewayTransferResponse struct {
+}
+
+func (m *MsgAcceptGatewayTransferResponse) Reset()         { *m = MsgAcceptGatewayTransferResponse{} }
+func (m *MsgAcceptGatewayTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptGatewayTransferResponse) ProtoMessage()    {}
+func (*MsgAcceptGatewayTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_586f618667a3f0d9, []int{59}
+}
+func (m *MsgAcceptGatewayTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptGatewayTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptGatewayTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptGatewayTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptGatewayTransferResponse.Merge(m, src)
+}
+func (m *MsgAcceptGatewayTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptGatewayTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptGatewayTransferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptGatewayTransferResponse proto.InternalMessageInfo
+
+// MsgDecommissionGateway announces that the gateway shuts down at end_time.
+// Active contracts still running at end_time are closed pro rata. An
+// end_time of 0 withdraws the announcement before it takes effect.
+type MsgDecommissionGateway struct {
+	Operator  string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	GatewayId uint64 `protobuf:"varint,2,opt,name=gateway_id,json=gatewayId,proto3" json:"gateway_id,omitempty"`
+	EndTime   uint64 `protobuf:"varint,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (m *MsgDecommissionGateway) Reset()         { *m = MsgDecommissionGateway{} }
+func (m *MsgDecommissionGateway) String() string { return proto.CompactTextString(m) }
+func (*MsgDecommissionGateway) ProtoMessage()    {}
+func (*MsgDecommissionGateway) Descriptor() ([]byte, []int) {
+	return fileDescriptor_586f618667a3f0d9, []int{60}
+}
+func (m *MsgDecommissionGateway) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDecommissionGateway) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDecommissionGateway.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDecommissionGateway) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDecommissionGateway.Merge(m, src)
+}
+func (m *MsgDecommissionGateway) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDecommissionGateway) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDecommissionGateway.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDecommissionGateway proto.InternalMessageInfo
+
+func (m *MsgDecommissionGateway) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *MsgDecommissionGateway) GetGatewayId() uint64 {
+	if m != nil {
+		return m.GatewayId
+	}
+	return 0
+}
+
+func (m *MsgDecommissionGateway) GetEndTime() uint64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+type MsgDecommissionGatewayResponse struct {
+}
+
+func (m *MsgDecommissionGatewayResponse) Reset()         { *m = MsgDecommissionGatewayResponse{} }
+func (m *MsgDecommissionGatewayResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDecommissionGatewayResponse) ProtoMessage()    {}
+func (*MsgDecommissionGatewayResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_586f618667a3f0d9, []int{61}
+}
+func (m *MsgDecommissionGatewayResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDecommissionGatewayResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDecommissionGatewayResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDecommissionGatewayResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDecommissionGatewayResponse.Merge(m, src)
+}
+func (m *MsgDecommissionGatewayResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDecommissionGatewayResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDecommissionGatewayResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDecommissionGatewayResponse proto.InternalMessageInfo
+
+// MsgMigrateContract settles a contract with a decommissioning gateway and
+// moves the client's remaining escrow into a new contract with another
+// gateway. The new contract runs for as many whole months as the remaining
+// escrow covers at the new price; the rest is refunded.
+type MsgMigrateContract struct {
+	Client       string `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
+	ContractId   uint64 `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	NewGatewayId uint64 `protobuf:"varint,3,opt,name=new_gateway_id,json=newGatewayId,proto3" json:"new_gateway_id,omitempty"`
+	PriceUlmn    uint64 `protobuf:"varint,4,opt,name=price_ulmn,json=priceUlmn,proto3" json:"price_ulmn,omitempty"`
+	OfferId      uint64 `protobuf:"varint,5,opt,name=offer_id,json=offerId,proto3" json:"offer_id,omitempty"`
+}
+
+func (m *MsgMigrateContract) Reset()         { *m = MsgMigrateContract{} }
+func (m *MsgMigrateContract) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateContract) ProtoMessage()    {}
+func (*MsgMigrateContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_586f618667a3f0d9, []int{62}
+}
+func (m *MsgMigrateContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateContract.Merge(m, src)
+}
+func (m *MsgMigrateContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateContract proto.InternalMessageInfo
+
+func (m *MsgMigrateContract) GetClient() string {
+	if m != nil {
+		return m.Client
+	}
+	return ""
+}
+
+func (m *MsgMigrateContract) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
+	}
+	return 0
+}
+
+func (m *MsgMigrateContract) GetNewGatewayId() uint64 {
+	if m != nil {
+		return m.NewGatewayId
+	}
+	return 0
+}
+
+func (m *MsgMigrateContract) GetPriceUlmn() uint64 {
+	if m != nil {
+		return m.PriceUlmn
+	}
+	return 0
+}
+
+func (m *MsgMigrateContract) GetOfferId() uint64 {
+	if m != nil {
+		return m.OfferId
+	}
+	return 0
+}
+
+type MsgMigrateContractResponse struct {
+	NewContractId uint64 `protobuf:"varint,1,opt,name=new_contract_id,json=newContractId,proto3" json:"new_contract_id,omitempty"`
+	MonthsTotal   uint32 `protobuf:"varint,2,opt,name=months_total,json=monthsTotal,proto3" json:"months_total,omitempty"`
+	RefundedUlmn  string `protobuf:"bytes,3,opt,name=refunded_ulmn,json=refundedUlmn,proto3" json:"refunded_ulmn,omitempty"`
+}
+
+func (m *MsgMigrateContractResponse) Reset()         { *m = MsgMigrateContractResponse{} }
+func (m *MsgMigrateContractResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateContractResponse) ProtoMessage()    {}
+func (*MsgMigrateContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_586f618667a3f0d9, []int{63}
+}
+func (m *MsgMigrateContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateContractResponse.Merge(m, src)
+}
+func (m *MsgMigrateContractResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateContractResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateContractResponse proto.InternalMessageInfo
+
+func (m *MsgMigrateContractResponse) GetNewContractId() uint64 {
+	if m != nil {
+		return m.NewContractId
+	}
+	return 0
+}
+
+func (m *MsgMigrateContractResponse) GetMonthsTotal() uint32 {
+	if m != nil {
+		return m.MonthsTotal
+	}
+	return 0
+}
+
+func (m *MsgMigrateContractResponse) GetRefundedUlmn() string {
+	if m != nil {
+		return m.RefundedUlmn
+	}
+	return ""
+}
+
+// MsgGatewayHeartbeat tells the chain the gateway is online. A gateway that
+// was deactivated for missed heartbeats is re-activated by it.
+type MsgGatewayHeartbeat struct {
+	Operator  string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	GatewayId uint64 `protobuf:"varint,2,opt,name=gateway_id,json=gatewayId,proto3" json:"gateway_id,omitempty"`
+}
+
+func (m *MsgGatewayHeartbeat) Reset()         { *m = MsgGatewayHeartbeat{} }
+func (m *MsgGatewayHeartbeat) String() string { return proto.CompactTextString(m) }
+func (*MsgGatewayHeartbeat) ProtoMessage()    {}
+func (*MsgGatewayHeartbeat) Descriptor() ([]byte, []int) {
+	return fileDescriptor_586f618667a3f0d9, []int{64}
+}
+func (m *MsgGatewayHeartbeat) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGatewayHeartbeat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGatewayHeartbeat.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGatewayHeartbeat) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGatewayHeartbeat.Merge(m, src)
+}
+func (m *MsgGatewayHeartbeat) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGatewayHeartbeat) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGatewayHeartbeat.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGatewayHeartbeat proto.InternalMessageInfo
+
+func (m *MsgGatewayHeartbeat) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *MsgGatewayHeartbeat) GetGatewayId() uint64 {
+	if m != nil {
+		return m.GatewayId
+	}
+	return 0
+}
+
+type MsgGatewayHeartbeatResponse struct {
+	OfflineAt uint64 `protobuf:"varint,1,opt,name=offline_at,json=offlineAt,proto3" json:"offline_at,omitempty"`
+}
+
+func (m *MsgGatewayHeartbeatResponse) Reset()         { *m = MsgGatewayHeartbeatResponse{} }
+func (m *MsgGatewayHeartbeatResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGatewayHeartbeatResponse) ProtoMessage()    {}
+func (*MsgGatewayHeartbeatResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_586f618667a3f0d9, []int{65}
+}
+func (m *MsgGatewayHeartbeatResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGatewayHeartbeatResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGatewayHeartbeatResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGatewayHeartbeatResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGatewayHeartbeatResponse.Merge(m, src)
+}
+func (m *MsgGatewayHeartbeatResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGatewayHeartbeatResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGatewayHeartbeatResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGatewayHeartbeatResponse proto.InternalMessageInfo
+
+func (m *MsgGatewayHeartbeatResponse) GetOfflineAt() uint64 {
+	if m != nil {
+		return m.OfflineAt
+	}
+	return 0
+}
+
+// MsgTransferContract hands the contract, with its refund and cancel rights,
+// to new_client. At a gateway that requires consent the transfer waits for
+// MsgApproveContractTransfer; an empty new_client withdraws it.
+type MsgTransferContract struct {
+	Client     string `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
+	ContractId uint64 `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	NewClient  string `protobuf:"bytes,3,opt,name=new_client,json=newClient,proto3" json:"new_client,omitempty"`
+}
+
+func (m *MsgTransferContract) Reset()         { *m = MsgTransferContract{} }
+func (m *MsgTransferContract) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferContract) ProtoMessage()    {}
+func (*MsgTransferContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_586f618667a3f0d9, []int{66}
+}
+func (m *MsgTransferContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferContract.Merge(m, src)
+}
+func (m *MsgTransferContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferContract proto.InternalMessageInfo
+
+func (m *MsgTransferContract) GetClient() string {
+	if m != nil {
+		return m.Client
+	}
+	return ""
+}
+
+func (m *MsgTransferContract) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
+	}
+	return 0
+}
+
+func (m *MsgTransferContract) GetNewClient() string {
+	if m != nil {
+		return m.NewClient
+	}
+	return ""
+}
+
+type MsgTransferContractResponse struct {
+	Pending bool `protobuf:"varint,1,opt,name=pending,proto3" json:"pending,omitempty"`
+}
+
+func (m *MsgTransferContractResponse) Reset()         { *m = MsgTransferContractResponse{} }
+func (m *MsgTransferContractResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferContractResponse) ProtoMessage()    {}
+func (*MsgTransferContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_586f618667a3f0d9, []int{67}
+}
+func (m *MsgTransferContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferContractResponse.Merge(m, src)
+}
+func (m *MsgTransferContractResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferContractResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferContractResponse proto.InternalMessageInfo
+
+func (m *MsgTransferContractResponse) GetPending() bool {
+	if m != nil {
+		return m.Pending
+	}
+	return false
+}
+
+// MsgApproveContractTransfer completes a pending contract transfer; the
+// signer must be the gateway operator.
+type MsgApproveContractTransfer struct {
+	Operator   string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	ContractId uint64 `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+}
+
+func (m *MsgApproveContractTransfer) Reset()         { *m = MsgApproveContractTransfer{} }
+func (m *MsgApproveContractTransfer) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveContractTransfer) ProtoMessage()    {}
+func (*MsgApproveContractTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_586f618667a3f0d9, []int{68}
+}
+func (m *MsgApproveContractTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApproveContractTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveContractTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgApproveContractTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveContractTransfer.Merge(m, src)
+}
+func (m *MsgApproveContractTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveContractTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveContractTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgApproveContractTransfer proto.InternalMessageInfo
+
+func (m *MsgApproveContractTransfer) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *MsgApproveContractTransfer) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
+	}
+	return 0
+}
+
+type MsgApproveContractTransferResponse struct {
+}
+
+func (m *MsgApproveContractTransferResponse) Reset()         { *m = MsgApproveContractTransferResponse{} }
+func (m *MsgApproveContractTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveContractTransferResponse) ProtoMessage()    {}
+func (*MsgApproveContractTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_586f618667a3f0d9, []int{69}
+}
+func (m *MsgApproveContractTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApproveContractTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveContractTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgApproveContractTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveContractTransferResponse.Merge(m, src)
+}
+func (m *MsgApproveContractTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveContractTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveContractTransferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgApproveContractTransferResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgRegisterGateway)(nil), "lumen.gateway.v1.MsgRegisterGateway")
+	proto.RegisterType((*MsgRegisterGatewayResponse)(nil), "lumen.gateway.v1.MsgRegisterGatewayResponse")
+	proto.RegisterType((*MsgUpdateGateway)(nil), "lumen.gateway.v1.MsgUpdateGateway")
+	proto.RegisterType((*MsgUpdateGatewayResponse)(nil), "lumen.gateway.v1.MsgUpdateGatewayResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "lumen.gateway.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "lumen.gateway.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgCreateContract)(nil), "lumen.gateway.v1.MsgCreateContract")
+	proto.RegisterType((*MsgCreateContractResponse)(nil), "lumen.gateway.v1.MsgCreateContractResponse")
+	proto.RegisterType((*MsgClaimPayment)(nil), "lumen.gateway.v1.MsgClaimPayment")
+	proto.RegisterType((*MsgClaimPaymentResponse)(nil), "lumen.gateway.v1.MsgClaimPaymentResponse")
+	proto.RegisterType((*MsgCancelContract)(nil), "lumen.gateway.v1.MsgCancelContract")
+	proto.RegisterType((*MsgCancelContractResponse)(nil), "lumen.gateway.v1.MsgCancelContractResponse")
+	proto.RegisterType((*MsgFinalizeContract)(nil), "lumen.gateway.v1.MsgFinalizeContract")
+	proto.RegisterType((*MsgFinalizeContractResponse)(nil), "lumen.gateway.v1.MsgFinalizeContractResponse")
+	proto.RegisterType((*MsgBindDomain)(nil), "lumen.gateway.v1.MsgBindDomain")
+	proto.RegisterType((*MsgBindDomainResponse)(nil), "lumen.gateway.v1.MsgBindDomainResponse")
+	proto.RegisterType((*MsgUnbindDomain)(nil), "lumen.gateway.v1.MsgUnbindDomain")
+	proto.RegisterType((*MsgUnbindDomainResponse)(nil), "lumen.gateway.v1.MsgUnbindDomainResponse")
+	proto.RegisterType((*MsgSubmitUsageReport)(nil), "lumen.gateway.v1.MsgSubmitUsageReport")
+	proto.RegisterType((*MsgSubmitUsageReportResponse)(nil), "lumen.gateway.v1.MsgSubmitUsageReportResponse")
+	proto.RegisterType((*MsgAcknowledgeUsageReport)(nil), "lumen.gateway.v1.MsgAcknowledgeUsageReport")
+	proto.RegisterType((*MsgAcknowledgeUsageReportResponse)(nil), "lumen.gateway.v1.MsgAcknowledgeUsageReportResponse")
+	proto.RegisterType((*MsgDisputeUsageReport)(nil), "lumen.gateway.v1.MsgDisputeUsageReport")
+	proto.RegisterType((*MsgDisputeUsageReportResponse)(nil), "lumen.gateway.v1.MsgDisputeUsageReportResponse")
+	proto.RegisterType((*MsgOpenDispute)(nil), "lumen.gateway.v1.MsgOpenDispute")
+	proto.RegisterType((*MsgOpenDisputeResponse)(nil), "lumen.gateway.v1.MsgOpenDisputeResponse")
+	proto.RegisterType((*MsgSubmitDisputeEvidence)(nil), "lumen.gateway.v1.MsgSubmitDisputeEvidence")
+	proto.RegisterType((*MsgSubmitDisputeEvidenceResponse)(nil), "lumen.gateway.v1.MsgSubmitDisputeEvidenceResponse")
+	proto.RegisterType((*MsgResolveDispute)(nil), "lumen.gateway.v1.MsgResolveDispute")
+	proto.RegisterType((*MsgResolveDisputeResponse)(nil), "lumen.gateway.v1.MsgResolveDisputeResponse")
+	proto.RegisterType((*MsgBondGateway)(nil), "lumen.gateway.v1.MsgBondGateway")
+	proto.RegisterType((*MsgBondGatewayResponse)(nil), "lumen.gateway.v1.MsgBondGatewayResponse")
+	proto.RegisterType((*MsgUnbondGateway)(nil), "lumen.gateway.v1.MsgUnbondGateway")
+	proto.RegisterType((*MsgUnbondGatewayResponse)(nil), "lumen.gateway.v1.MsgUnbondGatewayResponse")
+	proto.RegisterType((*MsgWithdrawBond)(nil), "lumen.gateway.v1.MsgWithdrawBond")
+	proto.RegisterType((*MsgWithdrawBondResponse)(nil), "lumen.gateway.v1.MsgWithdrawBondResponse")
+	proto.RegisterType((*MsgCreateOffer)(nil), "lumen.gateway.v1.MsgCreateOffer")
+	proto.RegisterType((*MsgCreateOfferResponse)(nil), "lumen.gateway.v1.MsgCreateOfferResponse")
+	proto.RegisterType((*MsgRetireOffer)(nil), "lumen.gateway.v1.MsgRetireOffer")
+	proto.RegisterType((*MsgRetireOfferResponse)(nil), "lumen.gateway.v1.MsgRetireOfferResponse")
+	proto.RegisterType((*MsgExtendContract)(nil), "lumen.gateway.v1.MsgExtendContract")
+	proto.RegisterType((*MsgExtendContractResponse)(nil), "lumen.gateway.v1.MsgExtendContractResponse")
+	proto.RegisterType((*MsgAmendContract)(nil), "lumen.gateway.v1.MsgAmendContract")
+	proto.RegisterType((*MsgAmendContractResponse)(nil), "lumen.gateway.v1.MsgAmendContractResponse")
+	proto.RegisterType((*MsgAcceptAmendment)(nil), "lumen.gateway.v1.MsgAcceptAmendment")
+	proto.RegisterType((*MsgAcceptAmendmentResponse)(nil), "lumen.gateway.v1.MsgAcceptAmendmentResponse")
+	proto.RegisterType((*MsgClaimAll)(nil), "lumen.gateway.v1.MsgClaimAll")
+	proto.RegisterType((*MsgClaimAllResponse)(nil), "lumen.gateway.v1.MsgClaimAllResponse")
+	proto.RegisterType((*MsgAcceptContract)(nil), "lumen.gateway.v1.MsgAcceptContract")
+	proto.RegisterType((*MsgAcceptContractResponse)(nil), "lumen.gateway.v1.MsgAcceptContractResponse")
+	proto.RegisterType((*MsgRejectContract)(nil), "lumen.gateway.v1.MsgRejectContract")
+	proto.RegisterType((*MsgRejectContractResponse)(nil), "lumen.gateway.v1.MsgRejectContractResponse")
+	proto.RegisterType((*MsgSetGatewayDenoms)(nil), "lumen.gateway.v1.MsgSetGatewayDenoms")
+	proto.RegisterType((*MsgSetGatewayDenomsResponse)(nil), "lumen.gateway.v1.MsgSetGatewayDenomsResponse")
+	proto.RegisterType((*MsgTreasurySpend)(nil), "lumen.gateway.v1.MsgTreasurySpend")
+	proto.RegisterType((*MsgTreasurySpendResponse)(nil), "lumen.gateway.v1.MsgTreasurySpendResponse")
+	proto.RegisterType((*MsgTransferGateway)(nil), "lumen.gateway.v1.MsgTransferGateway")
+	proto.RegisterType((*MsgTransferGatewayResponse)(nil), "lumen.gateway.v1.MsgTransferGatewayResponse")
+	proto.RegisterType((*MsgAcceptGatewayTransfer)(nil), "lumen.gateway.v1.MsgAcceptGatewayTransfer")
+	proto.RegisterType((*MsgAcceptGatewayTransferResponse)(nil), "lumen.gateway.v1.MsgAcceptGatewayTransferResponse")
+	proto.RegisterType((*MsgDecommissionGateway)(nil), "lumen.gateway.v1.MsgDecommissionGateway")
+	proto.RegisterType((*MsgDecommissionGatewayResponse)(nil), "lumen.gateway.v1.MsgDecommissionGatewayResponse")
+	proto.RegisterType((*MsgMigrateContract)(nil), "lumen.gateway.v1.MsgMigrateContract")
+	proto.RegisterType((*MsgMigrateContractResponse)(nil), "lumen.gateway.v1.MsgMigrateContractResponse")
+	proto.RegisterType((*MsgGatewayHeartbeat)(nil), "lumen.gateway.v1.MsgGatewayHeartbeat")
+	proto.RegisterType((*MsgGatewayHeartbeatResponse)(nil), "lumen.gateway.v1.MsgGatewayHeartbeatResponse")
+	proto.RegisterType((*MsgTransferContract)(nil), "lumen.gateway.v1.MsgTransferContract")
+	proto.RegisterType((*MsgTransferContractResponse)(nil), "lumen.gateway.v1.MsgTransferContractResponse")
+	proto.RegisterType((*MsgApproveContractTransfer)(nil), "lumen.gateway.v1.MsgApproveContractTransfer")
+	proto.RegisterType((*MsgApproveContractTransferResponse)(nil), "lumen.gateway.v1.MsgApproveContractTransferResponse")
+}
+
+func init() { proto.RegisterFile("lumen/gateway/v1/tx.proto", fileDescriptor_586f618667a3f0d9) }
+
+var fileDescriptor_586f618667a3f0d9 = []byte{
+	// 2820 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcd, 0x6f, 0xdc, 0xc6,
+	0x15, 0x37, 0x25, 0xd9, 0xd2, 0xbe, 0x95, 0x64, 0x6b, 0xed, 0x48, 0xbb, 0xb4, 0x25, 0xcb, 0x6b,
+	0x3b, 0x95, 0x65, 0x7b, 0x37, 0x96, 0x5d, 0xb7, 0x51, 0x82, 0x20, 0x92, 0x9c, 0x26, 0x3e, 0x08,
+	0x11, 0x68, 0x3b, 0x45, 0x8b, 0x00, 0x8b, 0x59, 0x72, 0x44, 0xb1, 0xe6, 0x57, 0x49, 0xae, 0x64,
+	0x05, 0x68, 0x51, 0xe4, 0x52, 0xf4, 0x0b, 0x68, 0x80, 0x16, 0x39, 0xf4, 0x52, 0x14, 0x68, 0x51,
+	0x14, 0x28, 0x90, 0x43, 0x0f, 0x05, 0xfa, 0x0f, 0xe4, 0x18, 0x14, 0x3d, 0xf4, 0xd4, 0x0f, 0xfb,
+	0x10, 0xf4, 0x5c, 0x14, 0xbd, 0x16, 0xf3, 0xc1, 0xd9, 0xe1, 0x90, 0x2b, 0xae, 0xe3, 0xae, 0x93,
+	0x8b, 0x2d, 0xce, 0xfc, 0xde, 0xbc, 0xcf, 0x79, 0x33, 0xef, 0xcd, 0x42, 0xc3, 0xed, 0x79, 0xd8,
+	0x6f, 0xdb, 0x28, 0xc1, 0x07, 0xe8, 0xb0, 0xbd, 0x7f, 0xa3, 0x9d, 0x3c, 0x6a, 0x85, 0x51, 0x90,
+	0x04, 0xb5, 0x53, 0x74, 0xaa, 0xc5, 0xa7, 0x5a, 0xfb, 0x37, 0xf4, 0x39, 0xe4, 0x39, 0x7e, 0xd0,
+	0xa6, 0xff, 0x32, 0x90, 0xbe, 0x60, 0x06, 0xb1, 0x17, 0xc4, 0x6d, 0x2f, 0xb6, 0x09, 0xb1, 0x17,
+	0xdb, 0x7c, 0xa2, 0xc1, 0x26, 0x3a, 0xf4, 0xab, 0xcd, 0x3e, 0xf8, 0xd4, 0x12, 0xa7, 0xe9, 0xa2,
+	0x18, 0xb7, 0xf7, 0x6f, 0x74, 0x71, 0x82, 0x6e, 0xb4, 0xcd, 0xc0, 0xf1, 0xf9, 0xfc, 0x19, 0x3b,
+	0xb0, 0x03, 0x46, 0x47, 0xfe, 0x4a, 0xa9, 0xec, 0x20, 0xb0, 0x5d, 0xdc, 0xa6, 0x5f, 0xdd, 0xde,
+	0x6e, 0xfb, 0x20, 0x42, 0x61, 0x88, 0xa3, 0x74, 0xd5, 0x73, 0x79, 0x4d, 0x0e, 0x43, 0x9c, 0xce,
+	0x2e, 0xe6, 0x66, 0x43, 0x14, 0x21, 0x8f, 0x4f, 0x37, 0x9f, 0x68, 0x50, 0xdb, 0x8e, 0x6d, 0x03,
+	0xdb, 0x4e, 0x9c, 0xe0, 0xe8, 0x4d, 0x06, 0xab, 0xdd, 0x82, 0xa9, 0x20, 0xc4, 0x11, 0x4a, 0x82,
+	0xa8, 0xae, 0x2d, 0x6b, 0x2b, 0x95, 0xcd, 0xfa, 0x9f, 0xff, 0x70, 0xfd, 0x0c, 0xd7, 0x66, 0xc3,
+	0xb2, 0x22, 0x1c, 0xc7, 0xf7, 0x92, 0xc8, 0xf1, 0x6d, 0x43, 0x20, 0x6b, 0x2f, 0xc1, 0x89, 0x10,
+	0x1d, 0x06, 0xbd, 0xa4, 0x3e, 0x56, 0x42, 0xc3, 0x71, 0x35, 0x1d, 0xa6, 0x3c, 0x9c, 0x20, 0x0b,
+	0x25, 0xa8, 0x3e, 0x4e, 0x68, 0x0c, 0xf1, 0x5d, 0x5b, 0x87, 0xc9, 0x30, 0x0a, 0x76, 0x1d, 0x17,
+	0xd7, 0x27, 0x96, 0xb5, 0x95, 0xea, 0xda, 0x72, 0x4b, 0x75, 0x4c, 0x8b, 0xcb, 0xbb, 0xc3, 0x70,
+	0x46, 0x4a, 0xb0, 0x3e, 0xf3, 0xfe, 0xa7, 0x1f, 0xad, 0x0a, 0xc1, 0x9a, 0xd7, 0x40, 0xcf, 0x2b,
+	0x69, 0xe0, 0x38, 0x0c, 0xfc, 0x18, 0xd7, 0x66, 0x61, 0xcc, 0xb1, 0xa8, 0x9a, 0x13, 0xc6, 0x98,
+	0x63, 0x35, 0xff, 0x35, 0x0e, 0xa7, 0xb6, 0x63, 0xfb, 0x41, 0x68, 0xa1, 0x04, 0x3f, 0x9b, 0x45,
+	0x16, 0x01, 0xb8, 0xb4, 0x1d, 0xc7, 0xa2, 0x56, 0x99, 0x30, 0x2a, 0x7c, 0xe4, 0xae, 0x55, 0xbb,
+	0x25, 0x0c, 0x36, 0x4e, 0x35, 0x3c, 0xd7, 0x62, 0xbe, 0x6e, 0xa5, 0xbe, 0x6e, 0xb1, 0x15, 0xdf,
+	0x41, 0x6e, 0x0f, 0x0b, 0xa3, 0x7d, 0x55, 0x32, 0xda, 0xc4, 0x10, 0x74, 0x7d, 0x93, 0xae, 0xc1,
+	0x09, 0x64, 0x26, 0xce, 0x3e, 0xae, 0x1f, 0xa7, 0x74, 0x7a, 0x8e, 0x6e, 0x33, 0x08, 0x5c, 0xce,
+	0x8d, 0x21, 0x6b, 0x2f, 0x03, 0xa0, 0x5e, 0x12, 0x74, 0x4c, 0x17, 0x39, 0x5e, 0xfd, 0x44, 0x29,
+	0x5d, 0x85, 0xa0, 0xb7, 0x08, 0x58, 0xf6, 0xe0, 0xe4, 0x53, 0x7a, 0xb0, 0xf6, 0x0e, 0x34, 0xcc,
+	0xc0, 0x4f, 0x22, 0x64, 0x26, 0x9d, 0x24, 0x42, 0x7e, 0xbc, 0x8b, 0xa3, 0x8e, 0x49, 0xfc, 0xe5,
+	0x27, 0xf5, 0xa9, 0x52, 0x29, 0x16, 0x52, 0xe2, 0xfb, 0x9c, 0x76, 0x8b, 0x91, 0xaa, 0x91, 0xa1,
+	0x43, 0x5d, 0x75, 0x75, 0x1a, 0x17, 0xcd, 0x3f, 0x69, 0x70, 0x52, 0x4c, 0xee, 0xd0, 0x5d, 0x53,
+	0xbb, 0x0d, 0x44, 0xbf, 0xbd, 0x20, 0x72, 0x92, 0xc3, 0xd2, 0x38, 0xe8, 0x43, 0x6b, 0xaf, 0x10,
+	0x4f, 0x93, 0x15, 0x68, 0x10, 0x54, 0xd7, 0xea, 0x79, 0x4b, 0x30, 0x0e, 0x9b, 0x95, 0x8f, 0xff,
+	0x76, 0xfe, 0xd8, 0x6f, 0x3f, 0xfd, 0x68, 0x55, 0x33, 0x38, 0xc9, 0xfa, 0x4d, 0x22, 0x73, 0x7f,
+	0xb1, 0x1f, 0x7e, 0xfa, 0xd1, 0xea, 0x32, 0xdb, 0xd6, 0x8f, 0xd2, 0x8d, 0x1d, 0xb7, 0x15, 0x49,
+	0x9b, 0x0d, 0x58, 0x50, 0x86, 0x84, 0x62, 0x8f, 0xc7, 0x60, 0x6e, 0x3b, 0xb6, 0xb7, 0x22, 0x8c,
+	0x12, 0xbc, 0xc5, 0x0d, 0x45, 0x76, 0xaf, 0xe9, 0x3a, 0xc4, 0xbc, 0x65, 0x7a, 0x71, 0x5c, 0x59,
+	0x74, 0x2f, 0x02, 0x84, 0x91, 0x63, 0xe2, 0x4e, 0xcf, 0xf5, 0x7c, 0x1a, 0xe1, 0x13, 0x46, 0x85,
+	0x8e, 0x3c, 0x70, 0x3d, 0xbf, 0xd6, 0x86, 0x33, 0x71, 0x12, 0x44, 0xc8, 0xc6, 0x1d, 0xbb, 0xdb,
+	0x09, 0x71, 0xd4, 0xf1, 0x02, 0x3f, 0xd9, 0xa3, 0x21, 0x3d, 0x61, 0xcc, 0xf1, 0xb9, 0x37, 0xbb,
+	0x3b, 0x38, 0xda, 0x26, 0x13, 0x84, 0xc0, 0xc7, 0xc9, 0x41, 0x10, 0x3d, 0xcc, 0x12, 0x1c, 0x67,
+	0x04, 0x7c, 0x4e, 0x22, 0xb8, 0x00, 0xd3, 0x14, 0x11, 0x77, 0x92, 0x20, 0x41, 0x2e, 0x0d, 0xde,
+	0x19, 0xa3, 0xca, 0xc6, 0xee, 0x93, 0xa1, 0x4c, 0x02, 0x9a, 0x54, 0x12, 0x50, 0x03, 0xa6, 0x82,
+	0x5d, 0x12, 0x76, 0x8e, 0x45, 0x23, 0x6e, 0xc2, 0x98, 0xa4, 0xdf, 0x77, 0xad, 0xda, 0x19, 0x38,
+	0x6e, 0x61, 0x3f, 0xf0, 0xea, 0x15, 0x4a, 0xc3, 0x3e, 0xd6, 0xab, 0xc4, 0x4f, 0xdc, 0x38, 0xcd,
+	0x57, 0xa1, 0x91, 0xb3, 0xb1, 0x48, 0x39, 0xe7, 0xa1, 0x2a, 0xa2, 0x5b, 0xe4, 0x1e, 0x48, 0x87,
+	0xee, 0x5a, 0xcd, 0x03, 0x1a, 0x7a, 0x74, 0x1b, 0xed, 0xa0, 0x43, 0x8f, 0x58, 0xfb, 0xb3, 0x65,
+	0x20, 0x85, 0xd3, 0x98, 0xca, 0x49, 0xdd, 0x10, 0xb7, 0x61, 0x41, 0x61, 0x2c, 0x84, 0x3e, 0x0b,
+	0x95, 0x10, 0x39, 0x16, 0x73, 0xa7, 0xc6, 0x8c, 0x45, 0x06, 0x88, 0x37, 0x9b, 0x31, 0x0b, 0x29,
+	0xe4, 0x9b, 0xd8, 0x7d, 0x86, 0x90, 0x2a, 0x15, 0x37, 0x63, 0xe3, 0xd7, 0xa1, 0x91, 0x63, 0x2a,
+	0xc4, 0xbd, 0x08, 0x33, 0x11, 0xde, 0xed, 0xf9, 0x16, 0xce, 0x88, 0x3c, 0x9d, 0x0e, 0x52, 0xb1,
+	0xbf, 0x0b, 0xa7, 0xb7, 0x63, 0xfb, 0x6b, 0x8e, 0x8f, 0x5c, 0xe7, 0xbd, 0xfe, 0x5e, 0xb8, 0x0d,
+	0x95, 0x5d, 0x3e, 0x56, 0x6e, 0xec, 0x3e, 0xb4, 0x5c, 0xfc, 0x59, 0xba, 0x95, 0x05, 0x41, 0xf3,
+	0x35, 0x38, 0x5b, 0xc0, 0x5f, 0x8e, 0x93, 0x08, 0x1f, 0xa0, 0x28, 0xa3, 0x01, 0xb0, 0x21, 0x2a,
+	0xff, 0x8f, 0x34, 0x98, 0xd9, 0x8e, 0xed, 0x4d, 0xc7, 0xb7, 0xee, 0x04, 0x1e, 0x72, 0xfc, 0xd1,
+	0x1c, 0x54, 0xf3, 0x70, 0xc2, 0xa2, 0xcb, 0xf3, 0x53, 0x9a, 0x7f, 0xa9, 0xc1, 0xb3, 0x00, 0x2f,
+	0x64, 0x84, 0x11, 0x19, 0xe7, 0x27, 0x3c, 0x95, 0xfa, 0xdd, 0x2f, 0x86, 0xa0, 0x3c, 0x39, 0xfa,
+	0xdd, 0xbc, 0xa8, 0xff, 0xd1, 0xe0, 0xcc, 0x76, 0x6c, 0xdf, 0xeb, 0x75, 0x3d, 0x27, 0x79, 0x10,
+	0x23, 0x1b, 0x1b, 0x38, 0x0c, 0xa2, 0x51, 0xed, 0x3f, 0x92, 0x4a, 0x58, 0x1a, 0x1b, 0xa7, 0xd9,
+	0x89, 0x7d, 0x10, 0x35, 0xfb, 0xc9, 0x91, 0xa7, 0xc4, 0x8a, 0x48, 0x89, 0x64, 0xba, 0x9f, 0x0a,
+	0x79, 0x02, 0xac, 0x88, 0x04, 0x48, 0x42, 0x1f, 0xef, 0x3b, 0x16, 0xf6, 0x4d, 0xdc, 0xd9, 0x43,
+	0xf1, 0x1e, 0xcd, 0x7c, 0x15, 0x63, 0x3a, 0x1d, 0x7c, 0x0b, 0xc5, 0x7b, 0xaa, 0x49, 0xee, 0xc2,
+	0xb9, 0x22, 0xb5, 0x45, 0x28, 0x5e, 0x81, 0x53, 0x96, 0x13, 0x87, 0xbd, 0x04, 0x77, 0x2c, 0x8c,
+	0x2c, 0xd7, 0xf1, 0x31, 0xcf, 0x5b, 0x27, 0xf9, 0xf8, 0x1d, 0x3e, 0xdc, 0xfc, 0x40, 0xa3, 0xfb,
+	0x72, 0xc3, 0x7c, 0xe8, 0x07, 0x07, 0x2e, 0xb6, 0x6c, 0x2c, 0xdb, 0xf1, 0xff, 0x9f, 0x14, 0x8a,
+	0x6d, 0x98, 0x4d, 0x15, 0x17, 0xe1, 0xc2, 0x40, 0x91, 0x84, 0xef, 0x7f, 0xad, 0xd1, 0x00, 0xbe,
+	0xc3, 0xf4, 0xf9, 0x3c, 0x84, 0x26, 0x01, 0x1c, 0x61, 0x14, 0x07, 0x3e, 0x75, 0x7a, 0xc5, 0xe0,
+	0x5f, 0x59, 0x65, 0x5e, 0x83, 0xc5, 0x42, 0x31, 0x85, 0xb3, 0x16, 0x01, 0x52, 0x67, 0x89, 0xe3,
+	0xa5, 0xc2, 0x47, 0xee, 0x5a, 0xcd, 0xdf, 0x6b, 0x30, 0xbb, 0x1d, 0xdb, 0x6f, 0x87, 0xd8, 0xe7,
+	0x8b, 0x8c, 0x42, 0xc1, 0xbe, 0x2a, 0xe3, 0xb2, 0x2a, 0xf9, 0xe8, 0x9c, 0x28, 0x88, 0xce, 0x8c,
+	0xbe, 0xf7, 0x60, 0x3e, 0x2b, 0xee, 0x90, 0x8a, 0x92, 0xe3, 0x5d, 0x04, 0x2b, 0x13, 0x50, 0x7c,
+	0x37, 0x7f, 0xa3, 0x41, 0x5d, 0x44, 0x3c, 0x5f, 0xf7, 0x0d, 0x2e, 0x02, 0x39, 0x00, 0x62, 0x3a,
+	0x91, 0x0c, 0x73, 0x00, 0x08, 0xa8, 0x22, 0xcf, 0x98, 0x2a, 0x4f, 0x4e, 0xf5, 0xf1, 0x02, 0xd5,
+	0xd9, 0x19, 0x21, 0xd6, 0x6c, 0x36, 0x61, 0x79, 0x90, 0x9c, 0x22, 0x72, 0xff, 0xa8, 0xd1, 0xf3,
+	0xd7, 0xc0, 0x71, 0xe0, 0xee, 0xe3, 0xd4, 0xa9, 0x6b, 0x30, 0x89, 0xa2, 0xae, 0x33, 0x8c, 0x0e,
+	0x29, 0xb0, 0x4c, 0x83, 0x55, 0x98, 0x63, 0x4e, 0xe9, 0xb0, 0x73, 0xb4, 0xd3, 0x0d, 0x63, 0x1e,
+	0xc1, 0x27, 0xd9, 0x84, 0x41, 0xc7, 0x37, 0xc3, 0x98, 0x06, 0x40, 0xcf, 0x75, 0x7c, 0x5b, 0xc4,
+	0x32, 0xfd, 0x5a, 0x9f, 0x26, 0x0a, 0xa6, 0x0c, 0x9b, 0x87, 0xd0, 0xc8, 0x49, 0x2e, 0xfc, 0x7b,
+	0x0d, 0x6a, 0x59, 0x76, 0xd2, 0x39, 0x78, 0x4a, 0xe6, 0x47, 0xaf, 0x94, 0x2d, 0x38, 0x9d, 0x1e,
+	0x0e, 0xac, 0x56, 0x62, 0x70, 0x5a, 0x8d, 0x1a, 0x73, 0x7c, 0x6a, 0x87, 0xce, 0xd0, 0xd3, 0xf3,
+	0xe7, 0x6c, 0x1f, 0x6c, 0x06, 0xbe, 0x35, 0xd2, 0x3a, 0xef, 0x3c, 0x54, 0x91, 0x17, 0xf4, 0xfc,
+	0xa4, 0x7f, 0x15, 0xae, 0x18, 0xc0, 0x86, 0x88, 0x20, 0x6a, 0x2e, 0x7e, 0x19, 0xe6, 0xb3, 0x62,
+	0xc9, 0x17, 0x82, 0x6e, 0xa0, 0x5e, 0x69, 0x80, 0x0d, 0x51, 0x95, 0x3e, 0xd4, 0x58, 0xf1, 0xea,
+	0x77, 0xbf, 0x68, 0x4a, 0xbd, 0x02, 0x75, 0x55, 0xb0, 0xec, 0x7d, 0xd8, 0x0b, 0x5d, 0x9c, 0xe0,
+	0x0e, 0x4a, 0xfa, 0xf7, 0x61, 0x36, 0xb4, 0x91, 0x34, 0x7b, 0xf4, 0xfe, 0xf0, 0x75, 0x27, 0xd9,
+	0xb3, 0x22, 0x74, 0x40, 0x2c, 0x33, 0x12, 0xa5, 0x54, 0x99, 0xd7, 0x61, 0x41, 0x61, 0x2b, 0x8b,
+	0x2c, 0xab, 0xaf, 0xa9, 0xea, 0x37, 0xff, 0x39, 0x0e, 0xb3, 0xa2, 0x02, 0x78, 0x9b, 0x14, 0x0e,
+	0xa3, 0xf1, 0xc3, 0xe7, 0x5e, 0x66, 0x2d, 0x02, 0x78, 0x8e, 0xcf, 0x50, 0x31, 0x2f, 0xb2, 0x2a,
+	0x9e, 0xe3, 0xd3, 0xd9, 0x98, 0x4e, 0xa3, 0x47, 0xe9, 0xf4, 0x24, 0x9f, 0x46, 0x8f, 0xf8, 0x74,
+	0x1d, 0x26, 0x23, 0x6c, 0x3b, 0x81, 0x1f, 0xd7, 0xa7, 0x96, 0xc7, 0x57, 0x2a, 0x46, 0xfa, 0x59,
+	0xbb, 0x0c, 0xb3, 0x26, 0x0a, 0x91, 0xe9, 0x24, 0x87, 0x9d, 0xd8, 0x0d, 0x92, 0x98, 0x56, 0x5b,
+	0x33, 0xc6, 0x4c, 0x3a, 0x7a, 0x8f, 0x0c, 0xf6, 0x6b, 0x31, 0x90, 0x6a, 0xb1, 0xda, 0x03, 0x38,
+	0x6d, 0xd2, 0xba, 0xc0, 0x45, 0x89, 0x13, 0xf8, 0x9d, 0x30, 0x70, 0x1d, 0xf3, 0xb0, 0x5e, 0xa5,
+	0xd5, 0xf7, 0xa5, 0x7c, 0xf5, 0xbd, 0x25, 0x81, 0x77, 0x28, 0xd6, 0xa8, 0x99, 0xb9, 0x31, 0x35,
+	0x3e, 0x6e, 0xc2, 0x7c, 0xd6, 0xc5, 0x22, 0x3c, 0xe4, 0xe2, 0x51, 0xcb, 0x14, 0x8f, 0xcd, 0x90,
+	0xc6, 0x85, 0x81, 0x13, 0x27, 0x7a, 0xa6, 0xb8, 0x90, 0x59, 0x8c, 0x65, 0x58, 0xa8, 0x62, 0xd6,
+	0x61, 0x3e, 0xcb, 0x51, 0x9c, 0x1b, 0xbf, 0x64, 0xe7, 0xc6, 0x1b, 0x8f, 0x12, 0xec, 0x5b, 0x23,
+	0xac, 0xdb, 0x6a, 0x57, 0x61, 0x0e, 0x59, 0x96, 0x43, 0x4c, 0x89, 0xdc, 0x34, 0x18, 0xd8, 0xb9,
+	0x71, 0xaa, 0x3f, 0xc1, 0x62, 0x22, 0x7b, 0xf8, 0x77, 0xa0, 0x91, 0x93, 0x50, 0x98, 0x59, 0x2d,
+	0xf1, 0xb5, 0x7c, 0x89, 0x7f, 0x1e, 0xaa, 0x38, 0x36, 0xa3, 0xe0, 0x40, 0x3e, 0x0c, 0x80, 0x0d,
+	0xd1, 0x8d, 0xfa, 0x6f, 0x96, 0x32, 0x37, 0xbc, 0x11, 0x9b, 0xe0, 0xf3, 0xde, 0xa8, 0x59, 0xb3,
+	0xb2, 0xce, 0xd7, 0x86, 0x57, 0x60, 0xd5, 0xe6, 0x7b, 0xb4, 0x29, 0xbc, 0x61, 0x9a, 0x38, 0x4c,
+	0x28, 0xe2, 0x39, 0x36, 0x20, 0x2c, 0xd0, 0xf3, 0xbc, 0x65, 0x7f, 0x9b, 0x7b, 0x28, 0xb2, 0xb3,
+	0x07, 0x60, 0x95, 0x8f, 0x51, 0x3b, 0xe6, 0xea, 0xfe, 0xb1, 0x82, 0xba, 0xff, 0xfb, 0x1a, 0x54,
+	0xd3, 0x3e, 0xc7, 0x86, 0xeb, 0x8e, 0x26, 0x33, 0x9f, 0x81, 0xe3, 0xae, 0xe3, 0x39, 0x49, 0x7a,
+	0xc3, 0xa7, 0x1f, 0xaa, 0xbe, 0xbb, 0x70, 0x5a, 0x12, 0x44, 0x28, 0x5a, 0x87, 0x49, 0xda, 0x71,
+	0xc5, 0x16, 0x8f, 0xe9, 0xf4, 0x93, 0xcc, 0xc4, 0x0f, 0x9d, 0x30, 0xc4, 0x8c, 0xe3, 0x8c, 0x91,
+	0x7e, 0x66, 0x1b, 0x34, 0xe3, 0x4a, 0x83, 0xe6, 0x10, 0xe6, 0x84, 0x5d, 0x45, 0x94, 0x3f, 0x1f,
+	0x97, 0xae, 0x43, 0x23, 0xc7, 0x5a, 0xbe, 0xc1, 0xc7, 0x09, 0x8a, 0x92, 0x4e, 0xe2, 0x78, 0x69,
+	0x45, 0x59, 0xa1, 0x23, 0xf7, 0x1d, 0x8f, 0xd6, 0x92, 0xec, 0x62, 0xfb, 0x2d, 0x6c, 0x8e, 0x5a,
+	0xee, 0x41, 0x15, 0x8b, 0xaa, 0xcf, 0xeb, 0xd0, 0xc8, 0x89, 0xf4, 0x74, 0x6d, 0xa7, 0x0f, 0x34,
+	0xea, 0xf5, 0x7b, 0x38, 0xe1, 0x37, 0xa1, 0x3b, 0xe4, 0xd0, 0x8a, 0x47, 0xd7, 0x13, 0xa1, 0xcb,
+	0xd7, 0xc7, 0xe9, 0x01, 0xcb, 0xbf, 0x54, 0xad, 0x16, 0xe1, 0x6c, 0x81, 0x48, 0x22, 0x27, 0xfc,
+	0x6a, 0x8c, 0x66, 0xc9, 0xfb, 0xc4, 0x24, 0xbd, 0xe8, 0xf0, 0x5e, 0x88, 0x7d, 0xeb, 0x33, 0xb7,
+	0xc3, 0x6f, 0x43, 0x25, 0xc2, 0xa6, 0x13, 0xd2, 0x04, 0x5b, 0xf6, 0x58, 0xd4, 0x87, 0xd6, 0xf6,
+	0xe0, 0x04, 0xbb, 0x61, 0x51, 0x55, 0xaa, 0x6b, 0x8d, 0x16, 0xa7, 0x20, 0x4f, 0x6a, 0x2d, 0xfe,
+	0xa4, 0xd6, 0xda, 0x0a, 0x1c, 0x7f, 0xf3, 0xcb, 0xa4, 0x8f, 0xfe, 0xbb, 0xbf, 0x9f, 0x5f, 0xb1,
+	0x9d, 0x64, 0xaf, 0xd7, 0x6d, 0x99, 0x81, 0xc7, 0x5f, 0xe3, 0xf8, 0x7f, 0xd7, 0x63, 0xeb, 0x21,
+	0x7f, 0x2a, 0x23, 0x04, 0x31, 0xef, 0xb9, 0xb3, 0xf5, 0xd7, 0x6f, 0xe5, 0x7b, 0xee, 0x17, 0x8a,
+	0x7a, 0xee, 0x19, 0x7b, 0xf0, 0xa4, 0x9a, 0x19, 0x13, 0x06, 0xfc, 0x05, 0x7b, 0x6a, 0x4b, 0x1f,
+	0x24, 0x46, 0x7a, 0x37, 0xbf, 0x00, 0xd3, 0x3e, 0x3e, 0xe8, 0x88, 0x85, 0x59, 0x38, 0x57, 0x7d,
+	0x7c, 0xf0, 0x36, 0x1f, 0x52, 0xbd, 0x7f, 0x0e, 0xf4, 0xbc, 0x70, 0x42, 0xf6, 0x1f, 0xb0, 0x5a,
+	0x99, 0x6d, 0x61, 0x3e, 0x99, 0x62, 0x6b, 0xaf, 0x28, 0xcc, 0xca, 0xb4, 0x90, 0xc5, 0x28, 0xbb,
+	0x8f, 0xcf, 0x11, 0x29, 0x33, 0xcb, 0xf3, 0x72, 0xb8, 0x50, 0x14, 0x21, 0xef, 0x87, 0x1a, 0xbd,
+	0xf1, 0xdc, 0xc1, 0x66, 0xe0, 0x79, 0x4e, 0x1c, 0x3b, 0x81, 0x3f, 0x52, 0x7b, 0x37, 0x60, 0x0a,
+	0xfb, 0x16, 0x4b, 0x61, 0xec, 0x60, 0x9f, 0xc4, 0xbe, 0x45, 0x12, 0x98, 0x6a, 0xe7, 0x65, 0x58,
+	0x2a, 0x16, 0x4c, 0xc8, 0xfe, 0x17, 0x16, 0x27, 0xdb, 0x8e, 0x1d, 0x3d, 0xdb, 0xf3, 0x4c, 0x69,
+	0xba, 0xbb, 0x04, 0xb3, 0xc4, 0xb2, 0x92, 0x62, 0x4c, 0x76, 0x62, 0xef, 0x37, 0x07, 0xd4, 0x17,
+	0x13, 0xea, 0xb5, 0x45, 0xbe, 0x85, 0x1e, 0xcf, 0xde, 0x42, 0x33, 0xf7, 0x8d, 0x1f, 0x6b, 0xa0,
+	0xe7, 0xd5, 0x12, 0x69, 0xf3, 0x45, 0x38, 0x49, 0x64, 0xc9, 0xbf, 0x8a, 0xcc, 0xf8, 0xf8, 0x60,
+	0xab, 0x2f, 0xb3, 0x7a, 0xe1, 0x1b, 0xcb, 0x5f, 0xf8, 0x72, 0x19, 0x78, 0xbc, 0x20, 0x03, 0x1f,
+	0xd2, 0x04, 0xcc, 0xb5, 0x7c, 0x0b, 0xa3, 0x28, 0xe9, 0x62, 0x94, 0x3c, 0x97, 0xa2, 0xf2, 0x55,
+	0x38, 0x5b, 0xc0, 0x5a, 0x3e, 0x10, 0x83, 0xdd, 0x5d, 0xd2, 0xa2, 0xea, 0x97, 0xc2, 0x15, 0x3e,
+	0xb2, 0x91, 0x34, 0x7f, 0xc6, 0x8e, 0x0e, 0xe9, 0x5d, 0x73, 0x94, 0x17, 0x56, 0xea, 0x13, 0xb6,
+	0x2c, 0xb3, 0x62, 0x85, 0xb8, 0x83, 0x0e, 0x64, 0xdd, 0xfb, 0x15, 0x38, 0x5b, 0x20, 0x95, 0x7c,
+	0x9d, 0x21, 0xc9, 0x90, 0xf4, 0x82, 0x88, 0x78, 0x53, 0x46, 0xfa, 0xd9, 0x7c, 0x9f, 0xc5, 0xc5,
+	0x46, 0x18, 0x46, 0xc1, 0xbe, 0x88, 0x0b, 0x91, 0x5c, 0x9e, 0xcf, 0x0d, 0xe5, 0x12, 0x34, 0x07,
+	0xcb, 0x90, 0x2a, 0xb1, 0xf6, 0xdf, 0xb3, 0x30, 0xbe, 0x1d, 0xdb, 0xb5, 0x77, 0x61, 0x3a, 0xf3,
+	0x28, 0x7c, 0x21, 0x5f, 0x4e, 0x2a, 0x4f, 0xaf, 0xfa, 0x95, 0x52, 0x88, 0x30, 0x15, 0x86, 0x93,
+	0xea, 0xcf, 0x31, 0x2e, 0x15, 0x52, 0x2b, 0x28, 0xfd, 0xda, 0x30, 0x28, 0xc1, 0xa6, 0x03, 0x33,
+	0xd9, 0x5f, 0x38, 0x34, 0x8f, 0x10, 0x31, 0x65, 0xb1, 0x5a, 0x8e, 0x11, 0x0c, 0xba, 0x30, 0xab,
+	0xbc, 0x30, 0x5f, 0x2c, 0xa4, 0xce, 0x82, 0xf4, 0xab, 0x43, 0x80, 0x04, 0x8f, 0x77, 0x61, 0x3a,
+	0xf3, 0x46, 0x5a, 0xec, 0x09, 0x19, 0xa2, 0x5f, 0x29, 0x85, 0x64, 0x34, 0xc8, 0x3e, 0x68, 0x0e,
+	0xd0, 0x20, 0x03, 0xd2, 0xaf, 0x0e, 0x01, 0x12, 0x3c, 0xf6, 0xe0, 0x54, 0xee, 0xf5, 0xf1, 0x72,
+	0xe1, 0x02, 0x2a, 0x4c, 0xbf, 0x3e, 0x14, 0x4c, 0x70, 0x7a, 0x07, 0x40, 0x7a, 0x26, 0x3c, 0x5f,
+	0x48, 0xdc, 0x07, 0xe8, 0x5f, 0x2a, 0x01, 0xc8, 0x3e, 0xc8, 0xbc, 0xeb, 0x0d, 0xd8, 0x0d, 0x12,
+	0x44, 0xbf, 0x52, 0x0a, 0x11, 0xab, 0x3f, 0x84, 0xb9, 0xfc, 0x53, 0xdc, 0x8b, 0x85, 0xf4, 0x39,
+	0x9c, 0xde, 0x1a, 0x0e, 0x27, 0x98, 0xbd, 0x07, 0xf3, 0x03, 0x1e, 0xad, 0x8a, 0x7d, 0x5a, 0x0c,
+	0xd6, 0x6f, 0x3e, 0x05, 0x58, 0xf0, 0xf6, 0xa1, 0x56, 0xf0, 0xee, 0x54, 0xec, 0x85, 0x3c, 0x50,
+	0x6f, 0x0f, 0x09, 0x14, 0xfc, 0xbe, 0x01, 0x55, 0xf9, 0xfd, 0x67, 0xb9, 0x90, 0x5e, 0x42, 0xe8,
+	0x2b, 0x65, 0x08, 0xb1, 0xf4, 0x01, 0xbc, 0x50, 0xfc, 0xaa, 0xb2, 0x7a, 0x84, 0x3f, 0x14, 0xac,
+	0xbe, 0x36, 0x3c, 0x56, 0xde, 0xb0, 0xca, 0x0b, 0xc8, 0xc5, 0x01, 0x39, 0x51, 0x06, 0xe9, 0x57,
+	0x87, 0x00, 0xc9, 0x76, 0x93, 0xdf, 0x0b, 0x8a, 0xed, 0x26, 0x21, 0xf4, 0x95, 0x32, 0x44, 0x26,
+	0x25, 0x67, 0xfa, 0xf6, 0xcd, 0x41, 0xfb, 0x44, 0x5a, 0x7e, 0xb5, 0x1c, 0x23, 0x6f, 0xd5, 0x4c,
+	0x0b, 0xbd, 0x78, 0xab, 0xca, 0x10, 0xfd, 0x4a, 0x29, 0x44, 0xb6, 0x8c, 0xdc, 0xec, 0x5e, 0x3e,
+	0x22, 0x91, 0x53, 0x84, 0xbe, 0x52, 0x86, 0x90, 0x97, 0x96, 0xfb, 0xa5, 0xcb, 0x03, 0x1c, 0x26,
+	0x10, 0xfa, 0x4a, 0x19, 0x42, 0x8e, 0x19, 0xa5, 0xfb, 0x59, 0x1c, 0x33, 0x59, 0x90, 0x7e, 0x75,
+	0x08, 0x90, 0xec, 0xd8, 0x6c, 0x77, 0xb1, 0xd8, 0xb1, 0x19, 0x8c, 0xbe, 0x5a, 0x8e, 0x91, 0xef,
+	0x0c, 0x6a, 0xb7, 0xee, 0xd2, 0x80, 0x24, 0x94, 0x41, 0xe9, 0xd7, 0x86, 0x41, 0x09, 0x36, 0x3b,
+	0x30, 0x25, 0x3a, 0x66, 0x8b, 0x83, 0xcf, 0xd1, 0x0d, 0xd7, 0xd5, 0x2f, 0x1f, 0x39, 0x2d, 0x5b,
+	0x5f, 0x69, 0x49, 0x5d, 0x3c, 0x42, 0xa2, 0x12, 0xeb, 0x0f, 0xe8, 0x30, 0xd1, 0xac, 0x90, 0x69,
+	0x1f, 0x0d, 0xca, 0x0a, 0x32, 0x48, 0xbf, 0x3a, 0x04, 0x48, 0x3e, 0xc6, 0x73, 0xcd, 0x9c, 0x62,
+	0x13, 0xa8, 0x30, 0xfd, 0xfa, 0x50, 0x30, 0x39, 0x96, 0xb2, 0x3d, 0x98, 0xe2, 0x58, 0xca, 0x60,
+	0xf4, 0xd5, 0x72, 0x8c, 0x1c, 0x4b, 0x6a, 0x8f, 0xe2, 0xd2, 0x00, 0xf2, 0x0c, 0x4a, 0xbf, 0x36,
+	0x0c, 0x4a, 0x3e, 0x24, 0x8a, 0xdb, 0x09, 0xab, 0x47, 0xf8, 0x56, 0xc1, 0xea, 0x6b, 0xc3, 0x63,
+	0x05, 0xe3, 0x6f, 0xc3, 0xe9, 0xa2, 0xbe, 0x40, 0x71, 0xc6, 0x28, 0x40, 0xea, 0x2f, 0x0d, 0x8b,
+	0x94, 0x4d, 0xaa, 0x96, 0xf3, 0xc5, 0x26, 0x55, 0x50, 0xfa, 0xb5, 0x61, 0x50, 0x72, 0x10, 0xe6,
+	0x0a, 0xda, 0xe2, 0x20, 0x54, 0x61, 0xfa, 0xf5, 0xa1, 0x60, 0x32, 0xa7, 0x5c, 0x01, 0x7a, 0xf9,
+	0x48, 0xf7, 0x97, 0xdc, 0x5a, 0x07, 0x16, 0x8e, 0xdf, 0x81, 0x85, 0x41, 0xa5, 0xe1, 0x80, 0xdc,
+	0x55, 0x8c, 0xd6, 0x6f, 0x3d, 0x0d, 0x3a, 0x65, 0xaf, 0x1f, 0xff, 0x1e, 0xe9, 0x0a, 0x6e, 0xbe,
+	0xf4, 0xf1, 0xe3, 0x25, 0xed, 0x93, 0xc7, 0x4b, 0xda, 0x3f, 0x1e, 0x2f, 0x69, 0x3f, 0x7d, 0xb2,
+	0x74, 0xec, 0x93, 0x27, 0x4b, 0xc7, 0xfe, 0xfa, 0x64, 0xe9, 0xd8, 0x37, 0xe7, 0x73, 0x4d, 0x41,
+	0xda, 0x52, 0xec, 0x9e, 0xa0, 0x3f, 0x4a, 0xbe, 0xf9, 0xbf, 0x01, 0x00, 0x73, 0x1b, 0x8f, 0x00,
+	0x68, 0x30, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	RegisterGateway(ctx context.Context, in *MsgRegisterGateway, opts ...grpc.CallOption) (*MsgRegisterGatewayResponse, error)
+	UpdateGateway(ctx context.Context, in *MsgUpdateGateway, opts ...grpc.CallOption) (*MsgUpdateGatewayResponse, error)
+	CreateContract(ctx context.Context, in *MsgCreateContract, opts ...grpc.CallOption) (*MsgCreateContractResponse, error)
+	ClaimPayment(ctx context.Context, in *MsgClaimPayment, opts ...grpc.CallOption) (*MsgClaimPaymentResponse, error)
+	CancelContract(ctx context.Context, in *MsgCancelContract, opts ...grpc.CallOption) (*MsgCancelContractResponse, error)
+	FinalizeContract(ctx context.Context, in *MsgFinalizeContract, opts ...grpc.CallOption) (*MsgFinalizeContractResponse, error)
+	BindDomain(ctx context.Context, in *MsgBindDomain, opts ...grpc.CallOption) (*MsgBindDomainResponse, error)
+	UnbindDomain(ctx context.Context, in *MsgUnbindDomain, opts ...grpc.CallOption) (*MsgUnbindDomainResponse, error)
+	SubmitUsageReport(ctx context.Context, in *MsgSubmitUsageReport, opts ...grpc.CallOption) (*MsgSubmitUsageReportResponse, error)
+	AcknowledgeUsageReport(ctx context.Context, in *MsgAcknowledgeUsageReport, opts ...grpc.CallOption) (*MsgAcknowledgeUsageReportResponse, error)
+	DisputeUsageReport(ctx context.Context, in *MsgDisputeUsageReport, opts ...grpc.CallOption) (*MsgDisputeUsageReportResponse, error)
+	OpenDispute(ctx context.Context, in *MsgOpenDispute, opts ...grpc.CallOption) (*MsgOpenDisputeResponse, error)
+	SubmitDisputeEvidence(ctx context.Context, in *MsgSubmitDisputeEvidence, opts ...grpc.CallOption) (*MsgSubmitDisputeEvidenceResponse, error)
+	ResolveDispute(ctx context.Context, in *MsgResolveDispute, opts ...grpc.CallOption) (*MsgResolveDisputeResponse, error)
+	BondGateway(ctx context.Context, in *MsgBondGateway, opts ...grpc.CallOption) (*MsgBondGatewayResponse, error)
+	UnbondGateway(ctx context.Context, in *MsgUnbondGateway, opts ...grpc.CallOption) (*MsgUnbondGatewayResponse, error)
+	WithdrawBond(ctx context.Context, in *MsgWithdrawBond, opts ...grpc.CallOption) (*MsgWithdrawBondResponse, error)
+	CreateOffer(ctx context.Context, in *MsgCreateOffer, opts ...grpc.CallOption) (*MsgCreateOfferResponse, error)
+	RetireOffer(ctx context.Context, in *MsgRetireOffer, opts ...grpc.CallOption) (*MsgRetireOfferResponse, error)
+	ExtendContract(ctx context.Context, in *MsgExtendContract, opts ...grpc.CallOption) (*MsgExtendContractResponse, error)
+	AmendContract(ctx context.Context, in *MsgAmendContract, opts ...grpc.CallOption) (*MsgAmendContractResponse, error)
+	AcceptAmendment(ctx context.Context, in *MsgAcceptAmendment, opts ...grpc.CallOption) (*MsgAcceptAmendmentResponse, error)
+	ClaimAll(ctx context.Context, in *MsgClaimAll, opts ...grpc.CallOption) (*MsgClaimAllResponse, error)
+	AcceptContract(ctx context.Context, in *MsgAcceptContract, opts ...grpc.CallOption) (*MsgAcceptContractResponse, error)
+	RejectContract(ctx context.Context, in *MsgRejectContract, opts ...grpc.CallOption) (*MsgRejectContractResponse, error)
+	SetGatewayDenoms(ctx context.Context, in *MsgSetGatewayDenoms, opts ...grpc.CallOption) (*MsgSetGatewayDenomsResponse, error)
+	TreasurySpend(ctx context.Context, in *MsgTreasurySpend, opts ...grpc.CallOption) (*MsgTreasurySpendResponse, error)
+	TransferGateway(ctx context.Context, in *MsgTransferGateway, opts ...grpc.CallOption) (*MsgTransferGatewayResponse, error)
+	AcceptGatewayTransfer(ctx context.Context, in *MsgAcceptGatewayTransfer, opts ...grpc.CallOption) (*MsgAcceptGatewayTransferResponse, error)
+	DecommissionGateway(ctx context.Context, in *MsgDecommissionGateway, opts ...grpc.CallOption) (*MsgDecommissionGatewayResponse, error)
+	MigrateContract(ctx context.Context, in *MsgMigrateContract, opts ...grpc.CallOption) (*MsgMigrateContractResponse, error)
+	GatewayHeartbeat(ctx context.Context, in *MsgGatewayHeartbeat, opts ...grpc.CallOption) (*MsgGatewayHeartbeatResponse, error)
+	TransferContract(ctx context.Context, in *MsgTransferContract, opts ...grpc.CallOption) (*MsgTransferContractResponse, error)
+	ApproveContractTransfer(ctx context.Context, in *MsgApproveContractTransfer, opts ...grpc.CallOption) (*MsgApproveContractTransferResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/lumen.gateway.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterGateway(ctx context.Context, in *MsgRegisterGateway, opts ...grpc.CallOption) (*MsgRegisterGatewayResponse, error) {
+	out := new(MsgRegisterGatewayResponse)
+	err := c.cc.Invoke(ctx, "/lumen.gateway.v1.Msg/RegisterGateway", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateGateway(ctx context.Context, in *MsgUpdateGateway, opts ...grpc.CallOption) (*MsgUpdateGatewayResponse, error) {
+	out := new(MsgUpdateGatewayResponse)
+	err := c.cc.Invoke(ctx, "/lumen.gateway.v1.Msg/UpdateGateway", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateContract(ctx context.Context, in *MsgCreateContract, opts ...grpc.CallOption) (*MsgCreateContractResponse, error) {
+	out := new(MsgCreateContractResponse)
+	err := c.cc.Invoke(ctx, "/lumen.gateway.v1.Msg/CreateContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimPayment(ctx context.Context, in *MsgClaimPayment, opts ...grpc.CallOption) (*MsgClaimPaymentResponse, error) {
+	out := new(MsgClaimPaymentResponse)
+	err := c.cc.Invoke(ctx, "/lumen.gateway.v1.Msg/ClaimPayment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelContract(ctx context.Context, in *MsgCancelContract, opts ...grpc.CallOption) (*MsgCancelContractResponse, error) {
+	out := new(MsgCancelContractResponse)
+	err := c.cc.Invoke(ctx, "/lumen.gateway.v1.Msg/CancelContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FinalizeContract(ctx context.Context, in *MsgFinalizeContract, opts ...grpc.CallOption) (*MsgFinalizeContractResponse, error) {
+	out := new(MsgFinalizeContractResponse)
+	err := c.cc.Invoke(ctx, "/lumen.gateway.v1.Msg/FinalizeContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BindDomain(ctx context.Context, in *MsgBindDomain, opts ...grpc.CallOption) (*MsgBindDomainResponse, error) {
+	out := new(MsgBindDomainResponse)
+	err := c.cc.Invoke(ctx, "/lumen.gateway.v1.Msg/BindDomain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnbindDomain(ctx context.Context, in *MsgUnbindDomain, opts ...grpc.CallOption) (*MsgUnbindDomainResponse, error) {
+	out := new(MsgUnbindDomainResponse)
+	err := c.cc.Invoke(ctx, "/lumen.gateway.v1.Msg/UnbindDomain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitUsageReport(ctx context.Context, in *MsgSubmitUsageReport, opts ...grpc.CallOption) (*MsgSubmitUsageReportResponse, error) {
+	out := new(MsgSubmitUsageReportResponse)
+	err := c.cc.Invoke(ctx, "/lumen.gateway.v1.Msg/SubmitUsageReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcknowledgeUsageReport(ctx context.Context, in *MsgAcknowledgeUsageReport, opts ...grpc.CallOption) (*MsgAcknowledgeUsageReportResponse, error) {
+	out := new(MsgAcknowledgeUsageReportResponse)
+	err := c.cc.Invoke(ctx, "/lumen.gateway.v1.Msg/AcknowledgeUsageReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DisputeUsageReport(ctx context.Context, in *MsgDisputeUsageReport, opts ...grpc.CallOption) (*MsgDisputeUsageReportResponse, error) {
+	out := new(MsgDisputeUsageReportResponse)
+	err := c.cc.Invoke(ctx, "/lumen.gateway.v1.Msg/DisputeUsageReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) OpenDispute(ctx context.Context, in *MsgOpenDispute, opts ...grpc.CallOption) (*MsgOpenDisputeResponse, error) {
+	out := new(MsgOpenDisputeResponse)
+	err := c.cc.Invoke(ctx, "/lumen.gateway.v1.Msg/OpenDispute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitDisputeEvidence(ctx context.Context, in *MsgSubmitDisputeEvidence, opts ...grpc.CallOption) (*MsgSubmitDisputeEvidenceResponse, error) {
+	out := new(MsgSubmitDisputeEvidenceResponse)
+	err := c.cc.Invoke(ctx, "/lumen.gateway.v1.Msg/SubmitDisputeEvidence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResolveDispute(ctx context.Context, in *MsgResolveDispute, opts ...grpc.CallOption) (*MsgResolveDisputeResponse, error) {
+	out := new(MsgResolveDisputeResponse)
+	err := c.cc.Invoke(ctx, "/lumen.gateway.v1.Msg/ResolveDispute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BondGateway(ctx context.Context, in *MsgBondGateway, opts ...grpc.CallOption) (*MsgBondGatewayResponse, error) {
+	out := new(MsgBondGatewayResponse)
+	err := c.cc.Invoke(ctx, "/lumen.gateway.v1.Msg/BondGateway", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnbondGateway(ctx context.Context, in *MsgUnbondGateway, opts ...grpc.CallOption) (*MsgUnbondGatewayResponse, error) {
+	out := new(MsgUnbondGatewayResponse)
+	err := c.cc.Invoke(ctx, "/lumen.gateway.v1.Msg/UnbondGateway", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawBond(ctx context.Context, in *MsgWithdrawBond, opts ...grpc.CallOption) (*MsgWithdrawBondResponse, error) {
+	out := new(MsgWithdrawBondResponse)
+	err := c.cc.Invoke(ctx, "/lumen.gateway.v1.Msg/WithdrawBond", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateOffer(ctx context.Context, in *MsgCreateOffer, opts ...grpc.CallOption) (*MsgCreateOfferResponse, error) {
+	out := new(MsgCreateOfferResponse)
+	err := c.cc.Invoke(ctx, "/lumen.gateway.v1.Msg/CreateOffer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RetireOffer(ctx context.Context, in *MsgRetireOffer, opts ...grpc.CallOption) (*MsgRetireOfferResponse, error) {
+	out := new(MsgRetireOfferResponse)
+	err := c.cc.Invoke(ctx, "/lumen.gateway.v1.Msg/RetireOffer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ExtendContract(ctx context.Context, in *MsgExtendContract, opts ...grpc.CallOption) (*MsgExtendContractResponse, error) {
+	out := new(MsgExtendContractResponse)
+	err := c.cc.Invoke(ctx, "/lumen.gateway.v1.Msg/ExtendContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AmendContract(ctx context.Context, in *MsgAmendContract, opts ...grpc.CallOption) (*MsgAmendContractResponse, error) {
+	out := new(MsgAmendContractResponse)
+	err := c.cc.Invoke(ctx, "/lumen.gateway.v1.Msg/AmendContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptAmendment(ctx context.Context, in *MsgAcceptAmendment, opts ...grpc.CallOption) (*MsgAcceptAmendmentResponse, error) {
+	out := new(MsgAcceptAmendmentResponse)
+	err := c.cc.Invoke(ctx, "/lumen.gateway.v1.Msg/AcceptAmendment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimAll(ctx context.Context, in *MsgClaimAll, opts ...grpc.CallOption) (*MsgClaimAllResponse, error) {
+	out := new(MsgClaimAllResponse)
+	err := c.cc.Invoke(ctx, "/lumen.gateway.v1.Msg/ClaimAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptContract(ctx context.Context, in *MsgAcceptContract, opts ...grpc.CallOption) (*MsgAcceptContractResponse, error) {
+	out := new(MsgAcceptContractResponse)
+	err := c.cc.Invoke(ctx, "/lumen.gateway.v1.Msg/AcceptContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RejectContract(ctx context.Context, in *MsgRejectContract, opts ...grpc.CallOption) (*MsgRejectContractResponse, error) {
+	out := new(MsgRejectContractResponse)
+	err := c.cc.Invoke(ctx, "/lumen.gateway.v1.Msg/RejectContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetGatewayDenoms(ctx context.Context, in *MsgSetGatewayDenoms, opts ...grpc.CallOption) (*MsgSetGatewayDenomsResponse, error) {
+	out := new(MsgSetGatewayDenomsResponse)
+	err := c.cc.Invoke(ctx, "/lumen.gateway.v1.Msg/SetGatewayDenoms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TreasurySpend(ctx context.Context, in *MsgTreasurySpend, opts ...grpc.CallOption) (*MsgTreasurySpendResponse, error) {
+	out := new(MsgTreasurySpendResponse)
+	err := c.cc.Invoke(ctx, "/lumen.gateway.v1.Msg/TreasurySpend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TransferGateway(ctx context.Context, in *MsgTransferGateway, opts ...grpc.CallOption) (*MsgTransferGatewayResponse, error) {
+	out := new(MsgTransferGatewayResponse)
+	err := c.cc.Invoke(ctx, "/lumen.gateway.v1.Msg/TransferGateway", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptGatewayTransfer(ctx context.Context, in *MsgAcceptGatewayTransfer, opts ...grpc.CallOption) (*MsgAcceptGatewayTransferResponse, error) {
+	out := new(MsgAcceptGatewayTransferResponse)
+	err := c.cc.Invoke(ctx, "/lumen.gateway.v1.Msg/AcceptGatewayTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DecommissionGateway(ctx context.Context, in *MsgDecommissionGateway, opts ...grpc.CallOption) (*MsgDecommissionGatewayResponse, error) {
+	out := new(MsgDecommissionGatewayResponse)
+	err := c.cc.Invoke(ctx, "/lumen.gateway.v1.Msg/DecommissionGateway", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) MigrateContract(ctx context.Context, in *MsgMigrateContract, opts ...grpc.CallOption) (*MsgMigrateContractResponse, error) {
+	out := new(MsgMigrateContractResponse)
+	err := c.cc.Invoke(ctx, "/lumen.gateway.v1.Msg/MigrateContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) GatewayHeartbeat(ctx context.Context, in *MsgGatewayHeartbeat, opts ...grpc.CallOption) (*MsgGatewayHeartbeatResponse, error) {
+	out := new(MsgGatewayHeartbeatResponse)
+	err := c.cc.Invoke(ctx, "/lumen.gateway.v1.Msg/GatewayHeartbeat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TransferContract(ctx context.Context, in *MsgTransferContract, opts ...grpc.CallOption) (*MsgTransferContractResponse, error) {
+	out := new(MsgTransferContractResponse)
+	err := c.cc.Invoke(ctx, "/lumen.gateway.v1.Msg/TransferContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ApproveContractTransfer(ctx context.Context, in *MsgApproveContractTransfer, opts ...grpc.CallOption) (*MsgApproveContractTransferResponse, error) {
+	out := new(MsgApproveContractTransferResponse)
+	err := c.cc.Invoke(ctx, "/lumen.gateway.v1.Msg/ApproveContractTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	RegisterGateway(context.Context, *MsgRegisterGateway) (*MsgRegisterGatewayResponse, error)
+	UpdateGateway(context.Context, *MsgUpdateGateway) (*MsgUpdateGatewayResponse, error)
+	CreateContract(context.Context, *MsgCreateContract) (*MsgCreateContractResponse, error)
+	ClaimPayment(context.Context, *MsgClaimPayment) (*MsgClaimPaymentResponse, error)
+	CancelContract(context.Context, *MsgCancelContract) (*MsgCancelContractResponse, error)
+	FinalizeContract(context.Context, *MsgFinalizeContract) (*MsgFinalizeContractResponse, error)
+	BindDomain(context.Context, *MsgBindDomain) (*MsgBindDomainResponse, error)
+	UnbindDomain(context.Context, *MsgUnbindDomain) (*MsgUnbindDomainResponse, error)
+	SubmitUsageReport(context.Context, *MsgSubmitUsageReport) (*MsgSubmitUsageReportResponse, error)
+	AcknowledgeUsageReport(context.Context, *MsgAcknowledgeUsageReport) (*MsgAcknowledgeUsageReportResponse, error)
+	DisputeUsageReport(context.Context, *MsgDisputeUsageReport) (*MsgDisputeUsageReportResponse, error)
+	OpenDispute(context.Context, *MsgOpenDispute) (*MsgOpenDisputeResponse, error)
+	SubmitDisputeEvidence(context.Context, *MsgSubmitDisputeEvidence) (*MsgSubmitDisputeEvidenceResponse, error)
+	ResolveDispute(context.Context, *MsgResolveDispute) (*MsgResolveDisputeResponse, error)
+	BondGateway(context.Context, *MsgBondGateway) (*MsgBondGatewayResponse, error)
+	UnbondGateway(context.Context, *MsgUnbondGateway) (*MsgUnbondGatewayResponse, error)
+	WithdrawBond(context.Context, *MsgWithdrawBond) (*MsgWithdrawBondResponse, error)
+	CreateOffer(context.Context, *MsgCreateOffer) (*MsgCreateOfferResponse, error)
+	RetireOffer(context.Context, *MsgRetireOffer) (*MsgRetireOfferResponse, error)
+	ExtendContract(context.Context, *MsgExtendContract) (*MsgExtendContractResponse, error)
+	AmendContract(context.Context, *MsgAmendContract) (*MsgAmendContractResponse, error)
+	AcceptAmendment(context.Context, *MsgAcceptAmendment) (*MsgAcceptAmendmentResponse, error)
+	ClaimAll(context.Context, *MsgClaimAll) (*MsgClaimAllResponse, error)
+	AcceptContract(context.Context, *MsgAcceptContract) (*MsgAcceptContractResponse, error)
+	RejectContract(context.Context, *MsgRejectContract) (*MsgRejectContractResponse, error)
+	SetGatewayDenoms(context.Context, *MsgSetGatewayDenoms) (*MsgSetGatewayDenomsResponse, error)
+	TreasurySpend(context.Context, *MsgTreasurySpend) (*MsgTreasurySpendResponse, error)
+	TransferGateway(context.Context, *MsgTransferGateway) (*MsgTransferGatewayResponse, error)
+	AcceptGatewayTransfer(context.Context, *MsgAcceptGatewayTransfer) (*MsgAcceptGatewayTransferResponse, error)
+	DecommissionGateway(context.Context, *MsgDecommissionGateway) (*MsgDecommissionGatewayResponse, error)
+	MigrateContract(context.Context, *MsgMigrateContract) (*MsgMigrateContractResponse, error)
+	GatewayHeartbeat(context.Context, *MsgGatewayHeartbeat) (*MsgGatewayHeartbeatResponse, error)
+	TransferContract(context.Context, *MsgTransferContract) (*MsgTransferContractResponse, error)
+	ApproveContractTransfer(context.Context, *MsgApproveContractTransfer) (*MsgApproveContractTransferResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) RegisterGateway(ctx context.Context, req *MsgRegisterGateway) (*MsgRegisterGatewayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterGateway not implemented")
+}
+func (*UnimplementedMsgServer) UpdateGateway(ctx context.Context, req *MsgUpdateGateway) (*MsgUpdateGatewayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGateway not implemented")
+}
+func (*UnimplementedMsgServer) CreateContract(ctx context.Context, req *MsgCreateContract) (*MsgCreateContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateContract not implemented")
+}
+func (*UnimplementedMsgServer) ClaimPayment(ctx context.Context, req *MsgClaimPayment) (*MsgClaimPaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimPayment not implemented")
+}
+func (*UnimplementedMsgServer) CancelContract(ctx context.Context, req *MsgCancelContract) (*MsgCancelContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelContract not implemented")
+}
+func (*UnimplementedMsgServer) FinalizeContract(ctx context.Context, req *MsgFinalizeContract) (*MsgFinalizeContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalizeContract not implemented")
+}
+func (*UnimplementedMsgServer) BindDomain(ctx context.Context, req *MsgBindDomain) (*MsgBindDomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BindDomain not implemented")
+}
+func (*UnimplementedMsgServer) UnbindDomain(ctx context.Context, req *MsgUnbindDomain) (*MsgUnbindDomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbindDomain not implemented")
+}
+func (*UnimplementedMsgServer) SubmitUsageReport(ctx context.Context, req *MsgSubmitUsageReport) (*MsgSubmitUsageReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitUsageReport not implemented")
+}
+func (*UnimplementedMsgServer) AcknowledgeUsageReport(ctx context.Context, req *MsgAcknowledgeUsageReport) (*MsgAcknowledgeUsageReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcknowledgeUsageReport not implemented")
+}
+func (*UnimplementedMsgServer) DisputeUsageReport(ctx context.Context, req *MsgDisputeUsageReport) (*MsgDisputeUsageReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisputeUsageReport not implemented")
+}
+func (*UnimplementedMsgServer) OpenDispute(ctx context.Context, req *MsgOpenDispute) (*MsgOpenDisputeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenDispute not implemented")
+}
+func (*UnimplementedMsgServer) SubmitDisputeEvidence(ctx context.Context, req *MsgSubmitDisputeEvidence) (*MsgSubmitDisputeEvidenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitDisputeEvidence not implemented")
+}
+func (*UnimplementedMsgServer) ResolveDispute(ctx context.Context, req *MsgResolveDispute) (*MsgResolveDisputeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveDispute not implemented")
+}
+func (*UnimplementedMsgServer) BondGateway(ctx context.Context, req *MsgBondGateway) (*MsgBondGatewayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BondGateway not implemented")
+}
+func (*UnimplementedMsgServer) UnbondGateway(ctx context.Context, req *MsgUnbondGateway) (*MsgUnbondGatewayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbondGateway not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawBond(ctx context.Context, req *MsgWithdrawBond) (*MsgWithdrawBondResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawBond not implemented")
+}
+func (*UnimplementedMsgServer) CreateOffer(ctx context.Context, req *MsgCreateOffer) (*MsgCreateOfferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOffer not implemented")
+}
+func (*UnimplementedMsgServer) RetireOffer(ctx context.Context, req *MsgRetireOffer) (*MsgRetireOfferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetireOffer not implemented")
+}
+func (*UnimplementedMsgServer) ExtendContract(ctx context.Context, req *MsgExtendContract) (*MsgExtendContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendContract not implemented")
+}
+func (*UnimplementedMsgServer) AmendContract(ctx context.Context, req *MsgAmendContract) (*MsgAmendContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AmendContract not implemented")
+}
+func (*UnimplementedMsgServer) AcceptAmendment(ctx context.Context, req *MsgAcceptAmendment) (*MsgAcceptAmendmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptAmendment not implemented")
+}
+func (*UnimplementedMsgServer) ClaimAll(ctx context.Context, req *MsgClaimAll) (*MsgClaimAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimAll not implemented")
+}
+func (*UnimplementedMsgServer) AcceptContract(ctx context.Context, req *MsgAcceptContract) (*MsgAcceptContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptContract not implemented")
+}
+func (*UnimplementedMsgServer) RejectContract(ctx context.Context, req *MsgRejectContract) (*MsgRejectContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectContract not implemented")
+}
+func (*UnimplementedMsgServer) SetGatewayDenoms(ctx context.Context, req *MsgSetGatewayDenoms) (*MsgSetGatewayDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGatewayDenoms not implemented")
+}
+func (*UnimplementedMsgServer) TreasurySpend(ctx context.Context, req *MsgTreasurySpend) (*MsgTreasurySpendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TreasurySpend not implemented")
+}
+func (*UnimplementedMsgServer) TransferGateway(ctx context.Context, req *MsgTransferGateway) (*MsgTransferGatewayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferGateway not implemented")
+}
+func (*UnimplementedMsgServer) AcceptGatewayTransfer(ctx context.Context, req *MsgAcceptGatewayTransfer) (*MsgAcceptGatewayTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptGatewayTransfer not implemented")
+}
+func (*UnimplementedMsgServer) DecommissionGateway(ctx context.Context, req *MsgDecommissionGateway) (*MsgDecommissionGatewayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecommissionGateway not implemented")
+}
+func (*UnimplementedMsgServer) MigrateContract(ctx context.Context, req *MsgMigrateContract) (*MsgMigrateContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateContract not implemented")
+}
+func (*UnimplementedMsgServer) GatewayHeartbeat(ctx context.Context, req *MsgGatewayHeartbeat) (*MsgGatewayHeartbeatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GatewayHeartbeat not implemented")
+}
+func (*UnimplementedMsgServer) TransferContract(ctx context.Context, req *MsgTransferContract) (*MsgTransferContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferContract not implemented")
+}
+func (*UnimplementedMsgServer) ApproveContractTransfer(ctx context.Context, req *MsgApproveContractTransfer) (*MsgApproveContractTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveContractTransfer not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumen.gateway.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterGateway_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterGateway)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterGateway(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumen.gateway.v1.Msg/RegisterGateway",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterGateway(ctx, req.(*MsgRegisterGateway))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateGateway_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateGateway)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateGateway(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumen.gateway.v1.Msg/UpdateGateway",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateGateway(ctx, req.(*MsgUpdateGateway))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumen.gateway.v1.Msg/CreateContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateContract(ctx, req.(*MsgCreateContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimPayment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumen.gateway.v1.Msg/ClaimPayment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimPayment(ctx, req.(*MsgClaimPayment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumen.gateway.v1.Msg/CancelContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelContract(ctx, req.(*MsgCancelContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FinalizeContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFinalizeContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FinalizeContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumen.gateway.v1.Msg/FinalizeContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FinalizeContract(ctx, req.(*MsgFinalizeContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BindDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBindDomain)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BindDomain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumen.gateway.v1.Msg/BindDomain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BindDomain(ctx, req.(*MsgBindDomain))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnbindDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnbindDomain)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnbindDomain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumen.gateway.v1.Msg/UnbindDomain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnbindDomain(ctx, req.(*MsgUnbindDomain))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitUsageReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitUsageReport)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitUsageReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumen.gateway.v1.Msg/SubmitUsageReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitUsageReport(ctx, req.(*MsgSubmitUsageReport))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcknowledgeUsageReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcknowledgeUsageReport)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcknowledgeUsageReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumen.gateway.v1.Msg/AcknowledgeUsageReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcknowledgeUsageReport(ctx, req.(*MsgAcknowledgeUsageReport))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DisputeUsageReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDisputeUsageReport)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DisputeUsageReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumen.gateway.v1.Msg/DisputeUsageReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DisputeUsageReport(ctx, req.(*MsgDisputeUsageReport))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_OpenDispute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgOpenDispute)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).OpenDispute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumen.gateway.v1.Msg/OpenDispute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).OpenDispute(ctx, req.(*MsgOpenDispute))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitDisputeEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitDisputeEvidence)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitDisputeEvidence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumen.gateway.v1.Msg/SubmitDisputeEvidence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitDisputeEvidence(ctx, req.(*MsgSubmitDisputeEvidence))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResolveDispute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResolveDispute)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResolveDispute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumen.gateway.v1.Msg/ResolveDispute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResolveDispute(ctx, req.(*MsgResolveDispute))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BondGateway_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBondGateway)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BondGateway(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumen.gateway.v1.Msg/BondGateway",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BondGateway(ctx, req.(*MsgBondGateway))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnbondGateway_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnbondGateway)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnbondGateway(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumen.gateway.v1.Msg/UnbondGateway",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnbondGateway(ctx, req.(*MsgUnbondGateway))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawBond_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawBond)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawBond(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumen.gateway.v1.Msg/WithdrawBond",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawBond(ctx, req.(*MsgWithdrawBond))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateOffer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumen.gateway.v1.Msg/CreateOffer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateOffer(ctx, req.(*MsgCreateOffer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RetireOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRetireOffer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RetireOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumen.gateway.v1.Msg/RetireOffer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RetireOffer(ctx, req.(*MsgRetireOffer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ExtendContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExtendContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ExtendContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumen.gateway.v1.Msg/ExtendContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExtendContract(ctx, req.(*MsgExtendContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AmendContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAmendContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AmendContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumen.gateway.v1.Msg/AmendContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AmendContract(ctx, req.(*MsgAmendContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptAmendment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptAmendment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptAmendment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumen.gateway.v1.Msg/AcceptAmendment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptAmendment(ctx, req.(*MsgAcceptAmendment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimAll)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumen.gateway.v1.Msg/ClaimAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimAll(ctx, req.(*MsgClaimAll))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumen.gateway.v1.Msg/AcceptContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptContract(ctx, req.(*MsgAcceptContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RejectContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRejectContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RejectContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumen.gateway.v1.Msg/RejectContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RejectContract(ctx, req.(*MsgRejectContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetGatewayDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetGatewayDenoms)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetGatewayDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumen.gateway.v1.Msg/SetGatewayDenoms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetGatewayDenoms(ctx, req.(*MsgSetGatewayDenoms))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TreasurySpend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTreasurySpend)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TreasurySpend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumen.gateway.v1.Msg/TreasurySpend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TreasurySpend(ctx, req.(*MsgTreasurySpend))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferGateway_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferGateway)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferGateway(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumen.gateway.v1.Msg/TransferGateway",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferGateway(ctx, req.(*MsgTransferGateway))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptGatewayTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptGatewayTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptGatewayTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumen.gateway.v1.Msg/AcceptGatewayTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptGatewayTransfer(ctx, req.(*MsgAcceptGatewayTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DecommissionGateway_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDecommissionGateway)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DecommissionGateway(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumen.gateway.v1.Msg/DecommissionGateway",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DecommissionGateway(ctx, req.(*MsgDecommissionGateway))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MigrateContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMigrateContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MigrateContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumen.gateway.v1.Msg/MigrateContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MigrateContract(ctx, req.(*MsgMigrateContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GatewayHeartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGatewayHeartbeat)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GatewayHeartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumen.gateway.v1.Msg/GatewayHeartbeat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GatewayHeartbeat(ctx, req.(*MsgGatewayHeartbeat))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumen.gateway.v1.Msg/TransferContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferContract(ctx, req.(*MsgTransferContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ApproveContractTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApproveContractTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ApproveContractTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumen.gateway.v1.Msg/ApproveContractTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApproveContractTransfer(ctx, req.(*MsgApproveContractTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "lumen.gateway.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "RegisterGateway",
+			Handler:    _Msg_RegisterGateway_Handler,
+		},
+		{
+			MethodName: "UpdateGateway",
+			Handler:    _Msg_UpdateGateway_Handler,
+		},
+		{
+			MethodName: "CreateContract",
+			Handler:    _Msg_CreateContract_Handler,
+		},
+		{
+			MethodName: "ClaimPayment",
+			Handler:    _Msg_ClaimPayment_Handler,
+		},
+		{
+			MethodName: "CancelContract",
+			Handler:    _Msg_CancelContract_Handler,
+		},
+		{
+			MethodName: "FinalizeContract",
+			Handler:    _Msg_FinalizeContract_Handler,
+		},
+		{
+			MethodName: "BindDomain",
+			Handler:    _Msg_BindDomain_Handler,
+		},
+		{
+			MethodName: "UnbindDomain",
+			Handler:    _Msg_UnbindDomain_Handler,
+		},
+		{
+			MethodName: "SubmitUsageReport",
+			Handler:    _Msg_SubmitUsageReport_Handler,
+		},
+		{
+			MethodName: "AcknowledgeUsageReport",
+			Handler:    _Msg_AcknowledgeUsageReport_Handler,
+		},
+		{
+			MethodName: "DisputeUsageReport",
+			Handler:    _Msg_DisputeUsageReport_Handler,
+		},
+		{
+			MethodName: "OpenDispute",
+			Handler:    _Msg_OpenDispute_Handler,
+		},
+		{
+			MethodName: "SubmitDisputeEvidence",
+			Handler:    _Msg_SubmitDisputeEvidence_Handler,
+		},
+		{
+			MethodName: "ResolveDispute",
+			Handler:    _Msg_ResolveDispute_Handler,
+		},
+		{
+			MethodName: "BondGateway",
+			Handler:    _Msg_BondGateway_Handler,
+		},
+		{
+			MethodName: "UnbondGateway",
+			Handler:    _Msg_UnbondGateway_Handler,
+		},
+		{
+			MethodName: "WithdrawBond",
+			Handler:    _Msg_WithdrawBond_Handler,
+		},
+		{
+			MethodName: "CreateOffer",
+			Handler:    _Msg_CreateOffer_Handler,
+		},
+		{
+			MethodName: "RetireOffer",
+			Handler:    _Msg_RetireOffer_Handler,
+		},
+		{
+			MethodName: "ExtendContract",
+			Handler:    _Msg_ExtendContract_Handler,
+		},
+		{
+			MethodName: "AmendContract",
+			Handler:    _Msg_AmendContract_Handler,
+		},
+		{
+			MethodName: "AcceptAmendment",
+			Handler:    _Msg_AcceptAmendment_Handler,
+		},
+		{
+			MethodName: "ClaimAll",
+			Handler:    _Msg_ClaimAll_Handler,
+		},
+		{
+			MethodName: "AcceptContract",
+			Handler:    _Msg_AcceptContract_Handler,
+		},
+		{
+			MethodName: "RejectContract",
+			Handler:    _Msg_RejectContract_Handler,
+		},
+		{
+			MethodName: "SetGatewayDenoms",
+			Handler:    _Msg_SetGatewayDenoms_Handler,
+		},
+		{
+			MethodName: "TreasurySpend",
+			Handler:    _Msg_TreasurySpend_Handler,
+		},
+		{
+			MethodName: "TransferGateway",
+			Handler:    _Msg_TransferGateway_Handler,
+		},
+		{
+			MethodName: "AcceptGatewayTransfer",
+			Handler:    _Msg_AcceptGatewayTransfer_Handler,
+		},
+		{
+			MethodName: "DecommissionGateway",
+			Handler:    _Msg_DecommissionGateway_Handler,
+		},
+		{
+			MethodName: "MigrateContract",
+			Handler:    _Msg_MigrateContract_Handler,
+		},
+		{
+			MethodName: "GatewayHeartbeat",
+			Handler:    _Msg_GatewayHeartbeat_Handler,
+		},
+		{
+			MethodName: "TransferContract",
+			Handler:    _Msg_TransferContract_Handler,
+		},
+		{
+			MethodName: "ApproveContractTransfer",
+			Handler:    _Msg_ApproveContractTransfer_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lumen/gateway/v1/tx.proto",
+}
+
+func (m *MsgRegisterGateway) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterGateway) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterGateway) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Profile != nil {
+		{
+			size, err := m.Profile.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Payout) > 0 {
+		i -= len(m.Payout)
+		copy(dAtA[i:], m.Payout)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Payout)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterGatewayResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterGatewayResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterGatewayResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateGateway) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateGateway) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateGateway) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ContractTransferConsent != nil {
+		{
+			size, err := m.ContractTransferConsent.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Profile != nil {
+		{
+			size, err := m.Profile.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.AutoClaim != nil {
+		{
+			size, err := m.AutoClaim.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Active != nil {
+		{
+			size, err := m.Active.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Payout != nil {
+		{
+			size, err := m.Payout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GatewayId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GatewayId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateGatewayResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateGatewayResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateGatewayResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.OfferId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OfferId))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.MonthsTotal != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MonthsTotal))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.NetworkGbPerMonth != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NetworkGbPerMonth))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StorageGbPerMonth != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StorageGbPerMonth))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PriceUlmn != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PriceUlmn))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.GatewayId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GatewayId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Client) > 0 {
+		i -= len(m.Client)
+		copy(dAtA[i:], m.Client)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Client)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ContractId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimPayment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimPayment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimPayment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ContractId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimPaymentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimPaymentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimPaymentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PaidUlmn) > 0 {
+		i -= len(m.PaidUlmn)
+		copy(dAtA[i:], m.PaidUlmn)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PaidUlmn)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ContractId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Client) > 0 {
+		i -= len(m.Client)
+		copy(dAtA[i:], m.Client)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Client)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RefundedUlmn) > 0 {
+		i -= len(m.RefundedUlmn)
+		copy(dAtA[i:], m.RefundedUlmn)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RefundedUlmn)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFinalizeContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFinalizeContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFinalizeContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ContractId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Finalizer) > 0 {
+		i -= len(m.Finalizer)
+		copy(dAtA[i:], m.Finalizer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Finalizer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFinalizeContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFinalizeContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFinalizeContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardUlmn) > 0 {
+		i -= len(m.RewardUlmn)
+		copy(dAtA[i:], m.RewardUlmn)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RewardUlmn)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBindDomain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBindDomain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBindDomain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GatewayId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GatewayId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBindDomainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBindDomainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBindDomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnbindDomain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnbindDomain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnbindDomain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GatewayId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GatewayId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnbindDomainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnbindDomainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnbindDomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitUsageReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitUsageReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitUsageReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EvidenceHash) > 0 {
+		i -= len(m.EvidenceHash)
+		copy(dAtA[i:], m.EvidenceHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EvidenceHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.NetworkGb != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NetworkGb))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StorageGb != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StorageGb))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Month != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Month))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitUsageReportResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitUsageReportResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitUsageReportResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DisputeDeadline != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DisputeDeadline))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcknowledgeUsageReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcknowledgeUsageReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcknowledgeUsageReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Month != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Month))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Client) > 0 {
+		i -= len(m.Client)
+		copy(dAtA[i:], m.Client)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Client)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcknowledgeUsageReportResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcknowledgeUsageReportResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcknowledgeUsageReportResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDisputeUsageReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDisputeUsageReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDisputeUsageReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Month != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Month))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Client) > 0 {
+		i -= len(m.Client)
+		copy(dAtA[i:], m.Client)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Client)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDisputeUsageReportResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDisputeUsageReportResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDisputeUsageReportResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DisputeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DisputeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOpenDispute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOpenDispute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOpenDispute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EvidenceHash) > 0 {
+		i -= len(m.EvidenceHash)
+		copy(dAtA[i:], m.EvidenceHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EvidenceHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Client) > 0 {
+		i -= len(m.Client)
+		copy(dAtA[i:], m.Client)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Client)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOpenDisputeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOpenDisputeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOpenDisputeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Deadline != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Deadline))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DisputeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DisputeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitDisputeEvidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitDisputeEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitDisputeEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EvidenceHash) > 0 {
+		i -= len(m.EvidenceHash)
+		copy(dAtA[i:], m.EvidenceHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EvidenceHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.DisputeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DisputeId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitDisputeEvidenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitDisputeEvidenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitDisputeEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResolveDispute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResolveDispute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResolveDispute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ruling) > 0 {
+		i -= len(m.Ruling)
+		copy(dAtA[i:], m.Ruling)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Ruling)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ClientRefundBps != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ClientRefundBps))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DisputeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DisputeId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Arbiter) > 0 {
+		i -= len(m.Arbiter)
+		copy(dAtA[i:], m.Arbiter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Arbiter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResolveDisputeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResolveDisputeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResolveDisputeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GatewayPayoutUlmn) > 0 {
+		i -= len(m.GatewayPayoutUlmn)
+		copy(dAtA[i:], m.GatewayPayoutUlmn)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GatewayPayoutUlmn)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientRefundUlmn) > 0 {
+		i -= len(m.ClientRefundUlmn)
+		copy(dAtA[i:], m.ClientRefundUlmn)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientRefundUlmn)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBondGateway) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBondGateway) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBondGateway) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AmountUlmn) > 0 {
+		i -= len(m.AmountUlmn)
+		copy(dAtA[i:], m.AmountUlmn)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AmountUlmn)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GatewayId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GatewayId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBondGatewayResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBondGatewayResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBondGatewayResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BondedUlmn) > 0 {
+		i -= len(m.BondedUlmn)
+		copy(dAtA[i:], m.BondedUlmn)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BondedUlmn)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnbondGateway) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnbondGateway) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnbondGateway) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AmountUlmn) > 0 {
+		i -= len(m.AmountUlmn)
+		copy(dAtA[i:], m.AmountUlmn)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AmountUlmn)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GatewayId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GatewayId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnbondGatewayResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnbondGatewayResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnbondGatewayResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CompleteAt != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CompleteAt))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawBond) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawBond) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawBond) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GatewayId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GatewayId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawBondResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawBondResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawBondResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AmountUlmn) > 0 {
+		i -= len(m.AmountUlmn)
+		copy(dAtA[i:], m.AmountUlmn)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AmountUlmn)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateOffer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateOffer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateOffer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CancellationPolicy != nil {
+		{
+			size, err := m.CancellationPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.CapacitySlots != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CapacitySlots))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Regions) > 0 {
+		for iNdEx := len(m.Regions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Regions[iNdEx])
+			copy(dAtA[i:], m.Regions[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Regions[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.MaxMonths != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxMonths))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MinMonths != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MinMonths))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.NetworkGbPerMonth != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NetworkGbPerMonth))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StorageGbPerMonth != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StorageGbPerMonth))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PriceUlmn != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PriceUlmn))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.GatewayId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GatewayId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateOfferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateOfferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateOfferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OfferId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OfferId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRetireOffer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRetireOffer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRetireOffer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OfferId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OfferId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRetireOfferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRetireOfferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRetireOfferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExtendContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExtendContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExtendContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AdditionalMonths != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AdditionalMonths))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Client) > 0 {
+		i -= len(m.Client)
+		copy(dAtA[i:], m.Client)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Client)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExtendContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExtendContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExtendContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EscrowUlmn) > 0 {
+		i -= len(m.EscrowUlmn)
+		copy(dAtA[i:], m.EscrowUlmn)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EscrowUlmn)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MonthsTotal != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MonthsTotal))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAmendContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAmendContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAmendContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NetworkGbPerMonth != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NetworkGbPerMonth))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StorageGbPerMonth != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StorageGbPerMonth))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PriceUlmn != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PriceUlmn))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Client) > 0 {
+		i -= len(m.Client)
+		copy(dAtA[i:], m.Client)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Client)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAmendContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAmendContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAmendContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptAmendment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptAmendment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptAmendment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ContractId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptAmendmentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptAmendmentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptAmendmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RefundedUlmn) > 0 {
+		i -= len(m.RefundedUlmn)
+		copy(dAtA[i:], m.RefundedUlmn)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RefundedUlmn)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChargedUlmn) > 0 {
+		i -= len(m.ChargedUlmn)
+		copy(dAtA[i:], m.ChargedUlmn)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChargedUlmn)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimAll) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimAll) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimAll) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.GatewayId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GatewayId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimAllResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimAllResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PaidUlmn) > 0 {
+		i -= len(m.PaidUlmn)
+		copy(dAtA[i:], m.PaidUlmn)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PaidUlmn)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Skipped != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Skipped))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Claimed != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Claimed))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ContractId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StartTime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRejectContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRejectContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRejectContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRejectContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRejectContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRejectContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RefundedUlmn) > 0 {
+		i -= len(m.RefundedUlmn)
+		copy(dAtA[i:], m.RefundedUlmn)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RefundedUlmn)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetGatewayDenoms) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetGatewayDenoms) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetGatewayDenoms) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.GatewayId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GatewayId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetGatewayDenomsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetGatewayDenomsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetGatewayDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTreasurySpend) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTreasurySpend) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTreasurySpend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTreasurySpendResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTreasurySpendResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTreasurySpendResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferGateway) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferGateway) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferGateway) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewOperator) > 0 {
+		i -= len(m.NewOperator)
+		copy(dAtA[i:], m.NewOperator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOperator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GatewayId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GatewayId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferGatewayResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferGatewayResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferGatewayResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptGatewayTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptGatewayTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptGatewayTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GatewayId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GatewayId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.NewOperator) > 0 {
+		i -= len(m.NewOperator)
+		copy(dAtA[i:], m.NewOperator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOperator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptGatewayTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptGatewayTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptGatewayTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDecommissionGateway) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDecommissionGateway) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDecommissionGateway) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndTime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EndTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.GatewayId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GatewayId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDecommissionGatewayResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDecommissionGatewayResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDecommissionGatewayResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OfferId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OfferId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PriceUlmn != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PriceUlmn))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NewGatewayId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NewGatewayId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Client) > 0 {
+		i -= len(m.Client)
+		copy(dAtA[i:], m.Client)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Client)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RefundedUlmn) > 0 {
+		i -= len(m.RefundedUlmn)
+		copy(dAtA[i:], m.RefundedUlmn)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RefundedUlmn)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MonthsTotal != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MonthsTotal))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.NewContractId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NewContractId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGatewayHeartbeat) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGatewayHeartbeat) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGatewayHeartbeat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GatewayId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GatewayId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGatewayHeartbeatResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGatewayHeartbeatResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGatewayHeartbeatResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OfflineAt != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OfflineAt))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewClient) > 0 {
+		i -= len(m.NewClient)
+		copy(dAtA[i:], m.NewClient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewClient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Client) > 0 {
+		i -= len(m.Client)
+		copy(dAtA[i:], m.Client)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Client)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pending {
+		i--
+		if m.Pending {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveContractTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveContractTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveContractTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ContractId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveContractTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveContractTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveContractTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgRegisterGateway) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Payout)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Profile != nil {
+		l = m.Profile.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterGatewayResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgUpdateGateway) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GatewayId != 0 {
+		n += 1 + sovTx(uint64(m.GatewayId))
+	}
+	if m.Payout != nil {
+		l = m.Payout.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Active != nil {
+		l = m.Active.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AutoClaim != nil {
+		l = m.AutoClaim.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Profile != nil {
+		l = m.Profile.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContractTransferConsent != nil {
+		l = m.ContractTransferConsent.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateGatewayResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Client)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GatewayId != 0 {
+		n += 1 + sovTx(uint64(m.GatewayId))
+	}
+	if m.PriceUlmn != 0 {
+		n += 1 + sovTx(uint64(m.PriceUlmn))
+	}
+	if m.StorageGbPerMonth != 0 {
+		n += 1 + sovTx(uint64(m.StorageGbPerMonth))
+	}
+	if m.NetworkGbPerMonth != 0 {
+		n += 1 + sovTx(uint64(m.NetworkGbPerMonth))
+	}
+	if m.MonthsTotal != 0 {
+		n += 1 + sovTx(uint64(m.MonthsTotal))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.OfferId != 0 {
+		n += 1 + sovTx(uint64(m.OfferId))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContractId != 0 {
+		n += 1 + sovTx(uint64(m.ContractId))
+	}
+	return n
+}
+
+func (m *MsgClaimPayment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovTx(uint64(m.ContractId))
+	}
+	return n
+}
+
+func (m *MsgClaimPaymentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PaidUlmn)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Client)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovTx(uint64(m.ContractId))
+	}
+	return n
+}
+
+func (m *MsgCancelContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RefundedUlmn)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFinalizeContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Finalizer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovTx(uint64(m.ContractId))
+	}
+	return n
+}
+
+func (m *MsgFinalizeContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RewardUlmn)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBindDomain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GatewayId != 0 {
+		n += 1 + sovTx(uint64(m.GatewayId))
+	}
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBindDomainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnbindDomain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GatewayId != 0 {
+		n += 1 + sovTx(uint64(m.GatewayId))
+	}
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnbindDomainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitUsageReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovTx(uint64(m.ContractId))
+	}
+	if m.Month != 0 {
+		n += 1 + sovTx(uint64(m.Month))
+	}
+	if m.StorageGb != 0 {
+		n += 1 + sovTx(uint64(m.StorageGb))
+	}
+	if m.NetworkGb != 0 {
+		n += 1 + sovTx(uint64(m.NetworkGb))
+	}
+	l = len(m.EvidenceHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitUsageReportResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DisputeDeadline != 0 {
+		n += 1 + sovTx(uint64(m.DisputeDeadline))
+	}
+	return n
+}
+
+func (m *MsgAcknowledgeUsageReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Client)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovTx(uint64(m.ContractId))
+	}
+	if m.Month != 0 {
+		n += 1 + sovTx(uint64(m.Month))
+	}
+	return n
+}
+
+func (m *MsgAcknowledgeUsageReportResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDisputeUsageReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Client)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovTx(uint64(m.ContractId))
+	}
+	if m.Month != 0 {
+		n += 1 + sovTx(uint64(m.Month))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDisputeUsageReportResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DisputeId != 0 {
+		n += 1 + sovTx(uint64(m.DisputeId))
+	}
+	return n
+}
+
+func (m *MsgOpenDispute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Client)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovTx(uint64(m.ContractId))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.EvidenceHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgOpenDisputeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DisputeId != 0 {
+		n += 1 + sovTx(uint64(m.DisputeId))
+	}
+	if m.Deadline != 0 {
+		n += 1 + sovTx(uint64(m.Deadline))
+	}
+	return n
+}
+
+func (m *MsgSubmitDisputeEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DisputeId != 0 {
+		n += 1 + sovTx(uint64(m.DisputeId))
+	}
+	l = len(m.EvidenceHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitDisputeEvidenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgResolveDispute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Arbiter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DisputeId != 0 {
+		n += 1 + sovTx(uint64(m.DisputeId))
+	}
+	if m.ClientRefundBps != 0 {
+		n += 1 + sovTx(uint64(m.ClientRefundBps))
+	}
+	l = len(m.Ruling)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgResolveDisputeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientRefundUlmn)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GatewayPayoutUlmn)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBondGateway) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GatewayId != 0 {
+		n += 1 + sovTx(uint64(m.GatewayId))
+	}
+	l = len(m.AmountUlmn)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBondGatewayResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BondedUlmn)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnbondGateway) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GatewayId != 0 {
+		n += 1 + sovTx(uint64(m.GatewayId))
+	}
+	l = len(m.AmountUlmn)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnbondGatewayResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CompleteAt != 0 {
+		n += 1 + sovTx(uint64(m.CompleteAt))
+	}
+	return n
+}
+
+func (m *MsgWithdrawBond) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GatewayId != 0 {
+		n += 1 + sovTx(uint64(m.GatewayId))
+	}
+	return n
+}
+
+func (m *MsgWithdrawBondResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AmountUlmn)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateOffer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GatewayId != 0 {
+		n += 1 + sovTx(uint64(m.GatewayId))
+	}
+	if m.PriceUlmn != 0 {
+		n += 1 + sovTx(uint64(m.PriceUlmn))
+	}
+	if m.StorageGbPerMonth != 0 {
+		n += 1 + sovTx(uint64(m.StorageGbPerMonth))
+	}
+	if m.NetworkGbPerMonth != 0 {
+		n += 1 + sovTx(uint64(m.NetworkGbPerMonth))
+	}
+	if m.MinMonths != 0 {
+		n += 1 + sovTx(uint64(m.MinMonths))
+	}
+	if m.MaxMonths != 0 {
+		n += 1 + sovTx(uint64(m.MaxMonths))
+	}
+	if len(m.Regions) > 0 {
+		for _, s := range m.Regions {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.CapacitySlots != 0 {
+		n += 1 + sovTx(uint64(m.CapacitySlots))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CancellationPolicy != nil {
+		l = m.CancellationPolicy.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateOfferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OfferId != 0 {
+		n += 1 + sovTx(uint64(m.OfferId))
+	}
+	return n
+}
+
+func (m *MsgRetireOffer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.OfferId != 0 {
+		n += 1 + sovTx(uint64(m.OfferId))
+	}
+	return n
+}
+
+func (m *MsgRetireOfferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgExtendContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Client)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovTx(uint64(m.ContractId))
+	}
+	if m.AdditionalMonths != 0 {
+		n += 1 + sovTx(uint64(m.AdditionalMonths))
+	}
+	return n
+}
+
+func (m *MsgExtendContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MonthsTotal != 0 {
+		n += 1 + sovTx(uint64(m.MonthsTotal))
+	}
+	l = len(m.EscrowUlmn)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAmendContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Client)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovTx(uint64(m.ContractId))
+	}
+	if m.PriceUlmn != 0 {
+		n += 1 + sovTx(uint64(m.PriceUlmn))
+	}
+	if m.StorageGbPerMonth != 0 {
+		n += 1 + sovTx(uint64(m.StorageGbPerMonth))
+	}
+	if m.NetworkGbPerMonth != 0 {
+		n += 1 + sovTx(uint64(m.NetworkGbPerMonth))
+	}
+	return n
+}
+
+func (m *MsgAmendContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAcceptAmendment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovTx(uint64(m.ContractId))
+	}
+	return n
+}
+
+func (m *MsgAcceptAmendmentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChargedUlmn)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RefundedUlmn)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimAll) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GatewayId != 0 {
+		n += 1 + sovTx(uint64(m.GatewayId))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovTx(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *MsgClaimAllResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Claimed != 0 {
+		n += 1 + sovTx(uint64(m.Claimed))
+	}
+	if m.Skipped != 0 {
+		n += 1 + sovTx(uint64(m.Skipped))
+	}
+	l = len(m.PaidUlmn)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAcceptContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovTx(uint64(m.ContractId))
+	}
+	return n
+}
+
+func (m *MsgAcceptContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartTime != 0 {
+		n += 1 + sovTx(uint64(m.StartTime))
+	}
+	return n
+}
+
+func (m *MsgRejectContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovTx(uint64(m.ContractId))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRejectContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RefundedUlmn)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetGatewayDenoms) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GatewayId != 0 {
+		n += 1 + sovTx(uint64(m.GatewayId))
+	}
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetGatewayDenomsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTreasurySpend) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgTreasurySpendResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransferGateway) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GatewayId != 0 {
+		n += 1 + sovTx(uint64(m.GatewayId))
+	}
+	l = len(m.NewOperator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferGatewayResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAcceptGatewayTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NewOperator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GatewayId != 0 {
+		n += 1 + sovTx(uint64(m.GatewayId))
+	}
+	return n
+}
+
+func (m *MsgAcceptGatewayTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDecommissionGateway) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GatewayId != 0 {
+		n += 1 + sovTx(uint64(m.GatewayId))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovTx(uint64(m.EndTime))
+	}
+	return n
+}
+
+func (m *MsgDecommissionGatewayResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMigrateContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Client)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovTx(uint64(m.ContractId))
+	}
+	if m.NewGatewayId != 0 {
+		n += 1 + sovTx(uint64(m.NewGatewayId))
+	}
+	if m.PriceUlmn != 0 {
+		n += 1 + sovTx(uint64(m.PriceUlmn))
+	}
+	if m.OfferId != 0 {
+		n += 1 + sovTx(uint64(m.OfferId))
+	}
+	return n
+}
+
+func (m *MsgMigrateContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NewContractId != 0 {
+		n += 1 + sovTx(uint64(m.NewContractId))
+	}
+	if m.MonthsTotal != 0 {
+		n += 1 + sovTx(uint64(m.MonthsTotal))
+	}
+	l = len(m.RefundedUlmn)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgGatewayHeartbeat) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GatewayId != 0 {
+		n += 1 + sovTx(uint64(m.GatewayId))
+	}
+	return n
+}
+
+func (m *MsgGatewayHeartbeatResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OfflineAt != 0 {
+		n += 1 + sovTx(uint64(m.OfflineAt))
+	}
+	return n
+}
+
+func (m *MsgTransferContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Client)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovTx(uint64(m.ContractId))
+	}
+	l = len(m.NewClient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pending {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgApproveContractTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovTx(uint64(m.ContractId))
+	}
+	return n
+}
+
+func (m *MsgApproveContractTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgRegisterGateway) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterGateway: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterGateway: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payout = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Profile == nil {
+				m.Profile = &GatewayProfile{}
+			}
+			if err := m.Profile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterGatewayResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterGatewayResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterGatewayResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateGateway) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateGateway: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateGateway: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayId", wireType)
+			}
+			m.GatewayId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GatewayId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Payout == nil {
+				m.Payout = &types.StringValue{}
+			}
+			if err := m.Payout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &types.StringValue{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Active == nil {
+				m.Active = &types.BoolValue{}
+			}
+			if err := m.Active.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoClaim", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AutoClaim == nil {
+				m.AutoClaim = &types.BoolValue{}
+			}
+			if err := m.AutoClaim.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Profile == nil {
+				m.Profile = &GatewayProfile{}
+			}
+			if err := m.Profile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractTransferConsent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ContractTransferConsent == nil {
+				m.ContractTransferConsent = &types.BoolValue{}
+			}
+			if err := m.ContractTransferConsent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateGatewayResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateGatewayResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateGatewayResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Client = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayId", wireType)
+			}
+			m.GatewayId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GatewayId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceUlmn", wireType)
+			}
+			m.PriceUlmn = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceUlmn |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageGbPerMonth", wireType)
+			}
+			m.StorageGbPerMonth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageGbPerMonth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkGbPerMonth", wireType)
+			}
+			m.NetworkGbPerMonth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NetworkGbPerMonth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonthsTotal", wireType)
+			}
+			m.MonthsTotal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MonthsTotal |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferId", wireType)
+			}
+			m.OfferId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OfferId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimPayment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimPayment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimPayment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimPaymentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimPaymentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimPaymentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaidUlmn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaidUlmn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Client = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundedUlmn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundedUlmn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFinalizeContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFinalizeContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFinalizeContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finalizer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Finalizer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFinalizeContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFinalizeContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFinalizeContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardUlmn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardUlmn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBindDomain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBindDomain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBindDomain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayId", wireType)
+			}
+			m.GatewayId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GatewayId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBindDomainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBindDomainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBindDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnbindDomain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnbindDomain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnbindDomain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayId", wireType)
+			}
+			m.GatewayId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GatewayId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnbindDomainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnbindDomainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnbindDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitUsageReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitUsageReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitUsageReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Month", wireType)
+			}
+			m.Month = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Month |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageGb", wireType)
+			}
+			m.StorageGb = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageGb |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkGb", wireType)
+			}
+			m.NetworkGb = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NetworkGb |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvidenceHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitUsageReportResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitUsageReportResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitUsageReportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeDeadline", wireType)
+			}
+			m.DisputeDeadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisputeDeadline |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcknowledgeUsageReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcknowledgeUsageReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcknowledgeUsageReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Client = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Month", wireType)
+			}
+			m.Month = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Month |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcknowledgeUsageReportResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcknowledgeUsageReportResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcknowledgeUsageReportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDisputeUsageReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDisputeUsageReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDisputeUsageReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Client = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Month", wireType)
+			}
+			m.Month = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Month |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDisputeUsageReportResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDisputeUsageReportResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDisputeUsageReportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeId", wireType)
+			}
+			m.DisputeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisputeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOpenDispute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOpenDispute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOpenDispute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Client = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvidenceHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOpenDisputeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOpenDisputeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOpenDisputeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeId", wireType)
+			}
+			m.DisputeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisputeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitDisputeEvidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitDisputeEvidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitDisputeEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeId", wireType)
+			}
+			m.DisputeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisputeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvidenceHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitDisputeEvidenceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitDisputeEvidenceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitDisputeEvidenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResolveDispute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResolveDispute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResolveDispute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Arbiter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Arbiter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeId", wireType)
+			}
+			m.DisputeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisputeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientRefundBps", wireType)
+			}
+			m.ClientRefundBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClientRefundBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ruling", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ruling = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResolveDisputeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResolveDisputeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResolveDisputeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientRefundUlmn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientRefundUlmn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayPayoutUlmn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GatewayPayoutUlmn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBondGateway) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBondGateway: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBondGateway: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayId", wireType)
+			}
+			m.GatewayId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GatewayId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountUlmn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AmountUlmn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBondGatewayResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBondGatewayResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBondGatewayResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondedUlmn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BondedUlmn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnbondGateway) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnbondGateway: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnbondGateway: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayId", wireType)
+			}
+			m.GatewayId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GatewayId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountUlmn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AmountUlmn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnbondGatewayResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnbondGatewayResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnbondGatewayResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompleteAt", wireType)
+			}
+			m.CompleteAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompleteAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawBond) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawBond: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawBond: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayId", wireType)
+			}
+			m.GatewayId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GatewayId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawBondResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawBondResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawBondResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountUlmn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AmountUlmn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateOffer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateOffer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateOffer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayId", wireType)
+			}
+			m.GatewayId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GatewayId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceUlmn", wireType)
+			}
+			m.PriceUlmn = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceUlmn |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageGbPerMonth", wireType)
+			}
+			m.StorageGbPerMonth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageGbPerMonth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkGbPerMonth", wireType)
+			}
+			m.NetworkGbPerMonth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NetworkGbPerMonth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinMonths", wireType)
+			}
+			m.MinMonths = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinMonths |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMonths", wireType)
+			}
+			m.MaxMonths = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMonths |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Regions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Regions = append(m.Regions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CapacitySlots", wireType)
+			}
+			m.CapacitySlots = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CapacitySlots |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancellationPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CancellationPolicy == nil {
+				m.CancellationPolicy = &CancellationPolicy{}
+			}
+			if err := m.CancellationPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateOfferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateOfferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateOfferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferId", wireType)
+			}
+			m.OfferId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OfferId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRetireOffer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRetireOffer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRetireOffer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferId", wireType)
+			}
+			m.OfferId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OfferId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRetireOfferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRetireOfferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRetireOfferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExtendContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExtendContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExtendContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Client = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalMonths", wireType)
+			}
+			m.AdditionalMonths = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AdditionalMonths |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExtendContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExtendContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExtendContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonthsTotal", wireType)
+			}
+			m.MonthsTotal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MonthsTotal |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowUlmn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EscrowUlmn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAmendContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAmendContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAmendContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Client = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceUlmn", wireType)
+			}
+			m.PriceUlmn = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceUlmn |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageGbPerMonth", wireType)
+			}
+			m.StorageGbPerMonth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageGbPerMonth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkGbPerMonth", wireType)
+			}
+			m.NetworkGbPerMonth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NetworkGbPerMonth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAmendContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAmendContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAmendContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptAmendment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptAmendment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptAmendment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptAmendmentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptAmendmentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptAmendmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChargedUlmn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChargedUlmn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundedUlmn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundedUlmn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimAll) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimAll: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimAll: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayId", wireType)
+			}
+			m.GatewayId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GatewayId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimAllResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimAllResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
+			}
+			m.Claimed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Claimed |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Skipped", wireType)
+			}
+			m.Skipped = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Skipped |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaidUlmn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaidUlmn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRejectContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRejectContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRejectContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRejectContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRejectContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRejectContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundedUlmn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundedUlmn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetGatewayDenoms) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetGatewayDenoms: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetGatewayDenoms: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayId", wireType)
+			}
+			m.GatewayId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GatewayId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetGatewayDenomsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetGatewayDenomsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetGatewayDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgRegisterGateway) Unmarshal(dAtA []byte) error {
+func (m *MsgTreasurySpend) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1917,15 +15159,15 @@ func (m *MsgRegisterGateway) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterGateway: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTreasurySpend: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterGateway: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTreasurySpend: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1953,11 +15195,11 @@ func (m *MsgRegisterGateway) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1985,13 +15227,13 @@ func (m *MsgRegisterGateway) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payout = string(dAtA[iNdEx:postIndex])
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2001,23 +15243,25 @@ func (m *MsgRegisterGateway) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2040,7 +15284,7 @@ func (m *MsgRegisterGateway) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterGatewayResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTreasurySpendResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2063,31 +15307,12 @@ func (m *MsgRegisterGatewayResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterGatewayResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTreasurySpendResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterGatewayResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTreasurySpendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2109,7 +15334,7 @@ func (m *MsgRegisterGatewayResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateGateway) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferGateway) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2132,10 +15357,10 @@ func (m *MsgUpdateGateway) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateGateway: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferGateway: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateGateway: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferGateway: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2191,9 +15416,9 @@ func (m *MsgUpdateGateway) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOperator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2203,33 +15428,129 @@ func (m *MsgUpdateGateway) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Payout == nil {
-				m.Payout = &types.StringValue{}
+			m.NewOperator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if err := m.Payout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferGatewayResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferGatewayResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferGatewayResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 4:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptGatewayTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptGatewayTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptGatewayTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOperator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2239,33 +15560,29 @@ func (m *MsgUpdateGateway) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = &types.StringValue{}
-			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NewOperator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayId", wireType)
 			}
-			var msglen int
+			m.GatewayId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2275,28 +15592,11 @@ func (m *MsgUpdateGateway) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.GatewayId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Active == nil {
-				m.Active = &types.BoolValue{}
-			}
-			if err := m.Active.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2318,7 +15618,7 @@ func (m *MsgUpdateGateway) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateGatewayResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceptGatewayTransferResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2341,10 +15641,10 @@ func (m *MsgUpdateGatewayResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateGatewayResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceptGatewayTransferResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateGatewayResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceptGatewayTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2368,7 +15668,7 @@ func (m *MsgUpdateGatewayResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgDecommissionGateway) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2391,15 +15691,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDecommissionGateway: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDecommissionGateway: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2427,13 +15727,13 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayId", wireType)
 			}
-			var msglen int
+			m.GatewayId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2443,25 +15743,30 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.GatewayId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2483,7 +15788,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDecommissionGatewayResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2506,10 +15811,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDecommissionGatewayResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDecommissionGatewayResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2533,7 +15838,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateContract) Unmarshal(dAtA []byte) error {
+func (m *MsgMigrateContract) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2556,10 +15861,10 @@ func (m *MsgCreateContract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateContract: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMigrateContract: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMigrateContract: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2596,9 +15901,9 @@ func (m *MsgCreateContract) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GatewayId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
-			m.GatewayId = 0
+			m.ContractId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2608,16 +15913,16 @@ func (m *MsgCreateContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GatewayId |= uint64(b&0x7F) << shift
+				m.ContractId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriceUlmn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewGatewayId", wireType)
 			}
-			m.PriceUlmn = 0
+			m.NewGatewayId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2627,16 +15932,16 @@ func (m *MsgCreateContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PriceUlmn |= uint64(b&0x7F) << shift
+				m.NewGatewayId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageGbPerMonth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceUlmn", wireType)
 			}
-			m.StorageGbPerMonth = 0
+			m.PriceUlmn = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2646,54 +15951,16 @@ func (m *MsgCreateContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StorageGbPerMonth |= uint64(b&0x7F) << shift
+				m.PriceUlmn |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetworkGbPerMonth", wireType)
-			}
-			m.NetworkGbPerMonth = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NetworkGbPerMonth |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MonthsTotal", wireType)
-			}
-			m.MonthsTotal = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MonthsTotal |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferId", wireType)
 			}
-			var stringLen uint64
+			m.OfferId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2703,24 +15970,11 @@ func (m *MsgCreateContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.OfferId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2742,7 +15996,7 @@ func (m *MsgCreateContract) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateContractResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMigrateContractResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2765,17 +16019,17 @@ func (m *MsgCreateContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMigrateContractResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMigrateContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewContractId", wireType)
 			}
-			m.ContractId = 0
+			m.NewContractId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2785,11 +16039,62 @@ func (m *MsgCreateContractResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ContractId |= uint64(b&0x7F) << shift
+				m.NewContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonthsTotal", wireType)
+			}
+			m.MonthsTotal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MonthsTotal |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundedUlmn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundedUlmn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2811,7 +16116,7 @@ func (m *MsgCreateContractResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimPayment) Unmarshal(dAtA []byte) error {
+func (m *MsgGatewayHeartbeat) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2834,10 +16139,10 @@ func (m *MsgClaimPayment) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimPayment: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGatewayHeartbeat: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimPayment: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGatewayHeartbeat: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2874,9 +16179,9 @@ func (m *MsgClaimPayment) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayId", wireType)
 			}
-			m.ContractId = 0
+			m.GatewayId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2886,7 +16191,7 @@ func (m *MsgClaimPayment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ContractId |= uint64(b&0x7F) << shift
+				m.GatewayId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2912,7 +16217,7 @@ func (m *MsgClaimPayment) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimPaymentResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgGatewayHeartbeatResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2935,17 +16240,17 @@ func (m *MsgClaimPaymentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimPaymentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGatewayHeartbeatResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimPaymentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGatewayHeartbeatResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PaidUlmn", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfflineAt", wireType)
 			}
-			var stringLen uint64
+			m.OfflineAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2955,24 +16260,11 @@ func (m *MsgClaimPaymentResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.OfflineAt |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PaidUlmn = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2994,7 +16286,7 @@ func (m *MsgClaimPaymentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelContract) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferContract) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3017,10 +16309,10 @@ func (m *MsgCancelContract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelContract: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferContract: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferContract: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3074,6 +16366,38 @@ func (m *MsgCancelContract) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewClient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewClient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3095,7 +16419,7 @@ func (m *MsgCancelContract) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelContractResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferContractResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3118,17 +16442,17 @@ func (m *MsgCancelContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferContractResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RefundedUlmn", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3138,24 +16462,12 @@ func (m *MsgCancelContractResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RefundedUlmn = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Pending = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3177,7 +16489,7 @@ func (m *MsgCancelContractResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFinalizeContract) Unmarshal(dAtA []byte) error {
+func (m *MsgApproveContractTransfer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3200,15 +16512,15 @@ func (m *MsgFinalizeContract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFinalizeContract: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgApproveContractTransfer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFinalizeContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgApproveContractTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Finalizer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3236,7 +16548,7 @@ func (m *MsgFinalizeContract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Finalizer = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
@@ -3278,7 +16590,7 @@ func (m *MsgFinalizeContract) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFinalizeContractResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgApproveContractTransferResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3301,44 +16613,12 @@ func (m *MsgFinalizeContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFinalizeContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgApproveContractTransferResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFinalizeContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgApproveContractTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardUlmn", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RewardUlmn = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -61,15 +63,118 @@ func (ContractStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_7d819620b0ccaefe, []int{0}
 }
 
+type UsageReportStatus int32
+
+const (
+	UsageReportStatus_USAGE_REPORT_STATUS_UNSPECIFIED UsageReportStatus = 0
+	UsageReportStatus_USAGE_REPORT_STATUS_PENDING     UsageReportStatus = 1
+	UsageReportStatus_USAGE_REPORT_STATUS_ACCEPTED    UsageReportStatus = 2
+	UsageReportStatus_USAGE_REPORT_STATUS_DISPUTED    UsageReportStatus = 3
+)
+
+var UsageReportStatus_name = map[int32]string{
+	0: "USAGE_REPORT_STATUS_UNSPECIFIED",
+	1: "USAGE_REPORT_STATUS_PENDING",
+	2: "USAGE_REPORT_STATUS_ACCEPTED",
+	3: "USAGE_REPORT_STATUS_DISPUTED",
+}
+
+var UsageReportStatus_value = map[string]int32{
+	"USAGE_REPORT_STATUS_UNSPECIFIED": 0,
+	"USAGE_REPORT_STATUS_PENDING":     1,
+	"USAGE_REPORT_STATUS_ACCEPTED":    2,
+	"USAGE_REPORT_STATUS_DISPUTED":    3,
+}
+
+func (x UsageReportStatus) String() string {
+	return proto.EnumName(UsageReportStatus_name, int32(x))
+}
+
+func (UsageReportStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7d819620b0ccaefe, []int{1}
+}
+
+type DisputeStatus int32
+
+const (
+	DisputeStatus_DISPUTE_STATUS_UNSPECIFIED DisputeStatus = 0
+	DisputeStatus_DISPUTE_STATUS_OPEN        DisputeStatus = 1
+	DisputeStatus_DISPUTE_STATUS_RESOLVED    DisputeStatus = 2
+	DisputeStatus_DISPUTE_STATUS_EXPIRED     DisputeStatus = 3
+)
+
+var DisputeStatus_name = map[int32]string{
+	0: "DISPUTE_STATUS_UNSPECIFIED",
+	1: "DISPUTE_STATUS_OPEN",
+	2: "DISPUTE_STATUS_RESOLVED",
+	3: "DISPUTE_STATUS_EXPIRED",
+}
+
+var DisputeStatus_value = map[string]int32{
+	"DISPUTE_STATUS_UNSPECIFIED": 0,
+	"DISPUTE_STATUS_OPEN":        1,
+	"DISPUTE_STATUS_RESOLVED":    2,
+	"DISPUTE_STATUS_EXPIRED":     3,
+}
+
+func (x DisputeStatus) String() string {
+	return proto.EnumName(DisputeStatus_name, int32(x))
+}
+
+func (DisputeStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7d819620b0ccaefe, []int{2}
+}
+
+type GatewayProtocol int32
+
+const (
+	GatewayProtocol_GATEWAY_PROTOCOL_UNSPECIFIED GatewayProtocol = 0
+	GatewayProtocol_GATEWAY_PROTOCOL_IPFS        GatewayProtocol = 1
+	GatewayProtocol_GATEWAY_PROTOCOL_HTTP        GatewayProtocol = 2
+	GatewayProtocol_GATEWAY_PROTOCOL_S3          GatewayProtocol = 3
+)
+
+var GatewayProtocol_name = map[int32]string{
+	0: "GATEWAY_PROTOCOL_UNSPECIFIED",
+	1: "GATEWAY_PROTOCOL_IPFS",
+	2: "GATEWAY_PROTOCOL_HTTP",
+	3: "GATEWAY_PROTOCOL_S3",
+}
+
+var GatewayProtocol_value = map[string]int32{
+	"GATEWAY_PROTOCOL_UNSPECIFIED": 0,
+	"GATEWAY_PROTOCOL_IPFS":        1,
+	"GATEWAY_PROTOCOL_HTTP":        2,
+	"GATEWAY_PROTOCOL_S3":          3,
+}
+
+func (x GatewayProtocol) String() string {
+	return proto.EnumName(GatewayProtocol_name, int32(x))
+}
+
+func (GatewayProtocol) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7d819620b0ccaefe, []int{3}
+}
+
 type Gateway struct {
-	Id            uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Operator      string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
-	Payout        string `protobuf:"bytes,3,opt,name=payout,proto3" json:"payout,omitempty"`
-	Active        bool   `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"`
-	Metadata      string `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	CreatedAt     uint64 `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	ActiveClients uint32 `protobuf:"varint,7,opt,name=active_clients,json=activeClients,proto3" json:"active_clients,omitempty"`
-	Cancellations uint32 `protobuf:"varint,8,opt,name=cancellations,proto3" json:"cancellations,omitempty"`
+	Id                      uint64          `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Operator                string          `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Payout                  string          `protobuf:"bytes,3,opt,name=payout,proto3" json:"payout,omitempty"`
+	Active                  bool            `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"`
+	Metadata                string          `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	CreatedAt               uint64          `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ActiveClients           uint32          `protobuf:"varint,7,opt,name=active_clients,json=activeClients,proto3" json:"active_clients,omitempty"`
+	Cancellations           uint32          `protobuf:"varint,8,opt,name=cancellations,proto3" json:"cancellations,omitempty"`
+	AutoClaim               bool            `protobuf:"varint,9,opt,name=auto_claim,json=autoClaim,proto3" json:"auto_claim,omitempty"`
+	AcceptedDenoms          []string        `protobuf:"bytes,10,rep,name=accepted_denoms,json=acceptedDenoms,proto3" json:"accepted_denoms,omitempty"`
+	Profile                 *GatewayProfile `protobuf:"bytes,11,opt,name=profile,proto3" json:"profile,omitempty"`
+	PendingOperator         string          `protobuf:"bytes,12,opt,name=pending_operator,json=pendingOperator,proto3" json:"pending_operator,omitempty"`
+	TransferredAt           uint64          `protobuf:"varint,13,opt,name=transferred_at,json=transferredAt,proto3" json:"transferred_at,omitempty"`
+	DecommissionAt          uint64          `protobuf:"varint,14,opt,name=decommission_at,json=decommissionAt,proto3" json:"decommission_at,omitempty"`
+	Archived                bool            `protobuf:"varint,15,opt,name=archived,proto3" json:"archived,omitempty"`
+	LastHeartbeatAt         uint64          `protobuf:"varint,16,opt,name=last_heartbeat_at,json=lastHeartbeatAt,proto3" json:"last_heartbeat_at,omitempty"`
+	Offline                 bool            `protobuf:"varint,17,opt,name=offline,proto3" json:"offline,omitempty"`
+	ContractTransferConsent bool            `protobuf:"varint,18,opt,name=contract_transfer_consent,json=contractTransferConsent,proto3" json:"contract_transfer_consent,omitempty"`
 }
 
 func (m *Gateway) Reset()         { *m = Gateway{} }
@@ -161,27 +266,306 @@ func (m *Gateway) GetCancellations() uint32 {
 	return 0
 }
 
+func (m *Gateway) GetAutoClaim() bool {
+	if m != nil {
+		return m.AutoClaim
+	}
+	return false
+}
+
+func (m *Gateway) GetAcceptedDenoms() []string {
+	if m != nil {
+		return m.AcceptedDenoms
+	}
+	return nil
+}
+
+func (m *Gateway) GetProfile() *GatewayProfile {
+	if m != nil {
+		return m.Profile
+	}
+	return nil
+}
+
+func (m *Gateway) GetPendingOperator() string {
+	if m != nil {
+		return m.PendingOperator
+	}
+	return ""
+}
+
+func (m *Gateway) GetTransferredAt() uint64 {
+	if m != nil {
+		return m.TransferredAt
+	}
+	return 0
+}
+
+func (m *Gateway) GetDecommissionAt() uint64 {
+	if m != nil {
+		return m.DecommissionAt
+	}
+	return 0
+}
+
+func (m *Gateway) GetArchived() bool {
+	if m != nil {
+		return m.Archived
+	}
+	return false
+}
+
+func (m *Gateway) GetLastHeartbeatAt() uint64 {
+	if m != nil {
+		return m.LastHeartbeatAt
+	}
+	return 0
+}
+
+func (m *Gateway) GetOffline() bool {
+	if m != nil {
+		return m.Offline
+	}
+	return false
+}
+
+func (m *Gateway) GetContractTransferConsent() bool {
+	if m != nil {
+		return m.ContractTransferConsent
+	}
+	return false
+}
+
+// GatewayProfile is the machine-readable description clients use to discover
+// a gateway: where to reach it, where it runs and what it serves.
+type GatewayProfile struct {
+	Endpoints []GatewayEndpoint `protobuf:"bytes,1,rep,name=endpoints,proto3" json:"endpoints"`
+	Regions   []string          `protobuf:"bytes,2,rep,name=regions,proto3" json:"regions,omitempty"`
+	Protocols []GatewayProtocol `protobuf:"varint,3,rep,packed,name=protocols,proto3,enum=lumen.gateway.v1.GatewayProtocol" json:"protocols,omitempty"`
+	Capacity  *GatewayCapacity  `protobuf:"bytes,4,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Contact   string            `protobuf:"bytes,5,opt,name=contact,proto3" json:"contact,omitempty"`
+}
+
+func (m *GatewayProfile) Reset()         { *m = GatewayProfile{} }
+func (m *GatewayProfile) String() string { return proto.CompactTextString(m) }
+func (*GatewayProfile) ProtoMessage()    {}
+func (*GatewayProfile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d819620b0ccaefe, []int{1}
+}
+func (m *GatewayProfile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GatewayProfile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GatewayProfile.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GatewayProfile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayProfile.Merge(m, src)
+}
+func (m *GatewayProfile) XXX_Size() int {
+	return m.Size()
+}
+func (m *GatewayProfile) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayProfile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GatewayProfile proto.InternalMessageInfo
+
+func (m *GatewayProfile) GetEndpoints() []GatewayEndpoint {
+	if m != nil {
+		return m.Endpoints
+	}
+	return nil
+}
+
+func (m *GatewayProfile) GetRegions() []string {
+	if m != nil {
+		return m.Regions
+	}
+	return nil
+}
+
+func (m *GatewayProfile) GetProtocols() []GatewayProtocol {
+	if m != nil {
+		return m.Protocols
+	}
+	return nil
+}
+
+func (m *GatewayProfile) GetCapacity() *GatewayCapacity {
+	if m != nil {
+		return m.Capacity
+	}
+	return nil
+}
+
+func (m *GatewayProfile) GetContact() string {
+	if m != nil {
+		return m.Contact
+	}
+	return ""
+}
+
+// GatewayEndpoint is a hostname serving one protocol. port 0 means the
+// protocol's default port.
+type GatewayEndpoint struct {
+	Host     string          `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	Protocol GatewayProtocol `protobuf:"varint,2,opt,name=protocol,proto3,enum=lumen.gateway.v1.GatewayProtocol" json:"protocol,omitempty"`
+	Port     uint32          `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+}
+
+func (m *GatewayEndpoint) Reset()         { *m = GatewayEndpoint{} }
+func (m *GatewayEndpoint) String() string { return proto.CompactTextString(m) }
+func (*GatewayEndpoint) ProtoMessage()    {}
+func (*GatewayEndpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d819620b0ccaefe, []int{2}
+}
+func (m *GatewayEndpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GatewayEndpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GatewayEndpoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GatewayEndpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayEndpoint.Merge(m, src)
+}
+func (m *GatewayEndpoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *GatewayEndpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayEndpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GatewayEndpoint proto.InternalMessageInfo
+
+func (m *GatewayEndpoint) GetHost() string {
+	if m != nil {
+		return m.Host
+	}
+	return ""
+}
+
+func (m *GatewayEndpoint) GetProtocol() GatewayProtocol {
+	if m != nil {
+		return m.Protocol
+	}
+	return GatewayProtocol_GATEWAY_PROTOCOL_UNSPECIFIED
+}
+
+func (m *GatewayEndpoint) GetPort() uint32 {
+	if m != nil {
+		return m.Port
+	}
+	return 0
+}
+
+// GatewayCapacity is the capacity an operator advertises; 0 means unstated.
+type GatewayCapacity struct {
+	StorageGb         uint64 `protobuf:"varint,1,opt,name=storage_gb,json=storageGb,proto3" json:"storage_gb,omitempty"`
+	NetworkGbPerMonth uint64 `protobuf:"varint,2,opt,name=network_gb_per_month,json=networkGbPerMonth,proto3" json:"network_gb_per_month,omitempty"`
+	MaxClients        uint32 `protobuf:"varint,3,opt,name=max_clients,json=maxClients,proto3" json:"max_clients,omitempty"`
+}
+
+func (m *GatewayCapacity) Reset()         { *m = GatewayCapacity{} }
+func (m *GatewayCapacity) String() string { return proto.CompactTextString(m) }
+func (*GatewayCapacity) ProtoMessage()    {}
+func (*GatewayCapacity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d819620b0ccaefe, []int{3}
+}
+func (m *GatewayCapacity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GatewayCapacity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GatewayCapacity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GatewayCapacity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayCapacity.Merge(m, src)
+}
+func (m *GatewayCapacity) XXX_Size() int {
+	return m.Size()
+}
+func (m *GatewayCapacity) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayCapacity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GatewayCapacity proto.InternalMessageInfo
+
+func (m *GatewayCapacity) GetStorageGb() uint64 {
+	if m != nil {
+		return m.StorageGb
+	}
+	return 0
+}
+
+func (m *GatewayCapacity) GetNetworkGbPerMonth() uint64 {
+	if m != nil {
+		return m.NetworkGbPerMonth
+	}
+	return 0
+}
+
+func (m *GatewayCapacity) GetMaxClients() uint32 {
+	if m != nil {
+		return m.MaxClients
+	}
+	return 0
+}
+
 type Contract struct {
-	Id                uint64         `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Client            string         `protobuf:"bytes,2,opt,name=client,proto3" json:"client,omitempty"`
-	GatewayId         uint64         `protobuf:"varint,3,opt,name=gateway_id,json=gatewayId,proto3" json:"gateway_id,omitempty"`
-	PriceUlmn         uint64         `protobuf:"varint,4,opt,name=price_ulmn,json=priceUlmn,proto3" json:"price_ulmn,omitempty"`
-	StorageGbPerMonth uint64         `protobuf:"varint,5,opt,name=storage_gb_per_month,json=storageGbPerMonth,proto3" json:"storage_gb_per_month,omitempty"`
-	NetworkGbPerMonth uint64         `protobuf:"varint,6,opt,name=network_gb_per_month,json=networkGbPerMonth,proto3" json:"network_gb_per_month,omitempty"`
-	MonthsTotal       uint32         `protobuf:"varint,7,opt,name=months_total,json=monthsTotal,proto3" json:"months_total,omitempty"`
-	StartTime         uint64         `protobuf:"varint,8,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EscrowUlmn        string         `protobuf:"bytes,9,opt,name=escrow_ulmn,json=escrowUlmn,proto3" json:"escrow_ulmn,omitempty"`
-	ClaimedMonths     uint32         `protobuf:"varint,10,opt,name=claimed_months,json=claimedMonths,proto3" json:"claimed_months,omitempty"`
-	Status            ContractStatus `protobuf:"varint,11,opt,name=status,proto3,enum=lumen.gateway.v1.ContractStatus" json:"status,omitempty"`
-	Metadata          string         `protobuf:"bytes,12,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	NextPayoutTime    uint64         `protobuf:"varint,13,opt,name=next_payout_time,json=nextPayoutTime,proto3" json:"next_payout_time,omitempty"`
+	Id                uint64             `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Client            string             `protobuf:"bytes,2,opt,name=client,proto3" json:"client,omitempty"`
+	GatewayId         uint64             `protobuf:"varint,3,opt,name=gateway_id,json=gatewayId,proto3" json:"gateway_id,omitempty"`
+	PriceUlmn         uint64             `protobuf:"varint,4,opt,name=price_ulmn,json=priceUlmn,proto3" json:"price_ulmn,omitempty"`
+	StorageGbPerMonth uint64             `protobuf:"varint,5,opt,name=storage_gb_per_month,json=storageGbPerMonth,proto3" json:"storage_gb_per_month,omitempty"`
+	NetworkGbPerMonth uint64             `protobuf:"varint,6,opt,name=network_gb_per_month,json=networkGbPerMonth,proto3" json:"network_gb_per_month,omitempty"`
+	MonthsTotal       uint32             `protobuf:"varint,7,opt,name=months_total,json=monthsTotal,proto3" json:"months_total,omitempty"`
+	StartTime         uint64             `protobuf:"varint,8,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EscrowUlmn        string             `protobuf:"bytes,9,opt,name=escrow_ulmn,json=escrowUlmn,proto3" json:"escrow_ulmn,omitempty"`
+	ClaimedMonths     uint32             `protobuf:"varint,10,opt,name=claimed_months,json=claimedMonths,proto3" json:"claimed_months,omitempty"`
+	Status            ContractStatus     `protobuf:"varint,11,opt,name=status,proto3,enum=lumen.gateway.v1.ContractStatus" json:"status,omitempty"`
+	Metadata          string             `protobuf:"bytes,12,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	NextPayoutTime    uint64             `protobuf:"varint,13,opt,name=next_payout_time,json=nextPayoutTime,proto3" json:"next_payout_time,omitempty"`
+	UsageWithheldUlmn string             `protobuf:"bytes,14,opt,name=usage_withheld_ulmn,json=usageWithheldUlmn,proto3" json:"usage_withheld_ulmn,omitempty"`
+	DisputeId         uint64             `protobuf:"varint,15,opt,name=dispute_id,json=disputeId,proto3" json:"dispute_id,omitempty"`
+	OfferId           uint64             `protobuf:"varint,16,opt,name=offer_id,json=offerId,proto3" json:"offer_id,omitempty"`
+	PendingAmendment  *ContractAmendment `protobuf:"bytes,17,opt,name=pending_amendment,json=pendingAmendment,proto3" json:"pending_amendment,omitempty"`
+	AcceptDeadline    uint64             `protobuf:"varint,18,opt,name=accept_deadline,json=acceptDeadline,proto3" json:"accept_deadline,omitempty"`
+	PendingTaxUlmn    string             `protobuf:"bytes,19,opt,name=pending_tax_ulmn,json=pendingTaxUlmn,proto3" json:"pending_tax_ulmn,omitempty"`
+	Denom             string             `protobuf:"bytes,20,opt,name=denom,proto3" json:"denom,omitempty"`
+	PendingClient     string             `protobuf:"bytes,21,opt,name=pending_client,json=pendingClient,proto3" json:"pending_client,omitempty"`
 }
 
 func (m *Contract) Reset()         { *m = Contract{} }
 func (m *Contract) String() string { return proto.CompactTextString(m) }
 func (*Contract) ProtoMessage()    {}
 func (*Contract) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7d819620b0ccaefe, []int{1}
+	return fileDescriptor_7d819620b0ccaefe, []int{4}
 }
 func (m *Contract) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -301,331 +685,5643 @@ func (m *Contract) GetNextPayoutTime() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterEnum("lumen.gateway.v1.ContractStatus", ContractStatus_name, ContractStatus_value)
-	proto.RegisterType((*Gateway)(nil), "lumen.gateway.v1.Gateway")
-	proto.RegisterType((*Contract)(nil), "lumen.gateway.v1.Contract")
+func (m *Contract) GetUsageWithheldUlmn() string {
+	if m != nil {
+		return m.UsageWithheldUlmn
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("lumen/gateway/v1/types.proto", fileDescriptor_7d819620b0ccaefe) }
+func (m *Contract) GetDisputeId() uint64 {
+	if m != nil {
+		return m.DisputeId
+	}
+	return 0
+}
 
-var fileDescriptor_7d819620b0ccaefe = []byte{
-	// 625 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x94, 0xcf, 0x4e, 0xdb, 0x40,
-	0x10, 0xc6, 0xe3, 0x60, 0x42, 0x32, 0x90, 0xc8, 0xac, 0x50, 0xea, 0x06, 0x08, 0x29, 0x2a, 0x52,
-	0xd4, 0x43, 0x52, 0xda, 0x4b, 0xaf, 0xa9, 0x63, 0x90, 0x25, 0x08, 0x91, 0x63, 0x7a, 0xe0, 0x62,
-	0x2d, 0xf6, 0x2a, 0xb5, 0x6a, 0x7b, 0x23, 0x7b, 0xc2, 0x9f, 0xb7, 0xe8, 0x53, 0x55, 0x3d, 0x72,
-	0xec, 0xa1, 0x87, 0x0a, 0xce, 0x7d, 0x87, 0xca, 0xbb, 0x9b, 0xaa, 0x09, 0xbd, 0x44, 0x9e, 0xdf,
-	0x37, 0xb3, 0x3b, 0xdf, 0x68, 0xb2, 0xb0, 0x17, 0xcf, 0x13, 0x96, 0xf6, 0xa7, 0x14, 0xd9, 0x2d,
-	0xbd, 0xef, 0xdf, 0x1c, 0xf7, 0xf1, 0x7e, 0xc6, 0xf2, 0xde, 0x2c, 0xe3, 0xc8, 0x89, 0x21, 0xd4,
-	0x9e, 0x52, 0x7b, 0x37, 0xc7, 0xad, 0x6d, 0x9a, 0x44, 0x29, 0xef, 0x8b, 0x5f, 0x99, 0xd4, 0xda,
-	0x99, 0xf2, 0x29, 0x17, 0x9f, 0xfd, 0xe2, 0x4b, 0xd2, 0xc3, 0xdf, 0x1a, 0x6c, 0x9c, 0xca, 0x3a,
-	0xd2, 0x80, 0x72, 0x14, 0x9a, 0x5a, 0x47, 0xeb, 0xea, 0x6e, 0x39, 0x0a, 0x49, 0x0b, 0xaa, 0x7c,
-	0xc6, 0x32, 0x8a, 0x3c, 0x33, 0xcb, 0x1d, 0xad, 0x5b, 0x73, 0xff, 0xc6, 0xa4, 0x09, 0x95, 0x19,
-	0xbd, 0xe7, 0x73, 0x34, 0xd7, 0x84, 0xa2, 0xa2, 0x82, 0xd3, 0x00, 0xa3, 0x1b, 0x66, 0xea, 0x1d,
-	0xad, 0x5b, 0x75, 0x55, 0x54, 0x9c, 0x95, 0x30, 0xa4, 0x21, 0x45, 0x6a, 0xae, 0xcb, 0xb3, 0x16,
-	0x31, 0xd9, 0x07, 0x08, 0x32, 0x46, 0x91, 0x85, 0x3e, 0x45, 0xb3, 0x22, 0xee, 0xaf, 0x29, 0x32,
-	0x40, 0x72, 0x04, 0x0d, 0x79, 0x88, 0x1f, 0xc4, 0x11, 0x4b, 0x31, 0x37, 0x37, 0x3a, 0x5a, 0xb7,
-	0xee, 0xd6, 0x25, 0xb5, 0x24, 0x24, 0xaf, 0xa1, 0x1e, 0xd0, 0x34, 0x60, 0x71, 0x4c, 0x31, 0xe2,
-	0x69, 0x6e, 0x56, 0x65, 0xd6, 0x12, 0x3c, 0xfc, 0xb9, 0x06, 0x55, 0x8b, 0xa7, 0x98, 0xd1, 0x00,
-	0x9f, 0x19, 0x6e, 0x42, 0x45, 0x5e, 0xa1, 0xec, 0xaa, 0xa8, 0x68, 0x50, 0xcd, 0xd6, 0x8f, 0x42,
-	0x61, 0x58, 0x77, 0x6b, 0x8a, 0x38, 0x61, 0x21, 0xcf, 0xb2, 0x28, 0x60, 0xfe, 0x3c, 0x4e, 0x52,
-	0xe1, 0x5b, 0x77, 0x6b, 0x82, 0x5c, 0xc6, 0x49, 0x4a, 0xfa, 0xb0, 0x93, 0x23, 0xcf, 0xe8, 0x94,
-	0xf9, 0xd3, 0x6b, 0x7f, 0xc6, 0x32, 0x3f, 0xe1, 0x29, 0x7e, 0x16, 0x63, 0xd0, 0xdd, 0x6d, 0xa5,
-	0x9d, 0x5e, 0x8f, 0x59, 0x76, 0x5e, 0x08, 0x45, 0x41, 0xca, 0xf0, 0x96, 0x67, 0x5f, 0x96, 0x0b,
-	0xe4, 0x64, 0xb6, 0x95, 0xf6, 0x4f, 0xc1, 0x2b, 0xd8, 0x12, 0x19, 0xb9, 0x8f, 0x1c, 0x69, 0xac,
-	0xe6, 0xb3, 0x29, 0x99, 0x57, 0xa0, 0xa2, 0xc7, 0x1c, 0x69, 0x86, 0x3e, 0x46, 0x09, 0x13, 0xa3,
-	0xd1, 0xdd, 0x9a, 0x20, 0x5e, 0x94, 0x30, 0x72, 0x00, 0x9b, 0x2c, 0x0f, 0x32, 0x7e, 0x2b, 0x3d,
-	0xd4, 0x84, 0x7d, 0x90, 0x48, 0x98, 0x38, 0x82, 0x46, 0x10, 0xd3, 0x28, 0x61, 0xa1, 0x6c, 0x26,
-	0x37, 0x41, 0x8d, 0x57, 0x52, 0xd1, 0x48, 0x4e, 0x3e, 0x40, 0x25, 0x47, 0x8a, 0xf3, 0xdc, 0xdc,
-	0xec, 0x68, 0xdd, 0xc6, 0xbb, 0x4e, 0x6f, 0x75, 0x35, 0x7b, 0x8b, 0xe9, 0x4f, 0x44, 0x9e, 0xab,
-	0xf2, 0x97, 0x16, 0x64, 0x6b, 0x65, 0x41, 0xba, 0x60, 0xa4, 0xec, 0x0e, 0x7d, 0xb9, 0x63, 0xd2,
-	0x42, 0x5d, 0x58, 0x68, 0x14, 0x7c, 0x2c, 0x70, 0xe1, 0xe3, 0xcd, 0x37, 0x0d, 0x1a, 0xcb, 0x17,
-	0x90, 0x03, 0xd8, 0xb5, 0x2e, 0x46, 0x9e, 0x3b, 0xb0, 0x3c, 0x7f, 0xe2, 0x0d, 0xbc, 0xcb, 0x89,
-	0x7f, 0x39, 0x9a, 0x8c, 0x6d, 0xcb, 0x39, 0x71, 0xec, 0xa1, 0x51, 0x22, 0xbb, 0xf0, 0x62, 0x35,
-	0x61, 0x6c, 0x8f, 0x86, 0xce, 0xe8, 0xd4, 0xd0, 0x48, 0x0b, 0x9a, 0xab, 0xe2, 0xc0, 0xf2, 0x9c,
-	0x4f, 0xb6, 0x51, 0x26, 0x7b, 0x60, 0xae, 0x6a, 0xd6, 0x60, 0x64, 0xd9, 0x67, 0xf6, 0xd0, 0x58,
-	0x23, 0xfb, 0xf0, 0xf2, 0x99, 0x7a, 0x71, 0x3e, 0x3e, 0xb3, 0x3d, 0x7b, 0x68, 0xe8, 0xff, 0x93,
-	0x4f, 0x9c, 0xd1, 0xe0, 0xcc, 0xb9, 0xb2, 0x87, 0xc6, 0xfa, 0xc7, 0xb7, 0xdf, 0x1f, 0xdb, 0xda,
-	0xc3, 0x63, 0x5b, 0xfb, 0xf5, 0xd8, 0xd6, 0xbe, 0x3e, 0xb5, 0x4b, 0x0f, 0x4f, 0xed, 0xd2, 0x8f,
-	0xa7, 0x76, 0xe9, 0xaa, 0x29, 0x9f, 0x82, 0xbb, 0xc5, 0x63, 0x90, 0xcb, 0xa7, 0xe0, 0xba, 0x22,
-	0xfe, 0xd0, 0xef, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x09, 0x4e, 0xdb, 0xc1, 0x2b, 0x04, 0x00,
-	0x00,
+func (m *Contract) GetOfferId() uint64 {
+	if m != nil {
+		return m.OfferId
+	}
+	return 0
 }
 
-func (m *Gateway) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Contract) GetPendingAmendment() *ContractAmendment {
+	if m != nil {
+		return m.PendingAmendment
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Gateway) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Contract) GetAcceptDeadline() uint64 {
+	if m != nil {
+		return m.AcceptDeadline
+	}
+	return 0
 }
 
-func (m *Gateway) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Cancellations != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Cancellations))
-		i--
-		dAtA[i] = 0x40
+func (m *Contract) GetPendingTaxUlmn() string {
+	if m != nil {
+		return m.PendingTaxUlmn
 	}
-	if m.ActiveClients != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.ActiveClients))
-		i--
-		dAtA[i] = 0x38
+	return ""
+}
+
+func (m *Contract) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if m.CreatedAt != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.CreatedAt))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.Metadata) > 0 {
-		i -= len(m.Metadata)
-		copy(dAtA[i:], m.Metadata)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Metadata)))
-		i--
-		dAtA[i] = 0x2a
+	return ""
+}
+
+func (m *Contract) GetPendingClient() string {
+	if m != nil {
+		return m.PendingClient
 	}
-	if m.Active {
-		i--
-		if m.Active {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	return ""
+}
+
+// ContractAmendment is a client-proposed change of quotas and price for the
+// remaining months of a contract. It applies once the gateway operator
+// accepts it.
+type ContractAmendment struct {
+	PriceUlmn         uint64 `protobuf:"varint,1,opt,name=price_ulmn,json=priceUlmn,proto3" json:"price_ulmn,omitempty"`
+	StorageGbPerMonth uint64 `protobuf:"varint,2,opt,name=storage_gb_per_month,json=storageGbPerMonth,proto3" json:"storage_gb_per_month,omitempty"`
+	NetworkGbPerMonth uint64 `protobuf:"varint,3,opt,name=network_gb_per_month,json=networkGbPerMonth,proto3" json:"network_gb_per_month,omitempty"`
+	ProposedAt        uint64 `protobuf:"varint,4,opt,name=proposed_at,json=proposedAt,proto3" json:"proposed_at,omitempty"`
+}
+
+func (m *ContractAmendment) Reset()         { *m = ContractAmendment{} }
+func (m *ContractAmendment) String() string { return proto.CompactTextString(m) }
+func (*ContractAmendment) ProtoMessage()    {}
+func (*ContractAmendment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d819620b0ccaefe, []int{5}
+}
+func (m *ContractAmendment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractAmendment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractAmendment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x20
+		return b[:n], nil
 	}
-	if len(m.Payout) > 0 {
-		i -= len(m.Payout)
-		copy(dAtA[i:], m.Payout)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Payout)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *ContractAmendment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractAmendment.Merge(m, src)
+}
+func (m *ContractAmendment) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractAmendment) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractAmendment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractAmendment proto.InternalMessageInfo
+
+func (m *ContractAmendment) GetPriceUlmn() uint64 {
+	if m != nil {
+		return m.PriceUlmn
 	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *ContractAmendment) GetStorageGbPerMonth() uint64 {
+	if m != nil {
+		return m.StorageGbPerMonth
 	}
-	if m.Id != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *ContractAmendment) GetNetworkGbPerMonth() uint64 {
+	if m != nil {
+		return m.NetworkGbPerMonth
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *Contract) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ContractAmendment) GetProposedAt() uint64 {
+	if m != nil {
+		return m.ProposedAt
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Contract) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Offer is a price list entry published by a gateway. A contract created
+// from an offer takes its price and quotas and holds one capacity slot until
+// it is canceled or finalized.
+type Offer struct {
+	Id                 uint64              `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	GatewayId          uint64              `protobuf:"varint,2,opt,name=gateway_id,json=gatewayId,proto3" json:"gateway_id,omitempty"`
+	PriceUlmn          uint64              `protobuf:"varint,3,opt,name=price_ulmn,json=priceUlmn,proto3" json:"price_ulmn,omitempty"`
+	StorageGbPerMonth  uint64              `protobuf:"varint,4,opt,name=storage_gb_per_month,json=storageGbPerMonth,proto3" json:"storage_gb_per_month,omitempty"`
+	NetworkGbPerMonth  uint64              `protobuf:"varint,5,opt,name=network_gb_per_month,json=networkGbPerMonth,proto3" json:"network_gb_per_month,omitempty"`
+	MinMonths          uint32              `protobuf:"varint,6,opt,name=min_months,json=minMonths,proto3" json:"min_months,omitempty"`
+	MaxMonths          uint32              `protobuf:"varint,7,opt,name=max_months,json=maxMonths,proto3" json:"max_months,omitempty"`
+	Regions            []string            `protobuf:"bytes,8,rep,name=regions,proto3" json:"regions,omitempty"`
+	CapacitySlots      uint32              `protobuf:"varint,9,opt,name=capacity_slots,json=capacitySlots,proto3" json:"capacity_slots,omitempty"`
+	UsedSlots          uint32              `protobuf:"varint,10,opt,name=used_slots,json=usedSlots,proto3" json:"used_slots,omitempty"`
+	Retired            bool                `protobuf:"varint,11,opt,name=retired,proto3" json:"retired,omitempty"`
+	CreatedAt          uint64              `protobuf:"varint,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Denom              string              `protobuf:"bytes,13,opt,name=denom,proto3" json:"denom,omitempty"`
+	CancellationPolicy *CancellationPolicy `protobuf:"bytes,14,opt,name=cancellation_policy,json=cancellationPolicy,proto3" json:"cancellation_policy,omitempty"`
 }
 
-func (m *Contract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.NextPayoutTime != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.NextPayoutTime))
-		i--
-		dAtA[i] = 0x68
-	}
-	if len(m.Metadata) > 0 {
-		i -= len(m.Metadata)
-		copy(dAtA[i:], m.Metadata)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Metadata)))
-		i--
-		dAtA[i] = 0x62
-	}
-	if m.Status != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x58
-	}
-	if m.ClaimedMonths != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.ClaimedMonths))
-		i--
-		dAtA[i] = 0x50
-	}
-	if len(m.EscrowUlmn) > 0 {
-		i -= len(m.EscrowUlmn)
-		copy(dAtA[i:], m.EscrowUlmn)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.EscrowUlmn)))
-		i--
-		dAtA[i] = 0x4a
+func (m *Offer) Reset()         { *m = Offer{} }
+func (m *Offer) String() string { return proto.CompactTextString(m) }
+func (*Offer) ProtoMessage()    {}
+func (*Offer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d819620b0ccaefe, []int{6}
+}
+func (m *Offer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Offer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Offer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.StartTime != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.StartTime))
-		i--
-		dAtA[i] = 0x40
+}
+func (m *Offer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Offer.Merge(m, src)
+}
+func (m *Offer) XXX_Size() int {
+	return m.Size()
+}
+func (m *Offer) XXX_DiscardUnknown() {
+	xxx_messageInfo_Offer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Offer proto.InternalMessageInfo
+
+func (m *Offer) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	if m.MonthsTotal != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.MonthsTotal))
-		i--
-		dAtA[i] = 0x38
+	return 0
+}
+
+func (m *Offer) GetGatewayId() uint64 {
+	if m != nil {
+		return m.GatewayId
 	}
-	if m.NetworkGbPerMonth != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.NetworkGbPerMonth))
-		i--
-		dAtA[i] = 0x30
+	return 0
+}
+
+func (m *Offer) GetPriceUlmn() uint64 {
+	if m != nil {
+		return m.PriceUlmn
 	}
-	if m.StorageGbPerMonth != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.StorageGbPerMonth))
-		i--
-		dAtA[i] = 0x28
+	return 0
+}
+
+func (m *Offer) GetStorageGbPerMonth() uint64 {
+	if m != nil {
+		return m.StorageGbPerMonth
 	}
-	if m.PriceUlmn != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.PriceUlmn))
-		i--
-		dAtA[i] = 0x20
+	return 0
+}
+
+func (m *Offer) GetNetworkGbPerMonth() uint64 {
+	if m != nil {
+		return m.NetworkGbPerMonth
 	}
-	if m.GatewayId != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.GatewayId))
-		i--
-		dAtA[i] = 0x18
+	return 0
+}
+
+func (m *Offer) GetMinMonths() uint32 {
+	if m != nil {
+		return m.MinMonths
 	}
-	if len(m.Client) > 0 {
-		i -= len(m.Client)
-		copy(dAtA[i:], m.Client)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Client)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *Offer) GetMaxMonths() uint32 {
+	if m != nil {
+		return m.MaxMonths
 	}
-	if m.Id != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *Offer) GetRegions() []string {
+	if m != nil {
+		return m.Regions
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Offer) GetCapacitySlots() uint32 {
+	if m != nil {
+		return m.CapacitySlots
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *Gateway) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *Offer) GetUsedSlots() uint32 {
+	if m != nil {
+		return m.UsedSlots
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovTypes(uint64(m.Id))
+	return 0
+}
+
+func (m *Offer) GetRetired() bool {
+	if m != nil {
+		return m.Retired
 	}
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return false
+}
+
+func (m *Offer) GetCreatedAt() uint64 {
+	if m != nil {
+		return m.CreatedAt
 	}
-	l = len(m.Payout)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return 0
+}
+
+func (m *Offer) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if m.Active {
-		n += 2
+	return ""
+}
+
+func (m *Offer) GetCancellationPolicy() *CancellationPolicy {
+	if m != nil {
+		return m.CancellationPolicy
 	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return nil
+}
+
+// GatewayBond is the stake an operator keeps in the GatewaysBond module
+// account. Unbonding funds stay slashable until unbonding_complete_at.
+type GatewayBond struct {
+	GatewayId           uint64 `protobuf:"varint,1,opt,name=gateway_id,json=gatewayId,proto3" json:"gateway_id,omitempty"`
+	BondedUlmn          string `protobuf:"bytes,2,opt,name=bonded_ulmn,json=bondedUlmn,proto3" json:"bonded_ulmn,omitempty"`
+	UnbondingUlmn       string `protobuf:"bytes,3,opt,name=unbonding_ulmn,json=unbondingUlmn,proto3" json:"unbonding_ulmn,omitempty"`
+	UnbondingCompleteAt uint64 `protobuf:"varint,4,opt,name=unbonding_complete_at,json=unbondingCompleteAt,proto3" json:"unbonding_complete_at,omitempty"`
+	SlashedUlmn         string `protobuf:"bytes,5,opt,name=slashed_ulmn,json=slashedUlmn,proto3" json:"slashed_ulmn,omitempty"`
+}
+
+func (m *GatewayBond) Reset()         { *m = GatewayBond{} }
+func (m *GatewayBond) String() string { return proto.CompactTextString(m) }
+func (*GatewayBond) ProtoMessage()    {}
+func (*GatewayBond) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d819620b0ccaefe, []int{7}
+}
+func (m *GatewayBond) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GatewayBond) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GatewayBond.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.CreatedAt != 0 {
-		n += 1 + sovTypes(uint64(m.CreatedAt))
+}
+func (m *GatewayBond) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayBond.Merge(m, src)
+}
+func (m *GatewayBond) XXX_Size() int {
+	return m.Size()
+}
+func (m *GatewayBond) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayBond.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GatewayBond proto.InternalMessageInfo
+
+func (m *GatewayBond) GetGatewayId() uint64 {
+	if m != nil {
+		return m.GatewayId
 	}
-	if m.ActiveClients != 0 {
-		n += 1 + sovTypes(uint64(m.ActiveClients))
+	return 0
+}
+
+func (m *GatewayBond) GetBondedUlmn() string {
+	if m != nil {
+		return m.BondedUlmn
 	}
-	if m.Cancellations != 0 {
-		n += 1 + sovTypes(uint64(m.Cancellations))
+	return ""
+}
+
+func (m *GatewayBond) GetUnbondingUlmn() string {
+	if m != nil {
+		return m.UnbondingUlmn
 	}
-	return n
+	return ""
 }
 
-func (m *Contract) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GatewayBond) GetUnbondingCompleteAt() uint64 {
+	if m != nil {
+		return m.UnbondingCompleteAt
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovTypes(uint64(m.Id))
+	return 0
+}
+
+func (m *GatewayBond) GetSlashedUlmn() string {
+	if m != nil {
+		return m.SlashedUlmn
 	}
-	l = len(m.Client)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return ""
+}
+
+// GatewayReputation tracks contract outcomes for a gateway. score is in
+// basis points (0-10000) and is recomputed whenever it is read, since it
+// also depends on the gateway's age.
+type GatewayReputation struct {
+	GatewayId          uint64 `protobuf:"varint,1,opt,name=gateway_id,json=gatewayId,proto3" json:"gateway_id,omitempty"`
+	ContractsCompleted uint64 `protobuf:"varint,2,opt,name=contracts_completed,json=contractsCompleted,proto3" json:"contracts_completed,omitempty"`
+	ContractsCancelled uint64 `protobuf:"varint,3,opt,name=contracts_cancelled,json=contractsCancelled,proto3" json:"contracts_cancelled,omitempty"`
+	MonthsDelivered    uint64 `protobuf:"varint,4,opt,name=months_delivered,json=monthsDelivered,proto3" json:"months_delivered,omitempty"`
+	DisputesLost       uint64 `protobuf:"varint,5,opt,name=disputes_lost,json=disputesLost,proto3" json:"disputes_lost,omitempty"`
+	Score              uint32 `protobuf:"varint,6,opt,name=score,proto3" json:"score,omitempty"`
+	UpdatedAt          uint64 `protobuf:"varint,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (m *GatewayReputation) Reset()         { *m = GatewayReputation{} }
+func (m *GatewayReputation) String() string { return proto.CompactTextString(m) }
+func (*GatewayReputation) ProtoMessage()    {}
+func (*GatewayReputation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d819620b0ccaefe, []int{8}
+}
+func (m *GatewayReputation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GatewayReputation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GatewayReputation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.GatewayId != 0 {
-		n += 1 + sovTypes(uint64(m.GatewayId))
+}
+func (m *GatewayReputation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayReputation.Merge(m, src)
+}
+func (m *GatewayReputation) XXX_Size() int {
+	return m.Size()
+}
+func (m *GatewayReputation) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayReputation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GatewayReputation proto.InternalMessageInfo
+
+func (m *GatewayReputation) GetGatewayId() uint64 {
+	if m != nil {
+		return m.GatewayId
 	}
-	if m.PriceUlmn != 0 {
-		n += 1 + sovTypes(uint64(m.PriceUlmn))
+	return 0
+}
+
+func (m *GatewayReputation) GetContractsCompleted() uint64 {
+	if m != nil {
+		return m.ContractsCompleted
 	}
-	if m.StorageGbPerMonth != 0 {
-		n += 1 + sovTypes(uint64(m.StorageGbPerMonth))
+	return 0
+}
+
+func (m *GatewayReputation) GetContractsCancelled() uint64 {
+	if m != nil {
+		return m.ContractsCancelled
 	}
-	if m.NetworkGbPerMonth != 0 {
-		n += 1 + sovTypes(uint64(m.NetworkGbPerMonth))
+	return 0
+}
+
+func (m *GatewayReputation) GetMonthsDelivered() uint64 {
+	if m != nil {
+		return m.MonthsDelivered
 	}
-	if m.MonthsTotal != 0 {
-		n += 1 + sovTypes(uint64(m.MonthsTotal))
+	return 0
+}
+
+func (m *GatewayReputation) GetDisputesLost() uint64 {
+	if m != nil {
+		return m.DisputesLost
 	}
-	if m.StartTime != 0 {
-		n += 1 + sovTypes(uint64(m.StartTime))
+	return 0
+}
+
+func (m *GatewayReputation) GetScore() uint32 {
+	if m != nil {
+		return m.Score
 	}
-	l = len(m.EscrowUlmn)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return 0
+}
+
+func (m *GatewayReputation) GetUpdatedAt() uint64 {
+	if m != nil {
+		return m.UpdatedAt
 	}
-	if m.ClaimedMonths != 0 {
-		n += 1 + sovTypes(uint64(m.ClaimedMonths))
+	return 0
+}
+
+// DomainBinding links a gateway to an x/dns name (name.ext) owned by the
+// gateway operator or payout address.
+type DomainBinding struct {
+	Domain    string `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	GatewayId uint64 `protobuf:"varint,2,opt,name=gateway_id,json=gatewayId,proto3" json:"gateway_id,omitempty"`
+	Owner     string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	BoundAt   uint64 `protobuf:"varint,4,opt,name=bound_at,json=boundAt,proto3" json:"bound_at,omitempty"`
+}
+
+func (m *DomainBinding) Reset()         { *m = DomainBinding{} }
+func (m *DomainBinding) String() string { return proto.CompactTextString(m) }
+func (*DomainBinding) ProtoMessage()    {}
+func (*DomainBinding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d819620b0ccaefe, []int{9}
+}
+func (m *DomainBinding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DomainBinding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DomainBinding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Status != 0 {
-		n += 1 + sovTypes(uint64(m.Status))
+}
+func (m *DomainBinding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DomainBinding.Merge(m, src)
+}
+func (m *DomainBinding) XXX_Size() int {
+	return m.Size()
+}
+func (m *DomainBinding) XXX_DiscardUnknown() {
+	xxx_messageInfo_DomainBinding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DomainBinding proto.InternalMessageInfo
+
+func (m *DomainBinding) GetDomain() string {
+	if m != nil {
+		return m.Domain
 	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return ""
+}
+
+func (m *DomainBinding) GetGatewayId() uint64 {
+	if m != nil {
+		return m.GatewayId
 	}
-	if m.NextPayoutTime != 0 {
-		n += 1 + sovTypes(uint64(m.NextPayoutTime))
+	return 0
+}
+
+func (m *DomainBinding) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *DomainBinding) GetBoundAt() uint64 {
+	if m != nil {
+		return m.BoundAt
+	}
+	return 0
+}
+
+// UsageReport is the usage a gateway claims to have delivered for one
+// contract month (1-based).
+type UsageReport struct {
+	ContractId   uint64            `protobuf:"varint,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	Month        uint32            `protobuf:"varint,2,opt,name=month,proto3" json:"month,omitempty"`
+	StorageGb    uint64            `protobuf:"varint,3,opt,name=storage_gb,json=storageGb,proto3" json:"storage_gb,omitempty"`
+	NetworkGb    uint64            `protobuf:"varint,4,opt,name=network_gb,json=networkGb,proto3" json:"network_gb,omitempty"`
+	EvidenceHash string            `protobuf:"bytes,5,opt,name=evidence_hash,json=evidenceHash,proto3" json:"evidence_hash,omitempty"`
+	Status       UsageReportStatus `protobuf:"varint,6,opt,name=status,proto3,enum=lumen.gateway.v1.UsageReportStatus" json:"status,omitempty"`
+	SubmittedAt  uint64            `protobuf:"varint,7,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+	// unix seconds; end of the dispute window while pending, and when a disputed
+	// report settles at the reported usage
+	DisputeDeadline uint64 `protobuf:"varint,8,opt,name=dispute_deadline,json=disputeDeadline,proto3" json:"dispute_deadline,omitempty"`
+	DisputeReason   string `protobuf:"bytes,9,opt,name=dispute_reason,json=disputeReason,proto3" json:"dispute_reason,omitempty"`
+}
+
+func (m *UsageReport) Reset()         { *m = UsageReport{} }
+func (m *UsageReport) String() string { return proto.CompactTextString(m) }
+func (*UsageReport) ProtoMessage()    {}
+func (*UsageReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d819620b0ccaefe, []int{10}
+}
+func (m *UsageReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UsageReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UsageReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UsageReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UsageReport.Merge(m, src)
+}
+func (m *UsageReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *UsageReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_UsageReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UsageReport proto.InternalMessageInfo
+
+func (m *UsageReport) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
+	}
+	return 0
+}
+
+func (m *UsageReport) GetMonth() uint32 {
+	if m != nil {
+		return m.Month
+	}
+	return 0
+}
+
+func (m *UsageReport) GetStorageGb() uint64 {
+	if m != nil {
+		return m.StorageGb
+	}
+	return 0
+}
+
+func (m *UsageReport) GetNetworkGb() uint64 {
+	if m != nil {
+		return m.NetworkGb
+	}
+	return 0
+}
+
+func (m *UsageReport) GetEvidenceHash() string {
+	if m != nil {
+		return m.EvidenceHash
+	}
+	return ""
+}
+
+func (m *UsageReport) GetStatus() UsageReportStatus {
+	if m != nil {
+		return m.Status
+	}
+	return UsageReportStatus_USAGE_REPORT_STATUS_UNSPECIFIED
+}
+
+func (m *UsageReport) GetSubmittedAt() uint64 {
+	if m != nil {
+		return m.SubmittedAt
+	}
+	return 0
+}
+
+func (m *UsageReport) GetDisputeDeadline() uint64 {
+	if m != nil {
+		return m.DisputeDeadline
+	}
+	return 0
+}
+
+func (m *UsageReport) GetDisputeReason() string {
+	if m != nil {
+		return m.DisputeReason
+	}
+	return ""
+}
+
+type DisputeEvidence struct {
+	Submitter   string `protobuf:"bytes,1,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	Hash        string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	SubmittedAt uint64 `protobuf:"varint,3,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+}
+
+func (m *DisputeEvidence) Reset()         { *m = DisputeEvidence{} }
+func (m *DisputeEvidence) String() string { return proto.CompactTextString(m) }
+func (*DisputeEvidence) ProtoMessage()    {}
+func (*DisputeEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d819620b0ccaefe, []int{11}
+}
+func (m *DisputeEvidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DisputeEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DisputeEvidence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DisputeEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisputeEvidence.Merge(m, src)
+}
+func (m *DisputeEvidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *DisputeEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisputeEvidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DisputeEvidence proto.InternalMessageInfo
+
+func (m *DisputeEvidence) GetSubmitter() string {
+	if m != nil {
+		return m.Submitter
+	}
+	return ""
+}
+
+func (m *DisputeEvidence) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *DisputeEvidence) GetSubmittedAt() uint64 {
+	if m != nil {
+		return m.SubmittedAt
+	}
+	return 0
+}
+
+// Dispute is a client complaint against a contract, ruled on by an arbiter
+// from params.arbiters or by the governance authority.
+type Dispute struct {
+	Id              uint64             `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ContractId      uint64             `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	GatewayId       uint64             `protobuf:"varint,3,opt,name=gateway_id,json=gatewayId,proto3" json:"gateway_id,omitempty"`
+	Client          string             `protobuf:"bytes,4,opt,name=client,proto3" json:"client,omitempty"`
+	Reason          string             `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Status          DisputeStatus      `protobuf:"varint,6,opt,name=status,proto3,enum=lumen.gateway.v1.DisputeStatus" json:"status,omitempty"`
+	OpenedAt        uint64             `protobuf:"varint,7,opt,name=opened_at,json=openedAt,proto3" json:"opened_at,omitempty"`
+	Deadline        uint64             `protobuf:"varint,8,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	Evidence        []*DisputeEvidence `protobuf:"bytes,9,rep,name=evidence,proto3" json:"evidence,omitempty"`
+	ClientRefundBps uint32             `protobuf:"varint,10,opt,name=client_refund_bps,json=clientRefundBps,proto3" json:"client_refund_bps,omitempty"`
+	Arbiter         string             `protobuf:"bytes,11,opt,name=arbiter,proto3" json:"arbiter,omitempty"`
+	ResolvedAt      uint64             `protobuf:"varint,12,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`
+	Ruling          string             `protobuf:"bytes,13,opt,name=ruling,proto3" json:"ruling,omitempty"`
+}
+
+func (m *Dispute) Reset()         { *m = Dispute{} }
+func (m *Dispute) String() string { return proto.CompactTextString(m) }
+func (*Dispute) ProtoMessage()    {}
+func (*Dispute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d819620b0ccaefe, []int{12}
+}
+func (m *Dispute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Dispute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Dispute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Dispute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Dispute.Merge(m, src)
+}
+func (m *Dispute) XXX_Size() int {
+	return m.Size()
+}
+func (m *Dispute) XXX_DiscardUnknown() {
+	xxx_messageInfo_Dispute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Dispute proto.InternalMessageInfo
+
+func (m *Dispute) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Dispute) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
+	}
+	return 0
+}
+
+func (m *Dispute) GetGatewayId() uint64 {
+	if m != nil {
+		return m.GatewayId
+	}
+	return 0
+}
+
+func (m *Dispute) GetClient() string {
+	if m != nil {
+		return m.Client
+	}
+	return ""
+}
+
+func (m *Dispute) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *Dispute) GetStatus() DisputeStatus {
+	if m != nil {
+		return m.Status
+	}
+	return DisputeStatus_DISPUTE_STATUS_UNSPECIFIED
+}
+
+func (m *Dispute) GetOpenedAt() uint64 {
+	if m != nil {
+		return m.OpenedAt
+	}
+	return 0
+}
+
+func (m *Dispute) GetDeadline() uint64 {
+	if m != nil {
+		return m.Deadline
+	}
+	return 0
+}
+
+func (m *Dispute) GetEvidence() []*DisputeEvidence {
+	if m != nil {
+		return m.Evidence
+	}
+	return nil
+}
+
+func (m *Dispute) GetClientRefundBps() uint32 {
+	if m != nil {
+		return m.ClientRefundBps
+	}
+	return 0
+}
+
+func (m *Dispute) GetArbiter() string {
+	if m != nil {
+		return m.Arbiter
+	}
+	return ""
+}
+
+func (m *Dispute) GetResolvedAt() uint64 {
+	if m != nil {
+		return m.ResolvedAt
+	}
+	return 0
+}
+
+func (m *Dispute) GetRuling() string {
+	if m != nil {
+		return m.Ruling
+	}
+	return ""
+}
+
+// TreasuryFlows totals what entered and left the gateways treasury, per denom.
+type TreasuryFlows struct {
+	// inflow is platform commission and slashed bonds credited to the treasury.
+	Inflow        github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=inflow,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"inflow"`
+	CommunityPool github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=community_pool,json=communityPool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"community_pool"`
+	Burned        github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=burned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burned"`
+	Stakers       github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=stakers,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"stakers"`
+	// spent is paid out by MsgTreasurySpend.
+	Spent github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=spent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spent"`
+}
+
+func (m *TreasuryFlows) Reset()         { *m = TreasuryFlows{} }
+func (m *TreasuryFlows) String() string { return proto.CompactTextString(m) }
+func (*TreasuryFlows) ProtoMessage()    {}
+func (*TreasuryFlows) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d819620b0ccaefe, []int{13}
+}
+func (m *TreasuryFlows) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TreasuryFlows) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TreasuryFlows.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TreasuryFlows) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TreasuryFlows.Merge(m, src)
+}
+func (m *TreasuryFlows) XXX_Size() int {
+	return m.Size()
+}
+func (m *TreasuryFlows) XXX_DiscardUnknown() {
+	xxx_messageInfo_TreasuryFlows.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TreasuryFlows proto.InternalMessageInfo
+
+func (m *TreasuryFlows) GetInflow() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Inflow
+	}
+	return nil
+}
+
+func (m *TreasuryFlows) GetCommunityPool() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CommunityPool
+	}
+	return nil
+}
+
+func (m *TreasuryFlows) GetBurned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Burned
+	}
+	return nil
+}
+
+func (m *TreasuryFlows) GetStakers() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Stakers
+	}
+	return nil
+}
+
+func (m *TreasuryFlows) GetSpent() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Spent
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("lumen.gateway.v1.ContractStatus", ContractStatus_name, ContractStatus_value)
+	proto.RegisterEnum("lumen.gateway.v1.UsageReportStatus", UsageReportStatus_name, UsageReportStatus_value)
+	proto.RegisterEnum("lumen.gateway.v1.DisputeStatus", DisputeStatus_name, DisputeStatus_value)
+	proto.RegisterEnum("lumen.gateway.v1.GatewayProtocol", GatewayProtocol_name, GatewayProtocol_value)
+	proto.RegisterType((*Gateway)(nil), "lumen.gateway.v1.Gateway")
+	proto.RegisterType((*GatewayProfile)(nil), "lumen.gateway.v1.GatewayProfile")
+	proto.RegisterType((*GatewayEndpoint)(nil), "lumen.gateway.v1.GatewayEndpoint")
+	proto.RegisterType((*GatewayCapacity)(nil), "lumen.gateway.v1.GatewayCapacity")
+	proto.RegisterType((*Contract)(nil), "lumen.gateway.v1.Contract")
+	proto.RegisterType((*ContractAmendment)(nil), "lumen.gateway.v1.ContractAmendment")
+	proto.RegisterType((*Offer)(nil), "lumen.gateway.v1.Offer")
+	proto.RegisterType((*GatewayBond)(nil), "lumen.gateway.v1.GatewayBond")
+	proto.RegisterType((*GatewayReputation)(nil), "lumen.gateway.v1.GatewayReputation")
+	proto.RegisterType((*DomainBinding)(nil), "lumen.gateway.v1.DomainBinding")
+	proto.RegisterType((*UsageReport)(nil), "lumen.gateway.v1.UsageReport")
+	proto.RegisterType((*DisputeEvidence)(nil), "lumen.gateway.v1.DisputeEvidence")
+	proto.RegisterType((*Dispute)(nil), "lumen.gateway.v1.Dispute")
+	proto.RegisterType((*TreasuryFlows)(nil), "lumen.gateway.v1.TreasuryFlows")
+}
+
+func init() { proto.RegisterFile("lumen/gateway/v1/types.proto", fileDescriptor_7d819620b0ccaefe) }
+
+var fileDescriptor_7d819620b0ccaefe = []byte{
+	// 2144 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcd, 0x6f, 0xdb, 0xc8,
+	0x15, 0x8f, 0x3e, 0x2c, 0x5b, 0x4f, 0x96, 0x2c, 0x8f, 0x1d, 0x87, 0x71, 0xe2, 0x8f, 0x55, 0x36,
+	0xa8, 0x1b, 0xa0, 0xd2, 0x26, 0x7b, 0x68, 0xb1, 0x45, 0x51, 0xc8, 0x92, 0xe2, 0x08, 0xf0, 0xda,
+	0x02, 0x2d, 0xef, 0xb6, 0x7b, 0x21, 0x46, 0xe4, 0xd8, 0x22, 0x42, 0x72, 0x08, 0xce, 0xc8, 0x1f,
+	0xc7, 0xf6, 0xd8, 0x5e, 0x7a, 0x2b, 0x7a, 0xe8, 0xbd, 0xe8, 0xbd, 0x87, 0xfe, 0x03, 0xc5, 0x02,
+	0xbd, 0xec, 0xb1, 0xbd, 0xb4, 0x45, 0xf2, 0x67, 0xf4, 0xb2, 0x98, 0x2f, 0x4a, 0xa6, 0xec, 0x24,
+	0x0b, 0xe4, 0x62, 0xeb, 0xfd, 0xde, 0xe3, 0xcc, 0xfb, 0x7e, 0x8f, 0x84, 0xc7, 0xc1, 0x24, 0x24,
+	0x51, 0xeb, 0x1c, 0x73, 0x72, 0x89, 0xaf, 0x5b, 0x17, 0xcf, 0x5b, 0xfc, 0x3a, 0x26, 0xac, 0x19,
+	0x27, 0x94, 0x53, 0x54, 0x97, 0xdc, 0xa6, 0xe6, 0x36, 0x2f, 0x9e, 0x6f, 0xae, 0xe2, 0xd0, 0x8f,
+	0x68, 0x4b, 0xfe, 0x55, 0x42, 0x9b, 0xeb, 0xe7, 0xf4, 0x9c, 0xca, 0x9f, 0x2d, 0xf1, 0x4b, 0xa3,
+	0xdb, 0x2e, 0x65, 0x21, 0x65, 0xad, 0x11, 0x66, 0xa4, 0x75, 0xf1, 0x7c, 0x44, 0x38, 0x7e, 0xde,
+	0x72, 0xa9, 0x1f, 0x69, 0xfe, 0xd6, 0xdc, 0xc5, 0x31, 0x4e, 0x70, 0xa8, 0x6f, 0x6e, 0xfc, 0x6e,
+	0x01, 0x16, 0x0f, 0x14, 0x0f, 0xd5, 0x20, 0xef, 0x7b, 0x56, 0x6e, 0x37, 0xb7, 0x57, 0xb4, 0xf3,
+	0xbe, 0x87, 0x36, 0x61, 0x89, 0xc6, 0x24, 0xc1, 0x9c, 0x26, 0x56, 0x7e, 0x37, 0xb7, 0x57, 0xb6,
+	0x53, 0x1a, 0x6d, 0x40, 0x29, 0xc6, 0xd7, 0x74, 0xc2, 0xad, 0x82, 0xe4, 0x68, 0x4a, 0xe0, 0xd8,
+	0xe5, 0xfe, 0x05, 0xb1, 0x8a, 0xbb, 0xb9, 0xbd, 0x25, 0x5b, 0x53, 0xe2, 0xac, 0x90, 0x70, 0xec,
+	0x61, 0x8e, 0xad, 0x05, 0x75, 0x96, 0xa1, 0xd1, 0x16, 0x80, 0x9b, 0x10, 0xcc, 0x89, 0xe7, 0x60,
+	0x6e, 0x95, 0xe4, 0xfd, 0x65, 0x8d, 0xb4, 0x39, 0x7a, 0x0a, 0x35, 0x75, 0x88, 0xe3, 0x06, 0x3e,
+	0x89, 0x38, 0xb3, 0x16, 0x77, 0x73, 0x7b, 0x55, 0xbb, 0xaa, 0xd0, 0x8e, 0x02, 0xd1, 0xa7, 0x50,
+	0x75, 0x71, 0xe4, 0x92, 0x20, 0xc0, 0xdc, 0xa7, 0x11, 0xb3, 0x96, 0x94, 0xd4, 0x0d, 0x50, 0xdc,
+	0x85, 0x27, 0x9c, 0x3a, 0x6e, 0x80, 0xfd, 0xd0, 0x2a, 0x4b, 0x1d, 0xcb, 0x02, 0xe9, 0x08, 0x00,
+	0xfd, 0x08, 0x56, 0xb0, 0xeb, 0x92, 0x58, 0xe8, 0xe2, 0x91, 0x88, 0x86, 0xcc, 0x82, 0xdd, 0xc2,
+	0x5e, 0xd9, 0xae, 0x19, 0xb8, 0x2b, 0x51, 0xf4, 0x05, 0x2c, 0xc6, 0x09, 0x3d, 0xf3, 0x03, 0x62,
+	0x55, 0x76, 0x73, 0x7b, 0x95, 0x17, 0xbb, 0xcd, 0x6c, 0x0c, 0x9b, 0xda, 0xaf, 0x03, 0x25, 0x67,
+	0x9b, 0x07, 0xd0, 0x8f, 0xa1, 0x1e, 0x93, 0xc8, 0xf3, 0xa3, 0x73, 0x27, 0xf5, 0xef, 0xb2, 0xf4,
+	0xc9, 0x8a, 0xc6, 0x8f, 0x8d, 0x9b, 0x9f, 0x42, 0x8d, 0x27, 0x38, 0x62, 0x67, 0x24, 0x49, 0x94,
+	0x7b, 0xaa, 0xd2, 0x3d, 0xd5, 0x19, 0xb4, 0xcd, 0x85, 0xda, 0x1e, 0x71, 0x69, 0x18, 0xfa, 0x8c,
+	0xf9, 0x34, 0x12, 0x72, 0x35, 0x29, 0x57, 0x9b, 0x85, 0xdb, 0x5c, 0x84, 0x01, 0x27, 0xee, 0xd8,
+	0xbf, 0x20, 0x9e, 0xb5, 0x22, 0x8d, 0x4f, 0x69, 0xf4, 0x0c, 0x56, 0x03, 0xcc, 0xb8, 0x33, 0x26,
+	0x38, 0xe1, 0x23, 0x82, 0xb9, 0x38, 0xa6, 0x2e, 0x8f, 0x59, 0x11, 0x8c, 0x57, 0x06, 0x6f, 0x73,
+	0x64, 0xc1, 0x22, 0x3d, 0x3b, 0x0b, 0xfc, 0x88, 0x58, 0xab, 0xf2, 0x18, 0x43, 0xa2, 0x2f, 0xe0,
+	0xa1, 0x4b, 0x23, 0x9e, 0x60, 0x97, 0x3b, 0x46, 0x49, 0xc7, 0xa5, 0x11, 0x23, 0x11, 0xb7, 0x90,
+	0x94, 0x7d, 0x60, 0x04, 0x86, 0x9a, 0xdf, 0x51, 0xec, 0xc6, 0xef, 0xf3, 0x50, 0xbb, 0xe9, 0x34,
+	0xd4, 0x83, 0x32, 0x89, 0xbc, 0x98, 0xfa, 0x22, 0xee, 0xb9, 0xdd, 0xc2, 0x5e, 0xe5, 0xc5, 0x27,
+	0x77, 0x7a, 0xba, 0xa7, 0x25, 0xf7, 0x8b, 0xdf, 0xfe, 0x67, 0xe7, 0x9e, 0x3d, 0x7d, 0x52, 0xe8,
+	0x9b, 0x90, 0x73, 0x99, 0x16, 0x79, 0x19, 0x4f, 0x43, 0xa2, 0x5f, 0x42, 0x59, 0x56, 0x82, 0x4b,
+	0x03, 0x66, 0x15, 0x76, 0x0b, 0x7b, 0xb5, 0x77, 0x5c, 0x30, 0xd0, 0x92, 0xf6, 0xf4, 0x19, 0xf4,
+	0x0b, 0x58, 0x72, 0x71, 0x8c, 0x5d, 0x9f, 0x5f, 0xcb, 0x9c, 0x7f, 0x97, 0x82, 0x1d, 0x2d, 0x68,
+	0xa7, 0x8f, 0x08, 0xcd, 0x84, 0x3b, 0xb0, 0xcb, 0x75, 0x5d, 0x18, 0xb2, 0x71, 0x05, 0x2b, 0x19,
+	0xbb, 0x10, 0x82, 0xe2, 0x98, 0x32, 0x2e, 0x6b, 0xb4, 0x6c, 0xcb, 0xdf, 0xe2, 0x7e, 0xa3, 0x8c,
+	0xac, 0xd2, 0x0f, 0xd2, 0x3f, 0x7d, 0x44, 0x1c, 0x19, 0xd3, 0x44, 0x95, 0x71, 0xd5, 0x96, 0xbf,
+	0x1b, 0xbf, 0xcd, 0xc1, 0x4a, 0x46, 0x63, 0x51, 0x38, 0x8c, 0xd3, 0x04, 0x9f, 0x13, 0xe7, 0x7c,
+	0xa4, 0x9b, 0x44, 0x59, 0x23, 0x07, 0x23, 0xd4, 0x82, 0xf5, 0x88, 0xf0, 0x4b, 0x9a, 0xbc, 0x76,
+	0xce, 0x47, 0x4e, 0x4c, 0x12, 0x27, 0xa4, 0x11, 0x1f, 0x4b, 0x8d, 0x8a, 0xf6, 0xaa, 0xe6, 0x1d,
+	0x8c, 0x06, 0x24, 0xf9, 0x52, 0x30, 0xd0, 0x0e, 0x54, 0x42, 0x7c, 0x95, 0x96, 0xb4, 0xba, 0x1e,
+	0x42, 0x7c, 0xa5, 0xeb, 0xb9, 0xf1, 0x97, 0x12, 0x2c, 0x75, 0x74, 0xa2, 0xcc, 0xb5, 0xa6, 0x0d,
+	0x28, 0xa9, 0x27, 0x75, 0x63, 0xd2, 0x94, 0xd0, 0x52, 0x5b, 0xed, 0xf8, 0x9e, 0x3c, 0xb4, 0x68,
+	0x97, 0x35, 0xd2, 0xf7, 0x04, 0x3b, 0x4e, 0x7c, 0x97, 0x38, 0x93, 0x20, 0x8c, 0x64, 0xb4, 0x8a,
+	0x22, 0x94, 0xbe, 0x4b, 0x4e, 0x83, 0x30, 0x12, 0x46, 0x4c, 0x6d, 0x9c, 0x31, 0x62, 0x41, 0x19,
+	0x91, 0x5a, 0x9b, 0x1a, 0x71, 0x97, 0xd5, 0xa5, 0xbb, 0xac, 0xfe, 0x04, 0x96, 0xa5, 0x04, 0x73,
+	0x38, 0xe5, 0x38, 0xd0, 0x9d, 0xac, 0xa2, 0xb0, 0xa1, 0x80, 0x94, 0xa3, 0x71, 0xc2, 0x1d, 0xee,
+	0x87, 0xc4, 0x5a, 0x32, 0x8e, 0xc6, 0x09, 0x1f, 0xfa, 0x21, 0x11, 0x7e, 0x23, 0xcc, 0x4d, 0xe8,
+	0xa5, 0xb2, 0xa1, 0x2c, 0xcd, 0x07, 0x05, 0x49, 0x23, 0x9e, 0x42, 0x4d, 0x36, 0x37, 0xe2, 0x29,
+	0x65, 0x44, 0x07, 0x53, 0x8d, 0x50, 0xa1, 0x52, 0x11, 0x86, 0x7e, 0x06, 0x25, 0xc6, 0x31, 0x9f,
+	0x30, 0xd9, 0xbf, 0x6a, 0xb7, 0xf5, 0x2f, 0xe3, 0xfd, 0x13, 0x29, 0x67, 0x6b, 0xf9, 0x1b, 0xad,
+	0x7c, 0x39, 0xd3, 0xca, 0xf7, 0xa0, 0x1e, 0x91, 0x2b, 0xee, 0xa8, 0x69, 0xa0, 0x4c, 0x50, 0x1d,
+	0xab, 0x26, 0xf0, 0x81, 0x84, 0xa5, 0x1d, 0x4d, 0x58, 0x9b, 0x30, 0xe1, 0xe9, 0x4b, 0x9f, 0x8f,
+	0xc7, 0x24, 0xf0, 0x94, 0x3d, 0x35, 0x79, 0xe0, 0xaa, 0x64, 0x7d, 0xad, 0x39, 0xd2, 0xac, 0x2d,
+	0x00, 0xcf, 0x67, 0xf1, 0x84, 0x13, 0xc7, 0x57, 0xbd, 0xab, 0x68, 0x97, 0x35, 0xd2, 0xf7, 0xd0,
+	0x43, 0x58, 0xa2, 0x67, 0xa2, 0xd5, 0xf8, 0x9e, 0xee, 0x59, 0x8b, 0x92, 0xee, 0x7b, 0x68, 0x00,
+	0xab, 0xa6, 0xdd, 0xe2, 0x90, 0x44, 0x5e, 0x28, 0xd2, 0x66, 0x55, 0x56, 0xea, 0x93, 0xbb, 0x8d,
+	0x6e, 0x1b, 0x51, 0xdb, 0x34, 0xeb, 0x14, 0x99, 0x4e, 0x09, 0xc7, 0x23, 0xd8, 0x93, 0x5d, 0x10,
+	0x29, 0x23, 0x15, 0xdc, 0xd5, 0xa8, 0x70, 0x87, 0xb9, 0x9a, 0xe3, 0x2b, 0x65, 0xe1, 0x9a, 0xb4,
+	0xb0, 0xa6, 0xf1, 0x21, 0xbe, 0x92, 0xe6, 0xad, 0xc3, 0x82, 0x9c, 0x37, 0xd6, 0xba, 0x64, 0x2b,
+	0x42, 0xc4, 0xd2, 0x3c, 0xaf, 0xd3, 0xfd, 0xbe, 0x64, 0x57, 0x35, 0xaa, 0x6a, 0xa5, 0xf1, 0xb7,
+	0x1c, 0xac, 0xce, 0xe9, 0x9d, 0x49, 0xf6, 0xdc, 0x87, 0x26, 0x7b, 0xfe, 0x87, 0x26, 0x7b, 0xe1,
+	0x1d, 0x25, 0x1e, 0x27, 0x34, 0xa6, 0x4c, 0x4d, 0x2e, 0x55, 0x6e, 0x60, 0xa0, 0x36, 0x6f, 0xfc,
+	0xbf, 0x00, 0x0b, 0xc7, 0x22, 0x4a, 0x73, 0xf5, 0x7d, 0xb3, 0x8e, 0xf3, 0xef, 0xae, 0xe3, 0xc2,
+	0x87, 0x9a, 0x56, 0xfc, 0xa1, 0xa6, 0x2d, 0xdc, 0x65, 0xda, 0x16, 0x40, 0xe8, 0x47, 0xa6, 0xc0,
+	0x4a, 0xb2, 0xc0, 0xca, 0xa1, 0x1f, 0xe9, 0xe2, 0x12, 0x6c, 0x7c, 0x65, 0xd8, 0x8b, 0x9a, 0x8d,
+	0xaf, 0x34, 0x7b, 0x66, 0x1a, 0x2d, 0xdd, 0x9c, 0x46, 0xa2, 0x78, 0x75, 0xc7, 0x75, 0x58, 0x40,
+	0x39, 0xb3, 0xca, 0xba, 0x78, 0x35, 0x7a, 0x22, 0x40, 0x71, 0xfe, 0x44, 0x78, 0x55, 0x89, 0xa8,
+	0xfa, 0x2e, 0x0b, 0x44, 0xb1, 0xe5, 0xf9, 0xdc, 0x4f, 0x88, 0x27, 0x8b, 0x7b, 0xc9, 0x36, 0x64,
+	0x66, 0xd5, 0x5a, 0xce, 0xae, 0x5a, 0x69, 0x16, 0x56, 0x67, 0xb3, 0xf0, 0x14, 0xd6, 0x66, 0x97,
+	0x28, 0x27, 0xa6, 0x81, 0xef, 0x5e, 0xcb, 0x52, 0xad, 0xbc, 0xf8, 0xf4, 0x96, 0x12, 0x9a, 0x11,
+	0x1e, 0x48, 0x59, 0x1b, 0xb9, 0x73, 0x58, 0xe3, 0x9f, 0x39, 0xa8, 0xe8, 0x29, 0xb3, 0x4f, 0xa3,
+	0x6c, 0xcc, 0x73, 0xd9, 0x98, 0xef, 0x40, 0x65, 0x44, 0x23, 0x8f, 0xe8, 0x46, 0xa1, 0xfa, 0x3e,
+	0x28, 0xc8, 0x34, 0xbe, 0x49, 0x34, 0xa2, 0xaa, 0x5c, 0xd2, 0xc4, 0x28, 0xdb, 0xd5, 0x14, 0x95,
+	0x62, 0x2f, 0xe0, 0xfe, 0x54, 0xcc, 0xa5, 0x61, 0x1c, 0x10, 0x4e, 0xa6, 0xf9, 0xb9, 0x96, 0x32,
+	0x3b, 0x9a, 0xd7, 0xe6, 0xa2, 0x6d, 0xb3, 0x00, 0xb3, 0xb1, 0xb9, 0x5c, 0x4d, 0xea, 0x8a, 0xc6,
+	0xc4, 0xb1, 0x8d, 0x3f, 0xe5, 0x61, 0x55, 0x5b, 0x63, 0x93, 0x78, 0xc2, 0xa5, 0xa5, 0xef, 0xb3,
+	0xa9, 0x05, 0x6b, 0x66, 0x17, 0x62, 0xa9, 0x2e, 0x26, 0xdf, 0x51, 0xca, 0x32, 0x9a, 0x64, 0x1f,
+	0x50, 0x3e, 0x25, 0x66, 0xd0, 0xcd, 0x3c, 0x60, 0x38, 0x62, 0xd7, 0xd4, 0x03, 0xc7, 0x23, 0x81,
+	0x7f, 0x41, 0x44, 0x4e, 0x28, 0x43, 0x57, 0x14, 0xde, 0x35, 0x30, 0x7a, 0x02, 0x55, 0xdd, 0x4f,
+	0x99, 0x13, 0x88, 0x2d, 0x43, 0x65, 0xff, 0xb2, 0x01, 0x0f, 0xc5, 0xb6, 0xb1, 0x0e, 0x0b, 0xcc,
+	0xa5, 0x09, 0xd1, 0x39, 0xaf, 0x08, 0x99, 0x8f, 0xb1, 0x67, 0xd2, 0x6a, 0x51, 0x99, 0xa9, 0x91,
+	0x36, 0x6f, 0x5c, 0x42, 0xb5, 0x4b, 0x43, 0xec, 0x47, 0xfb, 0xbe, 0xf4, 0xac, 0x18, 0xdf, 0x9e,
+	0x04, 0xf4, 0x26, 0xa3, 0xa9, 0xf7, 0x95, 0xfd, 0x3a, 0x2c, 0xd0, 0xcb, 0x88, 0x24, 0x3a, 0xb0,
+	0x8a, 0x10, 0xad, 0x7f, 0x44, 0x27, 0xd1, 0x4c, 0x8f, 0x59, 0x94, 0x74, 0x9b, 0x37, 0xfe, 0x9d,
+	0x87, 0xca, 0xa9, 0x18, 0x25, 0x36, 0x11, 0x8b, 0x8d, 0xc8, 0xa1, 0x74, 0x39, 0x4d, 0xe3, 0x01,
+	0x06, 0x52, 0x37, 0x4c, 0xbb, 0x60, 0xd5, 0x56, 0x44, 0x66, 0xf7, 0x29, 0x64, 0x77, 0x9f, 0x2d,
+	0x80, 0x69, 0xf7, 0x30, 0x5b, 0x45, 0xda, 0x33, 0x84, 0x5f, 0xc9, 0x85, 0xef, 0x91, 0xc8, 0x25,
+	0xce, 0x18, 0xb3, 0xb1, 0xce, 0x9e, 0x65, 0x03, 0xbe, 0xc2, 0x6c, 0x8c, 0x7e, 0x9e, 0x8e, 0xe3,
+	0x92, 0x1c, 0xc7, 0xb7, 0x4c, 0xa6, 0x19, 0x43, 0x32, 0x13, 0x59, 0xa4, 0xe7, 0x64, 0x14, 0xfa,
+	0xfc, 0x46, 0x00, 0x2a, 0x29, 0xd6, 0xe6, 0x22, 0x0f, 0xcc, 0xf8, 0x4c, 0x67, 0x96, 0xda, 0x2d,
+	0x56, 0x34, 0x9e, 0x0e, 0xad, 0xa7, 0x50, 0x33, 0xa2, 0x09, 0xc1, 0x8c, 0x9a, 0x25, 0xc3, 0x64,
+	0x87, 0x2d, 0xc1, 0xc6, 0x19, 0xac, 0x74, 0x15, 0xd0, 0xd3, 0x86, 0xa0, 0xc7, 0x50, 0x36, 0x77,
+	0x26, 0x3a, 0xb2, 0x53, 0x40, 0x2e, 0xaf, 0x98, 0x29, 0xd7, 0x8a, 0xe5, 0x55, 0x98, 0x9d, 0xd5,
+	0xbc, 0x30, 0xa7, 0x79, 0xe3, 0xef, 0x05, 0x58, 0xd4, 0x17, 0xcd, 0x8d, 0x89, 0x4c, 0x3c, 0xf3,
+	0x73, 0xf1, 0x7c, 0xcf, 0x3e, 0x38, 0x5d, 0x23, 0x8b, 0x37, 0xd6, 0xc8, 0x0d, 0x28, 0x69, 0xd3,
+	0x55, 0xac, 0x34, 0x85, 0x7e, 0x9a, 0x89, 0xd2, 0xce, 0x7c, 0x94, 0xb4, 0xaa, 0x99, 0x08, 0x3d,
+	0x82, 0x32, 0x8d, 0x49, 0x34, 0x1b, 0x9e, 0x25, 0x05, 0xa8, 0x97, 0xb2, 0x4c, 0x4c, 0x52, 0x5a,
+	0x6c, 0xf7, 0x26, 0x4f, 0xac, 0xf2, 0x5d, 0xaf, 0x3f, 0x99, 0x38, 0xd8, 0xe9, 0x23, 0xe2, 0x9d,
+	0x4e, 0x99, 0xe4, 0x24, 0xe4, 0x4c, 0xd4, 0xc8, 0x28, 0x36, 0xf3, 0x62, 0x45, 0x31, 0x6c, 0x89,
+	0xef, 0xc7, 0x72, 0x6a, 0xe0, 0x64, 0xe4, 0x8b, 0xd8, 0x55, 0xd4, 0x9b, 0x88, 0x26, 0x85, 0x9b,
+	0x13, 0xc2, 0x68, 0x70, 0x31, 0x3b, 0x36, 0xc0, 0x40, 0x6d, 0xe5, 0xaf, 0x49, 0xe0, 0x47, 0xe7,
+	0x7a, 0x70, 0x68, 0xaa, 0xf1, 0xc7, 0x22, 0x54, 0x87, 0xc2, 0x77, 0x93, 0xe4, 0xfa, 0x65, 0x40,
+	0x2f, 0x19, 0x72, 0xa1, 0xe4, 0x47, 0x67, 0x01, 0xbd, 0xd4, 0x2f, 0x73, 0x0f, 0x9b, 0xea, 0xfb,
+	0x45, 0x53, 0x7c, 0xbf, 0x68, 0xea, 0xef, 0x17, 0xcd, 0x0e, 0xf5, 0xa3, 0xfd, 0xcf, 0xc4, 0x4b,
+	0xdc, 0x5f, 0xff, 0xbb, 0xb3, 0x77, 0xee, 0xf3, 0xf1, 0x64, 0xd4, 0x74, 0x69, 0xd8, 0x52, 0xc2,
+	0xfa, 0xdf, 0x4f, 0x98, 0xf7, 0x5a, 0x7f, 0x46, 0x11, 0x0f, 0x30, 0x5b, 0x1f, 0x8d, 0x12, 0xa8,
+	0x89, 0xb7, 0xde, 0x49, 0x24, 0xc6, 0x68, 0x4c, 0xe5, 0x8b, 0xd1, 0x47, 0xbf, 0xac, 0x9a, 0x5e,
+	0x31, 0xa0, 0x34, 0x10, 0x86, 0x8d, 0x26, 0x49, 0x24, 0x9b, 0xf1, 0xc7, 0x37, 0x4c, 0x1d, 0x8d,
+	0x08, 0x2c, 0x32, 0x8e, 0x5f, 0x93, 0x84, 0x59, 0xc5, 0x8f, 0x7f, 0x8b, 0x39, 0x1b, 0x61, 0x58,
+	0x60, 0xb1, 0xa8, 0x8a, 0x85, 0x8f, 0x7f, 0x89, 0x3a, 0xf9, 0xd9, 0x3f, 0x72, 0x50, 0xbb, 0xf9,
+	0x7e, 0x81, 0x76, 0xe0, 0x51, 0xe7, 0xf8, 0x68, 0x68, 0xb7, 0x3b, 0x43, 0xe7, 0x64, 0xd8, 0x1e,
+	0x9e, 0x9e, 0x38, 0xa7, 0x47, 0x27, 0x83, 0x5e, 0xa7, 0xff, 0xb2, 0xdf, 0xeb, 0xd6, 0xef, 0xa1,
+	0x47, 0xf0, 0x20, 0x2b, 0x30, 0xe8, 0x1d, 0x75, 0xfb, 0x47, 0x07, 0xf5, 0x1c, 0xda, 0x84, 0x8d,
+	0x2c, 0xb3, 0xdd, 0x19, 0xf6, 0xbf, 0xea, 0xd5, 0xf3, 0xe8, 0x31, 0x58, 0x59, 0x5e, 0xa7, 0x7d,
+	0xd4, 0xe9, 0x1d, 0xf6, 0xba, 0xf5, 0x02, 0xda, 0x82, 0x87, 0x73, 0xdc, 0xe3, 0x2f, 0x07, 0x87,
+	0xbd, 0x61, 0xaf, 0x5b, 0x2f, 0xde, 0xc6, 0x7e, 0xd9, 0x3f, 0x6a, 0x1f, 0xf6, 0xbf, 0xe9, 0x75,
+	0xeb, 0x0b, 0xcf, 0xfe, 0x9c, 0x83, 0xd5, 0xb9, 0xce, 0x8c, 0x9e, 0xc0, 0xce, 0xe9, 0x49, 0xfb,
+	0xa0, 0xe7, 0xd8, 0xbd, 0xc1, 0xb1, 0x7d, 0x87, 0x3d, 0x3b, 0xf0, 0xe8, 0x36, 0xa1, 0xa9, 0x4d,
+	0xbb, 0xf0, 0xf8, 0x36, 0x81, 0x76, 0xa7, 0xd3, 0x1b, 0x08, 0xe5, 0xf2, 0x77, 0x49, 0x74, 0xfb,
+	0x27, 0x83, 0x53, 0x21, 0x51, 0x78, 0xf6, 0x9b, 0x1c, 0x54, 0x6f, 0xf4, 0x24, 0xb4, 0x0d, 0x9b,
+	0x9a, 0x7f, 0xbb, 0x5a, 0x0f, 0x60, 0x2d, 0xc3, 0x3f, 0x1e, 0xf4, 0x8e, 0xea, 0x39, 0xe1, 0xff,
+	0x0c, 0xc3, 0xee, 0x9d, 0x1c, 0x1f, 0x7e, 0x25, 0x35, 0xd9, 0x84, 0x8d, 0x0c, 0xb3, 0xf7, 0xab,
+	0x41, 0xdf, 0x96, 0x3a, 0xcc, 0x7c, 0x4f, 0x30, 0x5f, 0x20, 0x84, 0xe6, 0x07, 0xed, 0x61, 0xef,
+	0xeb, 0xf6, 0xaf, 0x9d, 0x81, 0x7d, 0x3c, 0x3c, 0xee, 0x1c, 0x1f, 0x66, 0xf4, 0x78, 0x08, 0xf7,
+	0xe7, 0x24, 0xfa, 0x83, 0x97, 0x27, 0xf5, 0xdc, 0xad, 0xac, 0x57, 0xc3, 0xe1, 0xa0, 0x9e, 0x17,
+	0xda, 0xcf, 0xb1, 0x4e, 0x3e, 0xaf, 0x17, 0xf6, 0x3f, 0xfb, 0xf6, 0xcd, 0x76, 0xee, 0xbb, 0x37,
+	0xdb, 0xb9, 0xff, 0xbd, 0xd9, 0xce, 0xfd, 0xe1, 0xed, 0xf6, 0xbd, 0xef, 0xde, 0x6e, 0xdf, 0xfb,
+	0xd7, 0xdb, 0xed, 0x7b, 0xdf, 0x6c, 0xa8, 0x4f, 0xa4, 0x57, 0xe6, 0x23, 0x29, 0x53, 0x09, 0x3b,
+	0x2a, 0xc9, 0x8f, 0x24, 0x9f, 0x7f, 0x3f, 0x00, 0xf8, 0x5c, 0xb7, 0xff, 0xbc, 0x15, 0x00, 0x00,
+}
+
+func (m *Gateway) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Gateway) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Gateway) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ContractTransferConsent {
+		i--
+		if m.ContractTransferConsent {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.Offline {
+		i--
+		if m.Offline {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.LastHeartbeatAt != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LastHeartbeatAt))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.Archived {
+		i--
+		if m.Archived {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.DecommissionAt != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.DecommissionAt))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.TransferredAt != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TransferredAt))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.PendingOperator) > 0 {
+		i -= len(m.PendingOperator)
+		copy(dAtA[i:], m.PendingOperator)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.PendingOperator)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Profile != nil {
+		{
+			size, err := m.Profile.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.AcceptedDenoms) > 0 {
+		for iNdEx := len(m.AcceptedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AcceptedDenoms[iNdEx])
+			copy(dAtA[i:], m.AcceptedDenoms[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.AcceptedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.AutoClaim {
+		i--
+		if m.AutoClaim {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Cancellations != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Cancellations))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ActiveClients != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ActiveClients))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Payout) > 0 {
+		i -= len(m.Payout)
+		copy(dAtA[i:], m.Payout)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Payout)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GatewayProfile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayProfile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayProfile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contact) > 0 {
+		i -= len(m.Contact)
+		copy(dAtA[i:], m.Contact)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Contact)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Capacity != nil {
+		{
+			size, err := m.Capacity.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Protocols) > 0 {
+		dAtA4 := make([]byte, len(m.Protocols)*10)
+		var j3 int
+		for _, num := range m.Protocols {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintTypes(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Regions) > 0 {
+		for iNdEx := len(m.Regions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Regions[iNdEx])
+			copy(dAtA[i:], m.Regions[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Regions[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Endpoints) > 0 {
+		for iNdEx := len(m.Endpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Endpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GatewayEndpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayEndpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayEndpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Port != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Port))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Protocol != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Protocol))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Host) > 0 {
+		i -= len(m.Host)
+		copy(dAtA[i:], m.Host)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Host)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GatewayCapacity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayCapacity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayCapacity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxClients != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxClients))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.NetworkGbPerMonth != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.NetworkGbPerMonth))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StorageGb != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StorageGb))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Contract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Contract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Contract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PendingClient) > 0 {
+		i -= len(m.PendingClient)
+		copy(dAtA[i:], m.PendingClient)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.PendingClient)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if len(m.PendingTaxUlmn) > 0 {
+		i -= len(m.PendingTaxUlmn)
+		copy(dAtA[i:], m.PendingTaxUlmn)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.PendingTaxUlmn)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.AcceptDeadline != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.AcceptDeadline))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.PendingAmendment != nil {
+		{
+			size, err := m.PendingAmendment.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.OfferId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.OfferId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.DisputeId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.DisputeId))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.UsageWithheldUlmn) > 0 {
+		i -= len(m.UsageWithheldUlmn)
+		copy(dAtA[i:], m.UsageWithheldUlmn)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.UsageWithheldUlmn)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.NextPayoutTime != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.NextPayoutTime))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Status != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.ClaimedMonths != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ClaimedMonths))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.EscrowUlmn) > 0 {
+		i -= len(m.EscrowUlmn)
+		copy(dAtA[i:], m.EscrowUlmn)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EscrowUlmn)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MonthsTotal != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MonthsTotal))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.NetworkGbPerMonth != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.NetworkGbPerMonth))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.StorageGbPerMonth != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StorageGbPerMonth))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PriceUlmn != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PriceUlmn))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.GatewayId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GatewayId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Client) > 0 {
+		i -= len(m.Client)
+		copy(dAtA[i:], m.Client)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Client)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractAmendment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractAmendment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractAmendment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposedAt != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ProposedAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NetworkGbPerMonth != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.NetworkGbPerMonth))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StorageGbPerMonth != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StorageGbPerMonth))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PriceUlmn != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PriceUlmn))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Offer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Offer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Offer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CancellationPolicy != nil {
+		{
+			size, err := m.CancellationPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Retired {
+		i--
+		if m.Retired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.UsedSlots != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.UsedSlots))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.CapacitySlots != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CapacitySlots))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Regions) > 0 {
+		for iNdEx := len(m.Regions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Regions[iNdEx])
+			copy(dAtA[i:], m.Regions[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Regions[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.MaxMonths != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxMonths))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MinMonths != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MinMonths))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.NetworkGbPerMonth != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.NetworkGbPerMonth))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StorageGbPerMonth != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StorageGbPerMonth))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PriceUlmn != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PriceUlmn))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.GatewayId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GatewayId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GatewayBond) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayBond) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayBond) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SlashedUlmn) > 0 {
+		i -= len(m.SlashedUlmn)
+		copy(dAtA[i:], m.SlashedUlmn)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.SlashedUlmn)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.UnbondingCompleteAt != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.UnbondingCompleteAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.UnbondingUlmn) > 0 {
+		i -= len(m.UnbondingUlmn)
+		copy(dAtA[i:], m.UnbondingUlmn)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.UnbondingUlmn)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BondedUlmn) > 0 {
+		i -= len(m.BondedUlmn)
+		copy(dAtA[i:], m.BondedUlmn)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.BondedUlmn)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.GatewayId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GatewayId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GatewayReputation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayReputation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayReputation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpdatedAt != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.UpdatedAt))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Score != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Score))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.DisputesLost != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.DisputesLost))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MonthsDelivered != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MonthsDelivered))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ContractsCancelled != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ContractsCancelled))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ContractsCompleted != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ContractsCompleted))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.GatewayId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GatewayId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DomainBinding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DomainBinding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DomainBinding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BoundAt != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.BoundAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GatewayId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GatewayId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UsageReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UsageReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UsageReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DisputeReason) > 0 {
+		i -= len(m.DisputeReason)
+		copy(dAtA[i:], m.DisputeReason)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.DisputeReason)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.DisputeDeadline != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.DisputeDeadline))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.SubmittedAt != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SubmittedAt))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Status != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.EvidenceHash) > 0 {
+		i -= len(m.EvidenceHash)
+		copy(dAtA[i:], m.EvidenceHash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EvidenceHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.NetworkGb != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.NetworkGb))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StorageGb != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StorageGb))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Month != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Month))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DisputeEvidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DisputeEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DisputeEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SubmittedAt != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SubmittedAt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Dispute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Dispute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Dispute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ruling) > 0 {
+		i -= len(m.Ruling)
+		copy(dAtA[i:], m.Ruling)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Ruling)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.ResolvedAt != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ResolvedAt))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.Arbiter) > 0 {
+		i -= len(m.Arbiter)
+		copy(dAtA[i:], m.Arbiter)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Arbiter)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.ClientRefundBps != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ClientRefundBps))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Evidence) > 0 {
+		for iNdEx := len(m.Evidence) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Evidence[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.Deadline != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Deadline))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.OpenedAt != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.OpenedAt))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Status != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Client) > 0 {
+		i -= len(m.Client)
+		copy(dAtA[i:], m.Client)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Client)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.GatewayId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GatewayId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TreasuryFlows) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TreasuryFlows) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TreasuryFlows) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Spent) > 0 {
+		for iNdEx := len(m.Spent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Stakers) > 0 {
+		for iNdEx := len(m.Stakers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stakers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Burned) > 0 {
+		for iNdEx := len(m.Burned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Burned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.CommunityPool) > 0 {
+		for iNdEx := len(m.CommunityPool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommunityPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Inflow) > 0 {
+		for iNdEx := len(m.Inflow) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Inflow[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Gateway) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Payout)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Active {
+		n += 2
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovTypes(uint64(m.CreatedAt))
+	}
+	if m.ActiveClients != 0 {
+		n += 1 + sovTypes(uint64(m.ActiveClients))
+	}
+	if m.Cancellations != 0 {
+		n += 1 + sovTypes(uint64(m.Cancellations))
+	}
+	if m.AutoClaim {
+		n += 2
+	}
+	if len(m.AcceptedDenoms) > 0 {
+		for _, s := range m.AcceptedDenoms {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.Profile != nil {
+		l = m.Profile.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.PendingOperator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.TransferredAt != 0 {
+		n += 1 + sovTypes(uint64(m.TransferredAt))
+	}
+	if m.DecommissionAt != 0 {
+		n += 1 + sovTypes(uint64(m.DecommissionAt))
+	}
+	if m.Archived {
+		n += 2
+	}
+	if m.LastHeartbeatAt != 0 {
+		n += 2 + sovTypes(uint64(m.LastHeartbeatAt))
+	}
+	if m.Offline {
+		n += 3
+	}
+	if m.ContractTransferConsent {
+		n += 3
+	}
+	return n
+}
+
+func (m *GatewayProfile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Endpoints) > 0 {
+		for _, e := range m.Endpoints {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Regions) > 0 {
+		for _, s := range m.Regions {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Protocols) > 0 {
+		l = 0
+		for _, e := range m.Protocols {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	if m.Capacity != nil {
+		l = m.Capacity.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Contact)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *GatewayEndpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Host)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Protocol != 0 {
+		n += 1 + sovTypes(uint64(m.Protocol))
+	}
+	if m.Port != 0 {
+		n += 1 + sovTypes(uint64(m.Port))
+	}
+	return n
+}
+
+func (m *GatewayCapacity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StorageGb != 0 {
+		n += 1 + sovTypes(uint64(m.StorageGb))
+	}
+	if m.NetworkGbPerMonth != 0 {
+		n += 1 + sovTypes(uint64(m.NetworkGbPerMonth))
+	}
+	if m.MaxClients != 0 {
+		n += 1 + sovTypes(uint64(m.MaxClients))
+	}
+	return n
+}
+
+func (m *Contract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	l = len(m.Client)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.GatewayId != 0 {
+		n += 1 + sovTypes(uint64(m.GatewayId))
+	}
+	if m.PriceUlmn != 0 {
+		n += 1 + sovTypes(uint64(m.PriceUlmn))
+	}
+	if m.StorageGbPerMonth != 0 {
+		n += 1 + sovTypes(uint64(m.StorageGbPerMonth))
+	}
+	if m.NetworkGbPerMonth != 0 {
+		n += 1 + sovTypes(uint64(m.NetworkGbPerMonth))
+	}
+	if m.MonthsTotal != 0 {
+		n += 1 + sovTypes(uint64(m.MonthsTotal))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovTypes(uint64(m.StartTime))
+	}
+	l = len(m.EscrowUlmn)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ClaimedMonths != 0 {
+		n += 1 + sovTypes(uint64(m.ClaimedMonths))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTypes(uint64(m.Status))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.NextPayoutTime != 0 {
+		n += 1 + sovTypes(uint64(m.NextPayoutTime))
+	}
+	l = len(m.UsageWithheldUlmn)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.DisputeId != 0 {
+		n += 1 + sovTypes(uint64(m.DisputeId))
+	}
+	if m.OfferId != 0 {
+		n += 2 + sovTypes(uint64(m.OfferId))
+	}
+	if m.PendingAmendment != nil {
+		l = m.PendingAmendment.Size()
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	if m.AcceptDeadline != 0 {
+		n += 2 + sovTypes(uint64(m.AcceptDeadline))
+	}
+	l = len(m.PendingTaxUlmn)
+	if l > 0 {
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	l = len(m.PendingClient)
+	if l > 0 {
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractAmendment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PriceUlmn != 0 {
+		n += 1 + sovTypes(uint64(m.PriceUlmn))
+	}
+	if m.StorageGbPerMonth != 0 {
+		n += 1 + sovTypes(uint64(m.StorageGbPerMonth))
+	}
+	if m.NetworkGbPerMonth != 0 {
+		n += 1 + sovTypes(uint64(m.NetworkGbPerMonth))
+	}
+	if m.ProposedAt != 0 {
+		n += 1 + sovTypes(uint64(m.ProposedAt))
+	}
+	return n
+}
+
+func (m *Offer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	if m.GatewayId != 0 {
+		n += 1 + sovTypes(uint64(m.GatewayId))
+	}
+	if m.PriceUlmn != 0 {
+		n += 1 + sovTypes(uint64(m.PriceUlmn))
+	}
+	if m.StorageGbPerMonth != 0 {
+		n += 1 + sovTypes(uint64(m.StorageGbPerMonth))
+	}
+	if m.NetworkGbPerMonth != 0 {
+		n += 1 + sovTypes(uint64(m.NetworkGbPerMonth))
+	}
+	if m.MinMonths != 0 {
+		n += 1 + sovTypes(uint64(m.MinMonths))
+	}
+	if m.MaxMonths != 0 {
+		n += 1 + sovTypes(uint64(m.MaxMonths))
+	}
+	if len(m.Regions) > 0 {
+		for _, s := range m.Regions {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.CapacitySlots != 0 {
+		n += 1 + sovTypes(uint64(m.CapacitySlots))
+	}
+	if m.UsedSlots != 0 {
+		n += 1 + sovTypes(uint64(m.UsedSlots))
+	}
+	if m.Retired {
+		n += 2
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovTypes(uint64(m.CreatedAt))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.CancellationPolicy != nil {
+		l = m.CancellationPolicy.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *GatewayBond) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GatewayId != 0 {
+		n += 1 + sovTypes(uint64(m.GatewayId))
+	}
+	l = len(m.BondedUlmn)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.UnbondingUlmn)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.UnbondingCompleteAt != 0 {
+		n += 1 + sovTypes(uint64(m.UnbondingCompleteAt))
+	}
+	l = len(m.SlashedUlmn)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *GatewayReputation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GatewayId != 0 {
+		n += 1 + sovTypes(uint64(m.GatewayId))
+	}
+	if m.ContractsCompleted != 0 {
+		n += 1 + sovTypes(uint64(m.ContractsCompleted))
+	}
+	if m.ContractsCancelled != 0 {
+		n += 1 + sovTypes(uint64(m.ContractsCancelled))
+	}
+	if m.MonthsDelivered != 0 {
+		n += 1 + sovTypes(uint64(m.MonthsDelivered))
+	}
+	if m.DisputesLost != 0 {
+		n += 1 + sovTypes(uint64(m.DisputesLost))
+	}
+	if m.Score != 0 {
+		n += 1 + sovTypes(uint64(m.Score))
+	}
+	if m.UpdatedAt != 0 {
+		n += 1 + sovTypes(uint64(m.UpdatedAt))
+	}
+	return n
+}
+
+func (m *DomainBinding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.GatewayId != 0 {
+		n += 1 + sovTypes(uint64(m.GatewayId))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.BoundAt != 0 {
+		n += 1 + sovTypes(uint64(m.BoundAt))
+	}
+	return n
+}
+
+func (m *UsageReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContractId != 0 {
+		n += 1 + sovTypes(uint64(m.ContractId))
+	}
+	if m.Month != 0 {
+		n += 1 + sovTypes(uint64(m.Month))
+	}
+	if m.StorageGb != 0 {
+		n += 1 + sovTypes(uint64(m.StorageGb))
+	}
+	if m.NetworkGb != 0 {
+		n += 1 + sovTypes(uint64(m.NetworkGb))
+	}
+	l = len(m.EvidenceHash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTypes(uint64(m.Status))
+	}
+	if m.SubmittedAt != 0 {
+		n += 1 + sovTypes(uint64(m.SubmittedAt))
+	}
+	if m.DisputeDeadline != 0 {
+		n += 1 + sovTypes(uint64(m.DisputeDeadline))
+	}
+	l = len(m.DisputeReason)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *DisputeEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.SubmittedAt != 0 {
+		n += 1 + sovTypes(uint64(m.SubmittedAt))
+	}
+	return n
+}
+
+func (m *Dispute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovTypes(uint64(m.ContractId))
+	}
+	if m.GatewayId != 0 {
+		n += 1 + sovTypes(uint64(m.GatewayId))
+	}
+	l = len(m.Client)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTypes(uint64(m.Status))
+	}
+	if m.OpenedAt != 0 {
+		n += 1 + sovTypes(uint64(m.OpenedAt))
+	}
+	if m.Deadline != 0 {
+		n += 1 + sovTypes(uint64(m.Deadline))
+	}
+	if len(m.Evidence) > 0 {
+		for _, e := range m.Evidence {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.ClientRefundBps != 0 {
+		n += 1 + sovTypes(uint64(m.ClientRefundBps))
+	}
+	l = len(m.Arbiter)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ResolvedAt != 0 {
+		n += 1 + sovTypes(uint64(m.ResolvedAt))
+	}
+	l = len(m.Ruling)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *TreasuryFlows) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Inflow) > 0 {
+		for _, e := range m.Inflow {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.CommunityPool) > 0 {
+		for _, e := range m.CommunityPool {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Burned) > 0 {
+		for _, e := range m.Burned {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Stakers) > 0 {
+		for _, e := range m.Stakers {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Spent) > 0 {
+		for _, e := range m.Spent {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Gateway) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Gateway: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Gateway: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payout = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveClients", wireType)
+			}
+			m.ActiveClients = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActiveClients |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cancellations", wireType)
+			}
+			m.Cancellations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cancellations |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoClaim", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoClaim = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AcceptedDenoms = append(m.AcceptedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Profile == nil {
+				m.Profile = &GatewayProfile{}
+			}
+			if err := m.Profile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingOperator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingOperator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferredAt", wireType)
+			}
+			m.TransferredAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TransferredAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecommissionAt", wireType)
+			}
+			m.DecommissionAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecommissionAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Archived", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Archived = bool(v != 0)
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHeartbeatAt", wireType)
+			}
+			m.LastHeartbeatAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastHeartbeatAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offline", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Offline = bool(v != 0)
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractTransferConsent", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ContractTransferConsent = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GatewayProfile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GatewayProfile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GatewayProfile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoints = append(m.Endpoints, GatewayEndpoint{})
+			if err := m.Endpoints[len(m.Endpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Regions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Regions = append(m.Regions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v GatewayProtocol
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= GatewayProtocol(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Protocols = append(m.Protocols, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Protocols) == 0 {
+					m.Protocols = make([]GatewayProtocol, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v GatewayProtocol
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= GatewayProtocol(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Protocols = append(m.Protocols, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Protocols", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Capacity == nil {
+				m.Capacity = &GatewayCapacity{}
+			}
+			if err := m.Capacity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contact = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GatewayEndpoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GatewayEndpoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GatewayEndpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Host = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
+			}
+			m.Protocol = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Protocol |= GatewayProtocol(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			m.Port = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Port |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GatewayCapacity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GatewayCapacity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GatewayCapacity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageGb", wireType)
+			}
+			m.StorageGb = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageGb |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkGbPerMonth", wireType)
+			}
+			m.NetworkGbPerMonth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NetworkGbPerMonth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxClients", wireType)
+			}
+			m.MaxClients = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxClients |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Contract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Contract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Contract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Client = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayId", wireType)
+			}
+			m.GatewayId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GatewayId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceUlmn", wireType)
+			}
+			m.PriceUlmn = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceUlmn |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageGbPerMonth", wireType)
+			}
+			m.StorageGbPerMonth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageGbPerMonth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkGbPerMonth", wireType)
+			}
+			m.NetworkGbPerMonth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NetworkGbPerMonth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonthsTotal", wireType)
+			}
+			m.MonthsTotal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MonthsTotal |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowUlmn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EscrowUlmn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimedMonths", wireType)
+			}
+			m.ClaimedMonths = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClaimedMonths |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ContractStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPayoutTime", wireType)
+			}
+			m.NextPayoutTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextPayoutTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsageWithheldUlmn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UsageWithheldUlmn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeId", wireType)
+			}
+			m.DisputeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisputeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferId", wireType)
+			}
+			m.OfferId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OfferId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingAmendment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingAmendment == nil {
+				m.PendingAmendment = &ContractAmendment{}
+			}
+			if err := m.PendingAmendment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptDeadline", wireType)
+			}
+			m.AcceptDeadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AcceptDeadline |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingTaxUlmn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingTaxUlmn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingClient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingClient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractAmendment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractAmendment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractAmendment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceUlmn", wireType)
+			}
+			m.PriceUlmn = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceUlmn |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageGbPerMonth", wireType)
+			}
+			m.StorageGbPerMonth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageGbPerMonth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkGbPerMonth", wireType)
+			}
+			m.NetworkGbPerMonth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NetworkGbPerMonth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposedAt", wireType)
+			}
+			m.ProposedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposedAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Offer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Offer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Offer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayId", wireType)
+			}
+			m.GatewayId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GatewayId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceUlmn", wireType)
+			}
+			m.PriceUlmn = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceUlmn |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageGbPerMonth", wireType)
+			}
+			m.StorageGbPerMonth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageGbPerMonth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkGbPerMonth", wireType)
+			}
+			m.NetworkGbPerMonth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NetworkGbPerMonth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinMonths", wireType)
+			}
+			m.MinMonths = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinMonths |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMonths", wireType)
+			}
+			m.MaxMonths = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMonths |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Regions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Regions = append(m.Regions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CapacitySlots", wireType)
+			}
+			m.CapacitySlots = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CapacitySlots |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedSlots", wireType)
+			}
+			m.UsedSlots = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UsedSlots |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Retired = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancellationPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CancellationPolicy == nil {
+				m.CancellationPolicy = &CancellationPolicy{}
+			}
+			if err := m.CancellationPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GatewayBond) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GatewayBond: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GatewayBond: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayId", wireType)
+			}
+			m.GatewayId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GatewayId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondedUlmn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BondedUlmn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingUlmn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingUlmn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingCompleteAt", wireType)
+			}
+			m.UnbondingCompleteAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingCompleteAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedUlmn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashedUlmn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GatewayReputation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GatewayReputation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GatewayReputation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayId", wireType)
+			}
+			m.GatewayId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GatewayId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractsCompleted", wireType)
+			}
+			m.ContractsCompleted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractsCompleted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractsCancelled", wireType)
+			}
+			m.ContractsCancelled = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractsCancelled |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonthsDelivered", wireType)
+			}
+			m.MonthsDelivered = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MonthsDelivered |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputesLost", wireType)
+			}
+			m.DisputesLost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisputesLost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			m.Score = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Score |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			m.UpdatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DomainBinding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DomainBinding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DomainBinding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayId", wireType)
+			}
+			m.GatewayId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GatewayId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BoundAt", wireType)
+			}
+			m.BoundAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BoundAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UsageReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UsageReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UsageReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Month", wireType)
+			}
+			m.Month = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Month |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageGb", wireType)
+			}
+			m.StorageGb = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageGb |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkGb", wireType)
+			}
+			m.NetworkGb = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NetworkGb |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvidenceHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= UsageReportStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmittedAt", wireType)
+			}
+			m.SubmittedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmittedAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeDeadline", wireType)
+			}
+			m.DisputeDeadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisputeDeadline |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisputeReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *DisputeEvidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DisputeEvidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DisputeEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmittedAt", wireType)
+			}
+			m.SubmittedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmittedAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Gateway) Unmarshal(dAtA []byte) error {
+func (m *Dispute) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -648,10 +6344,10 @@ func (m *Gateway) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Gateway: wiretype end group for non-group")
+			return fmt.Errorf("proto: Dispute: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Gateway: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Dispute: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -674,8 +6370,46 @@ func (m *Gateway) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayId", wireType)
+			}
+			m.GatewayId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GatewayId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -703,11 +6437,11 @@ func (m *Gateway) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.Client = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -735,13 +6469,104 @@ func (m *Gateway) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payout = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= DisputeStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenedAt", wireType)
+			}
+			m.OpenedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OpenedAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Evidence = append(m.Evidence, &DisputeEvidence{})
+			if err := m.Evidence[len(m.Evidence)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientRefundBps", wireType)
 			}
-			var v int
+			m.ClientRefundBps = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -751,15 +6576,14 @@ func (m *Gateway) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.ClientRefundBps |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Active = bool(v != 0)
-		case 5:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Arbiter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -787,13 +6611,13 @@ func (m *Gateway) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
+			m.Arbiter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolvedAt", wireType)
 			}
-			m.CreatedAt = 0
+			m.ResolvedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -803,16 +6627,16 @@ func (m *Gateway) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreatedAt |= uint64(b&0x7F) << shift
+				m.ResolvedAt |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActiveClients", wireType)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ruling", wireType)
 			}
-			m.ActiveClients = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -822,30 +6646,24 @@ func (m *Gateway) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ActiveClients |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cancellations", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
 			}
-			m.Cancellations = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Cancellations |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Ruling = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -867,7 +6685,7 @@ func (m *Gateway) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Contract) Unmarshal(dAtA []byte) error {
+func (m *TreasuryFlows) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -890,36 +6708,17 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Contract: wiretype end group for non-group")
+			return fmt.Errorf("proto: TreasuryFlows: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Contract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TreasuryFlows: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflow", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -929,29 +6728,31 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Client = string(dAtA[iNdEx:postIndex])
+			m.Inflow = append(m.Inflow, types.Coin{})
+			if err := m.Inflow[len(m.Inflow)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GatewayId", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
 			}
-			m.GatewayId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -961,54 +6762,31 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GatewayId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriceUlmn", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
 			}
-			m.PriceUlmn = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PriceUlmn |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageGbPerMonth", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.StorageGbPerMonth = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StorageGbPerMonth |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.CommunityPool = append(m.CommunityPool, types.Coin{})
+			if err := m.CommunityPool[len(m.CommunityPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetworkGbPerMonth", wireType)
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
 			}
-			m.NetworkGbPerMonth = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1018,54 +6796,31 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NetworkGbPerMonth |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MonthsTotal", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
 			}
-			m.MonthsTotal = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MonthsTotal |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.StartTime = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StartTime |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Burned = append(m.Burned, types.Coin{})
+			if err := m.Burned[len(m.Burned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		case 9:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EscrowUlmn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stakers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1075,67 +6830,31 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EscrowUlmn = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClaimedMonths", wireType)
-			}
-			m.ClaimedMonths = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ClaimedMonths |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= ContractStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Stakers = append(m.Stakers, types.Coin{})
+			if err := m.Stakers[len(m.Stakers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		case 12:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1145,43 +6864,26 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPayoutTime", wireType)
-			}
-			m.NextPayoutTime = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NextPayoutTime |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Spent = append(m.Spent, types.Coin{})
+			if err := m.Spent[len(m.Spent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
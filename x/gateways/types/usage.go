@@ -0,0 +1,48 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+// ValidateEvidenceHash accepts an empty hash or a hex encoded sha256 digest.
+func ValidateEvidenceHash(h string) error {
+	if h == "" {
+		return nil
+	}
+	bz, err := hex.DecodeString(h)
+	if err != nil || len(bz) != 32 {
+		return fmt.Errorf("evidence_hash must be a hex encoded sha256 digest")
+	}
+	return nil
+}
+
+// ProRatedAmount scales a monthly payment to the usage delivered in report.
+// Each contracted dimension (storage, network) contributes its delivered
+// fraction, capped at 100%, and the fractions are averaged. Contracts that
+// commit to neither dimension are paid in full.
+func ProRatedAmount(price sdkmath.Int, contract Contract, report UsageReport) sdkmath.Int {
+	type dimension struct{ delivered, contracted uint64 }
+	var dims []dimension
+	if contract.StorageGbPerMonth > 0 {
+		dims = append(dims, dimension{report.StorageGb, contract.StorageGbPerMonth})
+	}
+	if contract.NetworkGbPerMonth > 0 {
+		dims = append(dims, dimension{report.NetworkGb, contract.NetworkGbPerMonth})
+	}
+	if len(dims) == 0 {
+		return price
+	}
+
+	// Sum the fractions over a common denominator so rounding happens once.
+	num, den := sdkmath.ZeroInt(), sdkmath.OneInt()
+	for _, d := range dims {
+		delivered := min(d.delivered, d.contracted)
+		c := sdkmath.NewIntFromUint64(d.contracted)
+		num = num.Mul(c).Add(sdkmath.NewIntFromUint64(delivered).Mul(den))
+		den = den.Mul(c)
+	}
+	return price.Mul(num).Quo(den.MulRaw(int64(len(dims))))
+}
@@ -3,6 +3,8 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/collections"
+
 	"lumen/x/release/types"
 )
 
@@ -16,7 +18,7 @@ func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) er
 		if err := k.Release.Set(ctx, r.Id, *r); err != nil {
 			return err
 		}
-		if err := k.ByVersion.Set(ctx, r.Version, r.Id); err != nil {
+		if err := k.ByVersion.Set(ctx, collections.Join(r.Version, r.Channel), r.Id); err != nil {
 			return err
 		}
 		if !r.Yanked && r.Status == types.Release_VALIDATED {
@@ -30,6 +32,16 @@ func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) er
 			}
 		}
 	}
+	for _, key := range genState.PublisherKeys {
+		if err := k.PublisherKeys.Set(ctx, key.KeyId, *key); err != nil {
+			return err
+		}
+	}
+	for _, att := range genState.Attestations {
+		if err := k.Attestations.Set(ctx, collections.Join(att.ReleaseId, att.Attester), *att); err != nil {
+			return err
+		}
+	}
 	if genState.BundleCount > 0 {
 		if err := k.ReleaseSeq.Set(ctx, genState.BundleCount); err != nil {
 			return err
@@ -61,6 +73,22 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 	_ = k.Release.Walk(ctx, nil, func(_ uint64, r types.Release) (bool, error) { rr := r; list = append(list, &rr); return false, nil })
 	genesis.Releases = list
 
+	keys := []*types.PublisherKey{}
+	_ = k.PublisherKeys.Walk(ctx, nil, func(_ string, key types.PublisherKey) (bool, error) {
+		kk := key
+		keys = append(keys, &kk)
+		return false, nil
+	})
+	genesis.PublisherKeys = keys
+
+	attestations := []*types.Attestation{}
+	_ = k.Attestations.Walk(ctx, nil, func(_ collections.Pair[uint64, string], att types.Attestation) (bool, error) {
+		aa := att
+		attestations = append(attestations, &aa)
+		return false, nil
+	})
+	genesis.Attestations = attestations
+
 	last, _ := k.ReleaseSeq.Peek(ctx)
 	genesis.BundleCount = last
 
@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"lumen/crypto/pqc/dilithium"
 	"lumen/x/release/types"
 )
 
@@ -19,14 +20,16 @@ type Keeper struct {
 	cdc          codec.Codec
 	addressCodec address.Codec
 	authority    []byte
+	scheme       dilithium.Scheme
 
 	Schema collections.Schema
 	Params collections.Item[types.Params]
 
 	Release    collections.Map[uint64, types.Release]
 	ReleaseSeq collections.Sequence
-	ByVersion  collections.Map[string, uint64]
-	ByTriple   collections.Map[string, uint64]
+	// ByVersion maps (version, channel) to the release id.
+	ByVersion collections.Map[collections.Pair[string, string], uint64]
+	ByTriple  collections.Map[string, uint64]
 
 	bank  types.BankKeeper
 	distr types.DistributionKeeper
@@ -40,6 +43,11 @@ type Keeper struct {
 
 	ExpiryByID  collections.Map[uint64, int64]
 	ExpiryQueue collections.Map[collections.Pair[int64, uint64], bool]
+
+	// PublisherKeys holds the signing keys artifact signatures are checked
+	// against, by key_id.
+	PublisherKeys collections.Map[string, types.PublisherKey]
+	Attestations  collections.Map[collections.Pair[uint64, string], types.Attestation]
 }
 
 func NewKeeper(
@@ -59,12 +67,19 @@ func NewKeeper(
 		cdc:          cdc,
 		addressCodec: addressCodec,
 		authority:    authority,
+		scheme:       dilithium.Default(),
 
 		Params:     collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
 		Release:    collections.NewMap(sb, types.ReleaseKey, "release", collections.Uint64Key, codec.CollValue[types.Release](cdc)),
 		ReleaseSeq: collections.NewSequence(sb, types.ReleaseSeqKey, "release_seq"),
-		ByVersion:  collections.NewMap(sb, types.ByVersionKey, "by_version", collections.StringKey, collections.Uint64Value),
-		ByTriple:   collections.NewMap(sb, types.ByTripleKey, "by_cpk", collections.StringKey, collections.Uint64Value),
+		ByVersion: collections.NewMap(
+			sb,
+			types.ByVersionChannelKey,
+			"by_version",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			collections.Uint64Value,
+		),
+		ByTriple: collections.NewMap(sb, types.ByTripleKey, "by_cpk", collections.StringKey, collections.Uint64Value),
 
 		StateVersion: collections.NewItem(sb, types.StateVersionKey, "state_version", collections.Uint64Value),
 		ExpiryTTL:    collections.NewItem(sb, types.ExpiryTTLKey, "expiry_ttl", collections.Uint64Value),
@@ -80,6 +95,14 @@ func NewKeeper(
 			collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key),
 			collections.BoolValue,
 		),
+		PublisherKeys: collections.NewMap(sb, types.PublisherKeyKey, "publisher_keys", collections.StringKey, codec.CollValue[types.PublisherKey](cdc)),
+		Attestations: collections.NewMap(
+			sb,
+			types.AttestationKey,
+			"attestations",
+			collections.PairKeyCodec(collections.Uint64Key, collections.StringKey),
+			codec.CollValue[types.Attestation](cdc),
+		),
 	}
 
 	schema, err := sb.Build()
@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"lumen/x/release/types"
+)
+
+// Migrator runs the release store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+func NewMigrator(k Keeper) Migrator {
+	return Migrator{keeper: k}
+}
+
+// Migrate1to2 makes the version index channel-aware: the version -> id index,
+// where the same version on two channels collided, is dropped and every
+// release is indexed again by (version, channel).
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	legacy := collections.NewMap(
+		collections.NewSchemaBuilder(m.keeper.storeService),
+		types.ByVersionKey,
+		"legacy_by_version",
+		collections.StringKey,
+		collections.Uint64Value,
+	)
+	if err := legacy.Clear(ctx, nil); err != nil {
+		return err
+	}
+	return m.keeper.Release.Walk(ctx, nil, func(id uint64, r types.Release) (bool, error) {
+		return false, m.keeper.ByVersion.Set(ctx, collections.Join(r.Version, r.Channel), id)
+	})
+}
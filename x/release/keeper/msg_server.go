@@ -38,27 +38,9 @@ func (m msgServer) RejectRelease(ctx context.Context, msg *types.MsgRejectReleas
 	if err := m.enforcePendingAndNotYanked(release); err != nil {
 		return nil, err
 	}
-
-	release.Status = types.Release_REJECTED
-	release.EmergencyOk = false
-	release.EmergencyUntil = 0
-	if err := m.Release.Set(ctx, release.Id, release); err != nil {
-		return nil, err
-	}
-	if err := m.dequeueExpiry(ctx, release.Id); err != nil {
+	if err := m.rejectRelease(ctx, release); err != nil {
 		return nil, err
 	}
-	if err := m.forfeitEscrowToCommunityPool(ctx, release.Id); err != nil {
-		return nil, err
-	}
-
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
-		"release_reject",
-		sdk.NewAttribute("id", fmt.Sprintf("%d", release.Id)),
-		sdk.NewAttribute("status", release.Status.String()),
-	))
-
 	return &types.MsgRejectReleaseResponse{}, nil
 }
 
@@ -80,32 +62,29 @@ func (m msgServer) ValidateRelease(ctx context.Context, msg *types.MsgValidateRe
 	if err := m.enforcePendingAndNotYanked(release); err != nil {
 		return nil, err
 	}
+	if err := m.validateRelease(ctx, release); err != nil {
+		return nil, err
+	}
+	return &types.MsgValidateReleaseResponse{}, nil
+}
 
+// validateRelease marks a pending release VALIDATED, refunds its escrow and
+// points the latest index at it.
+func (k Keeper) validateRelease(ctx context.Context, release types.Release) error {
 	release.Status = types.Release_VALIDATED
 	release.EmergencyOk = false
 	release.EmergencyUntil = 0
-	if err := m.Release.Set(ctx, release.Id, release); err != nil {
-		return nil, err
+	if err := k.Release.Set(ctx, release.Id, release); err != nil {
+		return err
 	}
-	if err := m.dequeueExpiry(ctx, release.Id); err != nil {
-		return nil, err
+	if err := k.dequeueExpiry(ctx, release.Id); err != nil {
+		return err
 	}
-	if err := m.refundEscrow(ctx, release.Id); err != nil {
-		return nil, err
+	if err := k.refundEscrow(ctx, release.Id); err != nil {
+		return err
 	}
-
-	for _, a := range release.Artifacts {
-		if a == nil {
-			continue
-		}
-		key := tripleKey(release.Channel, a.Platform, a.Kind)
-		existingID, err := m.ByTriple.Get(ctx, key)
-		if err == nil && existingID > release.Id {
-			continue
-		}
-		if err := m.ByTriple.Set(ctx, key, release.Id); err != nil {
-			return nil, err
-		}
+	if err := k.pointLatest(ctx, release); err != nil {
+		return err
 	}
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -114,8 +93,32 @@ func (m msgServer) ValidateRelease(ctx context.Context, msg *types.MsgValidateRe
 		sdk.NewAttribute("id", fmt.Sprintf("%d", release.Id)),
 		sdk.NewAttribute("status", release.Status.String()),
 	))
+	return nil
+}
 
-	return &types.MsgValidateReleaseResponse{}, nil
+// rejectRelease marks a pending release REJECTED and forfeits its escrow to
+// the community pool.
+func (k Keeper) rejectRelease(ctx context.Context, release types.Release) error {
+	release.Status = types.Release_REJECTED
+	release.EmergencyOk = false
+	release.EmergencyUntil = 0
+	if err := k.Release.Set(ctx, release.Id, release); err != nil {
+		return err
+	}
+	if err := k.dequeueExpiry(ctx, release.Id); err != nil {
+		return err
+	}
+	if err := k.forfeitEscrowToCommunityPool(ctx, release.Id); err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		"release_reject",
+		sdk.NewAttribute("id", fmt.Sprintf("%d", release.Id)),
+		sdk.NewAttribute("status", release.Status.String()),
+	))
+	return nil
 }
 
 func (m msgServer) assertAuthority(authority string) error {
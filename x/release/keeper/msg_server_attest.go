@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"lumen/x/release/types"
+)
+
+// attestationsMatch reports whether the rebuilt hashes equal the published
+// artifact hashes, in release order.
+func attestationsMatch(r types.Release, hashes []string) bool {
+	if len(hashes) != len(r.Artifacts) {
+		return false
+	}
+	for i, a := range r.Artifacts {
+		if a == nil || !strings.EqualFold(strings.TrimSpace(hashes[i]), strings.TrimSpace(a.Sha256Hex)) {
+			return false
+		}
+	}
+	return true
+}
+
+// tallyAttestations settles a pending release once its attestations decide
+// it: attestation_quorum matching attestations validate it, and it is
+// rejected as soon as conflicting hashes leave too few attesters to reach
+// the quorum. Only attestations of current attesters count.
+func (k Keeper) tallyAttestations(ctx context.Context, p types.Params, r types.Release) (types.Release_ReleaseStatus, error) {
+	var matched, attested uint32
+	rng := collections.NewPrefixedPairRange[uint64, string](r.Id)
+	err := k.Attestations.Walk(ctx, rng, func(_ collections.Pair[uint64, string], att types.Attestation) (bool, error) {
+		if !containsString(p.Attesters, att.Attester) {
+			return false, nil
+		}
+		attested++
+		if att.Match {
+			matched++
+		}
+		return false, nil
+	})
+	if err != nil {
+		return r.Status, err
+	}
+
+	switch {
+	case matched >= p.AttestationQuorum:
+		if err := k.validateRelease(ctx, r); err != nil {
+			return r.Status, err
+		}
+		return types.Release_VALIDATED, nil
+	case matched+uint32(len(p.Attesters))-attested < p.AttestationQuorum:
+		if err := k.rejectRelease(ctx, r); err != nil {
+			return r.Status, err
+		}
+		return types.Release_REJECTED, nil
+	}
+	return r.Status, nil
+}
+
+func (m msgServer) AttestRelease(ctx context.Context, msg *types.MsgAttestRelease) (*types.MsgAttestReleaseResponse, error) {
+	params := m.GetParams(ctx)
+	if params.AttestationQuorum == 0 {
+		return nil, errorsmod.Wrap(types.ErrDisabled, "release attestation is disabled")
+	}
+	if !containsString(params.Attesters, msg.Attester) {
+		return nil, errorsmod.Wrap(types.ErrNotAuthorized, "not an attester")
+	}
+	release, err := m.Release.Get(ctx, msg.Id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "release %d not found", msg.Id)
+		}
+		return nil, err
+	}
+	if err := m.enforcePendingAndNotYanked(release); err != nil {
+		return nil, err
+	}
+	key := collections.Join(release.Id, msg.Attester)
+	has, err := m.Attestations.Has(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if has {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "release already attested")
+	}
+
+	match := attestationsMatch(release, msg.Sha256Hex)
+	hashes := make([]string, len(msg.Sha256Hex))
+	for i, h := range msg.Sha256Hex {
+		hashes[i] = strings.ToLower(strings.TrimSpace(h))
+	}
+	if err := m.Attestations.Set(ctx, key, types.Attestation{
+		ReleaseId:   release.Id,
+		Attester:    msg.Attester,
+		Sha256Hex:   hashes,
+		Match:       match,
+		SubmittedAt: m.nowUnix(ctx),
+	}); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		"release_attest",
+		sdk.NewAttribute("id", fmt.Sprintf("%d", release.Id)),
+		sdk.NewAttribute("attester", msg.Attester),
+		sdk.NewAttribute("match", fmt.Sprintf("%t", match)),
+	))
+
+	status, err := m.tallyAttestations(ctx, params, release)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgAttestReleaseResponse{Match: match, Status: status}, nil
+}
@@ -0,0 +1,96 @@
+package keeper_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"lumen/x/release/keeper"
+	"lumen/x/release/types"
+)
+
+func attesterFixture(t *testing.T, n int, quorum uint32) (*releaseFixture, []string) {
+	t.Helper()
+	f := newReleaseFixture(t)
+	attesters := make([]string, n)
+	for i := range attesters {
+		addr, err := f.addrCodec.BytesToString(sdk.AccAddress(bytes.Repeat([]byte{byte(0x60 + i)}, 20)))
+		require.NoError(t, err)
+		attesters[i] = addr
+	}
+	params := types.DefaultParams()
+	params.Attesters = attesters
+	params.AttestationQuorum = quorum
+	require.NoError(t, params.Validate())
+	require.NoError(t, f.keeper.SetParams(f.ctx, params))
+	return f, attesters
+}
+
+func TestAttestationQuorumValidatesRelease(t *testing.T) {
+	f, attesters := attesterFixture(t, 3, 2)
+	f.storeRelease(t, 1)
+	published := strings.Repeat("a", 64)
+
+	_, err := f.msgSrv.AttestRelease(f.ctx, &types.MsgAttestRelease{Attester: f.authority, Id: 1, Sha256Hex: []string{published}})
+	require.ErrorIs(t, err, types.ErrNotAuthorized)
+
+	res, err := f.msgSrv.AttestRelease(f.ctx, &types.MsgAttestRelease{Attester: attesters[0], Id: 1, Sha256Hex: []string{strings.ToUpper(published)}})
+	require.NoError(t, err)
+	require.True(t, res.Match)
+	require.Equal(t, types.Release_PENDING, res.Status)
+	_, err = f.msgSrv.AttestRelease(f.ctx, &types.MsgAttestRelease{Attester: attesters[0], Id: 1, Sha256Hex: []string{published}})
+	require.ErrorContains(t, err, "already attested")
+
+	res, err = f.msgSrv.AttestRelease(f.ctx, &types.MsgAttestRelease{Attester: attesters[1], Id: 1, Sha256Hex: []string{published}})
+	require.NoError(t, err)
+	require.Equal(t, types.Release_VALIDATED, res.Status)
+
+	latest, err := keeper.NewQueryServerImpl(f.keeper).Latest(f.ctx, &types.QueryLatestRequest{Channel: "beta", Platform: "linux", Kind: "daemon"})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), latest.Release.Id)
+	attestations, err := keeper.NewQueryServerImpl(f.keeper).Attestations(f.ctx, &types.QueryAttestationsRequest{Id: 1})
+	require.NoError(t, err)
+	require.Len(t, attestations.Attestations, 2)
+
+	// The release is settled; late attestations are refused.
+	_, err = f.msgSrv.AttestRelease(f.ctx, &types.MsgAttestRelease{Attester: attesters[2], Id: 1, Sha256Hex: []string{published}})
+	require.ErrorIs(t, err, types.ErrNotPending)
+}
+
+func TestConflictingAttestationsRejectRelease(t *testing.T) {
+	f, attesters := attesterFixture(t, 3, 2)
+	f.storeRelease(t, 1)
+	published := strings.Repeat("a", 64)
+	rebuilt := strings.Repeat("b", 64)
+
+	res, err := f.msgSrv.AttestRelease(f.ctx, &types.MsgAttestRelease{Attester: attesters[0], Id: 1, Sha256Hex: []string{rebuilt}})
+	require.NoError(t, err)
+	require.False(t, res.Match)
+	require.Equal(t, types.Release_PENDING, res.Status)
+	res, err = f.msgSrv.AttestRelease(f.ctx, &types.MsgAttestRelease{Attester: attesters[1], Id: 1, Sha256Hex: []string{published}})
+	require.NoError(t, err)
+	require.Equal(t, types.Release_PENDING, res.Status)
+
+	// With two conflicting hashes the quorum of two can no longer be met.
+	res, err = f.msgSrv.AttestRelease(f.ctx, &types.MsgAttestRelease{Attester: attesters[2], Id: 1, Sha256Hex: []string{rebuilt}})
+	require.NoError(t, err)
+	require.Equal(t, types.Release_REJECTED, res.Status)
+	release, err := f.keeper.Release.Get(f.ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, types.Release_REJECTED, release.Status)
+}
+
+func TestAttestationDisabledWithoutQuorum(t *testing.T) {
+	f, attesters := attesterFixture(t, 1, 0)
+	f.storeRelease(t, 1)
+
+	_, err := f.msgSrv.AttestRelease(f.ctx, &types.MsgAttestRelease{Attester: attesters[0], Id: 1, Sha256Hex: []string{strings.Repeat("a", 64)}})
+	require.ErrorIs(t, err, types.ErrDisabled)
+
+	params := types.DefaultParams()
+	params.AttestationQuorum = 1
+	require.ErrorContains(t, params.Validate(), "attestation_quorum")
+}
@@ -6,6 +6,7 @@ import (
 	"regexp"
 	"strings"
 
+	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -85,6 +86,19 @@ func (m msgServer) PublishRelease(ctx context.Context, msg *types.MsgPublishRele
 	if err := validateReleaseForPublish(params, &r); err != nil {
 		return nil, errorsmod.Wrapf(types.ErrInvalidRequest, "%s", err.Error())
 	}
+	if err := m.verifyArtifactSignatures(ctx, params, &r); err != nil {
+		return nil, err
+	}
+	if err := m.checkSupersedes(ctx, r); err != nil {
+		return nil, err
+	}
+	taken, err := m.ByVersion.Has(ctx, collections.Join(r.Version, r.Channel))
+	if err != nil {
+		return nil, err
+	}
+	if taken {
+		return nil, errorsmod.Wrapf(types.ErrInvalidRequest, "version %s already published on channel %s", r.Version, r.Channel)
+	}
 
 	last, _ := m.ReleaseSeq.Peek(ctx)
 	nextID := last + 1
@@ -113,7 +127,7 @@ func (m msgServer) PublishRelease(ctx context.Context, msg *types.MsgPublishRele
 	if err := m.Release.Set(ctx, nextID, r); err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "store release failed")
 	}
-	if err := m.ByVersion.Set(ctx, r.Version, nextID); err != nil {
+	if err := m.ByVersion.Set(ctx, collections.Join(r.Version, r.Channel), nextID); err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "index byVersion failed")
 	}
 
@@ -0,0 +1,185 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"lumen/x/release/types"
+)
+
+// pointLatest makes a validated, unyanked release the latest of each of its
+// channel/platform/kind triples unless a newer release already holds it.
+func (k Keeper) pointLatest(ctx context.Context, r types.Release) error {
+	for _, a := range r.Artifacts {
+		if a == nil {
+			continue
+		}
+		key := tripleKey(r.Channel, a.Platform, a.Kind)
+		existingID, err := k.ByTriple.Get(ctx, key)
+		if err == nil && existingID > r.Id {
+			continue
+		}
+		if err := k.ByTriple.Set(ctx, key, r.Id); err != nil {
+			return err
+		}
+		if existingID != r.Id {
+			k.emitLatestUpdate(ctx, key, existingID, r.Id)
+		}
+	}
+	return nil
+}
+
+// unpointLatest hands every triple whose latest release is r to the newest
+// other validated, unyanked release of that triple, or drops the triple when
+// there is none.
+func (k Keeper) unpointLatest(ctx context.Context, r types.Release) error {
+	for _, a := range r.Artifacts {
+		if a == nil {
+			continue
+		}
+		key := tripleKey(r.Channel, a.Platform, a.Kind)
+		existingID, err := k.ByTriple.Get(ctx, key)
+		if err != nil || existingID != r.Id {
+			continue
+		}
+		var previous uint64
+		err = k.Release.Walk(ctx, nil, func(id uint64, other types.Release) (bool, error) {
+			if id == r.Id || other.Yanked || other.Status != types.Release_VALIDATED {
+				return false, nil
+			}
+			for _, oa := range other.Artifacts {
+				if oa != nil && tripleKey(other.Channel, oa.Platform, oa.Kind) == key && id > previous {
+					previous = id
+				}
+			}
+			return false, nil
+		})
+		if err != nil {
+			return err
+		}
+		if previous == 0 {
+			err = k.ByTriple.Remove(ctx, key)
+		} else {
+			err = k.ByTriple.Set(ctx, key, previous)
+		}
+		if err != nil {
+			return err
+		}
+		k.emitLatestUpdate(ctx, key, r.Id, previous)
+	}
+	return nil
+}
+
+// emitLatestUpdate tells updaters which release now backs a triple; id 0
+// means the triple has no latest release any more.
+func (k Keeper) emitLatestUpdate(ctx context.Context, key string, previous, id uint64) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		"release_latest_update",
+		sdk.NewAttribute("triple", key),
+		sdk.NewAttribute("previous_id", fmt.Sprintf("%d", previous)),
+		sdk.NewAttribute("id", fmt.Sprintf("%d", id)),
+	))
+}
+
+func (m msgServer) YankRelease(ctx context.Context, msg *types.MsgYankRelease) (*types.MsgYankReleaseResponse, error) {
+	reason := strings.TrimSpace(msg.Reason)
+	if reason == "" || len(reason) > types.ReleaseYankReasonMaxLen {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "invalid reason")
+	}
+	release, err := m.Release.Get(ctx, msg.Id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "release %d not found", msg.Id)
+		}
+		return nil, err
+	}
+	if msg.Creator != release.Publisher {
+		if err := m.assertAuthority(msg.Creator); err != nil {
+			return nil, errorsmod.Wrap(types.ErrNotAuthorized, "only the publisher or the authority may yank")
+		}
+	}
+	if release.Yanked {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "release already yanked")
+	}
+
+	release.Yanked = true
+	release.YankReason = reason
+	release.YankedAt = m.nowUnix(ctx)
+	release.EmergencyOk = false
+	release.EmergencyUntil = 0
+	if err := m.Release.Set(ctx, release.Id, release); err != nil {
+		return nil, err
+	}
+	// A yanked pending release can no longer be validated; its escrow is
+	// forfeited as on rejection.
+	if release.Status == types.Release_PENDING {
+		if err := m.dequeueExpiry(ctx, release.Id); err != nil {
+			return nil, err
+		}
+		if err := m.forfeitEscrowToCommunityPool(ctx, release.Id); err != nil {
+			return nil, err
+		}
+	}
+	if err := m.unpointLatest(ctx, release); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		"release_yank",
+		sdk.NewAttribute("id", fmt.Sprintf("%d", release.Id)),
+		sdk.NewAttribute("version", release.Version),
+		sdk.NewAttribute("channel", release.Channel),
+		sdk.NewAttribute("by", msg.Creator),
+		sdk.NewAttribute("reason", reason),
+	))
+	return &types.MsgYankReleaseResponse{}, nil
+}
+
+func (m msgServer) UnyankRelease(ctx context.Context, msg *types.MsgUnyankRelease) (*types.MsgUnyankReleaseResponse, error) {
+	if err := m.assertAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+	release, err := m.Release.Get(ctx, msg.Id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "release %d not found", msg.Id)
+		}
+		return nil, err
+	}
+	if !release.Yanked {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "release not yanked")
+	}
+	// Yanking a pending release forfeited its escrow, so only validated
+	// releases can be restored.
+	if release.Status != types.Release_VALIDATED {
+		return nil, errorsmod.Wrapf(types.ErrInvalidRequest, "only validated releases can be unyanked; release is %s", release.Status)
+	}
+
+	release.Yanked = false
+	release.YankReason = ""
+	release.YankedAt = 0
+	if err := m.Release.Set(ctx, release.Id, release); err != nil {
+		return nil, err
+	}
+	if err := m.pointLatest(ctx, release); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		"release_unyank",
+		sdk.NewAttribute("id", fmt.Sprintf("%d", release.Id)),
+		sdk.NewAttribute("version", release.Version),
+		sdk.NewAttribute("channel", release.Channel),
+	))
+	return &types.MsgUnyankReleaseResponse{}, nil
+}
@@ -0,0 +1,91 @@
+package keeper_test
+
+import (
+	"bytes"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"lumen/x/release/keeper"
+	"lumen/x/release/types"
+)
+
+func TestYankRecomputesLatestAndUnyankRestoresIt(t *testing.T) {
+	f := newReleaseFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	publisher := sdk.AccAddress(bytes.Repeat([]byte{0x44}, 20)).String()
+	for id := uint64(1); id <= 2; id++ {
+		f.storeRelease(t, id)
+		release, err := f.keeper.Release.Get(f.ctx, id)
+		require.NoError(t, err)
+		release.Publisher = publisher
+		require.NoError(t, f.keeper.Release.Set(f.ctx, id, release))
+		_, err = f.msgSrv.ValidateRelease(f.ctx, &types.MsgValidateRelease{Authority: f.authority, Id: id})
+		require.NoError(t, err)
+	}
+	latest := func() uint64 {
+		resp, err := qs.Latest(f.ctx, &types.QueryLatestRequest{Channel: "beta", Platform: "linux", Kind: "daemon"})
+		if err != nil {
+			return 0
+		}
+		return resp.Release.Id
+	}
+	require.Equal(t, uint64(2), latest())
+
+	other := sdk.AccAddress(bytes.Repeat([]byte{0x05}, 20)).String()
+	_, err := f.msgSrv.YankRelease(f.ctx, &types.MsgYankRelease{Creator: other, Id: 2, Reason: "broken"})
+	require.ErrorIs(t, err, types.ErrNotAuthorized)
+	_, err = f.msgSrv.YankRelease(f.ctx, &types.MsgYankRelease{Creator: publisher, Id: 2})
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+
+	f.ctx = f.ctx.WithEventManager(sdk.NewEventManager())
+	_, err = f.msgSrv.YankRelease(f.ctx, &types.MsgYankRelease{Creator: publisher, Id: 2, Reason: "corrupt build"})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), latest())
+	release, err := f.keeper.Release.Get(f.ctx, 2)
+	require.NoError(t, err)
+	require.True(t, release.Yanked)
+	require.Equal(t, "corrupt build", release.YankReason)
+	var rollback bool
+	for _, ev := range f.ctx.EventManager().Events() {
+		if ev.Type == "release_latest_update" {
+			rollback = true
+		}
+	}
+	require.True(t, rollback)
+
+	_, err = f.msgSrv.YankRelease(f.ctx, &types.MsgYankRelease{Creator: publisher, Id: 2, Reason: "again"})
+	require.ErrorContains(t, err, "already yanked")
+
+	// The authority may yank too; with nothing left the triple has no latest.
+	_, err = f.msgSrv.YankRelease(f.ctx, &types.MsgYankRelease{Creator: f.authority, Id: 1, Reason: "security"})
+	require.NoError(t, err)
+	require.Zero(t, latest())
+
+	_, err = f.msgSrv.UnyankRelease(f.ctx, &types.MsgUnyankRelease{Authority: publisher, Id: 2})
+	require.Error(t, err)
+	_, err = f.msgSrv.UnyankRelease(f.ctx, &types.MsgUnyankRelease{Authority: f.authority, Id: 2})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), latest())
+	_, err = f.msgSrv.UnyankRelease(f.ctx, &types.MsgUnyankRelease{Authority: f.authority, Id: 1})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), latest())
+	_, err = f.msgSrv.UnyankRelease(f.ctx, &types.MsgUnyankRelease{Authority: f.authority, Id: 1})
+	require.ErrorContains(t, err, "not yanked")
+
+	// A yanked pending release lost its escrow and stays yanked.
+	f.storeRelease(t, 3)
+	release, err = f.keeper.Release.Get(f.ctx, 3)
+	require.NoError(t, err)
+	release.Publisher = publisher
+	require.NoError(t, f.keeper.Release.Set(f.ctx, 3, release))
+	_, err = f.msgSrv.YankRelease(f.ctx, &types.MsgYankRelease{Creator: publisher, Id: 3, Reason: "withdrawn"})
+	require.NoError(t, err)
+	_, err = f.msgSrv.UnyankRelease(f.ctx, &types.MsgUnyankRelease{Authority: f.authority, Id: 3})
+	require.ErrorContains(t, err, "only validated releases")
+	release, err = f.keeper.Release.Get(f.ctx, 3)
+	require.NoError(t, err)
+	require.True(t, release.Yanked)
+	require.Equal(t, types.Release_PENDING, release.Status)
+}
@@ -101,17 +101,124 @@ func (q queryServer) ByVersion(ctx context.Context, req *types.QueryByVersionReq
 	if req == nil || req.Version == "" {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
-	id, err := q.k.ByVersion.Get(ctx, req.Version)
+	r, err := q.k.releaseByVersion(ctx, req.Version, req.Channel)
+	if err != nil {
+		return nil, versionLookupError(err, req.Version)
+	}
+	return &types.QueryReleaseResponse{Release: &r}, nil
+}
+
+func (q queryServer) PublisherKeys(ctx context.Context, req *types.QueryPublisherKeysRequest) (*types.QueryPublisherKeysResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	keys := []*types.PublisherKey{}
+	err := q.k.PublisherKeys.Walk(ctx, nil, func(_ string, key types.PublisherKey) (bool, error) {
+		if req.Publisher == "" || key.Publisher == req.Publisher {
+			kk := key
+			keys = append(keys, &kk)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	return &types.QueryPublisherKeysResponse{Keys: keys}, nil
+}
+
+func (q queryServer) Attestations(ctx context.Context, req *types.QueryAttestationsRequest) (*types.QueryAttestationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	attestations := []*types.Attestation{}
+	rng := collections.NewPrefixedPairRange[uint64, string](req.Id)
+	err := q.k.Attestations.Walk(ctx, rng, func(_ collections.Pair[uint64, string], att types.Attestation) (bool, error) {
+		aa := att
+		attestations = append(attestations, &aa)
+		return false, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	return &types.QueryAttestationsResponse{Attestations: attestations}, nil
+}
+
+func (q queryServer) UpgradePath(ctx context.Context, req *types.QueryUpgradePathRequest) (*types.QueryUpgradePathResponse, error) {
+	if req == nil || req.Version == "" || req.Platform == "" || req.Kind == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	current, err := q.k.releaseByVersion(ctx, req.Version, req.Channel)
+	if err != nil {
+		return nil, versionLookupError(err, req.Version)
+	}
+	path, err := q.k.upgradePath(ctx, current, req.Platform, req.Kind)
+	if err != nil {
+		if errors.Is(err, types.ErrInvalidRequest) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	releases := make([]*types.Release, len(path))
+	for i := range path {
+		releases[i] = &path[i]
+	}
+	return &types.QueryUpgradePathResponse{Releases: releases}, nil
+}
+
+func (q queryServer) LatestByVersion(ctx context.Context, req *types.QueryLatestRequest) (*types.QueryReleaseResponse, error) {
+	if req == nil || req.Channel == "" || req.Platform == "" || req.Kind == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	r, err := q.k.latestByVersion(ctx, req.Channel, req.Platform, req.Kind)
 	if err != nil {
 		if errors.Is(err, collections.ErrNotFound) {
-			return nil, status.Error(codes.NotFound, fmt.Sprintf("version %s not found", req.Version))
+			return nil, status.Error(codes.NotFound, "not found")
 		}
 		return nil, status.Error(codes.Internal, "internal error")
 	}
-	r, err := q.k.Release.Get(ctx, id)
+	return &types.QueryReleaseResponse{Release: &r}, nil
+}
+
+func (q queryServer) VersionRange(ctx context.Context, req *types.QueryVersionRangeRequest) (*types.QueryVersionRangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	rng, err := types.ParseVersionRange(req.Range)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	page, limit := req.Page, req.Limit
+	if limit == 0 {
+		limit = 50
+	}
+	limit = min(limit, types.MaxVersionRangeLimit)
+	if page == 0 {
+		page = 1
+	}
+	matched, err := q.k.releasesInRange(ctx, rng, req.Channel, req.Platform, req.Kind)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "internal error")
 	}
-	rr := r
-	return &types.QueryReleaseResponse{Release: &rr}, nil
+	total := uint64(len(matched))
+	start := total
+	if page-1 <= total/limit {
+		start = min((page-1)*limit, total)
+	}
+	end := min(start+limit, total)
+	releases := make([]*types.Release, 0, end-start)
+	for i := start; i < end; i++ {
+		releases = append(releases, &matched[i])
+	}
+	return &types.QueryVersionRangeResponse{Releases: releases, Total: total}, nil
+}
+
+// versionLookupError maps a releaseByVersion failure to a gRPC status.
+func versionLookupError(err error, version string) error {
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		return status.Error(codes.NotFound, fmt.Sprintf("version %s not found", version))
+	case errors.Is(err, types.ErrInvalidRequest):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return status.Error(codes.Internal, "internal error")
 }
@@ -0,0 +1,167 @@
+package keeper
+
+import (
+	"context"
+	"crypto/ed25519"
+	"errors"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"lumen/crypto/pqc/dilithium"
+	"lumen/x/release/types"
+)
+
+// checkPubKey rejects public keys that do not fit their algorithm.
+func (k Keeper) checkPubKey(algo string, pub []byte) error {
+	switch algo {
+	case types.SigAlgoEd25519:
+		if len(pub) != ed25519.PublicKeySize {
+			return fmt.Errorf("ed25519 pub_key must be %d bytes", ed25519.PublicKeySize)
+		}
+	case types.SigAlgoDilithium3:
+		if !strings.EqualFold(k.scheme.Name(), algo) {
+			return fmt.Errorf("active dilithium backend is %s", k.scheme.Name())
+		}
+		if len(pub) != k.scheme.PublicKeySize() {
+			return fmt.Errorf("dilithium3 pub_key must be %d bytes", k.scheme.PublicKeySize())
+		}
+	default:
+		return fmt.Errorf("unsupported algo %q", algo)
+	}
+	return nil
+}
+
+func (k Keeper) verifySignature(key types.PublisherKey, digest, sig []byte) bool {
+	switch key.Algo {
+	case types.SigAlgoEd25519:
+		return len(key.PubKey) == ed25519.PublicKeySize && ed25519.Verify(key.PubKey, digest, sig)
+	case types.SigAlgoDilithium3:
+		return k.scheme.Verify(dilithium.PublicKey(key.PubKey), digest, dilithium.Signature(sig))
+	default:
+		return false
+	}
+}
+
+// verifyArtifactSignatures checks every artifact signature of r against the
+// registered keys of allowed publishers over types.ArtifactDigest. Unknown
+// keys and invalid signatures reject the release. On the stable channel each
+// artifact needs valid signatures from stable_sig_threshold distinct
+// publishers; several keys of one publisher count once.
+func (k Keeper) verifyArtifactSignatures(ctx context.Context, p types.Params, r *types.Release) error {
+	for i, a := range r.Artifacts {
+		digest := types.ArtifactDigest(r.Version, a)
+		seen := make(map[string]struct{}, len(a.Signatures))
+		signers := make(map[string]struct{}, len(a.Signatures))
+		for j, sig := range a.Signatures {
+			if _, dup := seen[sig.KeyId]; dup {
+				return errorsmod.Wrapf(types.ErrInvalidSignature, "artifact[%d]: duplicate key_id %s", i, sig.KeyId)
+			}
+			seen[sig.KeyId] = struct{}{}
+			key, err := k.PublisherKeys.Get(ctx, sig.KeyId)
+			if err != nil {
+				if errors.Is(err, collections.ErrNotFound) {
+					return errorsmod.Wrapf(types.ErrInvalidSignature, "artifact[%d].signatures[%d]: unknown key_id %s", i, j, sig.KeyId)
+				}
+				return err
+			}
+			if !containsString(p.AllowedPublishers, key.Publisher) {
+				return errorsmod.Wrapf(types.ErrInvalidSignature, "artifact[%d].signatures[%d]: key %s belongs to a publisher no longer allowed", i, j, sig.KeyId)
+			}
+			if sig.Algo != key.Algo {
+				return errorsmod.Wrapf(types.ErrInvalidSignature, "artifact[%d].signatures[%d]: algo %s does not match key %s", i, j, sig.Algo, sig.KeyId)
+			}
+			if !k.verifySignature(key, digest, sig.Sig) {
+				return errorsmod.Wrapf(types.ErrInvalidSignature, "artifact[%d].signatures[%d]: verification failed for key %s", i, j, sig.KeyId)
+			}
+			signers[key.Publisher] = struct{}{}
+		}
+		if r.Channel == "stable" && uint32(len(signers)) < p.StableSigThreshold {
+			return errorsmod.Wrapf(types.ErrInvalidSignature, "artifact[%d]: signed by %d of %d required publishers", i, len(signers), p.StableSigThreshold)
+		}
+	}
+	return nil
+}
+
+func (m msgServer) RegisterPublisherKey(ctx context.Context, msg *types.MsgRegisterPublisherKey) (*types.MsgRegisterPublisherKeyResponse, error) {
+	params := m.GetParams(ctx)
+	if !containsString(params.AllowedPublishers, msg.Creator) {
+		return nil, errorsmod.Wrap(types.ErrUnauthorizedPublisher, "creator not allowed")
+	}
+	keyID := strings.TrimSpace(msg.KeyId)
+	if keyID == "" || len(keyID) > types.PublisherKeyIDMaxLen {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "invalid key_id")
+	}
+	if err := m.checkPubKey(msg.Algo, msg.PubKey); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, err.Error())
+	}
+	has, err := m.PublisherKeys.Has(ctx, keyID)
+	if err != nil {
+		return nil, err
+	}
+	if has {
+		return nil, errorsmod.Wrapf(types.ErrInvalidRequest, "key_id %s already registered", keyID)
+	}
+	count := 0
+	err = m.PublisherKeys.Walk(ctx, nil, func(_ string, key types.PublisherKey) (bool, error) {
+		if key.Publisher == msg.Creator {
+			count++
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if count >= types.MaxKeysPerPublisher {
+		return nil, errorsmod.Wrapf(types.ErrInvalidRequest, "publisher already has %d keys", types.MaxKeysPerPublisher)
+	}
+
+	key := types.PublisherKey{
+		KeyId:     keyID,
+		Publisher: msg.Creator,
+		Algo:      msg.Algo,
+		PubKey:    msg.PubKey,
+		AddedAt:   m.nowUnix(ctx),
+	}
+	if err := m.PublisherKeys.Set(ctx, keyID, key); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		"release_publisher_key_add",
+		sdk.NewAttribute("key_id", keyID),
+		sdk.NewAttribute("publisher", msg.Creator),
+		sdk.NewAttribute("algo", msg.Algo),
+	))
+	return &types.MsgRegisterPublisherKeyResponse{}, nil
+}
+
+func (m msgServer) RemovePublisherKey(ctx context.Context, msg *types.MsgRemovePublisherKey) (*types.MsgRemovePublisherKeyResponse, error) {
+	keyID := strings.TrimSpace(msg.KeyId)
+	key, err := m.PublisherKeys.Get(ctx, keyID)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "key_id %s not found", keyID)
+		}
+		return nil, err
+	}
+	if key.Publisher != msg.Creator {
+		return nil, errorsmod.Wrap(types.ErrNotAuthorized, "key belongs to another publisher")
+	}
+	if err := m.PublisherKeys.Remove(ctx, keyID); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		"release_publisher_key_remove",
+		sdk.NewAttribute("key_id", keyID),
+		sdk.NewAttribute("publisher", msg.Creator),
+	))
+	return &types.MsgRemovePublisherKeyResponse{}, nil
+}
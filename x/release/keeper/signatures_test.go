@@ -0,0 +1,130 @@
+package keeper_test
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"lumen/crypto/pqc/dilithium"
+	"lumen/x/release/keeper"
+	"lumen/x/release/types"
+)
+
+func signedReleaseFixture(t *testing.T, threshold uint32) (*releaseFixture, string) {
+	t.Helper()
+	f := newReleaseFixture(t)
+	publisher, err := f.addrCodec.BytesToString(sdk.AccAddress(bytes.Repeat([]byte{0x33}, 20)))
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.AllowedPublishers = []string{publisher}
+	params.StableSigThreshold = threshold
+	require.NoError(t, params.Validate())
+	require.NoError(t, f.keeper.SetParams(f.ctx, params))
+	return f, publisher
+}
+
+func stableRelease(version string) types.Release {
+	return types.Release{
+		Version: version,
+		Channel: "stable",
+		Artifacts: []*types.Artifact{
+			{Platform: "linux-amd64", Kind: "daemon", Sha256Hex: strings.Repeat("ab", 32)},
+		},
+	}
+}
+
+func TestPublishVerifiesEd25519AndDilithiumSignatures(t *testing.T) {
+	f, publisher := signedReleaseFixture(t, 0)
+	cosigner, err := f.addrCodec.BytesToString(sdk.AccAddress(bytes.Repeat([]byte{0x44}, 20)))
+	require.NoError(t, err)
+	params := f.keeper.GetParams(f.ctx)
+	params.AllowedPublishers = append(params.AllowedPublishers, cosigner)
+	params.StableSigThreshold = 2
+	require.NoError(t, params.Validate())
+	require.NoError(t, f.keeper.SetParams(f.ctx, params))
+
+	edPub, edPriv, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	scheme := dilithium.Default()
+	pqPub, pqPriv, err := scheme.GenerateKey(nil)
+	require.NoError(t, err)
+
+	_, err = f.msgSrv.RegisterPublisherKey(f.ctx, &types.MsgRegisterPublisherKey{Creator: publisher, KeyId: "ed", Algo: types.SigAlgoEd25519, PubKey: edPub[:5]})
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+	_, err = f.msgSrv.RegisterPublisherKey(f.ctx, &types.MsgRegisterPublisherKey{Creator: f.authority, KeyId: "ed", Algo: types.SigAlgoEd25519, PubKey: edPub})
+	require.ErrorIs(t, err, types.ErrUnauthorizedPublisher)
+	_, err = f.msgSrv.RegisterPublisherKey(f.ctx, &types.MsgRegisterPublisherKey{Creator: publisher, KeyId: "ed", Algo: types.SigAlgoEd25519, PubKey: edPub})
+	require.NoError(t, err)
+	_, err = f.msgSrv.RegisterPublisherKey(f.ctx, &types.MsgRegisterPublisherKey{Creator: publisher, KeyId: "ed", Algo: types.SigAlgoEd25519, PubKey: edPub})
+	require.ErrorContains(t, err, "already registered")
+	_, err = f.msgSrv.RegisterPublisherKey(f.ctx, &types.MsgRegisterPublisherKey{Creator: publisher, KeyId: "pq", Algo: types.SigAlgoDilithium3, PubKey: pqPub})
+	require.NoError(t, err)
+
+	keys, err := keeper.NewQueryServerImpl(f.keeper).PublisherKeys(f.ctx, &types.QueryPublisherKeysRequest{Publisher: publisher})
+	require.NoError(t, err)
+	require.Len(t, keys.Keys, 2)
+
+	release := stableRelease("1.0.0")
+	digest := types.ArtifactDigest(release.Version, release.Artifacts[0])
+	pqSig, err := scheme.Sign(pqPriv, digest)
+	require.NoError(t, err)
+	release.Artifacts[0].Signatures = []*types.Signature{
+		{KeyId: "ed", Algo: types.SigAlgoEd25519, Sig: ed25519.Sign(edPriv, digest)},
+		{KeyId: "pq", Algo: types.SigAlgoDilithium3, Sig: pqSig},
+	}
+
+	// Two valid signatures from one publisher's keys fall short of the
+	// 2-of-N stable threshold: it counts publishers, not keys.
+	_, err = f.msgSrv.PublishRelease(f.ctx, &types.MsgPublishRelease{Creator: publisher, Release: release})
+	require.ErrorContains(t, err, "signed by 1 of 2 required publishers")
+
+	coPub, coPriv, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	_, err = f.msgSrv.RegisterPublisherKey(f.ctx, &types.MsgRegisterPublisherKey{Creator: cosigner, KeyId: "co", Algo: types.SigAlgoEd25519, PubKey: coPub})
+	require.NoError(t, err)
+	release.Artifacts[0].Signatures = append(release.Artifacts[0].Signatures, &types.Signature{KeyId: "co", Algo: types.SigAlgoEd25519, Sig: ed25519.Sign(coPriv, digest)})
+	_, err = f.msgSrv.PublishRelease(f.ctx, &types.MsgPublishRelease{Creator: publisher, Release: release})
+	require.NoError(t, err)
+
+	// The digest binds the version: the same signatures do not verify for
+	// another release.
+	other := release
+	other.Version = "1.0.1"
+	_, err = f.msgSrv.PublishRelease(f.ctx, &types.MsgPublishRelease{Creator: publisher, Release: other})
+	require.ErrorIs(t, err, types.ErrInvalidSignature)
+}
+
+func TestPublishRejectsUnknownOrRemovedKeys(t *testing.T) {
+	f, publisher := signedReleaseFixture(t, 0)
+
+	pub, priv, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	release := types.Release{
+		Version:   "2.0.0",
+		Channel:   "beta",
+		Artifacts: []*types.Artifact{{Platform: "linux-amd64", Kind: "cli", Sha256Hex: strings.Repeat("cd", 32)}},
+	}
+	release.Artifacts[0].Signatures = []*types.Signature{{KeyId: "k1", Algo: types.SigAlgoEd25519, Sig: ed25519.Sign(priv, types.ArtifactDigest(release.Version, release.Artifacts[0]))}}
+
+	_, err = f.msgSrv.PublishRelease(f.ctx, &types.MsgPublishRelease{Creator: publisher, Release: release})
+	require.ErrorContains(t, err, "unknown key_id")
+
+	_, err = f.msgSrv.RegisterPublisherKey(f.ctx, &types.MsgRegisterPublisherKey{Creator: publisher, KeyId: "k1", Algo: types.SigAlgoEd25519, PubKey: pub})
+	require.NoError(t, err)
+	_, err = f.msgSrv.RemovePublisherKey(f.ctx, &types.MsgRemovePublisherKey{Creator: f.authority, KeyId: "k1"})
+	require.ErrorIs(t, err, types.ErrNotAuthorized)
+	_, err = f.msgSrv.RemovePublisherKey(f.ctx, &types.MsgRemovePublisherKey{Creator: publisher, KeyId: "k1"})
+	require.NoError(t, err)
+	_, err = f.msgSrv.PublishRelease(f.ctx, &types.MsgPublishRelease{Creator: publisher, Release: release})
+	require.ErrorContains(t, err, "unknown key_id")
+
+	// Unsigned releases remain valid off the stable channel.
+	release.Artifacts[0].Signatures = nil
+	_, err = f.msgSrv.PublishRelease(f.ctx, &types.MsgPublishRelease{Creator: publisher, Release: release})
+	require.NoError(t, err)
+}
@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	"lumen/x/release/types"
+)
+
+// checkSupersedes enforces that every release r supersedes exists and is on
+// r's channel.
+func (k Keeper) checkSupersedes(ctx context.Context, r types.Release) error {
+	for _, id := range r.Supersedes {
+		old, err := k.Release.Get(ctx, id)
+		if err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				return errorsmod.Wrapf(types.ErrInvalidRequest, "superseded release %d not found", id)
+			}
+			return err
+		}
+		if old.Channel != r.Channel {
+			return errorsmod.Wrapf(types.ErrInvalidRequest, "superseded release %d is on channel %s", id, old.Channel)
+		}
+	}
+	return nil
+}
+
+// upgradePath returns the releases to install, in order, to get from current
+// to the latest release of its channel for platform/kind. Only validated,
+// unyanked releases of higher semver precedence are stepped through. Each hop
+// goes to the highest version that neither skips a required release nor
+// excludes the current one through its supersedes list.
+func (k Keeper) upgradePath(ctx context.Context, current types.Release, platform, kind string) ([]types.Release, error) {
+	from, err := types.ParseSemver(current.Version)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidRequest, "release %d: %s", current.Id, err)
+	}
+	key := tripleKey(current.Channel, platform, kind)
+	candidates, err := k.collectVersioned(ctx, func(r types.Release, v types.Semver) bool {
+		return !r.Yanked && r.Status == types.Release_VALIDATED && r.Channel == current.Channel &&
+			hasTriple(r, key) && v.Compare(from) > 0
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var path []types.Release
+	fromID, start := current.Id, 0
+	for start < len(candidates) {
+		next := -1
+		for j := len(candidates) - 1; j >= start; j-- {
+			c := candidates[j].release
+			if len(c.Supersedes) > 0 && !slices.Contains(c.Supersedes, fromID) {
+				continue
+			}
+			skipsRequired := false
+			for _, between := range candidates[start:j] {
+				if between.release.Required {
+					skipsRequired = true
+					break
+				}
+			}
+			if !skipsRequired {
+				next = j
+				break
+			}
+		}
+		if next < 0 {
+			return nil, errorsmod.Wrapf(types.ErrInvalidRequest, "no upgrade path from release %d", fromID)
+		}
+		path = append(path, candidates[next].release)
+		fromID, start = candidates[next].release.Id, next+1
+	}
+	return path, nil
+}
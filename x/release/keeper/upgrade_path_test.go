@@ -0,0 +1,78 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"lumen/x/release/keeper"
+	"lumen/x/release/types"
+)
+
+func TestPublishEnforcesSupersedes(t *testing.T) {
+	f, publisher := signedReleaseFixture(t, 0)
+	f.storeRelease(t, 1) // beta
+
+	release := stableRelease("2.0.0")
+	release.Supersedes = []uint64{7}
+	_, err := f.msgSrv.PublishRelease(f.ctx, &types.MsgPublishRelease{Creator: publisher, Release: release})
+	require.ErrorContains(t, err, "not found")
+
+	release.Supersedes = []uint64{1}
+	_, err = f.msgSrv.PublishRelease(f.ctx, &types.MsgPublishRelease{Creator: publisher, Release: release})
+	require.ErrorContains(t, err, "is on channel beta")
+
+	release.Supersedes = []uint64{1, 1}
+	require.ErrorContains(t, (&types.MsgPublishRelease{Creator: publisher, Release: release}).ValidateBasic(), "duplicate")
+
+	first, err := f.msgSrv.PublishRelease(f.ctx, &types.MsgPublishRelease{Creator: publisher, Release: stableRelease("1.0.0")})
+	require.NoError(t, err)
+	release.Supersedes = []uint64{first.Id}
+	_, err = f.msgSrv.PublishRelease(f.ctx, &types.MsgPublishRelease{Creator: publisher, Release: release})
+	require.NoError(t, err)
+}
+
+func TestUpgradePathHonoursRequiredAndSupersedes(t *testing.T) {
+	f := newReleaseFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	for id := uint64(1); id <= 5; id++ {
+		f.storeRelease(t, id)
+		release, err := f.keeper.Release.Get(f.ctx, id)
+		require.NoError(t, err)
+		release.Status = types.Release_VALIDATED
+		switch id {
+		case 2:
+			release.Required = true
+		case 4:
+			release.Supersedes = []uint64{3}
+		}
+		require.NoError(t, f.keeper.Release.Set(f.ctx, id, release))
+		require.NoError(t, f.keeper.ByVersion.Set(f.ctx, collections.Join(release.Version, release.Channel), id))
+	}
+	path := func(version string) []uint64 {
+		resp, err := qs.UpgradePath(f.ctx, &types.QueryUpgradePathRequest{Version: version, Platform: "LINUX", Kind: "daemon"})
+		require.NoError(t, err)
+		ids := make([]uint64, len(resp.Releases))
+		for i, r := range resp.Releases {
+			ids[i] = r.Id
+		}
+		return ids
+	}
+
+	// 1.0.2 is required, so 1.0.1 must step through it before jumping ahead.
+	require.Equal(t, []uint64{2, 5}, path("1.0.1"))
+	require.Empty(t, path("1.0.5"))
+
+	// Once 1.0.5 is yanked, 1.0.4 only accepts upgrades from 1.0.3.
+	release, err := f.keeper.Release.Get(f.ctx, 5)
+	require.NoError(t, err)
+	release.Yanked = true
+	require.NoError(t, f.keeper.Release.Set(f.ctx, 5, release))
+	require.Equal(t, []uint64{2, 3, 4}, path("1.0.1"))
+
+	_, err = qs.UpgradePath(f.ctx, &types.QueryUpgradePathRequest{Version: "9.9.9", Platform: "linux", Kind: "daemon"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
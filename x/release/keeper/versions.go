@@ -0,0 +1,131 @@
+package keeper
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	"lumen/x/release/types"
+)
+
+// releaseByVersion resolves a version on a channel. With an empty channel the
+// version must exist on exactly one channel.
+func (k Keeper) releaseByVersion(ctx context.Context, version, channel string) (types.Release, error) {
+	if channel != "" {
+		id, err := k.ByVersion.Get(ctx, collections.Join(version, channel))
+		if err != nil {
+			return types.Release{}, err
+		}
+		return k.Release.Get(ctx, id)
+	}
+	var ids []uint64
+	rng := collections.NewPrefixedPairRange[string, string](version)
+	err := k.ByVersion.Walk(ctx, rng, func(_ collections.Pair[string, string], id uint64) (bool, error) {
+		ids = append(ids, id)
+		return len(ids) > 1, nil
+	})
+	if err != nil {
+		return types.Release{}, err
+	}
+	switch len(ids) {
+	case 0:
+		return types.Release{}, collections.ErrNotFound
+	case 1:
+		return k.Release.Get(ctx, ids[0])
+	}
+	return types.Release{}, errorsmod.Wrapf(types.ErrInvalidRequest, "version %s exists on several channels; set channel", version)
+}
+
+// hasTriple reports whether r ships an artifact for the channel/platform/kind
+// key built by tripleKey.
+func hasTriple(r types.Release, key string) bool {
+	for _, a := range r.Artifacts {
+		if a != nil && tripleKey(r.Channel, a.Platform, a.Kind) == key {
+			return true
+		}
+	}
+	return false
+}
+
+// versionedRelease pairs a release with its parsed version.
+type versionedRelease struct {
+	release types.Release
+	version types.Semver
+}
+
+// collectVersioned returns the releases keep accepts in ascending semver
+// order, ties broken by id. Releases whose version does not parse (only
+// possible from genesis) are left out.
+func (k Keeper) collectVersioned(ctx context.Context, keep func(types.Release, types.Semver) bool) ([]versionedRelease, error) {
+	var out []versionedRelease
+	err := k.Release.Walk(ctx, nil, func(_ uint64, r types.Release) (bool, error) {
+		v, err := types.ParseSemver(r.Version)
+		if err != nil || !keep(r, v) {
+			return false, nil
+		}
+		out = append(out, versionedRelease{release: r, version: v})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	slices.SortStableFunc(out, func(a, b versionedRelease) int {
+		return a.version.Compare(b.version)
+	})
+	return out, nil
+}
+
+// latestByVersion returns the validated, unyanked release with the highest
+// semver precedence for a channel/platform/kind.
+func (k Keeper) latestByVersion(ctx context.Context, channel, platform, kind string) (types.Release, error) {
+	key := tripleKey(channel, platform, kind)
+	releases, err := k.collectVersioned(ctx, func(r types.Release, _ types.Semver) bool {
+		return !r.Yanked && r.Status == types.Release_VALIDATED && hasTriple(r, key)
+	})
+	if err != nil {
+		return types.Release{}, err
+	}
+	if len(releases) == 0 {
+		return types.Release{}, collections.ErrNotFound
+	}
+	return releases[len(releases)-1].release, nil
+}
+
+// releasesInRange returns the releases whose version lies in rng, optionally
+// narrowed to a channel and platform/kind, in ascending semver order.
+func (k Keeper) releasesInRange(ctx context.Context, rng types.VersionRange, channel, platform, kind string) ([]types.Release, error) {
+	releases, err := k.collectVersioned(ctx, func(r types.Release, v types.Semver) bool {
+		if channel != "" && r.Channel != channel {
+			return false
+		}
+		if (platform != "" || kind != "") && !hasArtifact(r, platform, kind) {
+			return false
+		}
+		return rng.Contains(v)
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := make([]types.Release, len(releases))
+	for i, vr := range releases {
+		out[i] = vr.release
+	}
+	return out, nil
+}
+
+// hasArtifact reports whether r ships an artifact matching platform and kind;
+// an empty value matches any.
+func hasArtifact(r types.Release, platform, kind string) bool {
+	for _, a := range r.Artifacts {
+		if a == nil {
+			continue
+		}
+		if (platform == "" || strings.EqualFold(a.Platform, platform)) && (kind == "" || strings.EqualFold(a.Kind, kind)) {
+			return true
+		}
+	}
+	return false
+}
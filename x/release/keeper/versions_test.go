@@ -0,0 +1,107 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"lumen/x/release/keeper"
+	"lumen/x/release/types"
+)
+
+func TestVersionsAreChannelAware(t *testing.T) {
+	f, publisher := signedReleaseFixture(t, 0)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	stable, err := f.msgSrv.PublishRelease(f.ctx, &types.MsgPublishRelease{Creator: publisher, Release: stableRelease("1.4.0")})
+	require.NoError(t, err)
+	release := stableRelease("1.4.0")
+	release.Channel = "beta"
+	beta, err := f.msgSrv.PublishRelease(f.ctx, &types.MsgPublishRelease{Creator: publisher, Release: release})
+	require.NoError(t, err)
+	_, err = f.msgSrv.PublishRelease(f.ctx, &types.MsgPublishRelease{Creator: publisher, Release: release})
+	require.ErrorContains(t, err, "already published on channel beta")
+
+	resp, err := qs.ByVersion(f.ctx, &types.QueryByVersionRequest{Version: "1.4.0", Channel: "stable"})
+	require.NoError(t, err)
+	require.Equal(t, stable.Id, resp.Release.Id)
+	resp, err = qs.ByVersion(f.ctx, &types.QueryByVersionRequest{Version: "1.4.0", Channel: "beta"})
+	require.NoError(t, err)
+	require.Equal(t, beta.Id, resp.Release.Id)
+	_, err = qs.ByVersion(f.ctx, &types.QueryByVersionRequest{Version: "1.4.0"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	only, err := f.msgSrv.PublishRelease(f.ctx, &types.MsgPublishRelease{Creator: publisher, Release: stableRelease("1.5.0")})
+	require.NoError(t, err)
+	resp, err = qs.ByVersion(f.ctx, &types.QueryByVersionRequest{Version: "1.5.0"})
+	require.NoError(t, err)
+	require.Equal(t, only.Id, resp.Release.Id)
+}
+
+func TestLatestByVersionAndRange(t *testing.T) {
+	f := newReleaseFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	// Ids follow publication order, versions do not: a 1.x hotfix ships after 2.0.0.
+	for id, version := range map[uint64]string{1: "1.4.0", 2: "2.0.0-rc.1", 3: "2.0.0", 4: "1.4.1", 5: "2.1.0"} {
+		f.storeRelease(t, id)
+		release, err := f.keeper.Release.Get(f.ctx, id)
+		require.NoError(t, err)
+		release.Version = version
+		if id != 5 {
+			release.Status = types.Release_VALIDATED
+		}
+		require.NoError(t, f.keeper.Release.Set(f.ctx, id, release))
+	}
+	// Latest follows the newest validated id.
+	require.NoError(t, f.keeper.ByTriple.Set(f.ctx, "beta|linux|daemon", 4))
+
+	latest, err := qs.Latest(f.ctx, &types.QueryLatestRequest{Channel: "beta", Platform: "linux", Kind: "daemon"})
+	require.NoError(t, err)
+	require.Equal(t, "1.4.1", latest.Release.Version)
+	latest, err = qs.LatestByVersion(f.ctx, &types.QueryLatestRequest{Channel: "beta", Platform: "linux", Kind: "daemon"})
+	require.NoError(t, err)
+	require.Equal(t, "2.0.0", latest.Release.Version)
+	_, err = qs.LatestByVersion(f.ctx, &types.QueryLatestRequest{Channel: "stable", Platform: "linux", Kind: "daemon"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	versions := func(req *types.QueryVersionRangeRequest) []string {
+		resp, err := qs.VersionRange(f.ctx, req)
+		require.NoError(t, err)
+		out := make([]string, len(resp.Releases))
+		for i, r := range resp.Releases {
+			out[i] = r.Version
+		}
+		return out
+	}
+	require.Equal(t, []string{"1.4.0", "1.4.1", "2.0.0-rc.1"}, versions(&types.QueryVersionRangeRequest{Range: ">=1.4.0 <2.0.0"}))
+	require.Equal(t, []string{"2.0.0", "2.1.0"}, versions(&types.QueryVersionRangeRequest{Range: ">=2.0.0", Channel: "beta", Platform: "linux"}))
+	require.Empty(t, versions(&types.QueryVersionRangeRequest{Range: ">=1.0.0", Channel: "stable"}))
+	_, err = qs.VersionRange(f.ctx, &types.QueryVersionRangeRequest{Range: "~1.4"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Results are paged in semver order; total counts every match.
+	require.Equal(t, []string{"2.0.0-rc.1", "2.0.0"}, versions(&types.QueryVersionRangeRequest{Range: ">=1.0.0", Page: 2, Limit: 2}))
+	require.Empty(t, versions(&types.QueryVersionRangeRequest{Range: ">=1.0.0", Page: 1 << 62, Limit: 2}))
+	resp, err := qs.VersionRange(f.ctx, &types.QueryVersionRangeRequest{Range: ">=1.0.0", Limit: 1_000})
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), resp.Total)
+	require.Len(t, resp.Releases, 5)
+}
+
+func TestMigrate1to2IndexesVersionsByChannel(t *testing.T) {
+	f := newReleaseFixture(t)
+	for id := uint64(1); id <= 2; id++ {
+		f.storeRelease(t, id)
+	}
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate1to2(f.ctx))
+
+	id, err := f.keeper.ByVersion.Get(f.ctx, collections.Join("1.0.2", "beta"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), id)
+	resp, err := keeper.NewQueryServerImpl(f.keeper).ByVersion(f.ctx, &types.QueryByVersionRequest{Version: "1.0.1"})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), resp.Release.Id)
+}
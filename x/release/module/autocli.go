@@ -15,7 +15,12 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 				{RpcMethod: "Release", Use: "release [id]", Short: "Get a release by id", PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}}},
 				{RpcMethod: "Releases", Use: "releases", Short: "List releases (page/limit via flags)"},
 				{RpcMethod: "Latest", Use: "latest [channel] [platform] [kind]", Short: "Get latest release for triple", PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "channel"}, {ProtoField: "platform"}, {ProtoField: "kind"}}},
-				{RpcMethod: "ByVersion", Use: "by-version [version]", Short: "Get by version", PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "version"}}},
+				{RpcMethod: "ByVersion", Use: "by-version [version]", Short: "Get by version (--channel when it exists on several channels)", PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "version"}}},
+				{RpcMethod: "LatestByVersion", Use: "latest-by-version [channel] [platform] [kind]", Short: "Get the highest semver validated release", PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "channel"}, {ProtoField: "platform"}, {ProtoField: "kind"}}},
+				{RpcMethod: "VersionRange", Use: "version-range [range]", Short: "List releases in a semver range, e.g. \">=1.4.0 <2.0.0\"", PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "range"}}},
+				{RpcMethod: "UpgradePath", Use: "upgrade-path [version] [platform] [kind]", Short: "Show the releases to install to upgrade from a version", PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "version"}, {ProtoField: "platform"}, {ProtoField: "kind"}}},
+				{RpcMethod: "Attestations", Use: "attestations [id]", Short: "List the attestations of a release", PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}}},
+				{RpcMethod: "PublisherKeys", Use: "publisher-keys", Short: "List registered publisher signing keys (--publisher filters)"},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -23,6 +28,10 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
 				{RpcMethod: "UpdateParams", Skip: true},
 				{RpcMethod: "PublishRelease", Use: "publish", Short: "Publish a new release"},
+				{RpcMethod: "RegisterPublisherKey", Use: "register-publisher-key [key_id] [algo] [pub_key]", Short: "Register an ed25519 or dilithium3 artifact signing key (pub_key base64)", PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "key_id"}, {ProtoField: "algo"}, {ProtoField: "pub_key"}}},
+				{RpcMethod: "RemovePublisherKey", Use: "remove-publisher-key [key_id]", Short: "Remove one of your artifact signing keys", PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "key_id"}}},
+				{RpcMethod: "AttestRelease", Use: "attest [id] [sha256_hex...]", Short: "Attest a pending release with your rebuilt artifact hashes, in artifact order", PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "sha256_hex", Varargs: true}}},
+				{RpcMethod: "YankRelease", Use: "yank [id] [reason]", Short: "Withdraw a release; the previous validated release becomes latest again", PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "reason"}}},
 			},
 		},
 	}
@@ -10,6 +10,11 @@ func RegisterInterfaces(registrar codectypes.InterfaceRegistry) {
 	registrar.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgPublishRelease{},
 		&MsgUpdateParams{},
+		&MsgRegisterPublisherKey{},
+		&MsgRemovePublisherKey{},
+		&MsgYankRelease{},
+		&MsgUnyankRelease{},
+		&MsgAttestRelease{},
 	)
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
 }
@@ -11,4 +11,5 @@ var (
 	ErrNotPending            = errors.Register(ModuleName, 1103, "release not in pending state")
 	ErrNotAuthorized         = errors.Register(ModuleName, 1104, "not authorized")
 	ErrDisabled              = errors.Register(ModuleName, 1105, "disabled")
+	ErrInvalidSignature      = errors.Register(ModuleName, 1106, "invalid artifact signature")
 )
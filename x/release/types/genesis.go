@@ -4,19 +4,54 @@ import "fmt"
 
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Params:      func() *Params { p := DefaultParams(); return &p }(),
-		Releases:    []*Release{},
-		BundleCount: 0,
+		Params:        func() *Params { p := DefaultParams(); return &p }(),
+		Releases:      []*Release{},
+		BundleCount:   0,
+		PublisherKeys: []*PublisherKey{},
+		Attestations:  []*Attestation{},
 	}
 }
 
 func (gs GenesisState) Validate() error {
 	idMap := make(map[uint64]bool)
+	versions := make(map[string]bool)
 	for _, r := range gs.Releases {
 		if _, ok := idMap[r.Id]; ok {
 			return fmt.Errorf("duplicated id for release")
 		}
 		idMap[r.Id] = true
+		key := r.Version + "@" + r.Channel
+		if r.Version != "" && versions[key] {
+			return fmt.Errorf("duplicated version %s on channel %s", r.Version, r.Channel)
+		}
+		versions[key] = true
+	}
+	keyIDs := make(map[string]bool)
+	for _, key := range gs.PublisherKeys {
+		if key == nil {
+			return fmt.Errorf("nil publisher key")
+		}
+		if keyIDs[key.KeyId] {
+			return fmt.Errorf("duplicated publisher key id %s", key.KeyId)
+		}
+		keyIDs[key.KeyId] = true
+		if !IsSupportedSigAlgo(key.Algo) {
+			return fmt.Errorf("publisher key %s: unsupported algo %q", key.KeyId, key.Algo)
+		}
+	}
+	attested := make(map[string]bool)
+	for _, att := range gs.Attestations {
+		if att == nil {
+			return fmt.Errorf("nil attestation")
+		}
+		if !idMap[att.ReleaseId] {
+			return fmt.Errorf("attestation references unknown release %d", att.ReleaseId)
+		}
+		key := fmt.Sprintf("%d/%s", att.ReleaseId, att.Attester)
+		if attested[key] {
+			return fmt.Errorf("duplicated attestation %s", key)
+		}
+		attested[key] = true
 	}
 	if gs.Params != nil {
 		return gs.Params.Validate()
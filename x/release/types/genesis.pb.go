@@ -23,9 +23,11 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type GenesisState struct {
-	Params      *Params    `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
-	Releases    []*Release `protobuf:"bytes,2,rep,name=releases,proto3" json:"releases,omitempty"`
-	BundleCount uint64     `protobuf:"varint,3,opt,name=bundle_count,json=bundleCount,proto3" json:"bundle_count,omitempty"`
+	Params        *Params         `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	Releases      []*Release      `protobuf:"bytes,2,rep,name=releases,proto3" json:"releases,omitempty"`
+	BundleCount   uint64          `protobuf:"varint,3,opt,name=bundle_count,json=bundleCount,proto3" json:"bundle_count,omitempty"`
+	PublisherKeys []*PublisherKey `protobuf:"bytes,4,rep,name=publisher_keys,json=publisherKeys,proto3" json:"publisher_keys,omitempty"`
+	Attestations  []*Attestation  `protobuf:"bytes,5,rep,name=attestations,proto3" json:"attestations,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -82,6 +84,20 @@ func (m *GenesisState) GetBundleCount() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetPublisherKeys() []*PublisherKey {
+	if m != nil {
+		return m.PublisherKeys
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAttestations() []*Attestation {
+	if m != nil {
+		return m.Attestations
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "lumen.release.v1.GenesisState")
 }
@@ -89,21 +105,25 @@ func init() {
 func init() { proto.RegisterFile("lumen/release/v1/genesis.proto", fileDescriptor_75bed67398c55a1e) }
 
 var fileDescriptor_75bed67398c55a1e = []byte{
-	// 218 bytes of a gzipped FileDescriptorProto
+	// 282 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcb, 0x29, 0xcd, 0x4d,
 	0xcd, 0xd3, 0x2f, 0x4a, 0xcd, 0x49, 0x4d, 0x2c, 0x4e, 0xd5, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd,
 	0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x00, 0xcb, 0xeb, 0x41,
 	0xe5, 0xf5, 0xca, 0x0c, 0xa5, 0x64, 0x30, 0x74, 0x94, 0x54, 0x16, 0xa4, 0x42, 0xd5, 0x4b, 0xc9,
-	0x62, 0xc8, 0x16, 0x24, 0x16, 0x25, 0xe6, 0x42, 0xa5, 0x95, 0x66, 0x31, 0x72, 0xf1, 0xb8, 0x43,
+	0x62, 0xc8, 0x16, 0x24, 0x16, 0x25, 0xe6, 0x42, 0xa5, 0x95, 0x96, 0x30, 0x71, 0xf1, 0xb8, 0x43,
 	0x2c, 0x08, 0x2e, 0x49, 0x2c, 0x49, 0x15, 0x32, 0xe0, 0x62, 0x83, 0x28, 0x90, 0x60, 0x54, 0x60,
 	0xd4, 0xe0, 0x36, 0x92, 0xd0, 0x43, 0xb7, 0x50, 0x2f, 0x00, 0x2c, 0x1f, 0x04, 0x55, 0x27, 0x64,
 	0xca, 0xc5, 0x01, 0x95, 0x2c, 0x96, 0x60, 0x52, 0x60, 0xd6, 0xe0, 0x36, 0x92, 0xc4, 0xd4, 0x13,
 	0x04, 0x61, 0x06, 0xc1, 0x95, 0x0a, 0x29, 0x72, 0xf1, 0x24, 0x95, 0xe6, 0xa5, 0xe4, 0xa4, 0xc6,
 	0x27, 0xe7, 0x97, 0xe6, 0x95, 0x48, 0x30, 0x2b, 0x30, 0x6a, 0xb0, 0x04, 0x71, 0x43, 0xc4, 0x9c,
-	0x41, 0x42, 0x4e, 0xfa, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c,
-	0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x25, 0x0a,
-	0xf1, 0x55, 0x05, 0xdc, 0x5f, 0x60, 0x2f, 0x27, 0xb1, 0x81, 0x3d, 0x65, 0x0c, 0x08, 0x00, 0x00,
-	0xff, 0xff, 0xc0, 0x07, 0x80, 0x22, 0x45, 0x01, 0x00, 0x00,
+	0x41, 0x42, 0x42, 0xae, 0x5c, 0x7c, 0x05, 0xa5, 0x49, 0x39, 0x99, 0xc5, 0x19, 0xa9, 0x45, 0xf1,
+	0xd9, 0xa9, 0x95, 0xc5, 0x12, 0x2c, 0x60, 0xf3, 0xe5, 0xb0, 0xb8, 0x09, 0xa6, 0xce, 0x3b, 0xb5,
+	0x32, 0x88, 0xb7, 0x00, 0x89, 0x57, 0x2c, 0xe4, 0xc8, 0xc5, 0x93, 0x58, 0x52, 0x92, 0x5a, 0x5c,
+	0x92, 0x58, 0x92, 0x99, 0x9f, 0x57, 0x2c, 0xc1, 0x0a, 0x36, 0x44, 0x16, 0xd3, 0x10, 0x47, 0x84,
+	0xaa, 0x20, 0x14, 0x2d, 0x4e, 0xfa, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0,
+	0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10,
+	0x25, 0x0a, 0x09, 0xdf, 0x0a, 0x78, 0x08, 0x83, 0x03, 0x3f, 0x89, 0x0d, 0x1c, 0xbc, 0xc6, 0x80,
+	0x01, 0x00, 0x74, 0xec, 0xe6, 0x14, 0xcf, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -126,6 +146,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Attestations) > 0 {
+		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.PublisherKeys) > 0 {
+		for iNdEx := len(m.PublisherKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PublisherKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.BundleCount != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.BundleCount))
 		i--
@@ -190,6 +238,18 @@ func (m *GenesisState) Size() (n int) {
 	if m.BundleCount != 0 {
 		n += 1 + sovGenesis(uint64(m.BundleCount))
 	}
+	if len(m.PublisherKeys) > 0 {
+		for _, e := range m.PublisherKeys {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Attestations) > 0 {
+		for _, e := range m.Attestations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -317,6 +377,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublisherKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublisherKeys = append(m.PublisherKeys, &PublisherKey{})
+			if err := m.PublisherKeys[len(m.PublisherKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestations = append(m.Attestations, &Attestation{})
+			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -28,6 +28,15 @@ func TestGenesisState_Validate(t *testing.T) {
 				Releases: []*types.Release{{Id: 1}, {Id: 1}},
 			},
 			valid: false,
+		}, {
+			desc: "stable threshold above allowed publishers",
+			genState: &types.GenesisState{Params: func() *types.Params {
+				p := types.DefaultParams()
+				p.AllowedPublishers = []string{"publisher"}
+				p.StableSigThreshold = 2
+				return &p
+			}()},
+			valid: false,
 		}, {
 			desc:     "empty params still valid",
 			genState: &types.GenesisState{Releases: []*types.Release{}, BundleCount: 0},
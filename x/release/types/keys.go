@@ -15,7 +15,11 @@ var ParamsKey = collections.NewPrefix("p_release")
 var (
 	ReleaseKey    = collections.NewPrefix("release/value/")
 	ReleaseSeqKey = collections.NewPrefix("release/seq")
-	ByVersionKey  = collections.NewPrefix("release/by_version")
+	// ByVersionKey indexed version -> release id before versions became
+	// per-channel; Migrate1to2 drops it in favour of ByVersionChannelKey.
+	ByVersionKey = collections.NewPrefix("release/by_version")
+	// ByVersionChannelKey indexes releases by (version, channel) -> release id.
+	ByVersionChannelKey = collections.NewPrefix("release/version_channel")
 	// ByTripleKey indexes releases by channel|platform|kind -> release id.
 	// Example composite key: {channel:"beta", platform:"linux-amd64", kind:"daemon"} → releaseID.
 	// Used to fetch the unique tuple without scanning every stored release.
@@ -28,4 +32,9 @@ var (
 	ExpiryQueueKey     = collections.NewPrefix("release/expiry_queue")
 	StateVersionKey    = collections.NewPrefix("release/state_version")
 	ExpiryTTLKey       = collections.NewPrefix("release/expiry_ttl")
+
+	// PublisherKeyKey indexes registered signing keys by key_id.
+	PublisherKeyKey = collections.NewPrefix("release/publisher_key")
+	// AttestationKey indexes attestations by (release id, attester).
+	AttestationKey = collections.NewPrefix("release/attestation")
 )
@@ -1,11 +1,19 @@
 package types
 
 const (
-	ReleaseVersionMaxLen   = 64
-	ReleaseChannelMaxLen   = 32
-	ReleaseNotesMaxLen     = 8 * 1024
-	ReleaseURLMaxLen       = 2048
-	ReleasePlatformMaxLen  = 64
-	ReleaseKindMaxLen      = 32
-	ReleaseSignatureMaxLen = 2048
+	ReleaseVersionMaxLen       = 64
+	ReleaseChannelMaxLen       = 32
+	ReleaseNotesMaxLen         = 8 * 1024
+	ReleaseURLMaxLen           = 2048
+	ReleasePlatformMaxLen      = 64
+	ReleaseKindMaxLen          = 32
+	ReleaseSignatureMaxLen     = 4096
+	PublisherKeyIDMaxLen       = 64
+	PublisherKeyMaxLen         = 2048
+	MaxKeysPerPublisher        = 8
+	ReleaseYankReasonMaxLen    = 256
+	MaxSupersedes              = 16
+	VersionRangeMaxLen         = 256
+	MaxVersionRangeComparators = 8
+	MaxVersionRangeLimit       = 100
 )
@@ -15,6 +15,11 @@ var (
 	_ sdk.Msg = (*MsgValidateRelease)(nil)
 	_ sdk.Msg = (*MsgRejectRelease)(nil)
 	_ sdk.Msg = (*MsgUpdateParams)(nil)
+	_ sdk.Msg = (*MsgRegisterPublisherKey)(nil)
+	_ sdk.Msg = (*MsgRemovePublisherKey)(nil)
+	_ sdk.Msg = (*MsgYankRelease)(nil)
+	_ sdk.Msg = (*MsgUnyankRelease)(nil)
+	_ sdk.Msg = (*MsgAttestRelease)(nil)
 )
 
 var (
@@ -69,6 +74,67 @@ func (msg *MsgUpdateParams) ValidateBasic() error {
 	return msg.Params.Validate()
 }
 
+func (msg *MsgRegisterPublisherKey) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address (%s)", err)
+	}
+	if err := validateASCIIString("key_id", msg.KeyId, PublisherKeyIDMaxLen); err != nil {
+		return err
+	}
+	if !IsSupportedSigAlgo(msg.Algo) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("unsupported algo %q", msg.Algo)
+	}
+	if len(msg.PubKey) == 0 || len(msg.PubKey) > PublisherKeyMaxLen {
+		return sdkerrors.ErrInvalidRequest.Wrap("pub_key length out of bounds")
+	}
+	return nil
+}
+
+func (msg *MsgRemovePublisherKey) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address (%s)", err)
+	}
+	return validateASCIIString("key_id", msg.KeyId, PublisherKeyIDMaxLen)
+}
+
+func (msg *MsgYankRelease) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address (%s)", err)
+	}
+	if msg.Id == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("id required")
+	}
+	return validateASCIIString("reason", msg.Reason, ReleaseYankReasonMaxLen)
+}
+
+func (msg *MsgUnyankRelease) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address (%s)", err)
+	}
+	if msg.Id == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("id required")
+	}
+	return nil
+}
+
+func (msg *MsgAttestRelease) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Attester); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid attester address (%s)", err)
+	}
+	if msg.Id == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("id required")
+	}
+	if len(msg.Sha256Hex) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("sha256_hex required")
+	}
+	for i, h := range msg.Sha256Hex {
+		if !reSHA256.MatchString(strings.ToLower(strings.TrimSpace(h))) {
+			return sdkerrors.ErrInvalidRequest.Wrapf("sha256_hex[%d]: invalid hash", i)
+		}
+	}
+	return nil
+}
+
 func validateReleasePayload(r *Release) error {
 	if strings.TrimSpace(r.Version) == "" {
 		return sdkerrors.ErrInvalidRequest.Wrap("version required")
@@ -99,6 +165,19 @@ func validateReleasePayload(r *Release) error {
 			return err
 		}
 	}
+	if len(r.Supersedes) > MaxSupersedes {
+		return sdkerrors.ErrInvalidRequest.Wrapf("too many supersedes: %d > %d", len(r.Supersedes), MaxSupersedes)
+	}
+	seen := make(map[uint64]struct{}, len(r.Supersedes))
+	for i, id := range r.Supersedes {
+		if id == 0 {
+			return sdkerrors.ErrInvalidRequest.Wrapf("supersedes[%d]: id required", i)
+		}
+		if _, dup := seen[id]; dup {
+			return sdkerrors.ErrInvalidRequest.Wrapf("supersedes[%d]: duplicate id %d", i, id)
+		}
+		seen[id] = struct{}{}
+	}
 	return nil
 }
 
@@ -124,7 +203,7 @@ func validateArtifact(idx int, a *Artifact) error {
 		if len(sig.Sig) > ReleaseSignatureMaxLen {
 			return sdkerrors.ErrInvalidRequest.Wrapf("artifact[%d].signatures[%d]: signature too large", idx, j)
 		}
-		if err := validateASCIIString("signature.key_id", sig.KeyId, ReleasePlatformMaxLen); err != nil {
+		if err := validateASCIIString("signature.key_id", sig.KeyId, PublisherKeyIDMaxLen); err != nil {
 			return sdkerrors.ErrInvalidRequest.Wrapf("artifact[%d].signatures[%d]: %s", idx, j, err.Error())
 		}
 		if err := validateASCIIString("signature.algo", sig.Algo, ReleaseKindMaxLen); err != nil {
@@ -75,5 +75,24 @@ func (p Params) Validate() error {
 	if p.RejectRefundBps > 10000 {
 		return fmt.Errorf("reject_refund_bps must be <= 10000")
 	}
+	attesters := map[string]struct{}{}
+	for _, a := range p.Attesters {
+		if a == "" {
+			return fmt.Errorf("attester must not be empty")
+		}
+		if _, ok := attesters[a]; ok {
+			return fmt.Errorf("duplicate attester: %s", a)
+		}
+		attesters[a] = struct{}{}
+	}
+	if p.AttestationQuorum > uint32(len(p.Attesters)) {
+		return fmt.Errorf("attestation_quorum must be <= number of attesters")
+	}
+	if p.StableSigThreshold > p.MaxSigsPerArt {
+		return fmt.Errorf("stable_sig_threshold must be <= max_sigs_per_art")
+	}
+	if p.StableSigThreshold > uint32(len(p.AllowedPublishers)) {
+		return fmt.Errorf("stable_sig_threshold must be <= number of allowed publishers")
+	}
 	return nil
 }
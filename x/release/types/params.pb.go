@@ -36,6 +36,9 @@ type Params struct {
 	DaoPublishers              []string `protobuf:"bytes,9,rep,name=dao_publishers,json=daoPublishers,proto3" json:"dao_publishers,omitempty"`
 	RejectRefundBps            uint32   `protobuf:"varint,10,opt,name=reject_refund_bps,json=rejectRefundBps,proto3" json:"reject_refund_bps,omitempty"`
 	RequireValidationForStable bool     `protobuf:"varint,11,opt,name=require_validation_for_stable,json=requireValidationForStable,proto3" json:"require_validation_for_stable,omitempty"`
+	StableSigThreshold         uint32   `protobuf:"varint,12,opt,name=stable_sig_threshold,json=stableSigThreshold,proto3" json:"stable_sig_threshold,omitempty"`
+	Attesters                  []string `protobuf:"bytes,13,rep,name=attesters,proto3" json:"attesters,omitempty"`
+	AttestationQuorum          uint32   `protobuf:"varint,14,opt,name=attestation_quorum,json=attestationQuorum,proto3" json:"attestation_quorum,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -148,6 +151,27 @@ func (m *Params) GetRequireValidationForStable() bool {
 	return false
 }
 
+func (m *Params) GetStableSigThreshold() uint32 {
+	if m != nil {
+		return m.StableSigThreshold
+	}
+	return 0
+}
+
+func (m *Params) GetAttesters() []string {
+	if m != nil {
+		return m.Attesters
+	}
+	return nil
+}
+
+func (m *Params) GetAttestationQuorum() uint32 {
+	if m != nil {
+		return m.AttestationQuorum
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "lumen.release.v1.Params")
 }
@@ -155,36 +179,39 @@ func init() {
 func init() { proto.RegisterFile("lumen/release/v1/params.proto", fileDescriptor_963f740ed0765578) }
 
 var fileDescriptor_963f740ed0765578 = []byte{
-	// 452 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0x1b, 0x3a, 0x4a, 0xe7, 0xd1, 0xad, 0xb5, 0x00, 0x59, 0x95, 0x96, 0x55, 0x43, 0x40,
-	0x34, 0x89, 0x46, 0x13, 0x37, 0x6e, 0xdd, 0x61, 0x27, 0x84, 0xaa, 0x8c, 0x71, 0xe0, 0x62, 0xb9,
-	0xcd, 0x6b, 0x66, 0xe4, 0xd8, 0xc6, 0x76, 0x4a, 0xf9, 0x0a, 0x9c, 0xf8, 0x08, 0x7c, 0x04, 0x3e,
-	0x06, 0xc7, 0x1d, 0x39, 0xa2, 0xf6, 0x00, 0xdf, 0x80, 0x2b, 0x8a, 0x93, 0x86, 0x8a, 0x4b, 0xf4,
-	0xf2, 0xff, 0xff, 0xde, 0x7b, 0xf6, 0xf3, 0x43, 0xc7, 0xa2, 0xc8, 0x41, 0xc6, 0x06, 0x04, 0x30,
-	0x0b, 0xf1, 0xf2, 0x3c, 0xd6, 0xcc, 0xb0, 0xdc, 0x8e, 0xb5, 0x51, 0x4e, 0xe1, 0xbe, 0xb7, 0xc7,
-	0xb5, 0x3d, 0x5e, 0x9e, 0x0f, 0x07, 0x2c, 0xe7, 0x52, 0xc5, 0xfe, 0x5b, 0x41, 0xc3, 0x07, 0x99,
-	0xca, 0x94, 0x0f, 0xe3, 0x32, 0xaa, 0xd4, 0xd3, 0x3f, 0x6d, 0xd4, 0x99, 0xfa, 0x5a, 0xf8, 0x39,
-	0xc2, 0x4c, 0x08, 0xf5, 0x11, 0x52, 0xaa, 0x8b, 0x99, 0xe0, 0xf6, 0x06, 0x8c, 0x25, 0xc1, 0xa8,
-	0x1d, 0xed, 0x27, 0x83, 0xda, 0x99, 0x36, 0x06, 0x1e, 0xa2, 0xee, 0xfc, 0x86, 0x49, 0x09, 0xc2,
-	0x92, 0x3b, 0x1e, 0x6a, 0xfe, 0xf1, 0x63, 0xd4, 0xcb, 0xd9, 0x8a, 0x32, 0xe3, 0xf8, 0x82, 0xcd,
-	0x9d, 0x25, 0xed, 0x51, 0x10, 0xf5, 0x92, 0xfb, 0x39, 0x5b, 0x4d, 0xb6, 0x1a, 0x7e, 0x86, 0xfa,
-	0x25, 0x54, 0x18, 0x61, 0xa9, 0x06, 0x53, 0xd2, 0x64, 0xcf, 0x73, 0x65, 0xf2, 0xb5, 0x11, 0x76,
-	0x0a, 0x66, 0x62, 0xdc, 0x16, 0xb4, 0x3c, 0xfb, 0x07, 0xde, 0x6d, 0xc0, 0x2b, 0x9e, 0x6d, 0xc1,
-	0xd3, 0xaa, 0xad, 0x54, 0x0e, 0x2c, 0x15, 0x20, 0x49, 0xc7, 0x53, 0x07, 0x39, 0x5b, 0xbd, 0x2e,
-	0xb5, 0x57, 0x20, 0x71, 0x84, 0xfa, 0xf5, 0xed, 0xe8, 0x02, 0x80, 0x16, 0x22, 0x97, 0xe4, 0xde,
-	0x28, 0x88, 0xf6, 0x92, 0xc3, 0x5a, 0xbf, 0x04, 0xb8, 0x16, 0xb9, 0xc4, 0x4f, 0xd1, 0x51, 0x59,
-	0x4d, 0x83, 0x4c, 0xb9, 0xcc, 0xa8, 0x73, 0x82, 0x74, 0x3d, 0x58, 0x36, 0x99, 0x56, 0xea, 0x1b,
-	0x27, 0xf0, 0x13, 0x74, 0x98, 0x32, 0xb5, 0x3b, 0xb3, 0x7d, 0x3f, 0x8e, 0x5e, 0xca, 0xd4, 0xce,
-	0xbc, 0xce, 0xd0, 0xc0, 0xc0, 0x7b, 0x98, 0x3b, 0x6a, 0x60, 0x51, 0xc8, 0x94, 0xce, 0xb4, 0x25,
-	0xc8, 0x1f, 0xf0, 0xa8, 0x32, 0x12, 0xaf, 0x5f, 0x68, 0x8b, 0x27, 0xe8, 0xd8, 0xc0, 0x87, 0x82,
-	0x1b, 0xa0, 0x4b, 0x26, 0x78, 0xca, 0x1c, 0x57, 0x92, 0x2e, 0x94, 0xa1, 0xd6, 0xb1, 0x99, 0x00,
-	0x72, 0x30, 0x0a, 0xa2, 0x6e, 0x32, 0xac, 0xa1, 0xb7, 0x0d, 0x73, 0xa9, 0xcc, 0x95, 0x27, 0x5e,
-	0x9e, 0xfc, 0xfe, 0x7a, 0x12, 0x7c, 0xfe, 0xf5, 0xed, 0xec, 0x51, 0xb5, 0x3b, 0xab, 0x66, 0x7b,
-	0xaa, 0xe7, 0xbe, 0x88, 0xbf, 0xaf, 0xc3, 0xe0, 0x76, 0x1d, 0x06, 0x3f, 0xd7, 0x61, 0xf0, 0x65,
-	0x13, 0xb6, 0x6e, 0x37, 0x61, 0xeb, 0xc7, 0x26, 0x6c, 0xbd, 0x7b, 0xf8, 0x7f, 0x86, 0xfb, 0xa4,
-	0xc1, 0xce, 0x3a, 0x7e, 0x63, 0x5e, 0xfc, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xca, 0x4e, 0x0b, 0xb0,
-	0x8d, 0x02, 0x00, 0x00,
+	// 506 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0x41, 0x6e, 0x13, 0x31,
+	0x14, 0x86, 0x33, 0x34, 0x84, 0xc4, 0x6d, 0xd2, 0xc4, 0x2a, 0xc8, 0x8a, 0xe8, 0x34, 0x2a, 0x02,
+	0xa2, 0x4a, 0x64, 0xa8, 0xd8, 0xb1, 0x4b, 0x17, 0x5d, 0x21, 0x14, 0x92, 0x96, 0x05, 0x1b, 0xcb,
+	0xc9, 0xbc, 0x4c, 0x8c, 0x3c, 0xf6, 0xd4, 0xf6, 0x84, 0x70, 0x05, 0x56, 0x1c, 0x81, 0x23, 0x70,
+	0x0c, 0x96, 0x5d, 0xb2, 0x44, 0xc9, 0x02, 0x4e, 0xc0, 0x1a, 0x8d, 0x9d, 0xa4, 0x11, 0x9b, 0xd1,
+	0xf8, 0xff, 0x3f, 0xbf, 0xe7, 0xf7, 0xf4, 0xa3, 0x63, 0x91, 0xa7, 0x20, 0x23, 0x0d, 0x02, 0x98,
+	0x81, 0x68, 0x7e, 0x1e, 0x65, 0x4c, 0xb3, 0xd4, 0xf4, 0x32, 0xad, 0xac, 0xc2, 0x4d, 0x67, 0xf7,
+	0xd6, 0x76, 0x6f, 0x7e, 0xde, 0x6e, 0xb1, 0x94, 0x4b, 0x15, 0xb9, 0xaf, 0x87, 0xda, 0x47, 0x89,
+	0x4a, 0x94, 0xfb, 0x8d, 0x8a, 0x3f, 0xaf, 0x9e, 0xfe, 0x2d, 0xa3, 0xca, 0xc0, 0xd5, 0xc2, 0x2f,
+	0x10, 0x66, 0x42, 0xa8, 0x4f, 0x10, 0xd3, 0x2c, 0x1f, 0x0b, 0x6e, 0x66, 0xa0, 0x0d, 0x09, 0x3a,
+	0x7b, 0xdd, 0xda, 0xb0, 0xb5, 0x76, 0x06, 0x5b, 0x03, 0xb7, 0x51, 0x75, 0x32, 0x63, 0x52, 0x82,
+	0x30, 0xe4, 0x9e, 0x83, 0xb6, 0x67, 0xfc, 0x04, 0xd5, 0x53, 0xb6, 0xa0, 0x4c, 0x5b, 0x3e, 0x65,
+	0x13, 0x6b, 0xc8, 0x5e, 0x27, 0xe8, 0xd6, 0x87, 0x07, 0x29, 0x5b, 0xf4, 0x37, 0x1a, 0x7e, 0x8e,
+	0x9a, 0x05, 0x94, 0x6b, 0x61, 0x68, 0x06, 0xba, 0xa0, 0x49, 0xd9, 0x71, 0xc5, 0xe5, 0x6b, 0x2d,
+	0xcc, 0x00, 0x74, 0x5f, 0xdb, 0x0d, 0x68, 0x78, 0x72, 0x07, 0xde, 0xdf, 0x82, 0x23, 0x9e, 0x6c,
+	0xc0, 0x53, 0xdf, 0x56, 0x2a, 0x0b, 0x86, 0x0a, 0x90, 0xa4, 0xe2, 0xa8, 0xfd, 0x94, 0x2d, 0xde,
+	0x16, 0xda, 0x1b, 0x90, 0xb8, 0x8b, 0x9a, 0xeb, 0xe9, 0xe8, 0x14, 0x80, 0xe6, 0x22, 0x95, 0xe4,
+	0x41, 0x27, 0xe8, 0x96, 0x87, 0x8d, 0xb5, 0x7e, 0x09, 0x70, 0x2d, 0x52, 0x89, 0x9f, 0xa1, 0xc3,
+	0xa2, 0x5a, 0x06, 0x32, 0xe6, 0x32, 0xa1, 0xd6, 0x0a, 0x52, 0x75, 0x60, 0xd1, 0x64, 0xe0, 0xd5,
+	0x2b, 0x2b, 0xf0, 0x53, 0xd4, 0x88, 0x99, 0xda, 0xdd, 0x59, 0xcd, 0xad, 0xa3, 0x1e, 0x33, 0xb5,
+	0xb3, 0xaf, 0x33, 0xd4, 0xd2, 0xf0, 0x11, 0x26, 0x96, 0x6a, 0x98, 0xe6, 0x32, 0xa6, 0xe3, 0xcc,
+	0x10, 0xe4, 0x1e, 0x78, 0xe8, 0x8d, 0xa1, 0xd3, 0x2f, 0x32, 0x83, 0xfb, 0xe8, 0x58, 0xc3, 0x4d,
+	0xce, 0x35, 0xd0, 0x39, 0x13, 0x3c, 0x66, 0x96, 0x2b, 0x49, 0xa7, 0x4a, 0x53, 0x63, 0xd9, 0x58,
+	0x00, 0xd9, 0xef, 0x04, 0xdd, 0xea, 0xb0, 0xbd, 0x86, 0xde, 0x6f, 0x99, 0x4b, 0xa5, 0x47, 0x8e,
+	0xc0, 0x2f, 0xd1, 0x91, 0x67, 0x8b, 0xbd, 0x51, 0x3b, 0xd3, 0x60, 0x66, 0x4a, 0xc4, 0xe4, 0xc0,
+	0x75, 0xc4, 0xde, 0x1b, 0xf1, 0xe4, 0x6a, 0xe3, 0xe0, 0xc7, 0xa8, 0xc6, 0xac, 0x05, 0x63, 0x8b,
+	0x11, 0xea, 0x6e, 0x84, 0x3b, 0xc1, 0xa5, 0xc3, 0x1d, 0xfc, 0x5b, 0x6e, 0x72, 0xa5, 0xf3, 0x94,
+	0x34, 0x5c, 0xb5, 0xd6, 0x8e, 0xf3, 0xce, 0x19, 0xaf, 0x4f, 0xfe, 0x7c, 0x3b, 0x09, 0xbe, 0xfc,
+	0xfe, 0x7e, 0xf6, 0xc8, 0x47, 0x77, 0xb1, 0x0d, 0xaf, 0x4f, 0xdb, 0x45, 0xf4, 0x63, 0x19, 0x06,
+	0xb7, 0xcb, 0x30, 0xf8, 0xb5, 0x0c, 0x83, 0xaf, 0xab, 0xb0, 0x74, 0xbb, 0x0a, 0x4b, 0x3f, 0x57,
+	0x61, 0xe9, 0xc3, 0xc3, 0xff, 0x6f, 0xd8, 0xcf, 0x19, 0x98, 0x71, 0xc5, 0x05, 0xf6, 0xd5, 0xbf,
+	0x01, 0x00, 0xa5, 0xfb, 0x9c, 0xd6, 0x0c, 0x03, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -254,6 +281,20 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.RequireValidationForStable != that1.RequireValidationForStable {
 		return false
 	}
+	if this.StableSigThreshold != that1.StableSigThreshold {
+		return false
+	}
+	if len(this.Attesters) != len(that1.Attesters) {
+		return false
+	}
+	for i := range this.Attesters {
+		if this.Attesters[i] != that1.Attesters[i] {
+			return false
+		}
+	}
+	if this.AttestationQuorum != that1.AttestationQuorum {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -276,6 +317,25 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AttestationQuorum != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AttestationQuorum))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.Attesters) > 0 {
+		for iNdEx := len(m.Attesters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Attesters[iNdEx])
+			copy(dAtA[i:], m.Attesters[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.Attesters[iNdEx])))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.StableSigThreshold != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.StableSigThreshold))
+		i--
+		dAtA[i] = 0x60
+	}
 	if m.RequireValidationForStable {
 		i--
 		if m.RequireValidationForStable {
@@ -410,6 +470,18 @@ func (m *Params) Size() (n int) {
 	if m.RequireValidationForStable {
 		n += 2
 	}
+	if m.StableSigThreshold != 0 {
+		n += 1 + sovParams(uint64(m.StableSigThreshold))
+	}
+	if len(m.Attesters) > 0 {
+		for _, s := range m.Attesters {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.AttestationQuorum != 0 {
+		n += 1 + sovParams(uint64(m.AttestationQuorum))
+	}
 	return n
 }
 
@@ -697,6 +769,76 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.RequireValidationForStable = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StableSigThreshold", wireType)
+			}
+			m.StableSigThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StableSigThreshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attesters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attesters = append(m.Attesters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationQuorum", wireType)
+			}
+			m.AttestationQuorum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttestationQuorum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -404,8 +404,11 @@ func (m *QueryLatestRequest) GetKind() string {
 	return ""
 }
 
+// QueryByVersionRequest may leave channel empty when the version exists on a
+// single channel.
 type QueryByVersionRequest struct {
 	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	Channel string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
 }
 
 func (m *QueryByVersionRequest) Reset()         { *m = QueryByVersionRequest{} }
@@ -448,804 +451,2744 @@ func (m *QueryByVersionRequest) GetVersion() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "lumen.release.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "lumen.release.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryReleaseRequest)(nil), "lumen.release.v1.QueryReleaseRequest")
-	proto.RegisterType((*QueryReleaseByIDRequest)(nil), "lumen.release.v1.QueryReleaseByIDRequest")
-	proto.RegisterType((*QueryReleaseResponse)(nil), "lumen.release.v1.QueryReleaseResponse")
-	proto.RegisterType((*QueryReleasesRequest)(nil), "lumen.release.v1.QueryReleasesRequest")
-	proto.RegisterType((*QueryReleasesResponse)(nil), "lumen.release.v1.QueryReleasesResponse")
-	proto.RegisterType((*QueryLatestRequest)(nil), "lumen.release.v1.QueryLatestRequest")
-	proto.RegisterType((*QueryByVersionRequest)(nil), "lumen.release.v1.QueryByVersionRequest")
+func (m *QueryByVersionRequest) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("lumen/release/v1/query.proto", fileDescriptor_e6fad665751b151f) }
-
-var fileDescriptor_e6fad665751b151f = []byte{
-	// 615 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcf, 0x6f, 0x12, 0x41,
-	0x14, 0xee, 0xd2, 0x16, 0xca, 0x23, 0x31, 0xe6, 0x15, 0x64, 0x8b, 0xed, 0x6a, 0x56, 0x51, 0x1b,
-	0x13, 0x46, 0x8a, 0x1e, 0xf4, 0x64, 0xd0, 0xc4, 0x18, 0x3d, 0x28, 0x07, 0x0f, 0x3d, 0x68, 0xa6,
-	0xee, 0x88, 0x1b, 0x97, 0x9d, 0xed, 0xee, 0x40, 0x4a, 0x90, 0x8b, 0x37, 0x6f, 0x26, 0x26, 0x9e,
-	0xfc, 0x23, 0xfc, 0x33, 0x3c, 0x36, 0xf1, 0xe2, 0xd1, 0x80, 0x7f, 0x88, 0x61, 0x66, 0x96, 0xb0,
-	0x4b, 0x29, 0x1c, 0xbc, 0xed, 0xbc, 0x1f, 0xdf, 0xf7, 0xcd, 0x9b, 0xef, 0x65, 0x61, 0xd7, 0xeb,
-	0x76, 0x98, 0x4f, 0x42, 0xe6, 0x31, 0x1a, 0x31, 0xd2, 0xab, 0x93, 0xe3, 0x2e, 0x0b, 0xfb, 0xb5,
-	0x20, 0xe4, 0x82, 0xe3, 0x45, 0x99, 0xad, 0xe9, 0x6c, 0xad, 0x57, 0xaf, 0xec, 0xb6, 0x39, 0x6f,
-	0x7b, 0x8c, 0xd0, 0xc0, 0x25, 0xd4, 0xf7, 0xb9, 0xa0, 0xc2, 0xe5, 0x7e, 0xa4, 0xea, 0x2b, 0xf3,
-	0x68, 0xa2, 0x1f, 0xb0, 0x38, 0xbb, 0x37, 0x97, 0x0d, 0x68, 0x48, 0x3b, 0x3a, 0x6d, 0x17, 0x01,
-	0x5f, 0x4e, 0xb8, 0x5f, 0xc8, 0x60, 0x8b, 0x1d, 0x77, 0x59, 0x24, 0xec, 0x27, 0xb0, 0x9d, 0x88,
-	0x46, 0x01, 0xf7, 0x23, 0x86, 0x77, 0x20, 0xab, 0x9a, 0x4d, 0xe3, 0xaa, 0x71, 0xab, 0x70, 0x60,
-	0xd6, 0xd2, 0x52, 0x6b, 0xba, 0x43, 0xd7, 0xd9, 0x55, 0x0d, 0xd4, 0x52, 0x15, 0x1a, 0x1f, 0x2f,
-	0x40, 0xc6, 0x75, 0x24, 0xc8, 0x46, 0x2b, 0xe3, 0x3a, 0xf6, 0x3e, 0x94, 0x67, 0xcb, 0x9a, 0xfd,
-	0xa7, 0x8f, 0x17, 0x95, 0x3e, 0x83, 0x62, 0x12, 0x51, 0x6b, 0x6b, 0x40, 0x4e, 0xcb, 0xd0, 0xe2,
-	0x76, 0xe6, 0xc5, 0xc5, 0x3d, 0x71, 0xa5, 0xfd, 0x30, 0x09, 0x16, 0xdf, 0x1f, 0x11, 0x36, 0x02,
-	0xda, 0x66, 0x9a, 0x56, 0x7e, 0x63, 0x11, 0x36, 0x3d, 0xb7, 0xe3, 0x0a, 0x33, 0x23, 0x83, 0xea,
-	0x60, 0x3b, 0x50, 0x4a, 0x21, 0x68, 0x3d, 0xf7, 0x60, 0x4b, 0xb3, 0x4c, 0xa6, 0xb5, 0x7e, 0xbe,
-	0xa0, 0x69, 0xe9, 0x84, 0x45, 0x70, 0x41, 0xbd, 0x98, 0x45, 0x1e, 0xec, 0xd7, 0xfa, 0x95, 0x9e,
-	0x53, 0xc1, 0x22, 0x11, 0xab, 0x34, 0x21, 0xf7, 0xf6, 0x3d, 0xf5, 0x7d, 0xe6, 0x49, 0xa1, 0xf9,
-	0x56, 0x7c, 0xc4, 0x0a, 0x6c, 0x05, 0x1e, 0x15, 0xef, 0x78, 0xd8, 0x91, 0x40, 0xf9, 0xd6, 0xf4,
-	0x3c, 0xb9, 0xdb, 0x07, 0xd7, 0x77, 0xcc, 0x75, 0x19, 0x97, 0xdf, 0x76, 0x5d, 0xdf, 0xa2, 0xd9,
-	0x7f, 0xc5, 0xc2, 0xc8, 0xe5, 0xfe, 0x0c, 0x45, 0x4f, 0x45, 0x62, 0x0a, 0x7d, 0x3c, 0xf8, 0x91,
-	0x83, 0x4d, 0xd9, 0x83, 0x02, 0xb2, 0xea, 0xd5, 0xf1, 0xfa, 0xfc, 0x0d, 0xe7, 0xcd, 0x55, 0xa9,
-	0x2e, 0xa9, 0x52, 0x03, 0xb4, 0xf7, 0x3e, 0xfd, 0xfa, 0xfb, 0x35, 0x53, 0xc6, 0x12, 0x49, 0x3a,
-	0x58, 0x39, 0x0b, 0x4f, 0x20, 0xa7, 0xa7, 0x87, 0x8b, 0x00, 0x93, 0xa6, 0xab, 0xdc, 0x58, 0x56,
-	0xa6, 0x89, 0x2d, 0x49, 0x6c, 0xe2, 0xa5, 0x14, 0xb1, 0xeb, 0x90, 0x81, 0xeb, 0x0c, 0xf1, 0xbb,
-	0x01, 0x85, 0x19, 0xa3, 0xe2, 0xfe, 0xf9, 0xb8, 0x33, 0x66, 0x5e, 0x59, 0xc2, 0x03, 0x29, 0xe1,
-	0xee, 0x61, 0x09, 0xb7, 0x53, 0x22, 0xa4, 0x82, 0xcb, 0xa9, 0x60, 0x22, 0xf9, 0x11, 0xb6, 0x62,
-	0x33, 0xe2, 0x12, 0xbe, 0xe9, 0x93, 0xdc, 0x5c, 0x5a, 0xa7, 0x85, 0x5d, 0x91, 0xc2, 0x76, 0xb0,
-	0x7c, 0xb6, 0x82, 0x08, 0xbb, 0x90, 0x55, 0x26, 0x5d, 0x68, 0x86, 0x84, 0x87, 0x57, 0x9e, 0xc8,
-	0x22, 0x37, 0x78, 0x8a, 0xec, 0x9b, 0x01, 0x05, 0x05, 0xfc, 0x88, 0xfa, 0xdc, 0xff, 0xcf, 0xe4,
-	0xf7, 0x25, 0x79, 0x03, 0xeb, 0x67, 0x92, 0x93, 0x81, 0x5e, 0xbb, 0x21, 0x19, 0xc4, 0x5b, 0x36,
-	0x24, 0x83, 0xc9, 0x62, 0x0d, 0xf1, 0xb3, 0x01, 0xf9, 0xe9, 0x56, 0xe1, 0xa2, 0x39, 0xa7, 0xf7,
-	0x6e, 0x65, 0x65, 0xb7, 0xa5, 0xb2, 0x2a, 0x5e, 0x4b, 0x29, 0x3b, 0xea, 0xbf, 0xd1, 0x8b, 0x4a,
-	0x06, 0xfa, 0x63, 0xd8, 0x24, 0x3f, 0x47, 0x96, 0x71, 0x3a, 0xb2, 0x8c, 0x3f, 0x23, 0xcb, 0xf8,
-	0x32, 0xb6, 0xd6, 0x4e, 0xc7, 0xd6, 0xda, 0xef, 0xb1, 0xb5, 0x76, 0x58, 0x52, 0xdd, 0x27, 0xd3,
-	0x7e, 0xf9, 0x07, 0x39, 0xca, 0xca, 0x7f, 0x44, 0xe3, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf3,
-	0x55, 0xa4, 0xd8, 0xb0, 0x06, 0x00, 0x00,
+// QueryVersionRangeRequest selects releases whose version satisfies every
+// comparator of range, e.g. ">=1.4.0 <2.0.0"; channel, platform and kind
+// narrow the result when set.
+type QueryVersionRangeRequest struct {
+	Range    string `protobuf:"bytes,1,opt,name=range,proto3" json:"range,omitempty"`
+	Channel  string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	Platform string `protobuf:"bytes,3,opt,name=platform,proto3" json:"platform,omitempty"`
+	Kind     string `protobuf:"bytes,4,opt,name=kind,proto3" json:"kind,omitempty"`
+	Page     uint64 `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	Limit    uint64 `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	Release(ctx context.Context, in *QueryReleaseRequest, opts ...grpc.CallOption) (*QueryReleaseResponse, error)
-	ReleaseByID(ctx context.Context, in *QueryReleaseByIDRequest, opts ...grpc.CallOption) (*QueryReleaseResponse, error)
-	Releases(ctx context.Context, in *QueryReleasesRequest, opts ...grpc.CallOption) (*QueryReleasesResponse, error)
-	Latest(ctx context.Context, in *QueryLatestRequest, opts ...grpc.CallOption) (*QueryReleaseResponse, error)
-	LatestCanon(ctx context.Context, in *QueryLatestRequest, opts ...grpc.CallOption) (*QueryReleaseResponse, error)
-	ByVersion(ctx context.Context, in *QueryByVersionRequest, opts ...grpc.CallOption) (*QueryReleaseResponse, error)
+func (m *QueryVersionRangeRequest) Reset()         { *m = QueryVersionRangeRequest{} }
+func (m *QueryVersionRangeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVersionRangeRequest) ProtoMessage()    {}
+func (*QueryVersionRangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6fad665751b151f, []int{9}
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryVersionRangeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVersionRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVersionRangeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVersionRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVersionRangeRequest.Merge(m, src)
+}
+func (m *QueryVersionRangeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVersionRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVersionRangeRequest.DiscardUnknown(m)
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+var xxx_messageInfo_QueryVersionRangeRequest proto.InternalMessageInfo
+
+func (m *QueryVersionRangeRequest) GetRange() string {
+	if m != nil {
+		return m.Range
+	}
+	return ""
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/lumen.release.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryVersionRangeRequest) GetChannel() string {
+	if m != nil {
+		return m.Channel
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) Release(ctx context.Context, in *QueryReleaseRequest, opts ...grpc.CallOption) (*QueryReleaseResponse, error) {
-	out := new(QueryReleaseResponse)
-	err := c.cc.Invoke(ctx, "/lumen.release.v1.Query/Release", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryVersionRangeRequest) GetPlatform() string {
+	if m != nil {
+		return m.Platform
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) ReleaseByID(ctx context.Context, in *QueryReleaseByIDRequest, opts ...grpc.CallOption) (*QueryReleaseResponse, error) {
-	out := new(QueryReleaseResponse)
-	err := c.cc.Invoke(ctx, "/lumen.release.v1.Query/ReleaseByID", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryVersionRangeRequest) GetKind() string {
+	if m != nil {
+		return m.Kind
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) Releases(ctx context.Context, in *QueryReleasesRequest, opts ...grpc.CallOption) (*QueryReleasesResponse, error) {
-	out := new(QueryReleasesResponse)
-	err := c.cc.Invoke(ctx, "/lumen.release.v1.Query/Releases", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryVersionRangeRequest) GetPage() uint64 {
+	if m != nil {
+		return m.Page
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) Latest(ctx context.Context, in *QueryLatestRequest, opts ...grpc.CallOption) (*QueryReleaseResponse, error) {
-	out := new(QueryReleaseResponse)
-	err := c.cc.Invoke(ctx, "/lumen.release.v1.Query/Latest", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryVersionRangeRequest) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) LatestCanon(ctx context.Context, in *QueryLatestRequest, opts ...grpc.CallOption) (*QueryReleaseResponse, error) {
-	out := new(QueryReleaseResponse)
-	err := c.cc.Invoke(ctx, "/lumen.release.v1.Query/LatestCanon", in, out, opts...)
-	if err != nil {
-		return nil, err
+// QueryVersionRangeResponse lists one page of the matching releases in
+// ascending semver order; total counts every match.
+type QueryVersionRangeResponse struct {
+	Releases []*Release `protobuf:"bytes,1,rep,name=releases,proto3" json:"releases,omitempty"`
+	Total    uint64     `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (m *QueryVersionRangeResponse) Reset()         { *m = QueryVersionRangeResponse{} }
+func (m *QueryVersionRangeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVersionRangeResponse) ProtoMessage()    {}
+func (*QueryVersionRangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6fad665751b151f, []int{10}
+}
+func (m *QueryVersionRangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVersionRangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVersionRangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryVersionRangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVersionRangeResponse.Merge(m, src)
+}
+func (m *QueryVersionRangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVersionRangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVersionRangeResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ByVersion(ctx context.Context, in *QueryByVersionRequest, opts ...grpc.CallOption) (*QueryReleaseResponse, error) {
-	out := new(QueryReleaseResponse)
-	err := c.cc.Invoke(ctx, "/lumen.release.v1.Query/ByVersion", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryVersionRangeResponse proto.InternalMessageInfo
+
+func (m *QueryVersionRangeResponse) GetReleases() []*Release {
+	if m != nil {
+		return m.Releases
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	Release(context.Context, *QueryReleaseRequest) (*QueryReleaseResponse, error)
-	ReleaseByID(context.Context, *QueryReleaseByIDRequest) (*QueryReleaseResponse, error)
-	Releases(context.Context, *QueryReleasesRequest) (*QueryReleasesResponse, error)
-	Latest(context.Context, *QueryLatestRequest) (*QueryReleaseResponse, error)
-	LatestCanon(context.Context, *QueryLatestRequest) (*QueryReleaseResponse, error)
-	ByVersion(context.Context, *QueryByVersionRequest) (*QueryReleaseResponse, error)
+func (m *QueryVersionRangeResponse) GetTotal() uint64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+type QueryPublisherKeysRequest struct {
+	Publisher string `protobuf:"bytes,1,opt,name=publisher,proto3" json:"publisher,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryPublisherKeysRequest) Reset()         { *m = QueryPublisherKeysRequest{} }
+func (m *QueryPublisherKeysRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPublisherKeysRequest) ProtoMessage()    {}
+func (*QueryPublisherKeysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6fad665751b151f, []int{11}
 }
-func (*UnimplementedQueryServer) Release(ctx context.Context, req *QueryReleaseRequest) (*QueryReleaseResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Release not implemented")
+func (m *QueryPublisherKeysRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) ReleaseByID(ctx context.Context, req *QueryReleaseByIDRequest) (*QueryReleaseResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReleaseByID not implemented")
+func (m *QueryPublisherKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPublisherKeysRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Releases(ctx context.Context, req *QueryReleasesRequest) (*QueryReleasesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Releases not implemented")
+func (m *QueryPublisherKeysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPublisherKeysRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) Latest(ctx context.Context, req *QueryLatestRequest) (*QueryReleaseResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Latest not implemented")
+func (m *QueryPublisherKeysRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) LatestCanon(ctx context.Context, req *QueryLatestRequest) (*QueryReleaseResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LatestCanon not implemented")
+func (m *QueryPublisherKeysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPublisherKeysRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) ByVersion(ctx context.Context, req *QueryByVersionRequest) (*QueryReleaseResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ByVersion not implemented")
+
+var xxx_messageInfo_QueryPublisherKeysRequest proto.InternalMessageInfo
+
+func (m *QueryPublisherKeysRequest) GetPublisher() string {
+	if m != nil {
+		return m.Publisher
+	}
+	return ""
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+type QueryPublisherKeysResponse struct {
+	Keys []*PublisherKey `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lumen.release.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryPublisherKeysResponse) Reset()         { *m = QueryPublisherKeysResponse{} }
+func (m *QueryPublisherKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPublisherKeysResponse) ProtoMessage()    {}
+func (*QueryPublisherKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6fad665751b151f, []int{12}
+}
+func (m *QueryPublisherKeysResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPublisherKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPublisherKeysResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryPublisherKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPublisherKeysResponse.Merge(m, src)
+}
+func (m *QueryPublisherKeysResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPublisherKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPublisherKeysResponse.DiscardUnknown(m)
 }
 
-func _Query_Release_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryReleaseRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Release(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lumen.release.v1.Query/Release",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Release(ctx, req.(*QueryReleaseRequest))
+var xxx_messageInfo_QueryPublisherKeysResponse proto.InternalMessageInfo
+
+func (m *QueryPublisherKeysResponse) GetKeys() []*PublisherKey {
+	if m != nil {
+		return m.Keys
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_ReleaseByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryReleaseByIDRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ReleaseByID(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lumen.release.v1.Query/ReleaseByID",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ReleaseByID(ctx, req.(*QueryReleaseByIDRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryAttestationsRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func _Query_Releases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryReleasesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Releases(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lumen.release.v1.Query/Releases",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Releases(ctx, req.(*QueryReleasesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryAttestationsRequest) Reset()         { *m = QueryAttestationsRequest{} }
+func (m *QueryAttestationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAttestationsRequest) ProtoMessage()    {}
+func (*QueryAttestationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6fad665751b151f, []int{13}
 }
-
-func _Query_Latest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLatestRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Latest(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lumen.release.v1.Query/Latest",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Latest(ctx, req.(*QueryLatestRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryAttestationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _Query_LatestCanon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLatestRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LatestCanon(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lumen.release.v1.Query/LatestCanon",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LatestCanon(ctx, req.(*QueryLatestRequest))
+func (m *QueryAttestationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttestationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryAttestationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttestationsRequest.Merge(m, src)
+}
+func (m *QueryAttestationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttestationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttestationsRequest.DiscardUnknown(m)
 }
 
-func _Query_ByVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryByVersionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ByVersion(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lumen.release.v1.Query/ByVersion",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ByVersion(ctx, req.(*QueryByVersionRequest))
+var xxx_messageInfo_QueryAttestationsRequest proto.InternalMessageInfo
+
+func (m *QueryAttestationsRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "lumen.release.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Release",
-			Handler:    _Query_Release_Handler,
-		},
-		{
-			MethodName: "ReleaseByID",
-			Handler:    _Query_ReleaseByID_Handler,
-		},
-		{
-			MethodName: "Releases",
-			Handler:    _Query_Releases_Handler,
-		},
-		{
-			MethodName: "Latest",
-			Handler:    _Query_Latest_Handler,
-		},
-		{
-			MethodName: "LatestCanon",
-			Handler:    _Query_LatestCanon_Handler,
-		},
-		{
-			MethodName: "ByVersion",
-			Handler:    _Query_ByVersion_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "lumen/release/v1/query.proto",
+type QueryAttestationsResponse struct {
+	Attestations []*Attestation `protobuf:"bytes,1,rep,name=attestations,proto3" json:"attestations,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAttestationsResponse) Reset()         { *m = QueryAttestationsResponse{} }
+func (m *QueryAttestationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAttestationsResponse) ProtoMessage()    {}
+func (*QueryAttestationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6fad665751b151f, []int{14}
+}
+func (m *QueryAttestationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttestationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttestationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAttestationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttestationsResponse.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryAttestationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttestationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttestationsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAttestationsResponse proto.InternalMessageInfo
+
+func (m *QueryAttestationsResponse) GetAttestations() []*Attestation {
+	if m != nil {
+		return m.Attestations
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryUpgradePathRequest names the running release; the path stays on its
+// channel.
+type QueryUpgradePathRequest struct {
+	Version  string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	Platform string `protobuf:"bytes,2,opt,name=platform,proto3" json:"platform,omitempty"`
+	Kind     string `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	Channel  string `protobuf:"bytes,4,opt,name=channel,proto3" json:"channel,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Params != nil {
-		{
-			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryUpgradePathRequest) Reset()         { *m = QueryUpgradePathRequest{} }
+func (m *QueryUpgradePathRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUpgradePathRequest) ProtoMessage()    {}
+func (*QueryUpgradePathRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6fad665751b151f, []int{15}
+}
+func (m *QueryUpgradePathRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUpgradePathRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUpgradePathRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryReleaseRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryUpgradePathRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUpgradePathRequest.Merge(m, src)
 }
-
-func (m *QueryReleaseRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryUpgradePathRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryReleaseRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *QueryUpgradePathRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUpgradePathRequest.DiscardUnknown(m)
 }
 
-func (m *QueryReleaseByIDRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryUpgradePathRequest proto.InternalMessageInfo
+
+func (m *QueryUpgradePathRequest) GetVersion() string {
+	if m != nil {
+		return m.Version
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryReleaseByIDRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryUpgradePathRequest) GetPlatform() string {
+	if m != nil {
+		return m.Platform
+	}
+	return ""
 }
 
-func (m *QueryReleaseByIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryUpgradePathRequest) GetKind() string {
+	if m != nil {
+		return m.Kind
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryReleaseResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryUpgradePathRequest) GetChannel() string {
+	if m != nil {
+		return m.Channel
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryReleaseResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryUpgradePathResponse lists the releases to install in ascending semver
+// order; the last one is the latest release. Empty when the current release is the latest.
+type QueryUpgradePathResponse struct {
+	Releases []*Release `protobuf:"bytes,1,rep,name=releases,proto3" json:"releases,omitempty"`
 }
 
-func (m *QueryReleaseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Release != nil {
-		{
-			size, err := m.Release.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryUpgradePathResponse) Reset()         { *m = QueryUpgradePathResponse{} }
+func (m *QueryUpgradePathResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUpgradePathResponse) ProtoMessage()    {}
+func (*QueryUpgradePathResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6fad665751b151f, []int{16}
+}
+func (m *QueryUpgradePathResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUpgradePathResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUpgradePathResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryUpgradePathResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUpgradePathResponse.Merge(m, src)
+}
+func (m *QueryUpgradePathResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUpgradePathResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUpgradePathResponse.DiscardUnknown(m)
 }
 
-func (m *QueryReleasesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryUpgradePathResponse proto.InternalMessageInfo
+
+func (m *QueryUpgradePathResponse) GetReleases() []*Release {
+	if m != nil {
+		return m.Releases
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryReleasesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "lumen.release.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "lumen.release.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryReleaseRequest)(nil), "lumen.release.v1.QueryReleaseRequest")
+	proto.RegisterType((*QueryReleaseByIDRequest)(nil), "lumen.release.v1.QueryReleaseByIDRequest")
+	proto.RegisterType((*QueryReleaseResponse)(nil), "lumen.release.v1.QueryReleaseResponse")
+	proto.RegisterType((*QueryReleasesRequest)(nil), "lumen.release.v1.QueryReleasesRequest")
+	proto.RegisterType((*QueryReleasesResponse)(nil), "lumen.release.v1.QueryReleasesResponse")
+	proto.RegisterType((*QueryLatestRequest)(nil), "lumen.release.v1.QueryLatestRequest")
+	proto.RegisterType((*QueryByVersionRequest)(nil), "lumen.release.v1.QueryByVersionRequest")
+	proto.RegisterType((*QueryVersionRangeRequest)(nil), "lumen.release.v1.QueryVersionRangeRequest")
+	proto.RegisterType((*QueryVersionRangeResponse)(nil), "lumen.release.v1.QueryVersionRangeResponse")
+	proto.RegisterType((*QueryPublisherKeysRequest)(nil), "lumen.release.v1.QueryPublisherKeysRequest")
+	proto.RegisterType((*QueryPublisherKeysResponse)(nil), "lumen.release.v1.QueryPublisherKeysResponse")
+	proto.RegisterType((*QueryAttestationsRequest)(nil), "lumen.release.v1.QueryAttestationsRequest")
+	proto.RegisterType((*QueryAttestationsResponse)(nil), "lumen.release.v1.QueryAttestationsResponse")
+	proto.RegisterType((*QueryUpgradePathRequest)(nil), "lumen.release.v1.QueryUpgradePathRequest")
+	proto.RegisterType((*QueryUpgradePathResponse)(nil), "lumen.release.v1.QueryUpgradePathResponse")
 }
 
-func (m *QueryReleasesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Limit != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Page != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Page))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("lumen/release/v1/query.proto", fileDescriptor_e6fad665751b151f) }
+
+var fileDescriptor_e6fad665751b151f = []byte{
+	// 924 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x97, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0xb3, 0x8e, 0xe3, 0x24, 0xcf, 0x29, 0xa0, 0xa9, 0x4d, 0xd6, 0x8b, 0xbd, 0xad, 0xb6,
+	0x35, 0xb4, 0x09, 0xf2, 0x92, 0x04, 0x0e, 0xe5, 0x44, 0x03, 0x12, 0x42, 0xe1, 0xe0, 0xae, 0x04,
+	0x87, 0x1e, 0x6a, 0x4d, 0xf0, 0x60, 0xaf, 0xba, 0xde, 0xdd, 0xee, 0xae, 0xa3, 0x5a, 0xc6, 0x12,
+	0xe2, 0xc6, 0x01, 0x89, 0x0a, 0x89, 0x13, 0x47, 0xfe, 0x18, 0x8e, 0x95, 0xb8, 0x70, 0x44, 0x09,
+	0x7f, 0x08, 0xda, 0x99, 0xb7, 0xeb, 0xfd, 0x59, 0x1b, 0x91, 0x9e, 0xbc, 0x33, 0xf3, 0xe6, 0x7d,
+	0x3f, 0x33, 0xef, 0xcd, 0x7b, 0x32, 0xb4, 0xad, 0xe9, 0x84, 0xd9, 0xba, 0xc7, 0x2c, 0x46, 0x7d,
+	0xa6, 0x5f, 0x1c, 0xe9, 0xcf, 0xa6, 0xcc, 0x9b, 0xf5, 0x5c, 0xcf, 0x09, 0x1c, 0xf2, 0x16, 0x5f,
+	0xed, 0xe1, 0x6a, 0xef, 0xe2, 0x48, 0x69, 0x8f, 0x1c, 0x67, 0x64, 0x31, 0x9d, 0xba, 0xa6, 0x4e,
+	0x6d, 0xdb, 0x09, 0x68, 0x60, 0x3a, 0xb6, 0x2f, 0xec, 0x95, 0xbc, 0xb7, 0x60, 0xe6, 0xb2, 0x68,
+	0xb5, 0x93, 0x5b, 0x75, 0xa9, 0x47, 0x27, 0xb8, 0xac, 0x35, 0x80, 0x3c, 0x0a, 0xb5, 0xfb, 0x7c,
+	0xd2, 0x60, 0xcf, 0xa6, 0xcc, 0x0f, 0xb4, 0xcf, 0xe1, 0x66, 0x6a, 0xd6, 0x77, 0x1d, 0xdb, 0x67,
+	0xe4, 0x03, 0xa8, 0x89, 0xcd, 0xb2, 0x74, 0x5b, 0xba, 0x57, 0x3f, 0x96, 0x7b, 0x59, 0xd4, 0x1e,
+	0xee, 0x40, 0x3b, 0xad, 0x8b, 0x8e, 0x0c, 0x61, 0x81, 0xfe, 0xc9, 0x1b, 0x50, 0x31, 0x87, 0xdc,
+	0x49, 0xd5, 0xa8, 0x98, 0x43, 0xed, 0x3e, 0xec, 0x27, 0xcd, 0x4e, 0x67, 0x5f, 0x7c, 0x56, 0x66,
+	0x7a, 0x06, 0x8d, 0xb4, 0x47, 0x64, 0x3b, 0x81, 0x6d, 0xc4, 0x40, 0xb8, 0x56, 0x1e, 0x2e, 0xda,
+	0x13, 0x59, 0x6a, 0x9f, 0xa4, 0x9d, 0x45, 0xe7, 0x27, 0x04, 0xaa, 0x2e, 0x1d, 0x31, 0x94, 0xe5,
+	0xdf, 0xa4, 0x01, 0x5b, 0x96, 0x39, 0x31, 0x03, 0xb9, 0xc2, 0x27, 0xc5, 0x40, 0x1b, 0x42, 0x33,
+	0xe3, 0x01, 0x79, 0x3e, 0x82, 0x1d, 0x54, 0x09, 0x6f, 0x6b, 0xf3, 0xd5, 0x40, 0xb1, 0x69, 0xa8,
+	0x12, 0x38, 0x01, 0xb5, 0x22, 0x15, 0x3e, 0xd0, 0x9e, 0x60, 0x94, 0xbe, 0xa4, 0x01, 0xf3, 0x83,
+	0x88, 0x52, 0x86, 0xed, 0x6f, 0xc6, 0xd4, 0xb6, 0x99, 0xc5, 0x41, 0x77, 0x8d, 0x68, 0x48, 0x14,
+	0xd8, 0x71, 0x2d, 0x1a, 0x7c, 0xeb, 0x78, 0x13, 0xee, 0x68, 0xd7, 0x88, 0xc7, 0xe1, 0xd9, 0x9e,
+	0x9a, 0xf6, 0x50, 0xde, 0xe4, 0xf3, 0xfc, 0x5b, 0x3b, 0xc3, 0x53, 0x9c, 0xce, 0xbe, 0x66, 0x9e,
+	0x6f, 0x3a, 0x76, 0x42, 0xe2, 0x42, 0xcc, 0x44, 0x12, 0x38, 0x4c, 0x8a, 0x57, 0x52, 0xe2, 0xda,
+	0xef, 0x12, 0xc8, 0xdc, 0x5b, 0xe4, 0x8b, 0xda, 0xa3, 0x38, 0xf2, 0x0d, 0xd8, 0xf2, 0xc2, 0x31,
+	0xba, 0x13, 0x83, 0x72, 0x67, 0xa9, 0x93, 0x6c, 0x96, 0x9c, 0xa4, 0xba, 0x3c, 0x49, 0x1c, 0xb9,
+	0xad, 0xa2, 0xc8, 0xd5, 0x92, 0x91, 0x1b, 0x43, 0xab, 0x80, 0xf2, 0x75, 0x44, 0xef, 0x01, 0x2a,
+	0xf5, 0xa7, 0xe7, 0x96, 0xe9, 0x8f, 0x99, 0x77, 0xc6, 0x66, 0x71, 0xaa, 0xb5, 0x61, 0xd7, 0x8d,
+	0xe6, 0xf1, 0x52, 0x96, 0x13, 0x5a, 0x1f, 0x94, 0xa2, 0xad, 0x48, 0x79, 0x0c, 0xd5, 0xa7, 0x6c,
+	0x16, 0x11, 0xaa, 0x05, 0xaf, 0x31, 0xb1, 0xcd, 0xe0, 0xb6, 0xda, 0x01, 0x06, 0xe7, 0x61, 0x10,
+	0xa6, 0x92, 0x28, 0x24, 0x65, 0x6f, 0xed, 0x09, 0xb4, 0x0a, 0x6c, 0x51, 0xfc, 0x21, 0xec, 0xd1,
+	0xc4, 0x3c, 0x42, 0x74, 0xf2, 0x10, 0x89, 0xdd, 0x46, 0x6a, 0x8b, 0xb6, 0xc0, 0x67, 0xff, 0x95,
+	0x3b, 0xf2, 0xe8, 0x90, 0xf5, 0x69, 0x30, 0x5e, 0x9d, 0x78, 0xff, 0x31, 0xb7, 0x93, 0xb9, 0x55,
+	0x4d, 0x27, 0xea, 0x23, 0x90, 0xf3, 0xf2, 0xff, 0x2b, 0x01, 0x8e, 0xbf, 0xbf, 0x01, 0x5b, 0xdc,
+	0x27, 0x09, 0xa0, 0x26, 0x6a, 0x21, 0xb9, 0x9b, 0xdf, 0x98, 0x2f, 0xb9, 0x4a, 0x77, 0x85, 0x95,
+	0xe0, 0xd2, 0x3a, 0x3f, 0xfc, 0xf9, 0xcf, 0x2f, 0x95, 0x7d, 0xd2, 0xd4, 0xd3, 0x75, 0x5d, 0xd4,
+	0x5b, 0xf2, 0x1c, 0xb6, 0x11, 0x8a, 0x94, 0x39, 0x4c, 0x97, 0x62, 0xe5, 0xdd, 0x55, 0x66, 0x28,
+	0xac, 0x72, 0x61, 0x99, 0xbc, 0x9d, 0x11, 0x36, 0x87, 0xfa, 0xdc, 0x1c, 0x2e, 0xc8, 0x6f, 0x12,
+	0xd4, 0x13, 0xe5, 0x9b, 0xdc, 0x7f, 0xb5, 0xdf, 0x44, 0x89, 0x5f, 0x1b, 0xe1, 0x63, 0x8e, 0xf0,
+	0x21, 0x79, 0x27, 0x83, 0x10, 0xfd, 0x86, 0x1c, 0x8f, 0x9b, 0xe4, 0x66, 0x66, 0x99, 0xe3, 0x7d,
+	0x07, 0x3b, 0x46, 0xf4, 0x4a, 0x57, 0xe8, 0xc5, 0x21, 0x79, 0x6f, 0xa5, 0x1d, 0x82, 0xdd, 0xe2,
+	0x60, 0x2d, 0xb2, 0x5f, 0x0c, 0xe6, 0x93, 0x29, 0xd4, 0x44, 0xe9, 0x2e, 0x4d, 0x86, 0x54, 0x65,
+	0x5f, 0xfb, 0x46, 0xca, 0xb2, 0xc1, 0x12, 0x62, 0xbf, 0x4a, 0x50, 0x17, 0x8e, 0x3f, 0xa5, 0xb6,
+	0x63, 0x5f, 0xb3, 0xf8, 0x03, 0x2e, 0x7e, 0x42, 0x8e, 0x0a, 0xc5, 0xf5, 0x39, 0x3e, 0xb3, 0x85,
+	0x3e, 0x8f, 0xde, 0xe7, 0x42, 0x9f, 0x87, 0x4f, 0x72, 0x41, 0x7e, 0x94, 0x60, 0x37, 0xee, 0x35,
+	0xa4, 0xec, 0x9e, 0xb3, 0xdd, 0x68, 0x6d, 0xb2, 0x43, 0x4e, 0xd6, 0x25, 0x77, 0x32, 0x64, 0xe7,
+	0xb3, 0x01, 0x56, 0x11, 0x7d, 0x8e, 0x1f, 0x9c, 0xe5, 0x4d, 0x71, 0x01, 0x4b, 0xa2, 0xeb, 0xbd,
+	0xa8, 0x7b, 0x1c, 0x47, 0x23, 0xb7, 0x0b, 0x2f, 0x6a, 0xb0, 0xa4, 0x22, 0x3f, 0x49, 0xb0, 0x97,
+	0xec, 0x47, 0xe4, 0xa0, 0x44, 0xa2, 0xa0, 0xb5, 0x2a, 0x87, 0x6b, 0xd9, 0x22, 0xd3, 0x5d, 0xce,
+	0xa4, 0x92, 0x76, 0x86, 0x09, 0x49, 0x06, 0xa2, 0x2f, 0xbf, 0x90, 0xe0, 0x46, 0xaa, 0xf5, 0x90,
+	0x32, 0x91, 0xa2, 0xde, 0xa6, 0xbc, 0xbf, 0x9e, 0x31, 0x22, 0x75, 0x39, 0xd2, 0x2d, 0xd2, 0xc9,
+	0x96, 0xb6, 0xc8, 0x7a, 0x10, 0x36, 0xb0, 0x30, 0x5e, 0xf5, 0x44, 0xc5, 0x2e, 0x2d, 0x34, 0xf9,
+	0xa6, 0xa2, 0x1c, 0xac, 0x63, 0x8a, 0x34, 0x77, 0x38, 0x4d, 0x27, 0x57, 0x6c, 0xa6, 0xc2, 0x76,
+	0xe0, 0x86, 0xda, 0x2f, 0x24, 0xd8, 0x4b, 0x36, 0xc7, 0xd2, 0x78, 0x15, 0x74, 0x5b, 0xe5, 0x70,
+	0x2d, 0xdb, 0x15, 0x39, 0x94, 0xec, 0xa7, 0xbc, 0xd2, 0x9d, 0xea, 0x7f, 0x5c, 0xaa, 0xd2, 0xcb,
+	0x4b, 0x55, 0xfa, 0xfb, 0x52, 0x95, 0x7e, 0xbe, 0x52, 0x37, 0x5e, 0x5e, 0xa9, 0x1b, 0x7f, 0x5d,
+	0xa9, 0x1b, 0x8f, 0x9b, 0x62, 0xeb, 0xf3, 0x78, 0x33, 0xff, 0x9f, 0x70, 0x5e, 0xe3, 0xff, 0x04,
+	0x4e, 0xfe, 0x1d, 0x00, 0x81, 0xf9, 0x25, 0x95, 0x96, 0x0c, 0x00, 0x00,
 }
 
-func (m *QueryReleasesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	Release(ctx context.Context, in *QueryReleaseRequest, opts ...grpc.CallOption) (*QueryReleaseResponse, error)
+	ReleaseByID(ctx context.Context, in *QueryReleaseByIDRequest, opts ...grpc.CallOption) (*QueryReleaseResponse, error)
+	Releases(ctx context.Context, in *QueryReleasesRequest, opts ...grpc.CallOption) (*QueryReleasesResponse, error)
+	Latest(ctx context.Context, in *QueryLatestRequest, opts ...grpc.CallOption) (*QueryReleaseResponse, error)
+	LatestCanon(ctx context.Context, in *QueryLatestRequest, opts ...grpc.CallOption) (*QueryReleaseResponse, error)
+	ByVersion(ctx context.Context, in *QueryByVersionRequest, opts ...grpc.CallOption) (*QueryReleaseResponse, error)
+	// LatestByVersion returns the validated, unyanked release with the highest
+	// semver precedence for a channel/platform/kind, whatever its id.
+	LatestByVersion(ctx context.Context, in *QueryLatestRequest, opts ...grpc.CallOption) (*QueryReleaseResponse, error)
+	VersionRange(ctx context.Context, in *QueryVersionRangeRequest, opts ...grpc.CallOption) (*QueryVersionRangeResponse, error)
+	PublisherKeys(ctx context.Context, in *QueryPublisherKeysRequest, opts ...grpc.CallOption) (*QueryPublisherKeysResponse, error)
+	UpgradePath(ctx context.Context, in *QueryUpgradePathRequest, opts ...grpc.CallOption) (*QueryUpgradePathResponse, error)
+	Attestations(ctx context.Context, in *QueryAttestationsRequest, opts ...grpc.CallOption) (*QueryAttestationsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/lumen.release.v1.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryReleasesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Release(ctx context.Context, in *QueryReleaseRequest, opts ...grpc.CallOption) (*QueryReleaseResponse, error) {
+	out := new(QueryReleaseResponse)
+	err := c.cc.Invoke(ctx, "/lumen.release.v1.Query/Release", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryReleasesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Total != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Total))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Releases) > 0 {
-		for iNdEx := len(m.Releases) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Releases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) ReleaseByID(ctx context.Context, in *QueryReleaseByIDRequest, opts ...grpc.CallOption) (*QueryReleaseResponse, error) {
+	out := new(QueryReleaseResponse)
+	err := c.cc.Invoke(ctx, "/lumen.release.v1.Query/ReleaseByID", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryLatestRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Releases(ctx context.Context, in *QueryReleasesRequest, opts ...grpc.CallOption) (*QueryReleasesResponse, error) {
+	out := new(QueryReleasesResponse)
+	err := c.cc.Invoke(ctx, "/lumen.release.v1.Query/Releases", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryLatestRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Latest(ctx context.Context, in *QueryLatestRequest, opts ...grpc.CallOption) (*QueryReleaseResponse, error) {
+	out := new(QueryReleaseResponse)
+	err := c.cc.Invoke(ctx, "/lumen.release.v1.Query/Latest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryLatestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Kind) > 0 {
-		i -= len(m.Kind)
-		copy(dAtA[i:], m.Kind)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Kind)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Platform) > 0 {
-		i -= len(m.Platform)
-		copy(dAtA[i:], m.Platform)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Platform)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Channel) > 0 {
-		i -= len(m.Channel)
-		copy(dAtA[i:], m.Channel)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Channel)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) LatestCanon(ctx context.Context, in *QueryLatestRequest, opts ...grpc.CallOption) (*QueryReleaseResponse, error) {
+	out := new(QueryReleaseResponse)
+	err := c.cc.Invoke(ctx, "/lumen.release.v1.Query/LatestCanon", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryByVersionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ByVersion(ctx context.Context, in *QueryByVersionRequest, opts ...grpc.CallOption) (*QueryReleaseResponse, error) {
+	out := new(QueryReleaseResponse)
+	err := c.cc.Invoke(ctx, "/lumen.release.v1.Query/ByVersion", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryByVersionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) LatestByVersion(ctx context.Context, in *QueryLatestRequest, opts ...grpc.CallOption) (*QueryReleaseResponse, error) {
+	out := new(QueryReleaseResponse)
+	err := c.cc.Invoke(ctx, "/lumen.release.v1.Query/LatestByVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryByVersionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Version) > 0 {
-		i -= len(m.Version)
-		copy(dAtA[i:], m.Version)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Version)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) VersionRange(ctx context.Context, in *QueryVersionRangeRequest, opts ...grpc.CallOption) (*QueryVersionRangeResponse, error) {
+	out := new(QueryVersionRangeResponse)
+	err := c.cc.Invoke(ctx, "/lumen.release.v1.Query/VersionRange", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *queryClient) PublisherKeys(ctx context.Context, in *QueryPublisherKeysRequest, opts ...grpc.CallOption) (*QueryPublisherKeysResponse, error) {
+	out := new(QueryPublisherKeysResponse)
+	err := c.cc.Invoke(ctx, "/lumen.release.v1.Query/PublisherKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (c *queryClient) UpgradePath(ctx context.Context, in *QueryUpgradePathRequest, opts ...grpc.CallOption) (*QueryUpgradePathResponse, error) {
+	out := new(QueryUpgradePathResponse)
+	err := c.cc.Invoke(ctx, "/lumen.release.v1.Query/UpgradePath", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) Attestations(ctx context.Context, in *QueryAttestationsRequest, opts ...grpc.CallOption) (*QueryAttestationsResponse, error) {
+	out := new(QueryAttestationsResponse)
+	err := c.cc.Invoke(ctx, "/lumen.release.v1.Query/Attestations", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryReleaseRequest) Size() (n int) {
-	if m == nil {
-		return 0
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	Release(context.Context, *QueryReleaseRequest) (*QueryReleaseResponse, error)
+	ReleaseByID(context.Context, *QueryReleaseByIDRequest) (*QueryReleaseResponse, error)
+	Releases(context.Context, *QueryReleasesRequest) (*QueryReleasesResponse, error)
+	Latest(context.Context, *QueryLatestRequest) (*QueryReleaseResponse, error)
+	LatestCanon(context.Context, *QueryLatestRequest) (*QueryReleaseResponse, error)
+	ByVersion(context.Context, *QueryByVersionRequest) (*QueryReleaseResponse, error)
+	// LatestByVersion returns the validated, unyanked release with the highest
+	// semver precedence for a channel/platform/kind, whatever its id.
+	LatestByVersion(context.Context, *QueryLatestRequest) (*QueryReleaseResponse, error)
+	VersionRange(context.Context, *QueryVersionRangeRequest) (*QueryVersionRangeResponse, error)
+	PublisherKeys(context.Context, *QueryPublisherKeysRequest) (*QueryPublisherKeysResponse, error)
+	UpgradePath(context.Context, *QueryUpgradePathRequest) (*QueryUpgradePathResponse, error)
+	Attestations(context.Context, *QueryAttestationsRequest) (*QueryAttestationsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Release(ctx context.Context, req *QueryReleaseRequest) (*QueryReleaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Release not implemented")
+}
+func (*UnimplementedQueryServer) ReleaseByID(ctx context.Context, req *QueryReleaseByIDRequest) (*QueryReleaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseByID not implemented")
+}
+func (*UnimplementedQueryServer) Releases(ctx context.Context, req *QueryReleasesRequest) (*QueryReleasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Releases not implemented")
+}
+func (*UnimplementedQueryServer) Latest(ctx context.Context, req *QueryLatestRequest) (*QueryReleaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Latest not implemented")
+}
+func (*UnimplementedQueryServer) LatestCanon(ctx context.Context, req *QueryLatestRequest) (*QueryReleaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LatestCanon not implemented")
+}
+func (*UnimplementedQueryServer) ByVersion(ctx context.Context, req *QueryByVersionRequest) (*QueryReleaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ByVersion not implemented")
+}
+func (*UnimplementedQueryServer) LatestByVersion(ctx context.Context, req *QueryLatestRequest) (*QueryReleaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LatestByVersion not implemented")
+}
+func (*UnimplementedQueryServer) VersionRange(ctx context.Context, req *QueryVersionRangeRequest) (*QueryVersionRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VersionRange not implemented")
+}
+func (*UnimplementedQueryServer) PublisherKeys(ctx context.Context, req *QueryPublisherKeysRequest) (*QueryPublisherKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublisherKeys not implemented")
+}
+func (*UnimplementedQueryServer) UpgradePath(ctx context.Context, req *QueryUpgradePathRequest) (*QueryUpgradePathResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradePath not implemented")
+}
+func (*UnimplementedQueryServer) Attestations(ctx context.Context, req *QueryAttestationsRequest) (*QueryAttestationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Attestations not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumen.release.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryReleaseByIDRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Release_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReleaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+	if interceptor == nil {
+		return srv.(QueryServer).Release(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumen.release.v1.Query/Release",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Release(ctx, req.(*QueryReleaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryReleaseResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ReleaseByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReleaseByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Release != nil {
-		l = m.Release.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).ReleaseByID(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumen.release.v1.Query/ReleaseByID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReleaseByID(ctx, req.(*QueryReleaseByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryReleasesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Releases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReleasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Page != 0 {
-		n += 1 + sovQuery(uint64(m.Page))
+	if interceptor == nil {
+		return srv.(QueryServer).Releases(ctx, in)
 	}
-	if m.Limit != 0 {
-		n += 1 + sovQuery(uint64(m.Limit))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumen.release.v1.Query/Releases",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Releases(ctx, req.(*QueryReleasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryReleasesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Latest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLatestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Releases) > 0 {
-		for _, e := range m.Releases {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).Latest(ctx, in)
 	}
-	if m.Total != 0 {
-		n += 1 + sovQuery(uint64(m.Total))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumen.release.v1.Query/Latest",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Latest(ctx, req.(*QueryLatestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLatestRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_LatestCanon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLatestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Channel)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).LatestCanon(ctx, in)
 	}
-	l = len(m.Platform)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumen.release.v1.Query/LatestCanon",
 	}
-	l = len(m.Kind)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LatestCanon(ctx, req.(*QueryLatestRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryByVersionRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ByVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryByVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).ByVersion(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumen.release.v1.Query/ByVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ByVersion(ctx, req.(*QueryByVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func _Query_LatestByVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLatestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LatestByVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumen.release.v1.Query/LatestByVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LatestByVersion(ctx, req.(*QueryLatestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func _Query_VersionRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVersionRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VersionRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumen.release.v1.Query/VersionRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VersionRange(ctx, req.(*QueryVersionRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
+
+func _Query_PublisherKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPublisherKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PublisherKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumen.release.v1.Query/PublisherKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PublisherKeys(ctx, req.(*QueryPublisherKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UpgradePath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUpgradePathRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UpgradePath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumen.release.v1.Query/UpgradePath",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UpgradePath(ctx, req.(*QueryUpgradePathRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Attestations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttestationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Attestations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumen.release.v1.Query/Attestations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Attestations(ctx, req.(*QueryAttestationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "lumen.release.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Release",
+			Handler:    _Query_Release_Handler,
+		},
+		{
+			MethodName: "ReleaseByID",
+			Handler:    _Query_ReleaseByID_Handler,
+		},
+		{
+			MethodName: "Releases",
+			Handler:    _Query_Releases_Handler,
+		},
+		{
+			MethodName: "Latest",
+			Handler:    _Query_Latest_Handler,
+		},
+		{
+			MethodName: "LatestCanon",
+			Handler:    _Query_LatestCanon_Handler,
+		},
+		{
+			MethodName: "ByVersion",
+			Handler:    _Query_ByVersion_Handler,
+		},
+		{
+			MethodName: "LatestByVersion",
+			Handler:    _Query_LatestByVersion_Handler,
+		},
+		{
+			MethodName: "VersionRange",
+			Handler:    _Query_VersionRange_Handler,
+		},
+		{
+			MethodName: "PublisherKeys",
+			Handler:    _Query_PublisherKeys_Handler,
+		},
+		{
+			MethodName: "UpgradePath",
+			Handler:    _Query_UpgradePath_Handler,
+		},
+		{
+			MethodName: "Attestations",
+			Handler:    _Query_Attestations_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lumen/release/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReleaseRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReleaseRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReleaseRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReleaseByIDRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReleaseByIDRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReleaseByIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReleaseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReleaseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReleaseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Release != nil {
+		{
+			size, err := m.Release.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReleasesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReleasesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReleasesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Page != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReleasesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReleasesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReleasesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Total != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Releases) > 0 {
+		for iNdEx := len(m.Releases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Releases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLatestRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLatestRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Platform) > 0 {
+		i -= len(m.Platform)
+		copy(dAtA[i:], m.Platform)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Platform)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryByVersionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryByVersionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryByVersionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVersionRangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVersionRangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVersionRangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Page != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Platform) > 0 {
+		i -= len(m.Platform)
+		copy(dAtA[i:], m.Platform)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Platform)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Range) > 0 {
+		i -= len(m.Range)
+		copy(dAtA[i:], m.Range)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Range)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVersionRangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVersionRangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVersionRangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Total != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Releases) > 0 {
+		for iNdEx := len(m.Releases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Releases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPublisherKeysRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPublisherKeysRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPublisherKeysRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Publisher) > 0 {
+		i -= len(m.Publisher)
+		copy(dAtA[i:], m.Publisher)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Publisher)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPublisherKeysResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPublisherKeysResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPublisherKeysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Keys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttestationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttestationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttestationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttestationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttestationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttestationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Attestations) > 0 {
+		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUpgradePathRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUpgradePathRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUpgradePathRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Platform) > 0 {
+		i -= len(m.Platform)
+		copy(dAtA[i:], m.Platform)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Platform)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUpgradePathResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUpgradePathResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUpgradePathResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Releases) > 0 {
+		for iNdEx := len(m.Releases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Releases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReleaseRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryReleaseByIDRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryReleaseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Release != nil {
+		l = m.Release.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReleasesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Page != 0 {
+		n += 1 + sovQuery(uint64(m.Page))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *QueryReleasesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Releases) > 0 {
+		for _, e := range m.Releases {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Total != 0 {
+		n += 1 + sovQuery(uint64(m.Total))
+	}
+	return n
+}
+
+func (m *QueryLatestRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Platform)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryByVersionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVersionRangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Range)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Platform)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Page != 0 {
+		n += 1 + sovQuery(uint64(m.Page))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *QueryVersionRangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Releases) > 0 {
+		for _, e := range m.Releases {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Total != 0 {
+		n += 1 + sovQuery(uint64(m.Total))
+	}
+	return n
+}
+
+func (m *QueryPublisherKeysRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Publisher)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPublisherKeysResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Keys) > 0 {
+		for _, e := range m.Keys {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAttestationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryAttestationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Attestations) > 0 {
+		for _, e := range m.Attestations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryUpgradePathRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Platform)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUpgradePathResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Releases) > 0 {
+		for _, e := range m.Releases {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReleaseRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReleaseRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReleaseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReleaseByIDRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReleaseByIDRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReleaseByIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReleaseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReleaseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReleaseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Release", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Release == nil {
+				m.Release = &Release{}
+			}
+			if err := m.Release.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReleasesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReleasesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReleasesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReleasesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReleasesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReleasesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Releases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Releases = append(m.Releases, &Release{})
+			if err := m.Releases[len(m.Releases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLatestRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLatestRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLatestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Platform", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Platform = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryByVersionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryByVersionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryByVersionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVersionRangeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
@@ -1253,25 +3196,191 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVersionRangeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVersionRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Range", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Range = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Platform", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Platform = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1293,7 +3402,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVersionRangeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1316,15 +3425,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVersionRangeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVersionRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Releases", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1351,68 +3460,16 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = &Params{}
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Releases = append(m.Releases, &Release{})
+			if err := m.Releases[len(m.Releases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryReleaseRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryReleaseRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryReleaseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
-			m.Id = 0
+			m.Total = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1422,7 +3479,7 @@ func (m *QueryReleaseRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.Total |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1448,7 +3505,7 @@ func (m *QueryReleaseRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryReleaseByIDRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPublisherKeysRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1471,17 +3528,17 @@ func (m *QueryReleaseByIDRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryReleaseByIDRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPublisherKeysRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryReleaseByIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPublisherKeysRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Publisher", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1491,11 +3548,24 @@ func (m *QueryReleaseByIDRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Publisher = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1517,7 +3587,7 @@ func (m *QueryReleaseByIDRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryReleaseResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPublisherKeysResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1540,15 +3610,15 @@ func (m *QueryReleaseResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryReleaseResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPublisherKeysResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryReleaseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPublisherKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Release", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1575,10 +3645,8 @@ func (m *QueryReleaseResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Release == nil {
-				m.Release = &Release{}
-			}
-			if err := m.Release.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Keys = append(m.Keys, &PublisherKey{})
+			if err := m.Keys[len(m.Keys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1603,7 +3671,7 @@ func (m *QueryReleaseResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryReleasesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAttestationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1626,36 +3694,17 @@ func (m *QueryReleasesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryReleasesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAttestationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryReleasesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAttestationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
-			}
-			m.Page = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Page |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.Limit = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1665,7 +3714,7 @@ func (m *QueryReleasesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1691,7 +3740,7 @@ func (m *QueryReleasesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryReleasesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAttestationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1714,15 +3763,15 @@ func (m *QueryReleasesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryReleasesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAttestationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryReleasesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAttestationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Releases", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1749,30 +3798,11 @@ func (m *QueryReleasesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Releases = append(m.Releases, &Release{})
-			if err := m.Releases[len(m.Releases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Attestations = append(m.Attestations, &Attestation{})
+			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
-			}
-			m.Total = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Total |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1794,7 +3824,7 @@ func (m *QueryReleasesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLatestRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryUpgradePathRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1817,15 +3847,15 @@ func (m *QueryLatestRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLatestRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUpgradePathRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLatestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUpgradePathRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1853,7 +3883,7 @@ func (m *QueryLatestRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Channel = string(dAtA[iNdEx:postIndex])
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -1919,6 +3949,38 @@ func (m *QueryLatestRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1940,7 +4002,7 @@ func (m *QueryLatestRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryByVersionRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryUpgradePathResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1963,17 +4025,17 @@ func (m *QueryByVersionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryByVersionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUpgradePathResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryByVersionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUpgradePathResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Releases", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1983,23 +4045,25 @@ func (m *QueryByVersionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.Releases = append(m.Releases, &Release{})
+			if err := m.Releases[len(m.Releases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
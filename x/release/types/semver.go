@@ -0,0 +1,171 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Semver is a parsed semantic version. Build metadata is dropped: it takes
+// no part in precedence.
+type Semver struct {
+	Major, Minor, Patch uint64
+	Pre                 []string
+}
+
+// ParseSemver parses a SemVer 2.0.0 version such as 1.4.0-rc.1+build.7.
+func ParseSemver(v string) (Semver, error) {
+	if !reSemver.MatchString(v) {
+		return Semver{}, fmt.Errorf("invalid semver %q", v)
+	}
+	if i := strings.IndexByte(v, '+'); i >= 0 {
+		v = v[:i]
+	}
+	var s Semver
+	core := v
+	if i := strings.IndexByte(v, '-'); i >= 0 {
+		core = v[:i]
+		s.Pre = strings.Split(v[i+1:], ".")
+	}
+	parts := strings.Split(core, ".")
+	for i, dst := range []*uint64{&s.Major, &s.Minor, &s.Patch} {
+		n, err := strconv.ParseUint(parts[i], 10, 64)
+		if err != nil {
+			return Semver{}, fmt.Errorf("invalid semver %q: %w", v, err)
+		}
+		*dst = n
+	}
+	return s, nil
+}
+
+// Compare returns -1, 0 or 1 as s has lower, equal or higher precedence than
+// o. A pre-release ranks below its normal version; pre-release identifiers
+// compare numerically when both are numeric, numeric below alphanumeric,
+// and a shorter list of equal identifiers ranks lower.
+func (s Semver) Compare(o Semver) int {
+	for _, c := range [][2]uint64{{s.Major, o.Major}, {s.Minor, o.Minor}, {s.Patch, o.Patch}} {
+		if c[0] != c[1] {
+			if c[0] < c[1] {
+				return -1
+			}
+			return 1
+		}
+	}
+	switch {
+	case len(s.Pre) == 0 && len(o.Pre) == 0:
+		return 0
+	case len(s.Pre) == 0:
+		return 1
+	case len(o.Pre) == 0:
+		return -1
+	}
+	for i := 0; i < len(s.Pre) && i < len(o.Pre); i++ {
+		if c := comparePreIdent(s.Pre[i], o.Pre[i]); c != 0 {
+			return c
+		}
+	}
+	switch {
+	case len(s.Pre) < len(o.Pre):
+		return -1
+	case len(s.Pre) > len(o.Pre):
+		return 1
+	}
+	return 0
+}
+
+func comparePreIdent(a, b string) int {
+	an, aErr := strconv.ParseUint(a, 10, 64)
+	bn, bErr := strconv.ParseUint(b, 10, 64)
+	switch {
+	case aErr == nil && bErr == nil:
+		if an == bn {
+			return 0
+		}
+		if an < bn {
+			return -1
+		}
+		return 1
+	case aErr == nil:
+		return -1
+	case bErr == nil:
+		return 1
+	}
+	return strings.Compare(a, b)
+}
+
+// CompareVersions compares two version strings by semver precedence.
+func CompareVersions(a, b string) (int, error) {
+	sa, err := ParseSemver(a)
+	if err != nil {
+		return 0, err
+	}
+	sb, err := ParseSemver(b)
+	if err != nil {
+		return 0, err
+	}
+	return sa.Compare(sb), nil
+}
+
+type versionConstraint struct {
+	op      string
+	version Semver
+}
+
+// VersionRange is a set of comparators that must all hold, e.g.
+// ">=1.4.0 <2.0.0".
+type VersionRange []versionConstraint
+
+// ParseVersionRange parses space-separated comparators (>=, >, <=, <, = or a
+// bare version for equality).
+func ParseVersionRange(s string) (VersionRange, error) {
+	if len(s) > VersionRangeMaxLen {
+		return nil, fmt.Errorf("range too long: %d > %d", len(s), VersionRangeMaxLen)
+	}
+	fields := strings.Fields(s)
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("empty range")
+	}
+	if len(fields) > MaxVersionRangeComparators {
+		return nil, fmt.Errorf("too many comparators: %d > %d", len(fields), MaxVersionRangeComparators)
+	}
+	r := make(VersionRange, 0, len(fields))
+	for _, f := range fields {
+		op := "="
+		for _, candidate := range []string{">=", "<=", ">", "<", "="} {
+			if strings.HasPrefix(f, candidate) {
+				op, f = candidate, f[len(candidate):]
+				break
+			}
+		}
+		v, err := ParseSemver(f)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, versionConstraint{op: op, version: v})
+	}
+	return r, nil
+}
+
+// Contains reports whether v satisfies every comparator of the range.
+func (r VersionRange) Contains(v Semver) bool {
+	for _, c := range r {
+		cmp := v.Compare(c.version)
+		var ok bool
+		switch c.op {
+		case ">=":
+			ok = cmp >= 0
+		case ">":
+			ok = cmp > 0
+		case "<=":
+			ok = cmp <= 0
+		case "<":
+			ok = cmp < 0
+		default:
+			ok = cmp == 0
+		}
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
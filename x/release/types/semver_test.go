@@ -0,0 +1,59 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"lumen/x/release/types"
+)
+
+func TestSemverPrecedence(t *testing.T) {
+	// SemVer 2.0.0 §11 example, lowest first.
+	ordered := []string{
+		"1.0.0-alpha", "1.0.0-alpha.1", "1.0.0-alpha.beta", "1.0.0-beta", "1.0.0-beta.2",
+		"1.0.0-beta.11", "1.0.0-rc.1", "1.0.0", "1.0.1", "1.10.0", "2.0.0",
+	}
+	for i := 1; i < len(ordered); i++ {
+		cmp, err := types.CompareVersions(ordered[i-1], ordered[i])
+		require.NoError(t, err)
+		require.Equal(t, -1, cmp, "%s < %s", ordered[i-1], ordered[i])
+		cmp, err = types.CompareVersions(ordered[i], ordered[i-1])
+		require.NoError(t, err)
+		require.Equal(t, 1, cmp)
+	}
+
+	cmp, err := types.CompareVersions("1.0.0+build.1", "1.0.0+build.2")
+	require.NoError(t, err)
+	require.Zero(t, cmp)
+	_, err = types.CompareVersions("1.0", "1.0.0")
+	require.Error(t, err)
+}
+
+func TestVersionRange(t *testing.T) {
+	rng, err := types.ParseVersionRange(">=1.4.0 <2.0.0")
+	require.NoError(t, err)
+	for v, want := range map[string]bool{
+		"1.3.9":        false,
+		"1.4.0-rc.1":   false,
+		"1.4.0":        true,
+		"1.9.9":        true,
+		"2.0.0-beta.1": true,
+		"2.0.0":        false,
+	} {
+		s, err := types.ParseSemver(v)
+		require.NoError(t, err)
+		require.Equal(t, want, rng.Contains(s), v)
+	}
+
+	exact, err := types.ParseVersionRange("1.2.3")
+	require.NoError(t, err)
+	s, err := types.ParseSemver("1.2.3+meta")
+	require.NoError(t, err)
+	require.True(t, exact.Contains(s))
+
+	for _, bad := range []string{"", ">=1.4", "~1.4.0", "=> 1.0.0"} {
+		_, err := types.ParseVersionRange(bad)
+		require.Error(t, err, bad)
+	}
+}
@@ -0,0 +1,35 @@
+package types
+
+import (
+	"crypto/sha256"
+	"strings"
+)
+
+// Signature algorithms accepted for publisher keys.
+const (
+	SigAlgoEd25519    = "ed25519"
+	SigAlgoDilithium3 = "dilithium3"
+)
+
+// artifactDigestDomain separates artifact digests from any other message a
+// publisher key might sign.
+const artifactDigestDomain = "lumen.release.v1.artifact"
+
+// IsSupportedSigAlgo reports whether algo names an accepted signature scheme.
+func IsSupportedSigAlgo(algo string) bool {
+	return algo == SigAlgoEd25519 || algo == SigAlgoDilithium3
+}
+
+// ArtifactDigest returns the canonical digest publishers sign for an
+// artifact: the SHA-256 of the domain tag, the lowercase sha256_hex, platform,
+// kind and release version, each on its own line.
+func ArtifactDigest(version string, a *Artifact) []byte {
+	var b strings.Builder
+	b.WriteString(artifactDigestDomain)
+	for _, field := range []string{strings.ToLower(a.Sha256Hex), a.Platform, a.Kind, version} {
+		b.WriteByte('\n')
+		b.WriteString(strings.TrimSpace(field))
+	}
+	sum := sha256.Sum256([]byte(b.String()))
+	return sum[:]
+}
@@ -495,789 +495,2888 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgPublishRelease)(nil), "lumen.release.v1.MsgPublishRelease")
-	proto.RegisterType((*MsgPublishReleaseResponse)(nil), "lumen.release.v1.MsgPublishReleaseResponse")
-	proto.RegisterType((*MsgSetEmergency)(nil), "lumen.release.v1.MsgSetEmergency")
-	proto.RegisterType((*MsgSetEmergencyResponse)(nil), "lumen.release.v1.MsgSetEmergencyResponse")
-	proto.RegisterType((*MsgValidateRelease)(nil), "lumen.release.v1.MsgValidateRelease")
-	proto.RegisterType((*MsgValidateReleaseResponse)(nil), "lumen.release.v1.MsgValidateReleaseResponse")
-	proto.RegisterType((*MsgRejectRelease)(nil), "lumen.release.v1.MsgRejectRelease")
-	proto.RegisterType((*MsgRejectReleaseResponse)(nil), "lumen.release.v1.MsgRejectReleaseResponse")
-	proto.RegisterType((*MsgUpdateParams)(nil), "lumen.release.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "lumen.release.v1.MsgUpdateParamsResponse")
+type MsgRegisterPublisherKey struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	KeyId   string `protobuf:"bytes,2,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	Algo    string `protobuf:"bytes,3,opt,name=algo,proto3" json:"algo,omitempty"`
+	PubKey  []byte `protobuf:"bytes,4,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
 }
 
-func init() { proto.RegisterFile("lumen/release/v1/tx.proto", fileDescriptor_e4221d9a8ae5bdbc) }
-
-var fileDescriptor_e4221d9a8ae5bdbc = []byte{
-	// 605 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x41, 0x6f, 0x12, 0x41,
-	0x14, 0x66, 0xa0, 0xb6, 0xf2, 0x4a, 0x69, 0xbb, 0xa9, 0xe9, 0xb2, 0xa9, 0x5b, 0xba, 0xf5, 0x80,
-	0x54, 0xd9, 0x14, 0x13, 0x0f, 0x98, 0x98, 0x48, 0xe2, 0x91, 0xd8, 0x6c, 0xd5, 0x83, 0x31, 0x21,
-	0x0b, 0x4c, 0xa6, 0xdb, 0xee, 0x32, 0x64, 0x67, 0x68, 0xca, 0xcd, 0x78, 0xf4, 0xe4, 0xc5, 0xff,
-	0x60, 0xe2, 0x85, 0x83, 0x27, 0x7f, 0x41, 0x8f, 0x8d, 0x27, 0x4f, 0xc6, 0xc0, 0x81, 0x3f, 0xe0,
-	0x0f, 0x30, 0xec, 0xce, 0x2e, 0xb0, 0x8b, 0xa1, 0x69, 0xd2, 0x0b, 0x61, 0xe7, 0x7d, 0xdf, 0x7b,
-	0xdf, 0x9b, 0xf7, 0xbd, 0x81, 0x9c, 0xdd, 0x75, 0x70, 0x5b, 0x77, 0xb1, 0x8d, 0x4d, 0x86, 0xf5,
-	0xf3, 0x43, 0x9d, 0x5f, 0x94, 0x3a, 0x2e, 0xe5, 0x54, 0xda, 0xf0, 0x42, 0x25, 0x11, 0x2a, 0x9d,
-	0x1f, 0x2a, 0x9b, 0xa6, 0x63, 0xb5, 0xa9, 0xee, 0xfd, 0xfa, 0x20, 0x65, 0xbb, 0x49, 0x99, 0x43,
-	0x99, 0xee, 0x30, 0x32, 0x26, 0x3b, 0x8c, 0x88, 0x40, 0xce, 0x0f, 0xd4, 0xbd, 0x2f, 0xdd, 0xff,
-	0x10, 0xa1, 0x2d, 0x42, 0x09, 0xf5, 0xcf, 0xc7, 0xff, 0xc4, 0xe9, 0x4e, 0x5c, 0x49, 0xaf, 0x83,
-	0x03, 0xce, 0xfd, 0x58, 0xb4, 0x63, 0xba, 0xa6, 0x23, 0xc2, 0xda, 0x17, 0x04, 0x9b, 0x35, 0x46,
-	0x8e, 0xba, 0x0d, 0xdb, 0x62, 0x27, 0x86, 0x8f, 0x92, 0xca, 0xb0, 0xd2, 0x74, 0xb1, 0xc9, 0xa9,
-	0x2b, 0xa3, 0x3c, 0x2a, 0xa4, 0xab, 0xf2, 0xcf, 0xef, 0x8f, 0xb7, 0x84, 0x96, 0x17, 0xad, 0x96,
-	0x8b, 0x19, 0x3b, 0xe6, 0xae, 0xd5, 0x26, 0x46, 0x00, 0x94, 0x9e, 0xc3, 0x8a, 0x28, 0x22, 0x27,
-	0xf3, 0xa8, 0xb0, 0x5a, 0xce, 0x95, 0xa2, 0xf7, 0x50, 0x12, 0xf9, 0xab, 0xe9, 0xcb, 0xdf, 0xbb,
-	0x89, 0xaf, 0xa3, 0x7e, 0x11, 0x19, 0x01, 0xa9, 0x92, 0xf9, 0x38, 0xea, 0x17, 0x83, 0x6c, 0xda,
-	0x01, 0xe4, 0x62, 0xb2, 0x0c, 0xcc, 0x3a, 0xb4, 0xcd, 0xb0, 0x94, 0x85, 0xa4, 0xd5, 0xf2, 0x94,
-	0x2d, 0x19, 0x49, 0xab, 0xa5, 0x7d, 0x43, 0xb0, 0x5e, 0x63, 0xe4, 0x18, 0xf3, 0x97, 0x0e, 0x76,
-	0x09, 0x6e, 0x37, 0x7b, 0x37, 0x6a, 0xc1, 0xcf, 0x9b, 0x0c, 0xf2, 0x4a, 0x7b, 0x90, 0xc1, 0x41,
-	0xc2, 0x3a, 0x3d, 0x93, 0x53, 0x79, 0x54, 0xb8, 0x6b, 0xac, 0x86, 0x67, 0xaf, 0xce, 0xa4, 0x7d,
-	0x58, 0x9b, 0x40, 0x38, 0xb7, 0xe5, 0xa5, 0x3c, 0x2a, 0xa4, 0x8c, 0x09, 0xef, 0x35, 0xb7, 0x23,
-	0xad, 0xe5, 0x60, 0x3b, 0x22, 0x36, 0x68, 0x4c, 0xb3, 0x41, 0xaa, 0x31, 0xf2, 0xd6, 0xb4, 0xad,
-	0x96, 0xc9, 0x71, 0x30, 0x8d, 0xa7, 0x90, 0x36, 0xbb, 0xfc, 0x84, 0xba, 0x16, 0xef, 0x2d, 0x6c,
-	0x66, 0x02, 0x8d, 0xb6, 0x53, 0xc9, 0x8e, 0x65, 0x4c, 0xe2, 0xda, 0x0e, 0x28, 0xf1, 0x6a, 0xa1,
-	0x96, 0x53, 0xd8, 0xa8, 0x31, 0x62, 0xe0, 0x53, 0xdc, 0xe4, 0xb7, 0xad, 0x44, 0x01, 0x39, 0x5a,
-	0x2b, 0xd4, 0xf1, 0xc3, 0x1f, 0xee, 0x9b, 0xce, 0x58, 0xe4, 0x91, 0xe7, 0xdd, 0x1b, 0xeb, 0x78,
-	0x06, 0xcb, 0xbe, 0xfb, 0x85, 0x45, 0xe5, 0xb8, 0x45, 0xfd, 0x0a, 0xd3, 0x0e, 0x15, 0x94, 0x4a,
-	0x79, 0x56, 0xf4, 0xa7, 0x51, 0xbf, 0xb8, 0xeb, 0x2f, 0xd7, 0x45, 0xb8, 0x5e, 0x11, 0xa1, 0x62,
-	0xd6, 0xd3, 0x47, 0x41, 0x5f, 0xe5, 0xbf, 0x29, 0x48, 0xd5, 0x18, 0x91, 0x1a, 0x90, 0x8d, 0x6c,
-	0xdf, 0x7e, 0x5c, 0x55, 0x6c, 0x17, 0x94, 0x83, 0x6b, 0x80, 0xc2, 0x85, 0x79, 0x0f, 0x99, 0x99,
-	0xe5, 0xd8, 0x9b, 0x4b, 0x9e, 0x86, 0x28, 0x0f, 0x17, 0x42, 0xc2, 0xec, 0x18, 0xd6, 0xa3, 0x96,
-	0x7d, 0x30, 0x97, 0x1d, 0x41, 0x29, 0x8f, 0xae, 0x83, 0x0a, 0xcb, 0xd4, 0x61, 0x6d, 0xd6, 0x8d,
-	0xda, 0x5c, 0xfa, 0x0c, 0x46, 0x29, 0x2e, 0xc6, 0x4c, 0xdf, 0xd2, 0x8c, 0xcb, 0xe6, 0xdf, 0xd2,
-	0x34, 0xe4, 0x3f, 0xb7, 0x34, 0x6f, 0xde, 0xca, 0x9d, 0x0f, 0x63, 0x37, 0x55, 0xf5, 0xcb, 0x81,
-	0x8a, 0xae, 0x06, 0x2a, 0xfa, 0x33, 0x50, 0xd1, 0xe7, 0xa1, 0x9a, 0xb8, 0x1a, 0xaa, 0x89, 0x5f,
-	0x43, 0x35, 0xf1, 0xee, 0x5e, 0xd4, 0x4c, 0xde, 0x33, 0xde, 0x58, 0xf6, 0x1e, 0xea, 0x27, 0xff,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0xea, 0x5a, 0xab, 0x12, 0x71, 0x06, 0x00, 0x00,
+func (m *MsgRegisterPublisherKey) Reset()         { *m = MsgRegisterPublisherKey{} }
+func (m *MsgRegisterPublisherKey) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterPublisherKey) ProtoMessage()    {}
+func (*MsgRegisterPublisherKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4221d9a8ae5bdbc, []int{10}
+}
+func (m *MsgRegisterPublisherKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterPublisherKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterPublisherKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterPublisherKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterPublisherKey.Merge(m, src)
+}
+func (m *MsgRegisterPublisherKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterPublisherKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterPublisherKey.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgRegisterPublisherKey proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	PublishRelease(ctx context.Context, in *MsgPublishRelease, opts ...grpc.CallOption) (*MsgPublishReleaseResponse, error)
-	SetEmergency(ctx context.Context, in *MsgSetEmergency, opts ...grpc.CallOption) (*MsgSetEmergencyResponse, error)
-	ValidateRelease(ctx context.Context, in *MsgValidateRelease, opts ...grpc.CallOption) (*MsgValidateReleaseResponse, error)
-	RejectRelease(ctx context.Context, in *MsgRejectRelease, opts ...grpc.CallOption) (*MsgRejectReleaseResponse, error)
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+func (m *MsgRegisterPublisherKey) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgRegisterPublisherKey) GetKeyId() string {
+	if m != nil {
+		return m.KeyId
+	}
+	return ""
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgRegisterPublisherKey) GetAlgo() string {
+	if m != nil {
+		return m.Algo
+	}
+	return ""
 }
 
-func (c *msgClient) PublishRelease(ctx context.Context, in *MsgPublishRelease, opts ...grpc.CallOption) (*MsgPublishReleaseResponse, error) {
-	out := new(MsgPublishReleaseResponse)
-	err := c.cc.Invoke(ctx, "/lumen.release.v1.Msg/PublishRelease", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterPublisherKey) GetPubKey() []byte {
+	if m != nil {
+		return m.PubKey
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) SetEmergency(ctx context.Context, in *MsgSetEmergency, opts ...grpc.CallOption) (*MsgSetEmergencyResponse, error) {
-	out := new(MsgSetEmergencyResponse)
-	err := c.cc.Invoke(ctx, "/lumen.release.v1.Msg/SetEmergency", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgRegisterPublisherKeyResponse struct {
 }
 
-func (c *msgClient) ValidateRelease(ctx context.Context, in *MsgValidateRelease, opts ...grpc.CallOption) (*MsgValidateReleaseResponse, error) {
-	out := new(MsgValidateReleaseResponse)
-	err := c.cc.Invoke(ctx, "/lumen.release.v1.Msg/ValidateRelease", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterPublisherKeyResponse) Reset()         { *m = MsgRegisterPublisherKeyResponse{} }
+func (m *MsgRegisterPublisherKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterPublisherKeyResponse) ProtoMessage()    {}
+func (*MsgRegisterPublisherKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4221d9a8ae5bdbc, []int{11}
+}
+func (m *MsgRegisterPublisherKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterPublisherKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterPublisherKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgRegisterPublisherKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterPublisherKeyResponse.Merge(m, src)
+}
+func (m *MsgRegisterPublisherKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterPublisherKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterPublisherKeyResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) RejectRelease(ctx context.Context, in *MsgRejectRelease, opts ...grpc.CallOption) (*MsgRejectReleaseResponse, error) {
-	out := new(MsgRejectReleaseResponse)
-	err := c.cc.Invoke(ctx, "/lumen.release.v1.Msg/RejectRelease", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRegisterPublisherKeyResponse proto.InternalMessageInfo
+
+type MsgRemovePublisherKey struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	KeyId   string `protobuf:"bytes,2,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+}
+
+func (m *MsgRemovePublisherKey) Reset()         { *m = MsgRemovePublisherKey{} }
+func (m *MsgRemovePublisherKey) String() string { return proto.CompactTextString(m) }
+func (*MsgRemovePublisherKey) ProtoMessage()    {}
+func (*MsgRemovePublisherKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4221d9a8ae5bdbc, []int{12}
+}
+func (m *MsgRemovePublisherKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemovePublisherKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemovePublisherKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgRemovePublisherKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemovePublisherKey.Merge(m, src)
+}
+func (m *MsgRemovePublisherKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemovePublisherKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemovePublisherKey.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/lumen.release.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRemovePublisherKey proto.InternalMessageInfo
+
+func (m *MsgRemovePublisherKey) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	PublishRelease(context.Context, *MsgPublishRelease) (*MsgPublishReleaseResponse, error)
-	SetEmergency(context.Context, *MsgSetEmergency) (*MsgSetEmergencyResponse, error)
-	ValidateRelease(context.Context, *MsgValidateRelease) (*MsgValidateReleaseResponse, error)
-	RejectRelease(context.Context, *MsgRejectRelease) (*MsgRejectReleaseResponse, error)
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+func (m *MsgRemovePublisherKey) GetKeyId() string {
+	if m != nil {
+		return m.KeyId
+	}
+	return ""
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+type MsgRemovePublisherKeyResponse struct {
 }
 
-func (*UnimplementedMsgServer) PublishRelease(ctx context.Context, req *MsgPublishRelease) (*MsgPublishReleaseResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PublishRelease not implemented")
+func (m *MsgRemovePublisherKeyResponse) Reset()         { *m = MsgRemovePublisherKeyResponse{} }
+func (m *MsgRemovePublisherKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemovePublisherKeyResponse) ProtoMessage()    {}
+func (*MsgRemovePublisherKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4221d9a8ae5bdbc, []int{13}
 }
-func (*UnimplementedMsgServer) SetEmergency(ctx context.Context, req *MsgSetEmergency) (*MsgSetEmergencyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetEmergency not implemented")
+func (m *MsgRemovePublisherKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) ValidateRelease(ctx context.Context, req *MsgValidateRelease) (*MsgValidateReleaseResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ValidateRelease not implemented")
+func (m *MsgRemovePublisherKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemovePublisherKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) RejectRelease(ctx context.Context, req *MsgRejectRelease) (*MsgRejectReleaseResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RejectRelease not implemented")
+func (m *MsgRemovePublisherKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemovePublisherKeyResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgRemovePublisherKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemovePublisherKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemovePublisherKeyResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgRemovePublisherKeyResponse proto.InternalMessageInfo
+
+// MsgYankRelease withdraws a release: it stops being served as the latest
+// release of its channel/platform/kind and the previous validated release
+// takes its place. Signed by the publisher or the authority.
+type MsgYankRelease struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Reason  string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func _Msg_PublishRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgPublishRelease)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).PublishRelease(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lumen.release.v1.Msg/PublishRelease",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).PublishRelease(ctx, req.(*MsgPublishRelease))
+func (m *MsgYankRelease) Reset()         { *m = MsgYankRelease{} }
+func (m *MsgYankRelease) String() string { return proto.CompactTextString(m) }
+func (*MsgYankRelease) ProtoMessage()    {}
+func (*MsgYankRelease) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4221d9a8ae5bdbc, []int{14}
+}
+func (m *MsgYankRelease) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgYankRelease) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgYankRelease.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgYankRelease) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgYankRelease.Merge(m, src)
+}
+func (m *MsgYankRelease) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgYankRelease) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgYankRelease.DiscardUnknown(m)
 }
 
-func _Msg_SetEmergency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetEmergency)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetEmergency(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lumen.release.v1.Msg/SetEmergency",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetEmergency(ctx, req.(*MsgSetEmergency))
+var xxx_messageInfo_MsgYankRelease proto.InternalMessageInfo
+
+func (m *MsgYankRelease) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_ValidateRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgValidateRelease)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ValidateRelease(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lumen.release.v1.Msg/ValidateRelease",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ValidateRelease(ctx, req.(*MsgValidateRelease))
+func (m *MsgYankRelease) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_RejectRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRejectRelease)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RejectRelease(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lumen.release.v1.Msg/RejectRelease",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RejectRelease(ctx, req.(*MsgRejectRelease))
+func (m *MsgYankRelease) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lumen.release.v1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgYankReleaseResponse struct {
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "lumen.release.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "PublishRelease",
-			Handler:    _Msg_PublishRelease_Handler,
-		},
-		{
-			MethodName: "SetEmergency",
-			Handler:    _Msg_SetEmergency_Handler,
-		},
-		{
-			MethodName: "ValidateRelease",
-			Handler:    _Msg_ValidateRelease_Handler,
-		},
-		{
-			MethodName: "RejectRelease",
-			Handler:    _Msg_RejectRelease_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "lumen/release/v1/tx.proto",
+func (m *MsgYankReleaseResponse) Reset()         { *m = MsgYankReleaseResponse{} }
+func (m *MsgYankReleaseResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgYankReleaseResponse) ProtoMessage()    {}
+func (*MsgYankReleaseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4221d9a8ae5bdbc, []int{15}
 }
-
-func (m *MsgPublishRelease) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgYankReleaseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgYankReleaseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgYankReleaseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgYankReleaseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgYankReleaseResponse.Merge(m, src)
+}
+func (m *MsgYankReleaseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgYankReleaseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgYankReleaseResponse.DiscardUnknown(m)
 }
 
-func (m *MsgPublishRelease) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgYankReleaseResponse proto.InternalMessageInfo
+
+// MsgUnyankRelease restores a yanked release. Authority only.
+type MsgUnyankRelease struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Id        uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *MsgPublishRelease) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Release.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgUnyankRelease) Reset()         { *m = MsgUnyankRelease{} }
+func (m *MsgUnyankRelease) String() string { return proto.CompactTextString(m) }
+func (*MsgUnyankRelease) ProtoMessage()    {}
+func (*MsgUnyankRelease) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4221d9a8ae5bdbc, []int{16}
+}
+func (m *MsgUnyankRelease) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnyankRelease) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnyankRelease.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgUnyankRelease) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnyankRelease.Merge(m, src)
+}
+func (m *MsgUnyankRelease) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnyankRelease) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnyankRelease.DiscardUnknown(m)
 }
 
-func (m *MsgPublishReleaseResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUnyankRelease proto.InternalMessageInfo
+
+func (m *MsgUnyankRelease) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgPublishReleaseResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUnyankRelease) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-func (m *MsgPublishReleaseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+type MsgUnyankReleaseResponse struct {
 }
 
-func (m *MsgSetEmergency) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUnyankReleaseResponse) Reset()         { *m = MsgUnyankReleaseResponse{} }
+func (m *MsgUnyankReleaseResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnyankReleaseResponse) ProtoMessage()    {}
+func (*MsgUnyankReleaseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4221d9a8ae5bdbc, []int{17}
+}
+func (m *MsgUnyankReleaseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnyankReleaseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnyankReleaseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgUnyankReleaseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnyankReleaseResponse.Merge(m, src)
+}
+func (m *MsgUnyankReleaseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnyankReleaseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnyankReleaseResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSetEmergency) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUnyankReleaseResponse proto.InternalMessageInfo
+
+// MsgAttestRelease submits an attester's rebuilt artifact hashes for a
+// pending release, one per artifact in release order.
+type MsgAttestRelease struct {
+	Attester  string   `protobuf:"bytes,1,opt,name=attester,proto3" json:"attester,omitempty"`
+	Id        uint64   `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Sha256Hex []string `protobuf:"bytes,3,rep,name=sha256_hex,json=sha256Hex,proto3" json:"sha256_hex,omitempty"`
 }
 
-func (m *MsgSetEmergency) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EmergencyTtl != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.EmergencyTtl))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.EmergencyOk {
-		i--
-		if m.EmergencyOk {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *MsgAttestRelease) Reset()         { *m = MsgAttestRelease{} }
+func (m *MsgAttestRelease) String() string { return proto.CompactTextString(m) }
+func (*MsgAttestRelease) ProtoMessage()    {}
+func (*MsgAttestRelease) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4221d9a8ae5bdbc, []int{18}
+}
+func (m *MsgAttestRelease) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAttestRelease) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAttestRelease.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
+		return b[:n], nil
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgAttestRelease) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAttestRelease.Merge(m, src)
+}
+func (m *MsgAttestRelease) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAttestRelease) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAttestRelease.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAttestRelease proto.InternalMessageInfo
+
+func (m *MsgAttestRelease) GetAttester() string {
+	if m != nil {
+		return m.Attester
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgSetEmergencyResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgAttestRelease) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgSetEmergencyResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgAttestRelease) GetSha256Hex() []string {
+	if m != nil {
+		return m.Sha256Hex
+	}
+	return nil
 }
 
-func (m *MsgSetEmergencyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgAttestReleaseResponse struct {
+	Match  bool                  `protobuf:"varint,1,opt,name=match,proto3" json:"match,omitempty"`
+	Status Release_ReleaseStatus `protobuf:"varint,2,opt,name=status,proto3,enum=lumen.release.v1.Release_ReleaseStatus" json:"status,omitempty"`
 }
 
-func (m *MsgValidateRelease) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgAttestReleaseResponse) Reset()         { *m = MsgAttestReleaseResponse{} }
+func (m *MsgAttestReleaseResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAttestReleaseResponse) ProtoMessage()    {}
+func (*MsgAttestReleaseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4221d9a8ae5bdbc, []int{19}
+}
+func (m *MsgAttestReleaseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAttestReleaseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAttestReleaseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgAttestReleaseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAttestReleaseResponse.Merge(m, src)
+}
+func (m *MsgAttestReleaseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAttestReleaseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAttestReleaseResponse.DiscardUnknown(m)
 }
 
-func (m *MsgValidateRelease) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgAttestReleaseResponse proto.InternalMessageInfo
+
+func (m *MsgAttestReleaseResponse) GetMatch() bool {
+	if m != nil {
+		return m.Match
+	}
+	return false
 }
 
-func (m *MsgValidateRelease) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgAttestReleaseResponse) GetStatus() Release_ReleaseStatus {
+	if m != nil {
+		return m.Status
 	}
-	return len(dAtA) - i, nil
+	return Release_PENDING
 }
 
-func (m *MsgValidateReleaseResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func init() {
+	proto.RegisterType((*MsgPublishRelease)(nil), "lumen.release.v1.MsgPublishRelease")
+	proto.RegisterType((*MsgPublishReleaseResponse)(nil), "lumen.release.v1.MsgPublishReleaseResponse")
+	proto.RegisterType((*MsgSetEmergency)(nil), "lumen.release.v1.MsgSetEmergency")
+	proto.RegisterType((*MsgSetEmergencyResponse)(nil), "lumen.release.v1.MsgSetEmergencyResponse")
+	proto.RegisterType((*MsgValidateRelease)(nil), "lumen.release.v1.MsgValidateRelease")
+	proto.RegisterType((*MsgValidateReleaseResponse)(nil), "lumen.release.v1.MsgValidateReleaseResponse")
+	proto.RegisterType((*MsgRejectRelease)(nil), "lumen.release.v1.MsgRejectRelease")
+	proto.RegisterType((*MsgRejectReleaseResponse)(nil), "lumen.release.v1.MsgRejectReleaseResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "lumen.release.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "lumen.release.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgRegisterPublisherKey)(nil), "lumen.release.v1.MsgRegisterPublisherKey")
+	proto.RegisterType((*MsgRegisterPublisherKeyResponse)(nil), "lumen.release.v1.MsgRegisterPublisherKeyResponse")
+	proto.RegisterType((*MsgRemovePublisherKey)(nil), "lumen.release.v1.MsgRemovePublisherKey")
+	proto.RegisterType((*MsgRemovePublisherKeyResponse)(nil), "lumen.release.v1.MsgRemovePublisherKeyResponse")
+	proto.RegisterType((*MsgYankRelease)(nil), "lumen.release.v1.MsgYankRelease")
+	proto.RegisterType((*MsgYankReleaseResponse)(nil), "lumen.release.v1.MsgYankReleaseResponse")
+	proto.RegisterType((*MsgUnyankRelease)(nil), "lumen.release.v1.MsgUnyankRelease")
+	proto.RegisterType((*MsgUnyankReleaseResponse)(nil), "lumen.release.v1.MsgUnyankReleaseResponse")
+	proto.RegisterType((*MsgAttestRelease)(nil), "lumen.release.v1.MsgAttestRelease")
+	proto.RegisterType((*MsgAttestReleaseResponse)(nil), "lumen.release.v1.MsgAttestReleaseResponse")
 }
 
-func (m *MsgValidateReleaseResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() { proto.RegisterFile("lumen/release/v1/tx.proto", fileDescriptor_e4221d9a8ae5bdbc) }
+
+var fileDescriptor_e4221d9a8ae5bdbc = []byte{
+	// 914 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0xae, 0x9b, 0x36, 0x6d, 0x5e, 0xdb, 0xec, 0xae, 0xd5, 0x6e, 0x1d, 0x6b, 0x9b, 0xa6, 0x59,
+	0xa4, 0x4d, 0xb3, 0x10, 0xab, 0x01, 0xf6, 0x50, 0x24, 0xd0, 0x56, 0x42, 0x02, 0xad, 0x22, 0x56,
+	0x2e, 0x8b, 0xb4, 0x08, 0x29, 0x72, 0x92, 0x91, 0xe3, 0x8d, 0x7f, 0xe1, 0x99, 0x54, 0x35, 0x27,
+	0xc4, 0x81, 0x03, 0x27, 0x2e, 0x9c, 0xb9, 0x22, 0x71, 0xe9, 0x81, 0x13, 0x7f, 0xc1, 0x1e, 0x57,
+	0x9c, 0x38, 0x21, 0xd4, 0x1e, 0xfa, 0x27, 0x70, 0x45, 0x19, 0x8f, 0xa7, 0xf6, 0xd8, 0x95, 0xa3,
+	0xae, 0x7a, 0x69, 0x33, 0xf3, 0xbe, 0xf7, 0xbe, 0xef, 0xcd, 0xbc, 0x79, 0xcf, 0x50, 0xb3, 0xa7,
+	0x0e, 0x72, 0xb5, 0x00, 0xd9, 0xc8, 0xc0, 0x48, 0x3b, 0x39, 0xd0, 0xc8, 0x69, 0xc7, 0x0f, 0x3c,
+	0xe2, 0xc9, 0x77, 0xa9, 0xa9, 0xc3, 0x4c, 0x9d, 0x93, 0x03, 0xf5, 0x9e, 0xe1, 0x58, 0xae, 0xa7,
+	0xd1, 0xbf, 0x11, 0x48, 0xdd, 0x1e, 0x7a, 0xd8, 0xf1, 0xb0, 0xe6, 0x60, 0x73, 0xe6, 0xec, 0x60,
+	0x93, 0x19, 0x6a, 0x91, 0xa1, 0x4f, 0x57, 0x5a, 0xb4, 0x60, 0xa6, 0x4d, 0xd3, 0x33, 0xbd, 0x68,
+	0x7f, 0xf6, 0x8b, 0xed, 0x3e, 0xc8, 0x2a, 0x09, 0x7d, 0x14, 0xfb, 0xec, 0x64, 0xac, 0xbe, 0x11,
+	0x18, 0x0e, 0x33, 0x37, 0x7f, 0x91, 0xe0, 0x5e, 0x0f, 0x9b, 0xcf, 0xa7, 0x03, 0xdb, 0xc2, 0x63,
+	0x3d, 0x42, 0xc9, 0x5d, 0x58, 0x19, 0x06, 0xc8, 0x20, 0x5e, 0xa0, 0x48, 0x0d, 0xa9, 0x55, 0x39,
+	0x52, 0xfe, 0xfa, 0xe3, 0xbd, 0x4d, 0xa6, 0xe5, 0xe9, 0x68, 0x14, 0x20, 0x8c, 0x8f, 0x49, 0x60,
+	0xb9, 0xa6, 0x1e, 0x03, 0xe5, 0x8f, 0x61, 0x85, 0x91, 0x28, 0x8b, 0x0d, 0xa9, 0xb5, 0xd6, 0xad,
+	0x75, 0xc4, 0x73, 0xe8, 0xb0, 0xf8, 0x47, 0x95, 0xd7, 0xff, 0xec, 0x2e, 0xfc, 0x76, 0x79, 0xd6,
+	0x96, 0xf4, 0xd8, 0xe9, 0x70, 0xfd, 0x87, 0xcb, 0xb3, 0x76, 0x1c, 0xad, 0xf9, 0x18, 0x6a, 0x19,
+	0x59, 0x3a, 0xc2, 0xbe, 0xe7, 0x62, 0x24, 0x57, 0x61, 0xd1, 0x1a, 0x51, 0x65, 0x4b, 0xfa, 0xa2,
+	0x35, 0x6a, 0xfe, 0x2e, 0xc1, 0x9d, 0x1e, 0x36, 0x8f, 0x11, 0xf9, 0xd4, 0x41, 0x81, 0x89, 0xdc,
+	0x61, 0x78, 0xa3, 0x14, 0xa2, 0xb8, 0x8b, 0x71, 0x5c, 0x79, 0x0f, 0xd6, 0x51, 0x1c, 0xb0, 0xef,
+	0x4d, 0x94, 0x52, 0x43, 0x6a, 0xad, 0xea, 0x6b, 0x7c, 0xef, 0x8b, 0x89, 0xfc, 0x10, 0x36, 0xae,
+	0x20, 0x84, 0xd8, 0xca, 0x52, 0x43, 0x6a, 0x95, 0xf4, 0x2b, 0xbf, 0x2f, 0x89, 0x2d, 0xa4, 0x56,
+	0x83, 0x6d, 0x41, 0x6c, 0x9c, 0x58, 0xd3, 0x06, 0xb9, 0x87, 0xcd, 0xaf, 0x0c, 0xdb, 0x1a, 0x19,
+	0x04, 0xc5, 0xb7, 0xf1, 0x04, 0x2a, 0xc6, 0x94, 0x8c, 0xbd, 0xc0, 0x22, 0x61, 0x61, 0x32, 0x57,
+	0x50, 0x31, 0x9d, 0xc3, 0xea, 0x4c, 0xc6, 0x95, 0xbd, 0xf9, 0x00, 0xd4, 0x2c, 0x1b, 0xd7, 0xf2,
+	0x0a, 0xee, 0xf6, 0xb0, 0xa9, 0xa3, 0x57, 0x68, 0x48, 0x6e, 0x5b, 0x89, 0x0a, 0x8a, 0xc8, 0xc5,
+	0x75, 0xfc, 0x19, 0x5d, 0xee, 0x0b, 0x7f, 0x26, 0xf2, 0x39, 0xad, 0xdd, 0x1b, 0xeb, 0xf8, 0x08,
+	0xca, 0x51, 0xf5, 0xb3, 0x12, 0x55, 0xb2, 0x25, 0x1a, 0x31, 0x24, 0x2b, 0x94, 0xb9, 0x1c, 0x76,
+	0xd3, 0xa2, 0x7f, 0xba, 0x3c, 0x6b, 0xef, 0x46, 0x8f, 0xeb, 0x94, 0x3f, 0x2f, 0x41, 0x28, 0xbb,
+	0xeb, 0xe4, 0x16, 0xcf, 0xeb, 0x57, 0x89, 0xda, 0x74, 0x64, 0x5a, 0x98, 0xa0, 0x80, 0x95, 0x3a,
+	0x0a, 0x9e, 0xa1, 0x9b, 0x15, 0xef, 0x16, 0x94, 0x27, 0x28, 0xec, 0xb3, 0x73, 0xae, 0xe8, 0xcb,
+	0x13, 0x14, 0x7e, 0x3e, 0x92, 0x65, 0x58, 0x32, 0x6c, 0xd3, 0xa3, 0xb5, 0x5b, 0xd1, 0xe9, 0x6f,
+	0x79, 0x1b, 0x56, 0xfc, 0xe9, 0xa0, 0x3f, 0x41, 0x21, 0x2d, 0xd7, 0x75, 0xbd, 0xec, 0x4f, 0x07,
+	0xcf, 0x50, 0x28, 0x14, 0xea, 0x1e, 0xec, 0x5e, 0x23, 0x90, 0x27, 0xe1, 0xc3, 0x16, 0x85, 0x38,
+	0xde, 0x09, 0xba, 0xa5, 0x0c, 0x04, 0x51, 0xbb, 0xb0, 0x93, 0xcb, 0xc8, 0x25, 0x7d, 0x07, 0xd5,
+	0x1e, 0x36, 0x5f, 0x1a, 0xee, 0xe4, 0x6d, 0xba, 0x99, 0xd8, 0x0a, 0xee, 0x43, 0x39, 0x40, 0x06,
+	0xf6, 0x5c, 0x76, 0x90, 0x6c, 0x25, 0x88, 0x53, 0xe0, 0x7e, 0x9a, 0x5b, 0x78, 0x4d, 0x2f, 0xdc,
+	0x30, 0xa1, 0xeb, 0x76, 0x5f, 0x53, 0x8a, 0x8b, 0xeb, 0xf8, 0x51, 0xa2, 0x42, 0x9e, 0x12, 0x82,
+	0x30, 0x7f, 0xd6, 0x1f, 0xc0, 0xaa, 0x41, 0x37, 0x50, 0xf1, 0x09, 0x71, 0x64, 0xe6, 0x88, 0x76,
+	0x00, 0xf0, 0xd8, 0xe8, 0x7e, 0xf8, 0xa4, 0x3f, 0x46, 0xa7, 0x4a, 0xa9, 0x51, 0x6a, 0x55, 0xf4,
+	0x4a, 0xb4, 0xf3, 0x19, 0x3a, 0x3d, 0xdc, 0x98, 0xa9, 0xe4, 0xde, 0xcd, 0x6f, 0x41, 0x11, 0x75,
+	0xf0, 0xfe, 0xbe, 0x09, 0xcb, 0x8e, 0x41, 0x86, 0x63, 0x2a, 0x66, 0x55, 0x8f, 0x16, 0xf2, 0x27,
+	0x50, 0xc6, 0xc4, 0x20, 0xd3, 0xe8, 0xf1, 0x56, 0xbb, 0x8f, 0xae, 0x9d, 0x2f, 0xf1, 0xff, 0x63,
+	0x0a, 0xd7, 0x99, 0x5b, 0xf7, 0xbf, 0x15, 0x28, 0xf5, 0xb0, 0x29, 0x0f, 0xa0, 0x2a, 0xcc, 0xbb,
+	0x87, 0xd9, 0x50, 0x99, 0xe9, 0xa3, 0x3e, 0x9e, 0x03, 0xc4, 0x53, 0xf8, 0x06, 0xd6, 0x53, 0xe3,
+	0x68, 0x2f, 0xd7, 0x39, 0x09, 0x51, 0xf7, 0x0b, 0x21, 0x3c, 0x3a, 0x82, 0x3b, 0xe2, 0x90, 0x78,
+	0x27, 0xd7, 0x5b, 0x40, 0xa9, 0xef, 0xce, 0x83, 0xe2, 0x34, 0x7d, 0xd8, 0x48, 0xf7, 0xff, 0x66,
+	0xae, 0x7b, 0x0a, 0xa3, 0xb6, 0x8b, 0x31, 0xc9, 0x53, 0x4a, 0xf5, 0xf5, 0xfc, 0x53, 0x4a, 0x42,
+	0xd4, 0xfd, 0x42, 0x08, 0x8f, 0x4e, 0x60, 0x33, 0xb7, 0xbb, 0xee, 0x5f, 0xa3, 0x30, 0x0b, 0x55,
+	0x0f, 0xe6, 0x86, 0x72, 0x56, 0x17, 0xe4, 0x9c, 0x7e, 0xf8, 0xe8, 0x9a, 0x40, 0x22, 0x50, 0xd5,
+	0xe6, 0x04, 0x72, 0xbe, 0x97, 0xb0, 0x96, 0x6c, 0x76, 0x8d, 0x5c, 0xff, 0x04, 0x42, 0x6d, 0x15,
+	0x21, 0x92, 0xf7, 0x9f, 0xee, 0x58, 0xf9, 0xf7, 0x9f, 0xc2, 0xa8, 0xed, 0x62, 0x4c, 0x92, 0x20,
+	0xdd, 0x89, 0xf2, 0x09, 0x52, 0x18, 0xb5, 0x5d, 0x8c, 0x89, 0x09, 0xd4, 0xe5, 0xef, 0x67, 0x23,
+	0xfc, 0x48, 0x7b, 0x7d, 0x5e, 0x97, 0xde, 0x9c, 0xd7, 0xa5, 0x7f, 0xcf, 0xeb, 0xd2, 0xcf, 0x17,
+	0xf5, 0x85, 0x37, 0x17, 0xf5, 0x85, 0xbf, 0x2f, 0xea, 0x0b, 0x5f, 0x6f, 0x89, 0x13, 0x9c, 0x7e,
+	0x3b, 0x0f, 0xca, 0xf4, 0xeb, 0xf8, 0xfd, 0xff, 0x07, 0x00, 0x09, 0x02, 0x0a, 0xaf, 0xe6, 0x0b,
+	0x00, 0x00,
 }
 
-func (m *MsgValidateReleaseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	PublishRelease(ctx context.Context, in *MsgPublishRelease, opts ...grpc.CallOption) (*MsgPublishReleaseResponse, error)
+	SetEmergency(ctx context.Context, in *MsgSetEmergency, opts ...grpc.CallOption) (*MsgSetEmergencyResponse, error)
+	ValidateRelease(ctx context.Context, in *MsgValidateRelease, opts ...grpc.CallOption) (*MsgValidateReleaseResponse, error)
+	RejectRelease(ctx context.Context, in *MsgRejectRelease, opts ...grpc.CallOption) (*MsgRejectReleaseResponse, error)
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	RegisterPublisherKey(ctx context.Context, in *MsgRegisterPublisherKey, opts ...grpc.CallOption) (*MsgRegisterPublisherKeyResponse, error)
+	RemovePublisherKey(ctx context.Context, in *MsgRemovePublisherKey, opts ...grpc.CallOption) (*MsgRemovePublisherKeyResponse, error)
+	YankRelease(ctx context.Context, in *MsgYankRelease, opts ...grpc.CallOption) (*MsgYankReleaseResponse, error)
+	UnyankRelease(ctx context.Context, in *MsgUnyankRelease, opts ...grpc.CallOption) (*MsgUnyankReleaseResponse, error)
+	AttestRelease(ctx context.Context, in *MsgAttestRelease, opts ...grpc.CallOption) (*MsgAttestReleaseResponse, error)
 }
 
-func (m *MsgRejectRelease) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) PublishRelease(ctx context.Context, in *MsgPublishRelease, opts ...grpc.CallOption) (*MsgPublishReleaseResponse, error) {
+	out := new(MsgPublishReleaseResponse)
+	err := c.cc.Invoke(ctx, "/lumen.release.v1.Msg/PublishRelease", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgRejectRelease) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetEmergency(ctx context.Context, in *MsgSetEmergency, opts ...grpc.CallOption) (*MsgSetEmergencyResponse, error) {
+	out := new(MsgSetEmergencyResponse)
+	err := c.cc.Invoke(ctx, "/lumen.release.v1.Msg/SetEmergency", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgRejectRelease) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) ValidateRelease(ctx context.Context, in *MsgValidateRelease, opts ...grpc.CallOption) (*MsgValidateReleaseResponse, error) {
+	out := new(MsgValidateReleaseResponse)
+	err := c.cc.Invoke(ctx, "/lumen.release.v1.Msg/ValidateRelease", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgRejectReleaseResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RejectRelease(ctx context.Context, in *MsgRejectRelease, opts ...grpc.CallOption) (*MsgRejectReleaseResponse, error) {
+	out := new(MsgRejectReleaseResponse)
+	err := c.cc.Invoke(ctx, "/lumen.release.v1.Msg/RejectRelease", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgRejectReleaseResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/lumen.release.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgRejectReleaseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) RegisterPublisherKey(ctx context.Context, in *MsgRegisterPublisherKey, opts ...grpc.CallOption) (*MsgRegisterPublisherKeyResponse, error) {
+	out := new(MsgRegisterPublisherKeyResponse)
+	err := c.cc.Invoke(ctx, "/lumen.release.v1.Msg/RegisterPublisherKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RemovePublisherKey(ctx context.Context, in *MsgRemovePublisherKey, opts ...grpc.CallOption) (*MsgRemovePublisherKeyResponse, error) {
+	out := new(MsgRemovePublisherKeyResponse)
+	err := c.cc.Invoke(ctx, "/lumen.release.v1.Msg/RemovePublisherKey", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) YankRelease(ctx context.Context, in *MsgYankRelease, opts ...grpc.CallOption) (*MsgYankReleaseResponse, error) {
+	out := new(MsgYankReleaseResponse)
+	err := c.cc.Invoke(ctx, "/lumen.release.v1.Msg/YankRelease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) UnyankRelease(ctx context.Context, in *MsgUnyankRelease, opts ...grpc.CallOption) (*MsgUnyankReleaseResponse, error) {
+	out := new(MsgUnyankReleaseResponse)
+	err := c.cc.Invoke(ctx, "/lumen.release.v1.Msg/UnyankRelease", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AttestRelease(ctx context.Context, in *MsgAttestRelease, opts ...grpc.CallOption) (*MsgAttestReleaseResponse, error) {
+	out := new(MsgAttestReleaseResponse)
+	err := c.cc.Invoke(ctx, "/lumen.release.v1.Msg/AttestRelease", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	PublishRelease(context.Context, *MsgPublishRelease) (*MsgPublishReleaseResponse, error)
+	SetEmergency(context.Context, *MsgSetEmergency) (*MsgSetEmergencyResponse, error)
+	ValidateRelease(context.Context, *MsgValidateRelease) (*MsgValidateReleaseResponse, error)
+	RejectRelease(context.Context, *MsgRejectRelease) (*MsgRejectReleaseResponse, error)
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	RegisterPublisherKey(context.Context, *MsgRegisterPublisherKey) (*MsgRegisterPublisherKeyResponse, error)
+	RemovePublisherKey(context.Context, *MsgRemovePublisherKey) (*MsgRemovePublisherKeyResponse, error)
+	YankRelease(context.Context, *MsgYankRelease) (*MsgYankReleaseResponse, error)
+	UnyankRelease(context.Context, *MsgUnyankRelease) (*MsgUnyankReleaseResponse, error)
+	AttestRelease(context.Context, *MsgAttestRelease) (*MsgAttestReleaseResponse, error)
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (*UnimplementedMsgServer) PublishRelease(ctx context.Context, req *MsgPublishRelease) (*MsgPublishReleaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishRelease not implemented")
 }
-func (m *MsgPublishRelease) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Release.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func (*UnimplementedMsgServer) SetEmergency(ctx context.Context, req *MsgSetEmergency) (*MsgSetEmergencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEmergency not implemented")
 }
-
-func (m *MsgPublishReleaseResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
-	}
-	return n
+func (*UnimplementedMsgServer) ValidateRelease(ctx context.Context, req *MsgValidateRelease) (*MsgValidateReleaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateRelease not implemented")
 }
-
-func (m *MsgSetEmergency) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (*UnimplementedMsgServer) RejectRelease(ctx context.Context, req *MsgRejectRelease) (*MsgRejectReleaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectRelease not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) RegisterPublisherKey(ctx context.Context, req *MsgRegisterPublisherKey) (*MsgRegisterPublisherKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterPublisherKey not implemented")
+}
+func (*UnimplementedMsgServer) RemovePublisherKey(ctx context.Context, req *MsgRemovePublisherKey) (*MsgRemovePublisherKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePublisherKey not implemented")
+}
+func (*UnimplementedMsgServer) YankRelease(ctx context.Context, req *MsgYankRelease) (*MsgYankReleaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method YankRelease not implemented")
+}
+func (*UnimplementedMsgServer) UnyankRelease(ctx context.Context, req *MsgUnyankRelease) (*MsgUnyankReleaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnyankRelease not implemented")
+}
+func (*UnimplementedMsgServer) AttestRelease(ctx context.Context, req *MsgAttestRelease) (*MsgAttestReleaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttestRelease not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_PublishRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPublishRelease)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	if interceptor == nil {
+		return srv.(MsgServer).PublishRelease(ctx, in)
 	}
-	if m.EmergencyOk {
-		n += 2
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumen.release.v1.Msg/PublishRelease",
 	}
-	if m.EmergencyTtl != 0 {
-		n += 1 + sovTx(uint64(m.EmergencyTtl))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PublishRelease(ctx, req.(*MsgPublishRelease))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSetEmergencyResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SetEmergency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetEmergency)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).SetEmergency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumen.release.v1.Msg/SetEmergency",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetEmergency(ctx, req.(*MsgSetEmergency))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgValidateRelease) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ValidateRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgValidateRelease)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).ValidateRelease(ctx, in)
 	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumen.release.v1.Msg/ValidateRelease",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ValidateRelease(ctx, req.(*MsgValidateRelease))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgValidateReleaseResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RejectRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRejectRelease)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).RejectRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumen.release.v1.Msg/RejectRelease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RejectRelease(ctx, req.(*MsgRejectRelease))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRejectRelease) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
 	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumen.release.v1.Msg/UpdateParams",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRejectReleaseResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RegisterPublisherKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterPublisherKey)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterPublisherKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumen.release.v1.Msg/RegisterPublisherKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterPublisherKey(ctx, req.(*MsgRegisterPublisherKey))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RemovePublisherKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemovePublisherKey)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).RemovePublisherKey(ctx, in)
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumen.release.v1.Msg/RemovePublisherKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemovePublisherKey(ctx, req.(*MsgRemovePublisherKey))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_YankRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgYankRelease)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).YankRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumen.release.v1.Msg/YankRelease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).YankRelease(ctx, req.(*MsgYankRelease))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func _Msg_UnyankRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnyankRelease)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnyankRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumen.release.v1.Msg/UnyankRelease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnyankRelease(ctx, req.(*MsgUnyankRelease))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func _Msg_AttestRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAttestRelease)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AttestRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumen.release.v1.Msg/AttestRelease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AttestRelease(ctx, req.(*MsgAttestRelease))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (m *MsgPublishRelease) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "lumen.release.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PublishRelease",
+			Handler:    _Msg_PublishRelease_Handler,
+		},
+		{
+			MethodName: "SetEmergency",
+			Handler:    _Msg_SetEmergency_Handler,
+		},
+		{
+			MethodName: "ValidateRelease",
+			Handler:    _Msg_ValidateRelease_Handler,
+		},
+		{
+			MethodName: "RejectRelease",
+			Handler:    _Msg_RejectRelease_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "RegisterPublisherKey",
+			Handler:    _Msg_RegisterPublisherKey_Handler,
+		},
+		{
+			MethodName: "RemovePublisherKey",
+			Handler:    _Msg_RemovePublisherKey_Handler,
+		},
+		{
+			MethodName: "YankRelease",
+			Handler:    _Msg_YankRelease_Handler,
+		},
+		{
+			MethodName: "UnyankRelease",
+			Handler:    _Msg_UnyankRelease_Handler,
+		},
+		{
+			MethodName: "AttestRelease",
+			Handler:    _Msg_AttestRelease_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lumen/release/v1/tx.proto",
+}
+
+func (m *MsgPublishRelease) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPublishRelease) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPublishRelease) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Release.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPublishReleaseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPublishReleaseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPublishReleaseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetEmergency) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetEmergency) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetEmergency) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EmergencyTtl != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EmergencyTtl))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EmergencyOk {
+		i--
+		if m.EmergencyOk {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetEmergencyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetEmergencyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetEmergencyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgValidateRelease) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgValidateRelease) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgValidateRelease) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgValidateReleaseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgValidateReleaseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgValidateReleaseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRejectRelease) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRejectRelease) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRejectRelease) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRejectReleaseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRejectReleaseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRejectReleaseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterPublisherKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterPublisherKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterPublisherKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Algo) > 0 {
+		i -= len(m.Algo)
+		copy(dAtA[i:], m.Algo)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Algo)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.KeyId) > 0 {
+		i -= len(m.KeyId)
+		copy(dAtA[i:], m.KeyId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.KeyId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterPublisherKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterPublisherKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterPublisherKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemovePublisherKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemovePublisherKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemovePublisherKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.KeyId) > 0 {
+		i -= len(m.KeyId)
+		copy(dAtA[i:], m.KeyId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.KeyId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemovePublisherKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemovePublisherKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemovePublisherKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgYankRelease) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgYankRelease) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgYankRelease) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgYankReleaseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgYankReleaseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgYankReleaseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnyankRelease) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnyankRelease) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnyankRelease) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnyankReleaseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnyankReleaseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnyankReleaseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAttestRelease) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAttestRelease) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAttestRelease) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sha256Hex) > 0 {
+		for iNdEx := len(m.Sha256Hex) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Sha256Hex[iNdEx])
+			copy(dAtA[i:], m.Sha256Hex[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Sha256Hex[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Attester) > 0 {
+		i -= len(m.Attester)
+		copy(dAtA[i:], m.Attester)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Attester)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAttestReleaseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAttestReleaseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAttestReleaseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Match {
+		i--
+		if m.Match {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgPublishRelease) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Release.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgPublishReleaseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgSetEmergency) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	if m.EmergencyOk {
+		n += 2
+	}
+	if m.EmergencyTtl != 0 {
+		n += 1 + sovTx(uint64(m.EmergencyTtl))
+	}
+	return n
+}
+
+func (m *MsgSetEmergencyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgValidateRelease) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgValidateReleaseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRejectRelease) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgRejectReleaseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterPublisherKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.KeyId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Algo)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterPublisherKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemovePublisherKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.KeyId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemovePublisherKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgYankRelease) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgYankReleaseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnyankRelease) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgUnyankReleaseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAttestRelease) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Attester)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	if len(m.Sha256Hex) > 0 {
+		for _, s := range m.Sha256Hex {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAttestReleaseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Match {
+		n += 2
+	}
+	if m.Status != 0 {
+		n += 1 + sovTx(uint64(m.Status))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgPublishRelease) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPublishRelease: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPublishRelease: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Release", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Release.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPublishReleaseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPublishReleaseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPublishReleaseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetEmergency) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetEmergency: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetEmergency: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmergencyOk", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EmergencyOk = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmergencyTtl", wireType)
+			}
+			m.EmergencyTtl = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EmergencyTtl |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetEmergencyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetEmergencyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetEmergencyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgValidateRelease) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgValidateRelease: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgValidateRelease: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgValidateReleaseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgValidateReleaseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgValidateReleaseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRejectRelease) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRejectRelease: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRejectRelease: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRejectReleaseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRejectReleaseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRejectReleaseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterPublisherKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterPublisherKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterPublisherKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyId", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPublishRelease: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPublishRelease: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Algo", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1305,13 +3404,13 @@ func (m *MsgPublishRelease) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Algo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Release", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1321,23 +3420,24 @@ func (m *MsgPublishRelease) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Release.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -1361,7 +3461,7 @@ func (m *MsgPublishRelease) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPublishReleaseResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterPublisherKeyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1384,31 +3484,12 @@ func (m *MsgPublishReleaseResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPublishReleaseResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterPublisherKeyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPublishReleaseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterPublisherKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1430,7 +3511,7 @@ func (m *MsgPublishReleaseResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetEmergency) Unmarshal(dAtA []byte) error {
+func (m *MsgRemovePublisherKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1453,10 +3534,10 @@ func (m *MsgSetEmergency) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetEmergency: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemovePublisherKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetEmergency: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemovePublisherKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1492,10 +3573,10 @@ func (m *MsgSetEmergency) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyId", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1505,50 +3586,24 @@ func (m *MsgSetEmergency) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EmergencyOk", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			m.EmergencyOk = bool(v != 0)
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EmergencyTtl", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			m.EmergencyTtl = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EmergencyTtl |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.KeyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1570,7 +3625,7 @@ func (m *MsgSetEmergency) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetEmergencyResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemovePublisherKeyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1593,10 +3648,10 @@ func (m *MsgSetEmergencyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetEmergencyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemovePublisherKeyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetEmergencyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemovePublisherKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1620,7 +3675,7 @@ func (m *MsgSetEmergencyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgValidateRelease) Unmarshal(dAtA []byte) error {
+func (m *MsgYankRelease) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1643,15 +3698,15 @@ func (m *MsgValidateRelease) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgValidateRelease: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgYankRelease: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgValidateRelease: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgYankRelease: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1679,7 +3734,7 @@ func (m *MsgValidateRelease) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
@@ -1700,6 +3755,38 @@ func (m *MsgValidateRelease) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1721,7 +3808,7 @@ func (m *MsgValidateRelease) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgValidateReleaseResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgYankReleaseResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1744,10 +3831,10 @@ func (m *MsgValidateReleaseResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgValidateReleaseResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgYankReleaseResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgValidateReleaseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgYankReleaseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1771,7 +3858,7 @@ func (m *MsgValidateReleaseResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRejectRelease) Unmarshal(dAtA []byte) error {
+func (m *MsgUnyankRelease) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1794,10 +3881,10 @@ func (m *MsgRejectRelease) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRejectRelease: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnyankRelease: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRejectRelease: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnyankRelease: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1872,7 +3959,7 @@ func (m *MsgRejectRelease) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRejectReleaseResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnyankReleaseResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1895,10 +3982,10 @@ func (m *MsgRejectReleaseResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRejectReleaseResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnyankReleaseResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRejectReleaseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnyankReleaseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1922,7 +4009,7 @@ func (m *MsgRejectReleaseResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgAttestRelease) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1945,15 +4032,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAttestRelease: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAttestRelease: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attester", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1981,13 +4068,32 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Attester = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sha256Hex", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1997,24 +4103,23 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sha256Hex = append(m.Sha256Hex, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2037,7 +4142,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAttestReleaseResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2060,12 +4165,51 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAttestReleaseResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAttestReleaseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Match", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Match = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= Release_ReleaseStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -50,7 +50,7 @@ func (x Release_ReleaseStatus) String() string {
 }
 
 func (Release_ReleaseStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_c7f9ffdeccd852bf, []int{2, 0}
+	return fileDescriptor_c7f9ffdeccd852bf, []int{4, 0}
 }
 
 type Signature struct {
@@ -113,6 +113,162 @@ func (m *Signature) GetSig() []byte {
 	return nil
 }
 
+// Attestation is an attester's reproducible-build result for a pending
+// release: the hashes it rebuilt, one per artifact in release order.
+type Attestation struct {
+	ReleaseId   uint64   `protobuf:"varint,1,opt,name=release_id,json=releaseId,proto3" json:"release_id,omitempty"`
+	Attester    string   `protobuf:"bytes,2,opt,name=attester,proto3" json:"attester,omitempty"`
+	Sha256Hex   []string `protobuf:"bytes,3,rep,name=sha256_hex,json=sha256Hex,proto3" json:"sha256_hex,omitempty"`
+	Match       bool     `protobuf:"varint,4,opt,name=match,proto3" json:"match,omitempty"`
+	SubmittedAt int64    `protobuf:"varint,5,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+}
+
+func (m *Attestation) Reset()         { *m = Attestation{} }
+func (m *Attestation) String() string { return proto.CompactTextString(m) }
+func (*Attestation) ProtoMessage()    {}
+func (*Attestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c7f9ffdeccd852bf, []int{1}
+}
+func (m *Attestation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Attestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Attestation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Attestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Attestation.Merge(m, src)
+}
+func (m *Attestation) XXX_Size() int {
+	return m.Size()
+}
+func (m *Attestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Attestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Attestation proto.InternalMessageInfo
+
+func (m *Attestation) GetReleaseId() uint64 {
+	if m != nil {
+		return m.ReleaseId
+	}
+	return 0
+}
+
+func (m *Attestation) GetAttester() string {
+	if m != nil {
+		return m.Attester
+	}
+	return ""
+}
+
+func (m *Attestation) GetSha256Hex() []string {
+	if m != nil {
+		return m.Sha256Hex
+	}
+	return nil
+}
+
+func (m *Attestation) GetMatch() bool {
+	if m != nil {
+		return m.Match
+	}
+	return false
+}
+
+func (m *Attestation) GetSubmittedAt() int64 {
+	if m != nil {
+		return m.SubmittedAt
+	}
+	return 0
+}
+
+// PublisherKey is a signing key registered by an allowed publisher. Artifact
+// signatures are verified against the registered keys.
+type PublisherKey struct {
+	KeyId     string `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	Publisher string `protobuf:"bytes,2,opt,name=publisher,proto3" json:"publisher,omitempty"`
+	Algo      string `protobuf:"bytes,3,opt,name=algo,proto3" json:"algo,omitempty"`
+	PubKey    []byte `protobuf:"bytes,4,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	AddedAt   int64  `protobuf:"varint,5,opt,name=added_at,json=addedAt,proto3" json:"added_at,omitempty"`
+}
+
+func (m *PublisherKey) Reset()         { *m = PublisherKey{} }
+func (m *PublisherKey) String() string { return proto.CompactTextString(m) }
+func (*PublisherKey) ProtoMessage()    {}
+func (*PublisherKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c7f9ffdeccd852bf, []int{2}
+}
+func (m *PublisherKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PublisherKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PublisherKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PublisherKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PublisherKey.Merge(m, src)
+}
+func (m *PublisherKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *PublisherKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_PublisherKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PublisherKey proto.InternalMessageInfo
+
+func (m *PublisherKey) GetKeyId() string {
+	if m != nil {
+		return m.KeyId
+	}
+	return ""
+}
+
+func (m *PublisherKey) GetPublisher() string {
+	if m != nil {
+		return m.Publisher
+	}
+	return ""
+}
+
+func (m *PublisherKey) GetAlgo() string {
+	if m != nil {
+		return m.Algo
+	}
+	return ""
+}
+
+func (m *PublisherKey) GetPubKey() []byte {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+func (m *PublisherKey) GetAddedAt() int64 {
+	if m != nil {
+		return m.AddedAt
+	}
+	return 0
+}
+
 type Artifact struct {
 	Platform   string       `protobuf:"bytes,1,opt,name=platform,proto3" json:"platform,omitempty"`
 	Kind       string       `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
@@ -127,7 +283,7 @@ func (m *Artifact) Reset()         { *m = Artifact{} }
 func (m *Artifact) String() string { return proto.CompactTextString(m) }
 func (*Artifact) ProtoMessage()    {}
 func (*Artifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c7f9ffdeccd852bf, []int{1}
+	return fileDescriptor_c7f9ffdeccd852bf, []int{3}
 }
 func (m *Artifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -218,13 +374,16 @@ type Release struct {
 	Status         Release_ReleaseStatus `protobuf:"varint,10,opt,name=status,proto3,enum=lumen.release.v1.Release_ReleaseStatus" json:"status,omitempty"`
 	EmergencyOk    bool                  `protobuf:"varint,11,opt,name=emergency_ok,json=emergencyOk,proto3" json:"emergency_ok,omitempty"`
 	EmergencyUntil int64                 `protobuf:"varint,12,opt,name=emergency_until,json=emergencyUntil,proto3" json:"emergency_until,omitempty"`
+	YankReason     string                `protobuf:"bytes,13,opt,name=yank_reason,json=yankReason,proto3" json:"yank_reason,omitempty"`
+	YankedAt       int64                 `protobuf:"varint,14,opt,name=yanked_at,json=yankedAt,proto3" json:"yanked_at,omitempty"`
+	Required       bool                  `protobuf:"varint,15,opt,name=required,proto3" json:"required,omitempty"`
 }
 
 func (m *Release) Reset()         { *m = Release{} }
 func (m *Release) String() string { return proto.CompactTextString(m) }
 func (*Release) ProtoMessage()    {}
 func (*Release) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c7f9ffdeccd852bf, []int{2}
+	return fileDescriptor_c7f9ffdeccd852bf, []int{4}
 }
 func (m *Release) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -337,9 +496,32 @@ func (m *Release) GetEmergencyUntil() int64 {
 	return 0
 }
 
+func (m *Release) GetYankReason() string {
+	if m != nil {
+		return m.YankReason
+	}
+	return ""
+}
+
+func (m *Release) GetYankedAt() int64 {
+	if m != nil {
+		return m.YankedAt
+	}
+	return 0
+}
+
+func (m *Release) GetRequired() bool {
+	if m != nil {
+		return m.Required
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterEnum("lumen.release.v1.Release_ReleaseStatus", Release_ReleaseStatus_name, Release_ReleaseStatus_value)
 	proto.RegisterType((*Signature)(nil), "lumen.release.v1.Signature")
+	proto.RegisterType((*Attestation)(nil), "lumen.release.v1.Attestation")
+	proto.RegisterType((*PublisherKey)(nil), "lumen.release.v1.PublisherKey")
 	proto.RegisterType((*Artifact)(nil), "lumen.release.v1.Artifact")
 	proto.RegisterType((*Release)(nil), "lumen.release.v1.Release")
 }
@@ -347,42 +529,52 @@ func init() {
 func init() { proto.RegisterFile("lumen/release/v1/types.proto", fileDescriptor_c7f9ffdeccd852bf) }
 
 var fileDescriptor_c7f9ffdeccd852bf = []byte{
-	// 554 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x53, 0x41, 0x6f, 0xd3, 0x4c,
-	0x10, 0xcd, 0xc6, 0x6e, 0x12, 0x4f, 0xd2, 0x7e, 0xd1, 0xea, 0x2b, 0x5a, 0x95, 0x62, 0x99, 0x5c,
-	0xea, 0x53, 0xa2, 0x16, 0x81, 0x90, 0x38, 0xa0, 0x40, 0x0c, 0x0d, 0x42, 0xa5, 0xda, 0x02, 0x42,
-	0x5c, 0xa2, 0x6d, 0x3c, 0x4d, 0x2c, 0xbb, 0x76, 0xe4, 0x5d, 0x57, 0x0d, 0xbf, 0x82, 0x9f, 0xc5,
-	0xb1, 0x47, 0x8e, 0xa8, 0x3d, 0xf0, 0x37, 0xd0, 0x6e, 0xed, 0xa4, 0xd0, 0x53, 0x66, 0xde, 0xbc,
-	0xbc, 0x9d, 0xf7, 0xac, 0x81, 0xdd, 0xa4, 0x38, 0xc7, 0x74, 0x90, 0x63, 0x82, 0x42, 0xe2, 0xe0,
-	0x62, 0x7f, 0xa0, 0x96, 0x0b, 0x94, 0xfd, 0x45, 0x9e, 0xa9, 0x8c, 0x76, 0xcd, 0xb4, 0x5f, 0x4e,
-	0xfb, 0x17, 0xfb, 0xbd, 0x43, 0x70, 0x4e, 0xa2, 0x59, 0x2a, 0x54, 0x91, 0x23, 0xdd, 0x86, 0x46,
-	0x8c, 0xcb, 0x49, 0x14, 0x32, 0xe2, 0x11, 0xdf, 0xe1, 0x1b, 0x31, 0x2e, 0xc7, 0x21, 0xa5, 0x60,
-	0x8b, 0x64, 0x96, 0xb1, 0xba, 0x01, 0x4d, 0x4d, 0xbb, 0x60, 0xc9, 0x68, 0xc6, 0x2c, 0x8f, 0xf8,
-	0x1d, 0xae, 0xcb, 0xde, 0x15, 0x81, 0xd6, 0x30, 0x57, 0xd1, 0x99, 0x98, 0x2a, 0xba, 0x03, 0xad,
-	0x45, 0x22, 0xd4, 0x59, 0x96, 0x9f, 0x97, 0x5a, 0xab, 0x5e, 0xcb, 0xc5, 0x51, 0x1a, 0x56, 0x72,
-	0xba, 0xd6, 0x72, 0xd3, 0x28, 0x34, 0x72, 0x0e, 0xd7, 0x25, 0x7d, 0x04, 0x20, 0xe7, 0xe2, 0xe0,
-	0xe9, 0xb3, 0xc9, 0x1c, 0x2f, 0x99, 0x6d, 0x06, 0xce, 0x2d, 0x72, 0x88, 0x97, 0x5a, 0x44, 0x46,
-	0xdf, 0x90, 0x6d, 0x78, 0xc4, 0xb7, 0xb9, 0xa9, 0x35, 0x56, 0xe4, 0x89, 0x64, 0x0d, 0xcf, 0xd2,
-	0xc2, 0xba, 0xa6, 0x2f, 0x00, 0x64, 0xe5, 0x4f, 0xb2, 0xa6, 0x67, 0xf9, 0xed, 0x83, 0x87, 0xfd,
-	0x7f, 0x63, 0xe8, 0xaf, 0x32, 0xe0, 0x77, 0xe8, 0xbd, 0xdf, 0x16, 0x34, 0xf9, 0x2d, 0x89, 0x6e,
-	0x41, 0xbd, 0xcc, 0xc5, 0xe6, 0xf5, 0x28, 0xa4, 0x0c, 0x9a, 0x17, 0x98, 0xcb, 0x28, 0x4b, 0x4b,
-	0x23, 0x55, 0xab, 0x27, 0xd3, 0xb9, 0x48, 0x53, 0x4c, 0x4a, 0x3f, 0x55, 0x4b, 0x9f, 0x83, 0x23,
-	0xca, 0x84, 0x24, 0xb3, 0xcd, 0x2e, 0x3b, 0xf7, 0x77, 0xa9, 0x42, 0xe4, 0x6b, 0x32, 0xdd, 0x05,
-	0x67, 0x51, 0x9c, 0x26, 0x91, 0x9c, 0x63, 0x6e, 0x3c, 0x3b, 0x7c, 0x0d, 0xd0, 0xff, 0x61, 0x23,
-	0xcd, 0x14, 0x6a, 0xe7, 0xe6, 0xb3, 0x99, 0x46, 0x27, 0x38, 0xcd, 0x51, 0x28, 0x0c, 0x27, 0x42,
-	0xb1, 0xa6, 0x47, 0x7c, 0x8b, 0x3b, 0x25, 0x32, 0x54, 0xf4, 0x01, 0x34, 0x96, 0x22, 0x8d, 0x31,
-	0x64, 0x2d, 0x8f, 0xf8, 0x2d, 0x5e, 0x76, 0xd4, 0x05, 0x90, 0xc5, 0x02, 0x73, 0x89, 0x21, 0x4a,
-	0xe6, 0x78, 0x96, 0x6f, 0xf3, 0x3b, 0x08, 0x7d, 0x09, 0x0d, 0xa9, 0x84, 0x2a, 0x24, 0x03, 0x8f,
-	0xf8, 0x5b, 0x07, 0x7b, 0xf7, 0x1d, 0x94, 0x99, 0x55, 0xbf, 0x27, 0x86, 0xce, 0xcb, 0xbf, 0xd1,
-	0xc7, 0xd0, 0xc1, 0x73, 0xcc, 0x67, 0x98, 0x4e, 0x97, 0x93, 0x2c, 0x66, 0x6d, 0xf3, 0x7c, 0x7b,
-	0x85, 0x7d, 0x88, 0xe9, 0x1e, 0xfc, 0xb7, 0xa6, 0x14, 0xa9, 0x8a, 0x12, 0xd6, 0x31, 0xfb, 0x6f,
-	0xad, 0xe0, 0x4f, 0x1a, 0xed, 0xbd, 0x81, 0xcd, 0xbf, 0x1e, 0xa1, 0x6d, 0x68, 0x1e, 0x07, 0x47,
-	0xa3, 0xf1, 0xd1, 0xdb, 0x6e, 0x8d, 0x6e, 0x82, 0xf3, 0x79, 0xf8, 0x7e, 0x3c, 0x1a, 0x7e, 0x0c,
-	0x46, 0x5d, 0x42, 0x3b, 0xd0, 0xe2, 0xc1, 0xbb, 0xe0, 0xb5, 0xee, 0xea, 0x9a, 0x19, 0x7c, 0x39,
-	0x1e, 0xf3, 0x60, 0xd4, 0xb5, 0x5e, 0x0d, 0x7e, 0x5c, 0xbb, 0xe4, 0xea, 0xda, 0x25, 0xbf, 0xae,
-	0x5d, 0xf2, 0xfd, 0xc6, 0xad, 0x5d, 0xdd, 0xb8, 0xb5, 0x9f, 0x37, 0x6e, 0xed, 0xeb, 0xf6, 0xed,
-	0x41, 0x5d, 0xae, 0x4e, 0xca, 0xdc, 0xd3, 0x69, 0xc3, 0x1c, 0xd4, 0x93, 0x3f, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0xdc, 0xbf, 0x94, 0x37, 0x70, 0x03, 0x00, 0x00,
+	// 716 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0xcd, 0x6e, 0xeb, 0x44,
+	0x14, 0x8e, 0x63, 0xe7, 0xc7, 0x27, 0x69, 0x1a, 0x8d, 0x5a, 0x18, 0xda, 0x12, 0x4c, 0x36, 0xf5,
+	0x2a, 0x51, 0x8b, 0x40, 0x48, 0x2c, 0x90, 0x21, 0x81, 0x06, 0x50, 0xa9, 0xa6, 0x80, 0x10, 0x9b,
+	0x68, 0x12, 0x9f, 0x26, 0x56, 0x1c, 0x3b, 0x78, 0xc6, 0x55, 0xc3, 0x2b, 0xb0, 0xb9, 0x8f, 0x70,
+	0x5f, 0xe5, 0xee, 0xee, 0xb2, 0xcb, 0xbb, 0xbc, 0x6a, 0x5f, 0xe4, 0x6a, 0x26, 0xb6, 0x93, 0xb6,
+	0xba, 0xab, 0x9c, 0xef, 0x9b, 0xe3, 0x99, 0xef, 0x7c, 0xe7, 0xe4, 0xc0, 0x49, 0x98, 0x2e, 0x31,
+	0xea, 0x27, 0x18, 0x22, 0x17, 0xd8, 0xbf, 0x3d, 0xeb, 0xcb, 0xf5, 0x0a, 0x45, 0x6f, 0x95, 0xc4,
+	0x32, 0x26, 0x6d, 0x7d, 0xda, 0xcb, 0x4e, 0x7b, 0xb7, 0x67, 0xdd, 0x0b, 0xb0, 0xaf, 0x83, 0x59,
+	0xc4, 0x65, 0x9a, 0x20, 0x39, 0x84, 0xea, 0x02, 0xd7, 0xe3, 0xc0, 0xa7, 0x86, 0x63, 0xb8, 0x36,
+	0xab, 0x2c, 0x70, 0x3d, 0xf2, 0x09, 0x01, 0x8b, 0x87, 0xb3, 0x98, 0x96, 0x35, 0xa9, 0x63, 0xd2,
+	0x06, 0x53, 0x04, 0x33, 0x6a, 0x3a, 0x86, 0xdb, 0x64, 0x2a, 0xec, 0xbe, 0x36, 0xa0, 0xe1, 0x49,
+	0x89, 0x42, 0x72, 0x19, 0xc4, 0x11, 0xf9, 0x1c, 0x20, 0x7b, 0x27, 0xbf, 0xd0, 0x62, 0x76, 0xc6,
+	0x8c, 0x7c, 0x72, 0x04, 0x75, 0xae, 0xb3, 0x31, 0xc9, 0x2e, 0x2e, 0xb0, 0xfa, 0x54, 0xcc, 0xf9,
+	0xf9, 0xd7, 0xdf, 0x8c, 0xe7, 0x78, 0x47, 0x4d, 0xc7, 0x74, 0x6d, 0x66, 0x6f, 0x98, 0x0b, 0xbc,
+	0x23, 0x07, 0x50, 0x59, 0x72, 0x39, 0x9d, 0x53, 0xcb, 0x31, 0xdc, 0x3a, 0xdb, 0x00, 0xf2, 0x25,
+	0x34, 0x45, 0x3a, 0x59, 0x06, 0x52, 0xa2, 0x3f, 0xe6, 0x92, 0x56, 0x1c, 0xc3, 0x35, 0x59, 0xa3,
+	0xe0, 0x3c, 0xd9, 0xfd, 0xdf, 0x80, 0xe6, 0x55, 0x3a, 0x09, 0x03, 0x31, 0xc7, 0xe4, 0x57, 0x5c,
+	0x7f, 0xac, 0xe0, 0x13, 0xb0, 0x57, 0x79, 0x5a, 0x26, 0x6e, 0x4b, 0x14, 0x76, 0x98, 0x3b, 0x76,
+	0x7c, 0x0a, 0xb5, 0x55, 0x3a, 0x19, 0x2f, 0x70, 0xad, 0x45, 0x35, 0x59, 0x75, 0x95, 0x4e, 0xd4,
+	0x0b, 0x9f, 0x41, 0x9d, 0xfb, 0xfe, 0xae, 0xa2, 0x9a, 0xc6, 0x9e, 0xec, 0xde, 0x1b, 0x50, 0xf7,
+	0x12, 0x19, 0xdc, 0xf0, 0xa9, 0x54, 0x76, 0xac, 0x42, 0x2e, 0x6f, 0xe2, 0x64, 0x99, 0x69, 0x29,
+	0xb0, 0x7a, 0x70, 0x11, 0x44, 0x7e, 0xee, 0xbf, 0x8a, 0x95, 0xff, 0xd3, 0xc0, 0xcf, 0x34, 0xa8,
+	0xf0, 0x99, 0x69, 0xd6, 0x46, 0xf5, 0xd6, 0x34, 0x02, 0x96, 0x08, 0xfe, 0x43, 0x2d, 0xc2, 0x62,
+	0x3a, 0x56, 0x5c, 0x9a, 0x84, 0x82, 0x56, 0xb5, 0xc3, 0x3a, 0x26, 0xdf, 0x01, 0x88, 0x7c, 0x20,
+	0x04, 0xad, 0x39, 0xa6, 0xdb, 0x38, 0x3f, 0xee, 0x3d, 0x9f, 0x9b, 0x5e, 0x31, 0x34, 0x6c, 0x27,
+	0xbd, 0xfb, 0xc6, 0x82, 0x1a, 0xdb, 0x24, 0x91, 0x16, 0x94, 0x8b, 0xbe, 0x97, 0x03, 0x9f, 0x50,
+	0xa8, 0xdd, 0x62, 0x22, 0x82, 0x38, 0xca, 0x0a, 0xc9, 0xa1, 0x3a, 0x99, 0xce, 0x79, 0x14, 0x61,
+	0x98, 0xd5, 0x93, 0x43, 0xf2, 0x2d, 0xd8, 0x3c, 0x73, 0x48, 0x50, 0x4b, 0x6b, 0x39, 0x7a, 0xa9,
+	0x25, 0x37, 0x91, 0x6d, 0x93, 0x9f, 0xb6, 0xb0, 0xf2, 0xbc, 0x85, 0x07, 0x50, 0x89, 0x62, 0x89,
+	0xaa, 0x72, 0xdd, 0x76, 0x0d, 0x94, 0x83, 0xd3, 0x04, 0x79, 0x36, 0x3f, 0x35, 0xdd, 0x2d, 0x3b,
+	0x63, 0x3c, 0x49, 0x3e, 0x81, 0xea, 0x9a, 0x47, 0x0b, 0xf4, 0x69, 0x5d, 0xcf, 0x5d, 0x86, 0x48,
+	0x07, 0x40, 0xa4, 0x2b, 0x4c, 0x04, 0xfa, 0x28, 0xa8, 0xed, 0x98, 0xae, 0xc5, 0x76, 0x18, 0xf2,
+	0x3d, 0x54, 0xd5, 0x7f, 0x22, 0x15, 0x14, 0x1c, 0xc3, 0x6d, 0x9d, 0x9f, 0xbe, 0xac, 0x20, 0xf3,
+	0x2c, 0xff, 0xbd, 0xd6, 0xe9, 0x2c, 0xfb, 0x4c, 0x4d, 0x36, 0x2e, 0x31, 0x99, 0x61, 0x34, 0x5d,
+	0x8f, 0xe3, 0x05, 0x6d, 0xe8, 0xe7, 0x1b, 0x05, 0xf7, 0xfb, 0x82, 0x9c, 0xc2, 0xfe, 0x36, 0x25,
+	0x8d, 0x64, 0x10, 0xd2, 0xa6, 0xd6, 0xdf, 0x2a, 0xe8, 0x3f, 0x15, 0x4b, 0xbe, 0x80, 0x86, 0x92,
+	0x3d, 0x4e, 0x90, 0x8b, 0x38, 0xa2, 0x7b, 0xba, 0x7e, 0x50, 0x14, 0xd3, 0x0c, 0x39, 0x06, 0x7b,
+	0x53, 0x97, 0xf2, 0xa0, 0xa5, 0xef, 0xa8, 0x6f, 0x08, 0x4f, 0x4f, 0x69, 0x82, 0xff, 0xa6, 0x41,
+	0x82, 0x3e, 0xdd, 0xd7, 0x2a, 0x0a, 0xdc, 0xfd, 0x09, 0xf6, 0x9e, 0xc8, 0x27, 0x0d, 0xa8, 0x5d,
+	0x0d, 0x2f, 0x07, 0xa3, 0xcb, 0x9f, 0xdb, 0x25, 0xb2, 0x07, 0xf6, 0x5f, 0xde, 0x6f, 0xa3, 0x81,
+	0xf7, 0xc7, 0x70, 0xd0, 0x36, 0x48, 0x13, 0xea, 0x6c, 0xf8, 0xcb, 0xf0, 0x47, 0x85, 0xca, 0x2a,
+	0x73, 0xf8, 0xf7, 0xd5, 0x88, 0x0d, 0x07, 0x6d, 0xf3, 0x87, 0xfe, 0xdb, 0x87, 0x8e, 0x71, 0xff,
+	0xd0, 0x31, 0xde, 0x3f, 0x74, 0x8c, 0x57, 0x8f, 0x9d, 0xd2, 0xfd, 0x63, 0xa7, 0xf4, 0xee, 0xb1,
+	0x53, 0xfa, 0xe7, 0x70, 0xb3, 0xdb, 0xee, 0x8a, 0xed, 0xa6, 0x57, 0xdb, 0xa4, 0xaa, 0x77, 0xdb,
+	0x57, 0x1f, 0x06, 0x00, 0x47, 0x8a, 0xf1, 0x77, 0xfb, 0x04, 0x00, 0x00,
 }
 
 func (m *Signature) Marshal() (dAtA []byte, err error) {
@@ -429,6 +621,121 @@ func (m *Signature) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Attestation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Attestation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Attestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SubmittedAt != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SubmittedAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Match {
+		i--
+		if m.Match {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Sha256Hex) > 0 {
+		for iNdEx := len(m.Sha256Hex) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Sha256Hex[iNdEx])
+			copy(dAtA[i:], m.Sha256Hex[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Sha256Hex[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Attester) > 0 {
+		i -= len(m.Attester)
+		copy(dAtA[i:], m.Attester)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Attester)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ReleaseId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ReleaseId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PublisherKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PublisherKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PublisherKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AddedAt != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.AddedAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Algo) > 0 {
+		i -= len(m.Algo)
+		copy(dAtA[i:], m.Algo)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Algo)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Publisher) > 0 {
+		i -= len(m.Publisher)
+		copy(dAtA[i:], m.Publisher)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Publisher)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.KeyId) > 0 {
+		i -= len(m.KeyId)
+		copy(dAtA[i:], m.KeyId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.KeyId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Artifact) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -528,6 +835,28 @@ func (m *Release) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Required {
+		i--
+		if m.Required {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.YankedAt != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.YankedAt))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.YankReason) > 0 {
+		i -= len(m.YankReason)
+		copy(dAtA[i:], m.YankReason)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.YankReason)))
+		i--
+		dAtA[i] = 0x6a
+	}
 	if m.EmergencyUntil != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.EmergencyUntil))
 		i--
@@ -663,109 +992,495 @@ func (m *Signature) Size() (n int) {
 	return n
 }
 
-func (m *Artifact) Size() (n int) {
+func (m *Attestation) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Platform)
+	if m.ReleaseId != 0 {
+		n += 1 + sovTypes(uint64(m.ReleaseId))
+	}
+	l = len(m.Attester)
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
-	l = len(m.Kind)
+	if len(m.Sha256Hex) > 0 {
+		for _, s := range m.Sha256Hex {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.Match {
+		n += 2
+	}
+	if m.SubmittedAt != 0 {
+		n += 1 + sovTypes(uint64(m.SubmittedAt))
+	}
+	return n
+}
+
+func (m *PublisherKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.KeyId)
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
-	l = len(m.Cid)
+	l = len(m.Publisher)
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
-	l = len(m.Sha256Hex)
+	l = len(m.Algo)
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
-	if m.Size_ != 0 {
-		n += 1 + sovTypes(uint64(m.Size_))
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
 	}
-	if len(m.Urls) > 0 {
-		for _, s := range m.Urls {
-			l = len(s)
-			n += 1 + l + sovTypes(uint64(l))
-		}
+	if m.AddedAt != 0 {
+		n += 1 + sovTypes(uint64(m.AddedAt))
 	}
-	if len(m.Signatures) > 0 {
-		for _, e := range m.Signatures {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *Artifact) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Platform)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Cid)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Sha256Hex)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovTypes(uint64(m.Size_))
+	}
+	if len(m.Urls) > 0 {
+		for _, s := range m.Urls {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Signatures) > 0 {
+		for _, e := range m.Signatures {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Release) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Artifacts) > 0 {
+		for _, e := range m.Artifacts {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = len(m.Publisher)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Notes)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovTypes(uint64(m.CreatedAt))
+	}
+	if m.Yanked {
+		n += 2
+	}
+	if len(m.Supersedes) > 0 {
+		l = 0
+		for _, e := range m.Supersedes {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	if m.Status != 0 {
+		n += 1 + sovTypes(uint64(m.Status))
+	}
+	if m.EmergencyOk {
+		n += 2
+	}
+	if m.EmergencyUntil != 0 {
+		n += 1 + sovTypes(uint64(m.EmergencyUntil))
+	}
+	l = len(m.YankReason)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.YankedAt != 0 {
+		n += 1 + sovTypes(uint64(m.YankedAt))
+	}
+	if m.Required {
+		n += 2
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Signature) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Signature: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Signature: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Algo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Algo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sig = append(m.Sig[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sig == nil {
+				m.Sig = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Attestation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Attestation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Attestation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseId", wireType)
+			}
+			m.ReleaseId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReleaseId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attester", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attester = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sha256Hex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sha256Hex = append(m.Sha256Hex, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Match", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Match = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmittedAt", wireType)
+			}
+			m.SubmittedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmittedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *Release) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovTypes(uint64(m.Id))
-	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Channel)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if len(m.Artifacts) > 0 {
-		for _, e := range m.Artifacts {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
-		}
-	}
-	l = len(m.Publisher)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Notes)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.CreatedAt != 0 {
-		n += 1 + sovTypes(uint64(m.CreatedAt))
-	}
-	if m.Yanked {
-		n += 2
-	}
-	if len(m.Supersedes) > 0 {
-		l = 0
-		for _, e := range m.Supersedes {
-			l += sovTypes(uint64(e))
-		}
-		n += 1 + sovTypes(uint64(l)) + l
-	}
-	if m.Status != 0 {
-		n += 1 + sovTypes(uint64(m.Status))
-	}
-	if m.EmergencyOk {
-		n += 2
-	}
-	if m.EmergencyUntil != 0 {
-		n += 1 + sovTypes(uint64(m.EmergencyUntil))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Signature) Unmarshal(dAtA []byte) error {
+func (m *PublisherKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -788,10 +1503,10 @@ func (m *Signature) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Signature: wiretype end group for non-group")
+			return fmt.Errorf("proto: PublisherKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Signature: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PublisherKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -827,6 +1542,38 @@ func (m *Signature) Unmarshal(dAtA []byte) error {
 			m.KeyId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Publisher", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Publisher = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Algo", wireType)
 			}
@@ -858,9 +1605,9 @@ func (m *Signature) Unmarshal(dAtA []byte) error {
 			}
 			m.Algo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -887,11 +1634,30 @@ func (m *Signature) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sig = append(m.Sig[:0], dAtA[iNdEx:postIndex]...)
-			if m.Sig == nil {
-				m.Sig = []byte{}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedAt", wireType)
+			}
+			m.AddedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AddedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1559,6 +2325,77 @@ func (m *Release) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YankReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.YankReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YankedAt", wireType)
+			}
+			m.YankedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.YankedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Required", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Required = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])